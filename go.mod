@@ -7,6 +7,7 @@ require (
 	github.com/planetscale/vtprotobuf v0.6.0
 	github.com/spf13/pflag v1.0.5
 	golang.org/x/sys v0.33.0
+	golang.org/x/text v0.28.0
 	google.golang.org/protobuf v1.33.0
 )
 
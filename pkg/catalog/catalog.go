@@ -0,0 +1,486 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package catalog keeps a model of databases, tables and views built from the
+// DDL statements applied to it.
+//
+// Every statement that changes the model produces a new version of it, a Schema.
+// A Schema is never changed once it has been produced, so it can be shared and
+// read while the catalog goes on ingesting statements.
+//
+// Database and table names are case sensitive, as they are in MySQL on Linux.
+// Column, index and constraint names are not.
+package catalog
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sysvars"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// Catalog ingests DDL statements and keeps every version of the schema they built.
+type Catalog struct {
+	mu        sync.RWMutex
+	defaultDB string
+	versions  []*Schema
+	// noForeignKeyChecks is set while foreign_key_checks is off, which lets
+	// tables referenced by foreign keys be dropped.
+	noForeignKeyChecks bool
+}
+
+// New returns a catalog whose first version holds the given database, which is
+// also the default for unqualified table names. If defaultDB is empty, the
+// catalog starts without any database.
+func New(defaultDB string) *Catalog {
+	s := &Schema{databases: map[string]*Database{}}
+	if defaultDB != "" {
+		s.databases[defaultDB] = newDatabase(defaultDB)
+	}
+	return &Catalog{defaultDB: defaultDB, versions: []*Schema{s}}
+}
+
+// Version returns the number of the current version of the schema. The first
+// version is 0.
+func (c *Catalog) Version() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.versions) - 1
+}
+
+// Schema returns the current version of the schema.
+func (c *Catalog) Schema() *Schema {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.versions[len(c.versions)-1]
+}
+
+// SchemaAt returns the given version of the schema, or nil if there is no such version.
+func (c *Catalog) SchemaAt(version int) *Schema {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if version < 0 || version >= len(c.versions) {
+		return nil
+	}
+	return c.versions[version]
+}
+
+// DefaultDatabase returns the database unqualified table names refer to.
+func (c *Catalog) DefaultDatabase() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.defaultDB
+}
+
+// Table returns the table with the given name from the current version of the
+// schema, or nil. Unqualified names refer to the default database.
+func (c *Catalog) Table(name sqlparser.TableName) *Table {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	db := c.defaultDB
+	if name.Qualifier.NotEmpty() {
+		db = name.Qualifier.String()
+	}
+	return c.versions[len(c.versions)-1].Table(db, name.Name.String())
+}
+
+// Apply applies a statement to the catalog. Statements that change the schema
+// produce a new version of it; the other ones are ignored, so a whole dump can
+// be applied. When the statement fails, the catalog is left as it was.
+//
+// The statements understood are CREATE, ALTER and DROP DATABASE; CREATE, ALTER,
+// DROP, RENAME and TRUNCATE TABLE; CREATE, ALTER and DROP VIEW; USE; and SET
+// foreign_key_checks.
+func (c *Catalog) Apply(stmt sqlparser.Statement) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current := c.versions[len(c.versions)-1]
+	ch := &change{
+		schema: &Schema{
+			Version:   len(c.versions),
+			databases: make(map[string]*Database, len(current.databases)),
+		},
+		defaultDB:          c.defaultDB,
+		noForeignKeyChecks: c.noForeignKeyChecks,
+		copied:             map[string]bool{},
+	}
+	for name, db := range current.databases {
+		ch.schema.databases[name] = db
+	}
+	if err := ch.apply(stmt); err != nil {
+		return err
+	}
+	c.defaultDB = ch.defaultDB
+	c.noForeignKeyChecks = ch.noForeignKeyChecks
+	if ch.changed {
+		c.versions = append(c.versions, ch.schema)
+	}
+	return nil
+}
+
+// Load parses the statements in sql and applies them in order. It stops at the
+// first statement that fails; the ones before it stay applied.
+func (c *Catalog) Load(parser *sqlparser.Parser, sql string) error {
+	pieces, err := parser.SplitStatementToPieces(sql)
+	if err != nil {
+		return err
+	}
+	for _, piece := range pieces {
+		stmt, err := parser.Parse(piece)
+		if err != nil {
+			if err == sqlparser.ErrEmpty {
+				continue
+			}
+			return err
+		}
+		if err := c.Apply(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Schema is one version of the model kept by a Catalog.
+type Schema struct {
+	Version   int
+	databases map[string]*Database
+}
+
+// Database returns the database with the given name, or nil.
+func (s *Schema) Database(name string) *Database {
+	return s.databases[name]
+}
+
+// Databases returns the databases in the schema, sorted by name.
+func (s *Schema) Databases() []*Database {
+	dbs := make([]*Database, 0, len(s.databases))
+	for _, db := range s.databases {
+		dbs = append(dbs, db)
+	}
+	sort.Slice(dbs, func(i, j int) bool { return dbs[i].Name < dbs[j].Name })
+	return dbs
+}
+
+// Table returns the table with the given name in the given database, or nil.
+func (s *Schema) Table(db, name string) *Table {
+	if d := s.databases[db]; d != nil {
+		return d.tables[name]
+	}
+	return nil
+}
+
+// View returns the view with the given name in the given database, or nil.
+func (s *Schema) View(db, name string) *View {
+	if d := s.databases[db]; d != nil {
+		return d.views[name]
+	}
+	return nil
+}
+
+// Column returns the column of the given table, or nil.
+func (s *Schema) Column(db, table, column string) *Column {
+	if t := s.Table(db, table); t != nil {
+		return t.Column(column)
+	}
+	return nil
+}
+
+// TableColumns returns the names of the columns of a table or a view, or false
+// if there is no such table or view.
+func (s *Schema) TableColumns(db, table string) ([]string, bool) {
+	if t := s.Table(db, table); t != nil {
+		columns := make([]string, 0, len(t.Columns))
+		for _, col := range t.Columns {
+			columns = append(columns, col.Name)
+		}
+		return columns, true
+	}
+	if v := s.View(db, table); v != nil {
+		return v.Columns, true
+	}
+	return nil, false
+}
+
+// ColumnType returns the type of a column of a table, or nil if there is no such
+// column. The columns of views have no declared type, so they give nil too.
+//
+// The type returned is a copy whose options say whether the column is nullable,
+// and whose character set and collation fall back to the defaults of the table
+// and of its database when the column declares none.
+func (s *Schema) ColumnType(db, table, column string) *sqlparser.ColumnType {
+	t := s.Table(db, table)
+	if t == nil {
+		return nil
+	}
+	col := t.Column(column)
+	if col == nil {
+		return nil
+	}
+	ct := sqlparser.CloneRefOfColumnType(col.Type)
+	if ct.Options == nil {
+		ct.Options = &sqlparser.ColumnTypeOptions{}
+	}
+	nullable := col.Nullable
+	ct.Options.Null = &nullable
+	if ct.Charset.Name == "" && ct.Options.Collate == "" {
+		ct.Charset.Name = t.Charset(s)
+		ct.Options.Collate = t.Collation(s)
+	}
+	return ct
+}
+
+// UniqueKeys returns the columns of the primary key and of the unique keys of a
+// table, in the order they were declared. Keys that have a nullable column or an
+// expression part are left out, since they do not identify the rows.
+func (s *Schema) UniqueKeys(db, table string) [][]string {
+	t := s.Table(db, table)
+	if t == nil {
+		return nil
+	}
+	var keys [][]string
+	for _, idx := range t.Indexes {
+		if !idx.Unique() {
+			continue
+		}
+		columns := idx.ColumnNames()
+		if len(columns) != len(idx.Columns) {
+			continue
+		}
+		nullable := false
+		for _, name := range columns {
+			if col := t.Column(name); col == nil || col.Nullable {
+				nullable = true
+			}
+		}
+		if !nullable {
+			keys = append(keys, columns)
+		}
+	}
+	return keys
+}
+
+func newDatabase(name string) *Database {
+	return &Database{Name: name, tables: map[string]*Table{}, views: map[string]*View{}}
+}
+
+// change is a new version of the schema being built by a statement. Databases
+// are shared with the previous version until they are changed.
+type change struct {
+	schema             *Schema
+	defaultDB          string
+	noForeignKeyChecks bool
+	copied             map[string]bool
+	changed            bool
+}
+
+func (c *change) apply(stmt sqlparser.Statement) error {
+	switch stmt := stmt.(type) {
+	case *sqlparser.CreateDatabase:
+		return c.createDatabase(stmt)
+	case *sqlparser.AlterDatabase:
+		return c.alterDatabase(stmt)
+	case *sqlparser.DropDatabase:
+		return c.dropDatabase(stmt)
+	case *sqlparser.CreateTable:
+		return c.createTable(stmt)
+	case *sqlparser.AlterTable:
+		return c.alterTable(stmt)
+	case *sqlparser.DropTable:
+		return c.dropTable(stmt)
+	case *sqlparser.RenameTable:
+		return c.renameTable(stmt)
+	case *sqlparser.TruncateTable:
+		return c.truncateTable(stmt)
+	case *sqlparser.CreateView:
+		return c.createView(stmt)
+	case *sqlparser.AlterView:
+		return c.alterView(stmt)
+	case *sqlparser.DropView:
+		return c.dropView(stmt)
+	case *sqlparser.Use:
+		return c.use(stmt)
+	case *sqlparser.Set:
+		c.set(stmt)
+	}
+	return nil
+}
+
+// database returns the database with the given name to be changed.
+func (c *change) database(name string) (*Database, error) {
+	db := c.schema.databases[name]
+	if db == nil {
+		return nil, unknownDatabase(name)
+	}
+	if !c.copied[name] {
+		db = db.clone()
+		c.schema.databases[name] = db
+		c.copied[name] = true
+	}
+	c.changed = true
+	return db, nil
+}
+
+// qualify returns the database and the name of a table.
+func (c *change) qualify(name sqlparser.TableName) (string, string, error) {
+	if name.Qualifier.NotEmpty() {
+		return name.Qualifier.String(), name.Name.String(), nil
+	}
+	if c.defaultDB == "" {
+		return "", "", noDatabaseSelected()
+	}
+	return c.defaultDB, name.Name.String(), nil
+}
+
+// table returns a copy of the table with the given name to be changed. The copy
+// replaces the table in its database.
+func (c *change) table(name sqlparser.TableName) (*Table, error) {
+	dbName, tableName, err := c.qualify(name)
+	if err != nil {
+		return nil, err
+	}
+	if c.schema.Table(dbName, tableName) == nil {
+		return nil, noSuchTable(dbName, tableName)
+	}
+	db, err := c.database(dbName)
+	if err != nil {
+		return nil, err
+	}
+	t := db.tables[tableName].clone()
+	t.Version = c.schema.Version
+	db.tables[tableName] = t
+	return t, nil
+}
+
+func (c *change) use(stmt *sqlparser.Use) error {
+	name := stmt.DBName.String()
+	if c.schema.databases[name] == nil {
+		return unknownDatabase(name)
+	}
+	c.defaultDB = name
+	return nil
+}
+
+// set records the value a SET statement gives foreign_key_checks in the
+// session. Values that are not ones of a boolean are ignored.
+func (c *change) set(stmt *sqlparser.Set) {
+	for _, expr := range stmt.Exprs {
+		if expr.Var.Scope != sqlparser.NoScope && expr.Var.Scope != sqlparser.SessionScope || !expr.Var.Name.EqualString(sysvars.ForeignKeyChecks) {
+			continue
+		}
+		var value string
+		switch val := expr.Expr.(type) {
+		case *sqlparser.Literal:
+			value = val.Val
+		case sqlparser.BoolVal:
+			value = strconv.FormatBool(bool(val))
+		}
+		switch strings.ToLower(value) {
+		case "1", "on", "true":
+			c.noForeignKeyChecks = false
+		case "0", "off", "false":
+			c.noForeignKeyChecks = true
+		}
+	}
+}
+
+func (c *change) createDatabase(stmt *sqlparser.CreateDatabase) error {
+	name := stmt.DBName.String()
+	if c.schema.databases[name] != nil {
+		if stmt.IfNotExists {
+			return nil
+		}
+		return vterrors.NewErrorf(vtrpcpb.Code_ALREADY_EXISTS, vterrors.DbCreateExists, "Can't create database '%s'; database exists", name)
+	}
+	db := newDatabase(name)
+	setDatabaseOptions(db, stmt.CreateOptions)
+	c.schema.databases[name] = db
+	c.copied[name] = true
+	c.changed = true
+	return nil
+}
+
+func (c *change) alterDatabase(stmt *sqlparser.AlterDatabase) error {
+	name := stmt.DBName.String()
+	if name == "" {
+		if c.defaultDB == "" {
+			return noDatabaseSelected()
+		}
+		name = c.defaultDB
+	}
+	db, err := c.database(name)
+	if err != nil {
+		return err
+	}
+	setDatabaseOptions(db, stmt.AlterOptions)
+	return nil
+}
+
+func setDatabaseOptions(db *Database, options []sqlparser.DatabaseOption) {
+	for _, opt := range options {
+		switch opt.Type {
+		case sqlparser.CharacterSetType:
+			db.Charset = opt.Value
+		case sqlparser.CollateType:
+			db.Collation = opt.Value
+		}
+	}
+}
+
+func (c *change) dropDatabase(stmt *sqlparser.DropDatabase) error {
+	name := stmt.DBName.String()
+	if c.schema.databases[name] == nil {
+		if stmt.IfExists {
+			return nil
+		}
+		return vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.DbDropExists, "Can't drop database '%s'; database doesn't exist", name)
+	}
+	delete(c.schema.databases, name)
+	c.changed = true
+	if c.defaultDB == name {
+		c.defaultDB = ""
+	}
+	return nil
+}
+
+func unknownDatabase(name string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.BadDb, "Unknown database '%s'", name)
+}
+
+func noDatabaseSelected() error {
+	return vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.NoDB, "No database selected")
+}
+
+func noSuchTable(db, name string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.NoSuchTable, "Table '%s.%s' doesn't exist", db, name)
+}
+
+func referencedTable(name, fk, table string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.FKCannotDropParent, "Cannot drop table '%s' referenced by a foreign key constraint '%s' on table '%s'.", name, fk, table)
+}
+
+func unknownTable(db, name string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.UnknownTable, "Unknown table '%s.%s'", db, name)
+}
+
+func tableExists(name string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_ALREADY_EXISTS, vterrors.Undefined, "Table '%s' already exists", name)
+}
@@ -0,0 +1,934 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+const primaryKeyName = "PRIMARY"
+
+func (c *change) createTable(stmt *sqlparser.CreateTable) error {
+	dbName, name, err := c.qualify(stmt.Table)
+	if err != nil {
+		return err
+	}
+	if c.schema.databases[dbName] == nil {
+		return unknownDatabase(dbName)
+	}
+	if c.schema.Table(dbName, name) != nil || c.schema.View(dbName, name) != nil {
+		if stmt.IfNotExists {
+			return nil
+		}
+		return tableExists(name)
+	}
+
+	var t *Table
+	if stmt.OptLike != nil {
+		likeDB, likeName, err := c.qualify(stmt.OptLike.LikeTable)
+		if err != nil {
+			return err
+		}
+		like := c.schema.Table(likeDB, likeName)
+		if like == nil {
+			return noSuchTable(likeDB, likeName)
+		}
+		// CREATE TABLE ... LIKE copies everything but the foreign keys.
+		t = like.clone()
+		t.ForeignKeys = nil
+		t.Options = removeOption(t.Options, "auto_increment")
+	} else {
+		t = &Table{}
+		if err := t.addSpec(stmt.TableSpec, dbName, name); err != nil {
+			return err
+		}
+	}
+	t.Database = dbName
+	t.Name = name
+	t.Temporary = stmt.Temp
+	t.Version = c.schema.Version
+
+	db, err := c.database(dbName)
+	if err != nil {
+		return err
+	}
+	db.tables[name] = t
+	return nil
+}
+
+// addSpec adds the columns, indexes and constraints of a CREATE TABLE statement
+// to an empty table.
+func (t *Table) addSpec(spec *sqlparser.TableSpec, db, name string) error {
+	if spec == nil {
+		return nil
+	}
+	// The names of the table are needed to name its constraints.
+	t.Database, t.Name = db, name
+	for _, def := range spec.Columns {
+		if err := t.addColumn(def, false, ""); err != nil {
+			return err
+		}
+	}
+	for _, def := range spec.Indexes {
+		if err := t.addIndex(newIndex(def)); err != nil {
+			return err
+		}
+	}
+	for _, def := range spec.Constraints {
+		if err := t.addConstraint(def); err != nil {
+			return err
+		}
+	}
+	t.Options = sqlparser.CloneTableOptions(spec.Options)
+	return nil
+}
+
+func (c *change) alterTable(stmt *sqlparser.AlterTable) error {
+	t, err := c.table(stmt.Table)
+	if err != nil {
+		return err
+	}
+	var rename *sqlparser.TableName
+	for _, opt := range stmt.AlterOptions {
+		if opt, ok := opt.(*sqlparser.RenameTableName); ok {
+			rename = &opt.Table
+			continue
+		}
+		if opt, ok := opt.(*sqlparser.DropColumn); ok {
+			if err := c.checkReferencedColumn(t, opt.Name.Name.String()); err != nil {
+				return err
+			}
+		}
+		if err := t.alter(opt); err != nil {
+			return err
+		}
+	}
+	if rename != nil {
+		return c.moveTable(t.Database, t.Name, *rename)
+	}
+	return nil
+}
+
+// alter applies an option of an ALTER TABLE statement. The options that do not
+// change the schema, like ALGORITHM or LOCK, are ignored.
+func (t *Table) alter(opt sqlparser.AlterOption) error {
+	switch opt := opt.(type) {
+	case *sqlparser.AddColumns:
+		first, after := opt.First, columnName(opt.After)
+		for _, def := range opt.Columns {
+			if err := t.addColumn(def, first, after); err != nil {
+				return err
+			}
+			// The columns added together stay together.
+			if first || after != "" {
+				first, after = false, def.Name.String()
+			}
+		}
+	case *sqlparser.DropColumn:
+		return t.dropColumn(opt.Name.Name.String())
+	case *sqlparser.ModifyColumn:
+		return t.changeColumn(opt.NewColDefinition.Name.String(), opt.NewColDefinition, opt.First, columnName(opt.After))
+	case *sqlparser.ChangeColumn:
+		return t.changeColumn(opt.OldColumn.Name.String(), opt.NewColDefinition, opt.First, columnName(opt.After))
+	case *sqlparser.RenameColumn:
+		return t.renameColumn(opt.OldName.Name.String(), opt.NewName.Name.String())
+	case *sqlparser.AlterColumn:
+		col := t.Column(opt.Column.Name.String())
+		if col == nil {
+			return badField(opt.Column.Name.String(), t.Name)
+		}
+		if opt.DropDefault {
+			col.Default = nil
+		} else if opt.DefaultVal != nil {
+			col.Default = sqlparser.CloneExpr(opt.DefaultVal)
+		}
+		if opt.Invisible != nil {
+			col.Invisible = *opt.Invisible
+		}
+		col.syncType()
+	case *sqlparser.AddIndexDefinition:
+		return t.addIndex(newIndex(opt.IndexDefinition))
+	case *sqlparser.AddConstraintDefinition:
+		return t.addConstraint(opt.ConstraintDefinition)
+	case *sqlparser.DropKey:
+		return t.dropKey(opt)
+	case *sqlparser.RenameIndex:
+		i := t.indexIndex(opt.OldName.String())
+		if i < 0 {
+			return keyDoesNotExist(opt.OldName.String(), t.Name)
+		}
+		if j := t.indexIndex(opt.NewName.String()); j >= 0 && j != i {
+			return duplicateKey(opt.NewName.String())
+		}
+		t.Indexes[i].Name = opt.NewName.String()
+	case *sqlparser.AlterIndex:
+		idx := t.Index(opt.Name.String())
+		if idx == nil {
+			return keyDoesNotExist(opt.Name.String(), t.Name)
+		}
+		idx.Invisible = opt.Invisible
+	case *sqlparser.AlterCheck:
+		check := t.check(opt.Name.String())
+		if check == nil {
+			return vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.Undefined, "Check constraint '%s' is not found in the table.", opt.Name.String())
+		}
+		check.Enforced = opt.Enforced
+	case *sqlparser.AlterCharset:
+		t.setOption(&sqlparser.TableOption{Name: "charset", String: opt.CharacterSet, CaseSensitive: true})
+		if opt.Collate != "" {
+			t.setOption(&sqlparser.TableOption{Name: "collate", String: opt.Collate, CaseSensitive: true})
+		}
+	case sqlparser.TableOptions:
+		for _, option := range opt {
+			t.setOption(sqlparser.CloneRefOfTableOption(option))
+		}
+	}
+	return nil
+}
+
+// dropTable drops tables. Like MySQL, it fails while foreign_key_checks is on
+// when a table that is not dropped too has a foreign key referencing one.
+func (c *change) dropTable(stmt *sqlparser.DropTable) error {
+	type tableKey struct{ db, name string }
+	var dropped []tableKey
+	for _, name := range stmt.FromTables {
+		dbName, tableName, err := c.qualify(name)
+		if err != nil {
+			return err
+		}
+		if c.schema.Table(dbName, tableName) == nil {
+			if stmt.IfExists {
+				continue
+			}
+			return unknownTable(dbName, tableName)
+		}
+		dropped = append(dropped, tableKey{dbName, tableName})
+	}
+	if !c.noForeignKeyChecks {
+		for _, db := range c.schema.Databases() {
+			for _, other := range db.Tables() {
+				if slices.Contains(dropped, tableKey{db.Name, other.Name}) {
+					continue
+				}
+				for _, fk := range other.ForeignKeys {
+					if slices.Contains(dropped, tableKey{fk.ReferencedDatabase, fk.ReferencedTable}) {
+						return referencedTable(fk.ReferencedTable, fk.Name, other.Name)
+					}
+				}
+			}
+		}
+	}
+	for _, table := range dropped {
+		db, err := c.database(table.db)
+		if err != nil {
+			return err
+		}
+		delete(db.tables, table.name)
+	}
+	return nil
+}
+
+func (c *change) renameTable(stmt *sqlparser.RenameTable) error {
+	for _, pair := range stmt.TablePairs {
+		dbName, name, err := c.qualify(pair.FromTable)
+		if err != nil {
+			return err
+		}
+		if err := c.moveTable(dbName, name, pair.ToTable); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveTable renames a table or a view, possibly into another database. The
+// foreign keys referencing a table follow it.
+func (c *change) moveTable(dbName, name string, to sqlparser.TableName) error {
+	toDB, toName, err := c.qualify(to)
+	if err != nil {
+		return err
+	}
+	if c.schema.databases[toDB] == nil {
+		return unknownDatabase(toDB)
+	}
+	t, v := c.schema.Table(dbName, name), c.schema.View(dbName, name)
+	if t == nil && v == nil {
+		return noSuchTable(dbName, name)
+	}
+	if dbName == toDB && name == toName {
+		return nil
+	}
+	if c.schema.Table(toDB, toName) != nil || c.schema.View(toDB, toName) != nil {
+		return tableExists(toName)
+	}
+
+	from, err := c.database(dbName)
+	if err != nil {
+		return err
+	}
+	into, err := c.database(toDB)
+	if err != nil {
+		return err
+	}
+	if v != nil {
+		moved := *v
+		moved.Database, moved.Name, moved.Version = toDB, toName, c.schema.Version
+		delete(from.views, name)
+		into.views[toName] = &moved
+		return nil
+	}
+
+	moved := t
+	if t.Version != c.schema.Version {
+		moved = t.clone()
+	}
+	moved.Database, moved.Name, moved.Version = toDB, toName, c.schema.Version
+	delete(from.tables, name)
+	into.tables[toName] = moved
+
+	for _, db := range c.schema.Databases() {
+		for _, other := range db.Tables() {
+			if !other.references(dbName, name) {
+				continue
+			}
+			changed, err := c.table(sqlparser.NewTableNameWithQualifier(other.Name, db.Name))
+			if err != nil {
+				return err
+			}
+			for _, fk := range changed.ForeignKeys {
+				if fk.ReferencedDatabase == dbName && fk.ReferencedTable == name {
+					fk.ReferencedDatabase, fk.ReferencedTable = toDB, toName
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkReferencedColumn fails, while foreign_key_checks is on, when the column
+// of a table that is dropped is referenced by a foreign key, as MySQL does.
+func (c *change) checkReferencedColumn(t *Table, column string) error {
+	if c.noForeignKeyChecks {
+		return nil
+	}
+	for _, db := range c.schema.Databases() {
+		for _, other := range db.Tables() {
+			for _, fk := range other.ForeignKeys {
+				if fk.ReferencedDatabase != t.Database || fk.ReferencedTable != t.Name {
+					continue
+				}
+				for _, col := range fk.ReferencedColumns {
+					if strings.EqualFold(col, column) {
+						return vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.FKColumnCannotDropChild, "Cannot drop column '%s': needed in a foreign key constraint '%s' of table '%s'", column, fk.Name, other.Name)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// references reports whether any foreign key of the table references the given table.
+func (t *Table) references(db, name string) bool {
+	for _, fk := range t.ForeignKeys {
+		if fk.ReferencedDatabase == db && fk.ReferencedTable == name {
+			return true
+		}
+	}
+	return false
+}
+
+// truncateTable checks that the table exists. TRUNCATE TABLE only removes rows,
+// so it does not change the schema.
+func (c *change) truncateTable(stmt *sqlparser.TruncateTable) error {
+	dbName, name, err := c.qualify(stmt.Table)
+	if err != nil {
+		return err
+	}
+	if c.schema.Table(dbName, name) == nil {
+		return noSuchTable(dbName, name)
+	}
+	return nil
+}
+
+func (c *change) createView(stmt *sqlparser.CreateView) error {
+	dbName, name, err := c.qualify(stmt.ViewName)
+	if err != nil {
+		return err
+	}
+	if c.schema.databases[dbName] == nil {
+		return unknownDatabase(dbName)
+	}
+	if c.schema.Table(dbName, name) != nil || (c.schema.View(dbName, name) != nil && !stmt.IsReplace) {
+		return tableExists(name)
+	}
+	v, err := newView(dbName, name, stmt.Columns, stmt.Select)
+	if err != nil {
+		return err
+	}
+	v.Version = c.schema.Version
+	v.Algorithm = stmt.Algorithm
+	v.Definer = sqlparser.CloneRefOfDefiner(stmt.Definer)
+	v.Security = stmt.Security
+	v.CheckOption = stmt.CheckOption
+
+	db, err := c.database(dbName)
+	if err != nil {
+		return err
+	}
+	db.views[name] = v
+	return nil
+}
+
+func (c *change) alterView(stmt *sqlparser.AlterView) error {
+	dbName, name, err := c.qualify(stmt.ViewName)
+	if err != nil {
+		return err
+	}
+	if c.schema.View(dbName, name) == nil {
+		return noSuchTable(dbName, name)
+	}
+	v, err := newView(dbName, name, stmt.Columns, stmt.Select)
+	if err != nil {
+		return err
+	}
+	v.Version = c.schema.Version
+	v.Algorithm = stmt.Algorithm
+	v.Definer = sqlparser.CloneRefOfDefiner(stmt.Definer)
+	v.Security = stmt.Security
+	v.CheckOption = stmt.CheckOption
+
+	db, err := c.database(dbName)
+	if err != nil {
+		return err
+	}
+	db.views[name] = v
+	return nil
+}
+
+func (c *change) dropView(stmt *sqlparser.DropView) error {
+	for _, name := range stmt.FromTables {
+		dbName, viewName, err := c.qualify(name)
+		if err != nil {
+			return err
+		}
+		if c.schema.View(dbName, viewName) == nil {
+			if stmt.IfExists {
+				continue
+			}
+			return unknownTable(dbName, viewName)
+		}
+		db, err := c.database(dbName)
+		if err != nil {
+			return err
+		}
+		delete(db.views, viewName)
+	}
+	return nil
+}
+
+func newView(db, name string, columns sqlparser.Columns, sel sqlparser.TableStatement) (*View, error) {
+	v := &View{Database: db, Name: name, Select: sqlparser.CloneTableStatement(sel)}
+	if len(columns) > 0 {
+		for _, col := range columns {
+			v.Columns = append(v.Columns, col.String())
+		}
+	} else {
+		v.Columns = selectColumnNames(sel)
+	}
+	if first, err := sqlparser.GetFirstSelect(sel); err == nil && first != nil && v.Columns != nil && first.SelectExprs != nil {
+		if len(v.Columns) != len(first.SelectExprs.Exprs) {
+			return nil, vterrors.VT03033()
+		}
+	}
+	return v, nil
+}
+
+// selectColumnNames returns the names of the columns of a select statement, or
+// nil if it has a star.
+func selectColumnNames(sel sqlparser.TableStatement) []string {
+	first, err := sqlparser.GetFirstSelect(sel)
+	if err != nil || first == nil || first.SelectExprs == nil {
+		return nil
+	}
+	var names []string
+	for _, expr := range first.SelectExprs.Exprs {
+		ae, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil
+		}
+		names = append(names, ae.ColumnName())
+	}
+	return names
+}
+
+// addColumn adds a column at the given position, or at the end, with the
+// indexes and the foreign key that come with its definition.
+func (t *Table) addColumn(def *sqlparser.ColumnDefinition, first bool, after string) error {
+	name := def.Name.String()
+	if t.columnIndex(name) >= 0 {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DupFieldName, "Duplicate column name '%s'", name)
+	}
+	t.Columns = append(t.Columns, newColumn(def))
+	if err := t.moveColumn(len(t.Columns)-1, first, after); err != nil {
+		return err
+	}
+	return t.addColumnKeys(def)
+}
+
+// addColumnKeys adds the index and foreign key declared along with a column.
+func (t *Table) addColumnKeys(def *sqlparser.ColumnDefinition) error {
+	name := def.Name.String()
+	opts := def.Type.Options
+	if opts == nil {
+		return nil
+	}
+	var keyType sqlparser.IndexType
+	switch opts.KeyOpt {
+	case sqlparser.ColKeyNone:
+	case sqlparser.ColKeyPrimary:
+		keyType = sqlparser.IndexTypePrimary
+	case sqlparser.ColKeyUnique, sqlparser.ColKeyUniqueKey:
+		keyType = sqlparser.IndexTypeUnique
+	case sqlparser.ColKeySpatialKey:
+		keyType = sqlparser.IndexTypeSpatial
+	case sqlparser.ColKeyFulltextKey:
+		keyType = sqlparser.IndexTypeFullText
+	case sqlparser.ColKey:
+		keyType = sqlparser.IndexTypeDefault
+	}
+	if opts.KeyOpt != sqlparser.ColKeyNone {
+		if err := t.addIndex(&Index{Type: keyType, Columns: []IndexColumn{{Column: name}}}); err != nil {
+			return err
+		}
+	}
+	if opts.Reference != nil {
+		return t.addForeignKey("", &sqlparser.ForeignKeyDefinition{
+			Source:              sqlparser.Columns{def.Name},
+			ReferenceDefinition: opts.Reference,
+		})
+	}
+	return nil
+}
+
+// moveColumn moves the column at index i to the front, after the named column,
+// or leaves it where it is.
+func (t *Table) moveColumn(i int, first bool, after string) error {
+	if !first && after == "" {
+		return nil
+	}
+	col := t.Columns[i]
+	t.Columns = append(t.Columns[:i], t.Columns[i+1:]...)
+	pos := 0
+	if !first {
+		pos = t.columnIndex(after)
+		if pos < 0 {
+			t.Columns = append(t.Columns[:i], append([]*Column{col}, t.Columns[i:]...)...)
+			return badField(after, t.Name)
+		}
+		pos++
+	}
+	t.Columns = append(t.Columns[:pos], append([]*Column{col}, t.Columns[pos:]...)...)
+	return nil
+}
+
+func (t *Table) dropColumn(name string) error {
+	i := t.columnIndex(name)
+	if i < 0 {
+		return cantDrop(name)
+	}
+	if len(t.Columns) == 1 {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Undefined, "You can't delete all columns with ALTER TABLE; use DROP TABLE instead")
+	}
+	for _, fk := range t.ForeignKeys {
+		for _, col := range fk.Columns {
+			if strings.EqualFold(col, name) {
+				return vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.FKColumnCannotDrop, "Cannot drop column '%s': needed in a foreign key constraint '%s'", name, fk.Name)
+			}
+		}
+	}
+	t.Columns = append(t.Columns[:i], t.Columns[i+1:]...)
+
+	// The column leaves the indexes it is part of, and the indexes left empty go away.
+	indexes := t.Indexes[:0]
+	for _, idx := range t.Indexes {
+		parts := idx.Columns[:0]
+		for _, part := range idx.Columns {
+			if part.Expression != nil || !strings.EqualFold(part.Column, name) {
+				parts = append(parts, part)
+			}
+		}
+		idx.Columns = parts
+		if len(parts) > 0 {
+			indexes = append(indexes, idx)
+		}
+	}
+	t.Indexes = indexes
+	return nil
+}
+
+// changeColumn replaces the definition of a column, renaming it if the new
+// definition has another name.
+func (t *Table) changeColumn(name string, def *sqlparser.ColumnDefinition, first bool, after string) error {
+	i := t.columnIndex(name)
+	if i < 0 {
+		return badField(name, t.Name)
+	}
+	newName := def.Name.String()
+	if j := t.columnIndex(newName); j >= 0 && j != i {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DupFieldName, "Duplicate column name '%s'", newName)
+	}
+	t.Columns[i] = newColumn(def)
+	t.renameColumnReferences(name, newName)
+	t.primaryKeyNotNull()
+	if err := t.moveColumn(i, first, after); err != nil {
+		return err
+	}
+	return t.addColumnKeys(def)
+}
+
+func (t *Table) renameColumn(from, to string) error {
+	i := t.columnIndex(from)
+	if i < 0 {
+		return badField(from, t.Name)
+	}
+	if j := t.columnIndex(to); j >= 0 && j != i {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DupFieldName, "Duplicate column name '%s'", to)
+	}
+	t.Columns[i].Name = to
+	t.renameColumnReferences(from, to)
+	return nil
+}
+
+// renameColumnReferences renames a column in the indexes and foreign keys of the table.
+func (t *Table) renameColumnReferences(from, to string) {
+	for _, idx := range t.Indexes {
+		for i := range idx.Columns {
+			if idx.Columns[i].Expression == nil && strings.EqualFold(idx.Columns[i].Column, from) {
+				idx.Columns[i].Column = to
+			}
+		}
+	}
+	for _, fk := range t.ForeignKeys {
+		for i, col := range fk.Columns {
+			if strings.EqualFold(col, from) {
+				fk.Columns[i] = to
+			}
+		}
+	}
+}
+
+// addIndex adds an index, naming it after its first column if it has no name,
+// the way MySQL does.
+func (t *Table) addIndex(idx *Index) error {
+	if idx.Primary() {
+		if t.PrimaryKey() != nil {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Undefined, "Multiple primary key defined")
+		}
+		idx.Name = primaryKeyName
+	} else if idx.Name == "" {
+		idx.Name = t.indexName(idx)
+	} else if strings.EqualFold(idx.Name, primaryKeyName) {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Undefined, "Incorrect index name '%s'", idx.Name)
+	}
+	if t.indexIndex(idx.Name) >= 0 {
+		return duplicateKey(idx.Name)
+	}
+	for _, part := range idx.Columns {
+		if part.Expression == nil && t.columnIndex(part.Column) < 0 {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.KeyDoesNotExist, "Key column '%s' doesn't exist in table", part.Column)
+		}
+	}
+	t.Indexes = append(t.Indexes, idx)
+	t.primaryKeyNotNull()
+	return nil
+}
+
+// indexName returns a name for an index that was not given one: the name of its
+// first column, with a suffix if another index already has it.
+func (t *Table) indexName(idx *Index) string {
+	base := "functional_index"
+	if len(idx.Columns) > 0 && idx.Columns[0].Expression == nil {
+		base = idx.Columns[0].Column
+	}
+	name := base
+	for n := 2; t.indexIndex(name) >= 0; n++ {
+		name = base + "_" + strconv.Itoa(n)
+	}
+	return name
+}
+
+// primaryKeyNotNull makes the columns of the primary key NOT NULL.
+func (t *Table) primaryKeyNotNull() {
+	pk := t.PrimaryKey()
+	if pk == nil {
+		return
+	}
+	for _, part := range pk.Columns {
+		if col := t.Column(part.Column); col != nil {
+			col.Nullable = false
+		}
+	}
+}
+
+func (t *Table) addConstraint(def *sqlparser.ConstraintDefinition) error {
+	switch details := def.Details.(type) {
+	case *sqlparser.ForeignKeyDefinition:
+		return t.addForeignKey(def.Name.String(), details)
+	case *sqlparser.CheckConstraintDefinition:
+		name := def.Name.String()
+		if name == "" {
+			name = t.constraintName("chk", len(t.Checks))
+		}
+		if t.check(name) != nil {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Undefined, "Duplicate check constraint name '%s'.", name)
+		}
+		t.Checks = append(t.Checks, &Check{Name: name, Expr: sqlparser.CloneExpr(details.Expr), Enforced: details.Enforced})
+	}
+	return nil
+}
+
+// addForeignKey adds a foreign key, and an index on its columns when there is
+// none it can use, the way MySQL does.
+func (t *Table) addForeignKey(name string, def *sqlparser.ForeignKeyDefinition) error {
+	ref := def.ReferenceDefinition
+	fk := &ForeignKey{
+		Name:               name,
+		ReferencedDatabase: t.Database,
+		ReferencedTable:    ref.ReferencedTable.Name.String(),
+		OnDelete:           ref.OnDelete,
+		OnUpdate:           ref.OnUpdate,
+	}
+	if ref.ReferencedTable.Qualifier.NotEmpty() {
+		fk.ReferencedDatabase = ref.ReferencedTable.Qualifier.String()
+	}
+	for _, col := range def.Source {
+		if t.columnIndex(col.String()) < 0 {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.KeyDoesNotExist, "Key column '%s' doesn't exist in table", col.String())
+		}
+		fk.Columns = append(fk.Columns, col.String())
+	}
+	for _, col := range ref.ReferencedColumns {
+		fk.ReferencedColumns = append(fk.ReferencedColumns, col.String())
+	}
+	if fk.Name == "" {
+		fk.Name = t.constraintName("ibfk", len(t.ForeignKeys))
+	}
+	if t.ForeignKey(fk.Name) != nil {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Undefined, "Duplicate foreign key constraint name '%s'", fk.Name)
+	}
+	t.ForeignKeys = append(t.ForeignKeys, fk)
+
+	if t.hasIndexOn(fk.Columns) {
+		return nil
+	}
+	idx := &Index{Name: def.IndexName.String()}
+	if idx.Name == "" {
+		idx.Name = name
+	}
+	for _, col := range fk.Columns {
+		idx.Columns = append(idx.Columns, IndexColumn{Column: col})
+	}
+	return t.addIndex(idx)
+}
+
+// hasIndexOn reports whether an index starts with the given columns.
+func (t *Table) hasIndexOn(columns []string) bool {
+	for _, idx := range t.Indexes {
+		if len(idx.Columns) < len(columns) {
+			continue
+		}
+		found := true
+		for i, col := range columns {
+			if idx.Columns[i].Expression != nil || !strings.EqualFold(idx.Columns[i].Column, col) {
+				found = false
+				break
+			}
+		}
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// constraintName returns a name for a constraint that was not given one, like
+// t_ibfk_1 for the first foreign key of t.
+func (t *Table) constraintName(kind string, n int) string {
+	for {
+		n++
+		name := fmt.Sprintf("%s_%s_%d", t.Name, kind, n)
+		if t.ForeignKey(name) == nil && t.check(name) == nil {
+			return name
+		}
+	}
+}
+
+func (t *Table) check(name string) *Check {
+	for _, check := range t.Checks {
+		if strings.EqualFold(check.Name, name) {
+			return check
+		}
+	}
+	return nil
+}
+
+func (t *Table) dropKey(opt *sqlparser.DropKey) error {
+	name := opt.Name.String()
+	switch opt.Type {
+	case sqlparser.PrimaryKeyType:
+		name = primaryKeyName
+		fallthrough
+	case sqlparser.NormalKeyType:
+		i := t.indexIndex(name)
+		if i < 0 {
+			return cantDrop(name)
+		}
+		t.Indexes = append(t.Indexes[:i], t.Indexes[i+1:]...)
+	case sqlparser.ForeignKeyType:
+		for i, fk := range t.ForeignKeys {
+			if strings.EqualFold(fk.Name, name) {
+				t.ForeignKeys = append(t.ForeignKeys[:i], t.ForeignKeys[i+1:]...)
+				return nil
+			}
+		}
+		return cantDrop(name)
+	case sqlparser.CheckKeyType:
+		for i, check := range t.Checks {
+			if strings.EqualFold(check.Name, name) {
+				t.Checks = append(t.Checks[:i], t.Checks[i+1:]...)
+				return nil
+			}
+		}
+		return cantDrop(name)
+	}
+	return nil
+}
+
+// setOption sets a table option, replacing the option with the same name.
+func (t *Table) setOption(opt *sqlparser.TableOption) {
+	if i := t.optionIndex(opt.Name); i >= 0 {
+		t.Options[i] = opt
+		return
+	}
+	t.Options = append(t.Options, opt)
+}
+
+func removeOption(options sqlparser.TableOptions, name string) sqlparser.TableOptions {
+	var res sqlparser.TableOptions
+	for _, opt := range options {
+		if !strings.EqualFold(opt.Name, name) {
+			res = append(res, opt)
+		}
+	}
+	return res
+}
+
+func newColumn(def *sqlparser.ColumnDefinition) *Column {
+	col := &Column{
+		Name:     def.Name.String(),
+		Type:     sqlparser.CloneRefOfColumnType(def.Type),
+		Nullable: true,
+	}
+	// The key and the reference of the column are kept with the indexes and the
+	// foreign keys of the table.
+	if opts := col.Type.Options; opts != nil {
+		opts.KeyOpt = sqlparser.ColKeyNone
+		opts.Reference = nil
+		if opts.Null != nil {
+			col.Nullable = *opts.Null
+		}
+		col.AutoIncrement = opts.Autoincrement
+		col.Default = opts.Default
+		col.Generated = opts.As
+		col.Invisible = opts.Invisible != nil && *opts.Invisible
+		if opts.Comment != nil {
+			col.Comment = opts.Comment.Val
+		}
+	}
+	return col
+}
+
+// syncType updates the options in the type of the column after its default or
+// its visibility changed.
+func (c *Column) syncType() {
+	if c.Type.Options == nil {
+		c.Type.Options = &sqlparser.ColumnTypeOptions{}
+	}
+	c.Type.Options.Default = c.Default
+	if c.Invisible {
+		invisible := true
+		c.Type.Options.Invisible = &invisible
+	} else {
+		c.Type.Options.Invisible = nil
+	}
+}
+
+func newIndex(def *sqlparser.IndexDefinition) *Index {
+	idx := &Index{
+		Name:    def.Info.Name.String(),
+		Type:    def.Info.Type,
+		Options: sqlparser.CloneSliceOfRefOfIndexOption(def.Options),
+	}
+	if idx.Name == "" && def.Info.ConstraintName.NotEmpty() {
+		idx.Name = def.Info.ConstraintName.String()
+	}
+	for _, col := range def.Columns {
+		idx.Columns = append(idx.Columns, IndexColumn{
+			Column:     col.Column.String(),
+			Length:     col.Length,
+			Expression: sqlparser.CloneExpr(col.Expression),
+			Descending: col.Direction == sqlparser.DescOrder,
+		})
+	}
+	for _, opt := range def.Options {
+		if strings.EqualFold(opt.Name, "invisible") {
+			idx.Invisible = true
+		}
+	}
+	return idx
+}
+
+func columnName(col *sqlparser.ColName) string {
+	if col == nil {
+		return ""
+	}
+	return col.Name.String()
+}
+
+func badField(name, table string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in '%s'", name, table)
+}
+
+func keyDoesNotExist(name, table string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.KeyDoesNotExist, "Key '%s' doesn't exist in table '%s'", name, table)
+}
+
+func cantDrop(name string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.KeyDoesNotExist, "Can't DROP '%s'; check that column/key exists", name)
+}
+
+func duplicateKey(name string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Undefined, "Duplicate key name '%s'", name)
+}
@@ -0,0 +1,664 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// ObjectKind is the kind of an object of a dependency graph.
+type ObjectKind int8
+
+const (
+	// TableObject is a base table.
+	TableObject ObjectKind = iota
+	// ViewObject is a view.
+	ViewObject
+	// ProcedureObject is a stored procedure.
+	ProcedureObject
+)
+
+// String returns the name of the kind.
+func (k ObjectKind) String() string {
+	switch k {
+	case TableObject:
+		return "table"
+	case ViewObject:
+		return "view"
+	case ProcedureObject:
+		return "procedure"
+	}
+	return "unknown"
+}
+
+// Object is a table, a view or a stored procedure.
+type Object struct {
+	Kind     ObjectKind
+	Database string
+	Name     string
+}
+
+// String returns the kind and the qualified name of the object.
+func (o Object) String() string {
+	return o.Kind.String() + " " + o.Database + "." + o.Name
+}
+
+// objectKey identifies an object by its name. Tables and views share a
+// namespace, procedures have their own.
+type objectKey struct {
+	routine  bool
+	db, name string
+}
+
+func (o Object) key() objectKey {
+	return objectKey{routine: o.Kind == ProcedureObject, db: o.Database, name: o.Name}
+}
+
+// reference is a reference of an object to another one. The foreign keys of
+// a table keep the name of their constraint, so that dropping one removes its
+// reference.
+type reference struct {
+	to         objectKey
+	foreignKey bool
+	constraint string
+}
+
+// Reference is a reference of an object to another one. ForeignKey is set for
+// the foreign keys of tables, with the name of their constraint when they have
+// one. An object that is not in the graph is given as a table, or as a
+// procedure when it is called, since what else it is is unknown.
+type Reference struct {
+	From       Object
+	To         Object
+	ForeignKey bool
+	Constraint string
+}
+
+// String returns the objects of the reference.
+func (r Reference) String() string {
+	return r.From.String() + " -> " + r.To.String()
+}
+
+// DependencyGraph records which tables, views and procedures refer to which,
+// from the statements that create them. Tables refer to the tables their
+// foreign keys reference, views to the tables and views their queries read,
+// and procedures to the tables and views their statements use and the
+// procedures they call.
+//
+// References to objects that are not in the graph are kept, so objects can be
+// added in any order, but they take no part in the orders the graph gives:
+// ExternalReferences returns them.
+type DependencyGraph struct {
+	defaultDB string
+	objects   []Object
+	refs      map[objectKey][]reference
+}
+
+// NewDependencyGraph returns an empty graph. Unqualified names refer to
+// defaultDB until a USE statement changes it.
+func NewDependencyGraph(defaultDB string) *DependencyGraph {
+	return &DependencyGraph{defaultDB: defaultDB, refs: map[objectKey][]reference{}}
+}
+
+// Add records the object a statement creates, changes or drops.
+//
+// The statements understood are CREATE, DROP and RENAME TABLE; ALTER TABLE
+// adding or dropping foreign keys or renaming the table; CREATE, ALTER and DROP VIEW; CREATE and DROP
+// PROCEDURE; DROP DATABASE and USE. The other ones are ignored, so a whole dump
+// can be added.
+func (g *DependencyGraph) Add(stmt sqlparser.Statement) error {
+	switch stmt := stmt.(type) {
+	case *sqlparser.CreateTable:
+		obj, err := g.object(TableObject, stmt.Table)
+		if err != nil {
+			return err
+		}
+		var refs []reference
+		if stmt.TableSpec != nil {
+			for _, constraint := range stmt.TableSpec.Constraints {
+				if ref, ok := g.foreignKey(obj.Database, constraint); ok {
+					refs = append(refs, ref)
+				}
+			}
+		}
+		g.set(obj, refs)
+	case *sqlparser.AlterTable:
+		return g.alterTable(stmt)
+	case *sqlparser.DropTable:
+		for _, name := range stmt.FromTables {
+			if err := g.remove(TableObject, name); err != nil {
+				return err
+			}
+		}
+	case *sqlparser.RenameTable:
+		for _, pair := range stmt.TablePairs {
+			if err := g.rename(pair.FromTable, pair.ToTable); err != nil {
+				return err
+			}
+		}
+	case *sqlparser.CreateView:
+		return g.view(stmt.ViewName, stmt.Select)
+	case *sqlparser.AlterView:
+		return g.view(stmt.ViewName, stmt.Select)
+	case *sqlparser.DropView:
+		for _, name := range stmt.FromTables {
+			if err := g.remove(ViewObject, name); err != nil {
+				return err
+			}
+		}
+	case *sqlparser.CreateProcedure:
+		obj, err := g.object(ProcedureObject, stmt.Name)
+		if err != nil {
+			return err
+		}
+		// The statements of a procedure run in the database of the procedure.
+		g.set(obj, references(stmt.Body, obj.Database))
+	case *sqlparser.DropProcedure:
+		return g.remove(ProcedureObject, stmt.Name)
+	case *sqlparser.DropDatabase:
+		name := stmt.GetDatabaseName()
+		var objects []Object
+		for _, obj := range g.objects {
+			if obj.Database == name {
+				delete(g.refs, obj.key())
+			} else {
+				objects = append(objects, obj)
+			}
+		}
+		g.objects = objects
+	case *sqlparser.Use:
+		g.defaultDB = stmt.DBName.String()
+	}
+	return nil
+}
+
+// Load parses the statements in sql and adds them in order. It stops at the
+// first statement that fails; the ones before it stay added.
+func (g *DependencyGraph) Load(parser *sqlparser.Parser, sql string) error {
+	pieces, err := parser.SplitStatementToPieces(sql)
+	if err != nil {
+		return err
+	}
+	for _, piece := range pieces {
+		stmt, err := parser.Parse(piece)
+		if err != nil {
+			if err == sqlparser.ErrEmpty {
+				continue
+			}
+			return err
+		}
+		if err := g.Add(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Objects returns the objects of the graph, in the order they were added.
+func (g *DependencyGraph) Objects() []Object {
+	return append([]Object(nil), g.objects...)
+}
+
+// References returns the objects of the graph an object refers to, in the
+// order they were added.
+func (g *DependencyGraph) References(obj Object) []Object {
+	var res []Object
+	for _, other := range g.objects {
+		if g.refers(obj.key(), other.key()) {
+			res = append(res, other)
+		}
+	}
+	return res
+}
+
+// Dependents returns the objects that refer to an object, directly or through
+// other objects, in an order they can be dropped in before it. The object is
+// looked up by name, so a table or a view can be given as either.
+func (g *DependencyGraph) Dependents(obj Object) []Object {
+	dependents := map[objectKey]bool{obj.key(): true}
+	for changed := true; changed; {
+		changed = false
+		for _, other := range g.objects {
+			if dependents[other.key()] {
+				continue
+			}
+			for _, ref := range g.refs[other.key()] {
+				if dependents[ref.to] && ref.to != other.key() {
+					dependents[other.key()] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	order, _, err := g.order()
+	if err != nil {
+		order = g.objects
+	}
+	var res []Object
+	for i := len(order) - 1; i >= 0; i-- {
+		if key := order[i].key(); dependents[key] && key != obj.key() {
+			res = append(res, order[i])
+		}
+	}
+	return res
+}
+
+// CreateOrder returns the objects in an order they can be created in: every
+// object comes after the ones it refers to. Objects that do not depend on each
+// other stay in the order they were added.
+//
+// Objects that refer to each other, like tables whose foreign keys reference
+// each other, are created without some of their references, which it returns
+// too: the foreign keys to add with ALTER TABLE once the objects are created,
+// and the references of procedures, which MySQL only resolves when they are
+// called. The first object added of a cycle is created first. It fails when
+// the objects of a cycle cannot be created without their references, which
+// only views cannot.
+func (g *DependencyGraph) CreateOrder() ([]Object, []Reference, error) {
+	return g.order()
+}
+
+// DropOrder returns the objects in an order they can be dropped in: every
+// object comes before the ones it refers to, but for the references
+// CreateOrder returns, which are returned too: the foreign keys to drop with
+// ALTER TABLE before the objects are dropped. It fails when CreateOrder does.
+func (g *DependencyGraph) DropOrder() ([]Object, []Reference, error) {
+	order, deferred, err := g.order()
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+	return order, deferred, nil
+}
+
+// ExternalReferences returns the references of the objects of the graph to
+// objects that are not in it, like the foreign keys of tables to tables of
+// other databases, in the order the objects were added.
+func (g *DependencyGraph) ExternalReferences() []Reference {
+	exists := g.keys()
+	var res []Reference
+	for _, obj := range g.objects {
+		for _, ref := range g.refs[obj.key()] {
+			if !exists[ref.to] {
+				res = append(res, g.reference(obj, ref))
+			}
+		}
+	}
+	return res
+}
+
+// Cycles returns the groups of objects that refer to each other, directly or
+// through other objects of the group. A table whose foreign keys reference
+// itself is not a cycle.
+func (g *DependencyGraph) Cycles() [][]Object {
+	// Tarjan's algorithm for strongly connected components.
+	index := map[objectKey]int{}
+	low := map[objectKey]int{}
+	onStack := map[objectKey]bool{}
+	var stack []Object
+	var cycles [][]Object
+
+	var connect func(obj Object)
+	connect = func(obj Object) {
+		key := obj.key()
+		index[key] = len(index)
+		low[key] = index[key]
+		stack = append(stack, obj)
+		onStack[key] = true
+		for _, other := range g.References(obj) {
+			otherKey := other.key()
+			if _, seen := index[otherKey]; !seen {
+				connect(other)
+				low[key] = min(low[key], low[otherKey])
+			} else if onStack[otherKey] {
+				low[key] = min(low[key], index[otherKey])
+			}
+		}
+		if low[key] != index[key] {
+			return
+		}
+		var component []Object
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top.key()] = false
+			component = append(component, top)
+			if top.key() == key {
+				break
+			}
+		}
+		if len(component) > 1 {
+			cycles = append(cycles, g.sorted(component))
+		}
+	}
+	for _, obj := range g.objects {
+		if _, seen := index[obj.key()]; !seen {
+			connect(obj)
+		}
+	}
+	return cycles
+}
+
+// order sorts the objects topologically, taking the first object added among
+// the ones whose references are all created. When none is, the first one added
+// of a cycle that can be created without the references that are not is
+// created without them, which are returned.
+func (g *DependencyGraph) order() ([]Object, []Reference, error) {
+	exists := g.keys()
+	inCycle := map[objectKey]bool{}
+	for _, cycle := range g.Cycles() {
+		for _, obj := range cycle {
+			inCycle[obj.key()] = true
+		}
+	}
+	created := map[objectKey]bool{}
+	var order []Object
+	var deferred []Reference
+	// pending returns the references of an object to the objects that are
+	// not created yet.
+	pending := func(obj Object) []reference {
+		var refs []reference
+		for _, ref := range g.refs[obj.key()] {
+			if exists[ref.to] && !created[ref.to] && ref.to != obj.key() {
+				refs = append(refs, ref)
+			}
+		}
+		return refs
+	}
+	for len(order) < len(g.objects) {
+		next := -1
+		for i, obj := range g.objects {
+			if !created[obj.key()] && len(pending(obj)) == 0 {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			for i, obj := range g.objects {
+				// Views cannot be created without the objects they read.
+				if !created[obj.key()] && inCycle[obj.key()] && obj.Kind != ViewObject {
+					for _, ref := range pending(obj) {
+						deferred = append(deferred, g.reference(obj, ref))
+					}
+					next = i
+					break
+				}
+			}
+		}
+		if next < 0 {
+			return nil, nil, g.cycleError(created)
+		}
+		created[g.objects[next].key()] = true
+		order = append(order, g.objects[next])
+	}
+	return order, deferred, nil
+}
+
+// cycleError returns the error of the cycles of the objects that are not
+// created.
+func (g *DependencyGraph) cycleError(created map[objectKey]bool) error {
+	var cycles []string
+	for _, cycle := range g.Cycles() {
+		var names []string
+		for _, obj := range cycle {
+			if created[obj.key()] {
+				names = nil
+				break
+			}
+			names = append(names, obj.Database+"."+obj.Name)
+		}
+		if len(names) > 0 {
+			cycles = append(cycles, strings.Join(names, ", "))
+		}
+	}
+	return vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Undefined, "Dependency cycle between %s", strings.Join(cycles, "; "))
+}
+
+// keys returns the keys of the objects of the graph.
+func (g *DependencyGraph) keys() map[objectKey]bool {
+	keys := map[objectKey]bool{}
+	for _, obj := range g.objects {
+		keys[obj.key()] = true
+	}
+	return keys
+}
+
+// reference returns the Reference of an object.
+func (g *DependencyGraph) reference(from Object, ref reference) Reference {
+	to := Object{Kind: TableObject, Database: ref.to.db, Name: ref.to.name}
+	if ref.to.routine {
+		to.Kind = ProcedureObject
+	}
+	for _, obj := range g.objects {
+		if obj.key() == ref.to {
+			to = obj
+		}
+	}
+	return Reference{From: from, To: to, ForeignKey: ref.foreignKey, Constraint: ref.constraint}
+}
+
+// sorted returns objects in the order they were added to the graph.
+func (g *DependencyGraph) sorted(objects []Object) []Object {
+	in := map[objectKey]bool{}
+	for _, obj := range objects {
+		in[obj.key()] = true
+	}
+	var res []Object
+	for _, obj := range g.objects {
+		if in[obj.key()] {
+			res = append(res, obj)
+		}
+	}
+	return res
+}
+
+func (g *DependencyGraph) refers(from, to objectKey) bool {
+	for _, ref := range g.refs[from] {
+		if ref.to == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *DependencyGraph) qualify(name sqlparser.TableName) (string, error) {
+	if name.Qualifier.NotEmpty() {
+		return name.Qualifier.String(), nil
+	}
+	if g.defaultDB == "" {
+		return "", noDatabaseSelected()
+	}
+	return g.defaultDB, nil
+}
+
+func (g *DependencyGraph) object(kind ObjectKind, name sqlparser.TableName) (Object, error) {
+	db, err := g.qualify(name)
+	if err != nil {
+		return Object{}, err
+	}
+	return Object{Kind: kind, Database: db, Name: name.Name.String()}, nil
+}
+
+// set adds an object with its references, or replaces the references of an
+// object with the same name.
+func (g *DependencyGraph) set(obj Object, refs []reference) {
+	found := false
+	for i, other := range g.objects {
+		if other.key() == obj.key() {
+			g.objects[i] = obj
+			found = true
+		}
+	}
+	if !found {
+		g.objects = append(g.objects, obj)
+	}
+	g.refs[obj.key()] = refs
+}
+
+func (g *DependencyGraph) remove(kind ObjectKind, name sqlparser.TableName) error {
+	obj, err := g.object(kind, name)
+	if err != nil {
+		return err
+	}
+	for i, other := range g.objects {
+		if other.key() == obj.key() {
+			g.objects = append(g.objects[:i:i], g.objects[i+1:]...)
+			delete(g.refs, obj.key())
+			break
+		}
+	}
+	return nil
+}
+
+// rename renames a table or a view. Foreign keys follow the table to its new
+// name, while the references of views and procedures keep referring to the old
+// name, like MySQL's bodies of views and routines do.
+func (g *DependencyGraph) rename(from, to sqlparser.TableName) error {
+	fromObj, err := g.object(TableObject, from)
+	if err != nil {
+		return err
+	}
+	toObj, err := g.object(TableObject, to)
+	if err != nil {
+		return err
+	}
+	for i, obj := range g.objects {
+		if obj.key() == fromObj.key() {
+			toObj.Kind = obj.Kind
+			g.objects[i] = toObj
+			g.refs[toObj.key()] = g.refs[fromObj.key()]
+			delete(g.refs, fromObj.key())
+			break
+		}
+	}
+	for _, refs := range g.refs {
+		for i, ref := range refs {
+			if ref.foreignKey && ref.to == fromObj.key() {
+				refs[i].to = toObj.key()
+			}
+		}
+	}
+	return nil
+}
+
+func (g *DependencyGraph) view(name sqlparser.TableName, query sqlparser.TableStatement) error {
+	obj, err := g.object(ViewObject, name)
+	if err != nil {
+		return err
+	}
+	db := g.defaultDB
+	if db == "" {
+		db = obj.Database
+	}
+	g.set(obj, references(query, db))
+	return nil
+}
+
+func (g *DependencyGraph) alterTable(stmt *sqlparser.AlterTable) error {
+	obj, err := g.object(TableObject, stmt.Table)
+	if err != nil {
+		return err
+	}
+	key := obj.key()
+	var rename *sqlparser.TableName
+	for _, opt := range stmt.AlterOptions {
+		switch opt := opt.(type) {
+		case *sqlparser.RenameTableName:
+			rename = &opt.Table
+		case *sqlparser.AddConstraintDefinition:
+			if ref, ok := g.foreignKey(obj.Database, opt.ConstraintDefinition); ok {
+				g.refs[key] = append(g.refs[key], ref)
+			}
+		case *sqlparser.DropKey:
+			if opt.Type != sqlparser.ForeignKeyType {
+				continue
+			}
+			var refs []reference
+			for _, ref := range g.refs[key] {
+				if !ref.foreignKey || !strings.EqualFold(ref.constraint, opt.Name.String()) {
+					refs = append(refs, ref)
+				}
+			}
+			g.refs[key] = refs
+		}
+	}
+	if rename != nil {
+		return g.rename(stmt.Table, *rename)
+	}
+	return nil
+}
+
+// foreignKey returns the reference of a foreign key constraint of a table of
+// database db, which the referenced table is in unless it is qualified.
+func (g *DependencyGraph) foreignKey(db string, constraint *sqlparser.ConstraintDefinition) (reference, bool) {
+	fk, ok := constraint.Details.(*sqlparser.ForeignKeyDefinition)
+	if !ok || fk.ReferenceDefinition == nil {
+		return reference{}, false
+	}
+	name := fk.ReferenceDefinition.ReferencedTable
+	if name.Qualifier.NotEmpty() {
+		db = name.Qualifier.String()
+	}
+	to := Object{Kind: TableObject, Database: db, Name: name.Name.String()}
+	return reference{to: to.key(), foreignKey: true, constraint: constraint.Name.String()}, true
+}
+
+// references returns the tables and views the statements under node use, and
+// the procedures they call. Unqualified names refer to defaultDB, except the
+// names of common table expressions.
+func references(node sqlparser.SQLNode, defaultDB string) []reference {
+	ctes := map[string]bool{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if cte, ok := node.(*sqlparser.CommonTableExpr); ok {
+			ctes[cte.ID.String()] = true
+		}
+		return true, nil
+	}, node)
+
+	var refs []reference
+	add := func(routine bool, name sqlparser.TableName) {
+		key := objectKey{routine: routine, db: name.Qualifier.String(), name: name.Name.String()}
+		if key.db == "" {
+			key.db = defaultDB
+		}
+		for _, ref := range refs {
+			if ref.to == key {
+				return
+			}
+		}
+		refs = append(refs, reference{to: key})
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.AliasedTableExpr:
+			if name, ok := node.Expr.(sqlparser.TableName); ok && (name.Qualifier.NotEmpty() || !ctes[name.Name.String()]) {
+				add(false, name)
+			}
+		case *sqlparser.CallProc:
+			add(true, node.Name)
+		}
+		return true, nil
+	}, node)
+	return refs
+}
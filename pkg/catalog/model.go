@@ -0,0 +1,339 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalog
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+)
+
+// Database is a database in a Schema, with its tables and views.
+type Database struct {
+	Name string
+	// Charset and Collation are the defaults for the tables created in the
+	// database, empty if they were never given.
+	Charset   string
+	Collation string
+
+	tables map[string]*Table
+	views  map[string]*View
+}
+
+// Table returns the table with the given name, or nil.
+func (db *Database) Table(name string) *Table {
+	return db.tables[name]
+}
+
+// Tables returns the tables of the database, sorted by name.
+func (db *Database) Tables() []*Table {
+	tables := make([]*Table, 0, len(db.tables))
+	for _, t := range db.tables {
+		tables = append(tables, t)
+	}
+	sort.Slice(tables, func(i, j int) bool { return tables[i].Name < tables[j].Name })
+	return tables
+}
+
+// View returns the view with the given name, or nil.
+func (db *Database) View(name string) *View {
+	return db.views[name]
+}
+
+// Views returns the views of the database, sorted by name.
+func (db *Database) Views() []*View {
+	views := make([]*View, 0, len(db.views))
+	for _, v := range db.views {
+		views = append(views, v)
+	}
+	sort.Slice(views, func(i, j int) bool { return views[i].Name < views[j].Name })
+	return views
+}
+
+func (db *Database) clone() *Database {
+	res := *db
+	res.tables = make(map[string]*Table, len(db.tables))
+	for name, t := range db.tables {
+		res.tables[name] = t
+	}
+	res.views = make(map[string]*View, len(db.views))
+	for name, v := range db.views {
+		res.views[name] = v
+	}
+	return &res
+}
+
+// Table is a table in a Schema.
+type Table struct {
+	Database  string
+	Name      string
+	Temporary bool
+	// Version is the version of the schema the table was created or last changed in.
+	Version int
+
+	Columns     []*Column
+	Indexes     []*Index
+	ForeignKeys []*ForeignKey
+	Checks      []*Check
+	Options     sqlparser.TableOptions
+}
+
+// Column returns the column with the given name, or nil. Column names are not
+// case sensitive.
+func (t *Table) Column(name string) *Column {
+	if i := t.columnIndex(name); i >= 0 {
+		return t.Columns[i]
+	}
+	return nil
+}
+
+// Index returns the index with the given name, or nil. The primary key is
+// named PRIMARY. Index names are not case sensitive.
+func (t *Table) Index(name string) *Index {
+	if i := t.indexIndex(name); i >= 0 {
+		return t.Indexes[i]
+	}
+	return nil
+}
+
+// PrimaryKey returns the primary key of the table, or nil if it has none.
+func (t *Table) PrimaryKey() *Index {
+	for _, idx := range t.Indexes {
+		if idx.Primary() {
+			return idx
+		}
+	}
+	return nil
+}
+
+// ForeignKey returns the foreign key with the given name, or nil.
+func (t *Table) ForeignKey(name string) *ForeignKey {
+	for _, fk := range t.ForeignKeys {
+		if strings.EqualFold(fk.Name, name) {
+			return fk
+		}
+	}
+	return nil
+}
+
+// Option returns the value of the table option with the given name, like ENGINE
+// or AUTO_INCREMENT, and whether it was set.
+func (t *Table) Option(name string) (string, bool) {
+	if i := t.optionIndex(name); i >= 0 {
+		return optionValue(t.Options[i]), true
+	}
+	return "", false
+}
+
+// Charset returns the character set of the table, falling back to the default
+// of its database.
+func (t *Table) Charset(s *Schema) string {
+	if charset, ok := t.Option("charset"); ok {
+		return charset
+	}
+	if db := s.Database(t.Database); db != nil {
+		return db.Charset
+	}
+	return ""
+}
+
+// Collation returns the collation of the table, falling back to the default of
+// its database.
+func (t *Table) Collation(s *Schema) string {
+	if collation, ok := t.Option("collate"); ok {
+		return collation
+	}
+	if db := s.Database(t.Database); db != nil {
+		return db.Collation
+	}
+	return ""
+}
+
+func (t *Table) columnIndex(name string) int {
+	for i, col := range t.Columns {
+		if strings.EqualFold(col.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *Table) indexIndex(name string) int {
+	for i, idx := range t.Indexes {
+		if strings.EqualFold(idx.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *Table) optionIndex(name string) int {
+	for i, opt := range t.Options {
+		if strings.EqualFold(opt.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func optionValue(opt *sqlparser.TableOption) string {
+	if opt.Value != nil {
+		return opt.Value.Val
+	}
+	return opt.String
+}
+
+// clone returns a copy of the table that can be changed without changing t.
+func (t *Table) clone() *Table {
+	res := *t
+	res.Columns = make([]*Column, len(t.Columns))
+	for i, col := range t.Columns {
+		c := *col
+		c.Type = sqlparser.CloneRefOfColumnType(col.Type)
+		res.Columns[i] = &c
+	}
+	res.Indexes = make([]*Index, len(t.Indexes))
+	for i, idx := range t.Indexes {
+		c := *idx
+		c.Columns = append([]IndexColumn(nil), idx.Columns...)
+		res.Indexes[i] = &c
+	}
+	res.ForeignKeys = make([]*ForeignKey, len(t.ForeignKeys))
+	for i, fk := range t.ForeignKeys {
+		c := *fk
+		c.Columns = append([]string(nil), fk.Columns...)
+		c.ReferencedColumns = append([]string(nil), fk.ReferencedColumns...)
+		res.ForeignKeys[i] = &c
+	}
+	res.Checks = make([]*Check, len(t.Checks))
+	for i, check := range t.Checks {
+		c := *check
+		res.Checks[i] = &c
+	}
+	res.Options = sqlparser.CloneTableOptions(t.Options)
+	return &res
+}
+
+// Column is a column of a table.
+type Column struct {
+	Name string
+	// Type is the type of the column as it was declared, with the options that
+	// came with it.
+	Type *sqlparser.ColumnType
+	// Nullable is false for columns declared NOT NULL and for the columns of the
+	// primary key.
+	Nullable      bool
+	AutoIncrement bool
+	Invisible     bool
+	// Default is the default value of the column, or nil if it has none.
+	Default sqlparser.Expr
+	// Generated is the expression of a generated column, or nil.
+	Generated sqlparser.Expr
+	Comment   string
+}
+
+// Definition returns the column as a column definition.
+func (c *Column) Definition() *sqlparser.ColumnDefinition {
+	return &sqlparser.ColumnDefinition{
+		Name: sqlparser.NewIdentifierCI(c.Name),
+		Type: sqlparser.CloneRefOfColumnType(c.Type),
+	}
+}
+
+// Collation returns the collation declared for the column, or an empty string.
+func (c *Column) Collation() string {
+	if c.Type.Options != nil {
+		return c.Type.Options.Collate
+	}
+	return ""
+}
+
+// Index is an index of a table, including its primary key.
+type Index struct {
+	Name      string
+	Type      sqlparser.IndexType
+	Columns   []IndexColumn
+	Invisible bool
+	Options   []*sqlparser.IndexOption
+}
+
+// Primary reports whether the index is the primary key.
+func (idx *Index) Primary() bool {
+	return idx.Type == sqlparser.IndexTypePrimary
+}
+
+// Unique reports whether the index is the primary key or a unique index.
+func (idx *Index) Unique() bool {
+	return idx.Type == sqlparser.IndexTypePrimary || idx.Type == sqlparser.IndexTypeUnique
+}
+
+// ColumnNames returns the names of the columns in the index, skipping the parts
+// that are expressions.
+func (idx *Index) ColumnNames() []string {
+	var names []string
+	for _, col := range idx.Columns {
+		if col.Expression == nil {
+			names = append(names, col.Column)
+		}
+	}
+	return names
+}
+
+// IndexColumn is a part of an index: either a column, with an optional prefix
+// length, or an expression.
+type IndexColumn struct {
+	Column     string
+	Length     *int
+	Expression sqlparser.Expr
+	Descending bool
+}
+
+// ForeignKey is a foreign key of a table.
+type ForeignKey struct {
+	Name               string
+	Columns            []string
+	ReferencedDatabase string
+	ReferencedTable    string
+	ReferencedColumns  []string
+	OnDelete           sqlparser.ReferenceAction
+	OnUpdate           sqlparser.ReferenceAction
+}
+
+// Check is a check constraint of a table.
+type Check struct {
+	Name     string
+	Expr     sqlparser.Expr
+	Enforced bool
+}
+
+// View is a view in a Schema.
+type View struct {
+	Database string
+	Name     string
+	// Version is the version of the schema the view was created or last changed in.
+	Version int
+	// Columns are the names of the columns of the view, either given with the
+	// view or taken from its select list. It is nil when the select list has a
+	// star, which needs the tables to expand.
+	Columns     []string
+	Select      sqlparser.TableStatement
+	Algorithm   string
+	Definer     *sqlparser.Definer
+	Security    string
+	CheckOption string
+}
@@ -0,0 +1,396 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/decimal"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// divPrecisionIncrement is the number of digits the result of a division has
+// after the decimal point, beyond the ones of its dividend, like MySQL's
+// div_precision_increment.
+const divPrecisionIncrement = 4
+
+// maxDecimalScale is the largest number of digits a decimal can have after the
+// decimal point.
+const maxDecimalScale = 30
+
+// arithmeticExpr is +, -, *, /, DIV or MOD.
+type arithmeticExpr struct {
+	op          sqlparser.BinaryExprOperator
+	left, right Expr
+	// sql is the expression as MySQL shows it in errors.
+	sql string
+}
+
+// negateExpr is unary minus.
+type negateExpr struct {
+	expr Expr
+	sql  string
+}
+
+func errOutOfRange(typ, sql string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "%s value is out of range in '%s'", typ, sql)
+}
+
+func (a *arithmeticExpr) eval(env *ExpressionEnv) (eval, error) {
+	left, err := a.left.eval(env)
+	if err != nil || left == nil {
+		return nil, err
+	}
+	right, err := a.right.eval(env)
+	if err != nil || right == nil {
+		return nil, err
+	}
+	left, right = env.toNumber(left), env.toNumber(right)
+
+	switch a.op {
+	case sqlparser.DivOp:
+		return a.div(env, left, right)
+	case sqlparser.IntDivOp:
+		return a.intDiv(env, left, right)
+	case sqlparser.ModOp:
+		return a.mod(env, left, right)
+	}
+
+	switch {
+	case isFloat(left) || isFloat(right):
+		l, r := env.toFloat(left), env.toFloat(right)
+		var f float64
+		switch a.op {
+		case sqlparser.PlusOp:
+			f = l + r
+		case sqlparser.MinusOp:
+			f = l - r
+		case sqlparser.MultOp:
+			f = l * r
+		}
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, errOutOfRange("DOUBLE", a.sql)
+		}
+		return evalFloat{f: f}, nil
+	case isDecimal(left) || isDecimal(right):
+		l, r := env.toDecimal(left), env.toDecimal(right)
+		switch a.op {
+		case sqlparser.PlusOp:
+			return evalDecimal{dec: l.dec.Add(r.dec), length: max(l.length, r.length)}, nil
+		case sqlparser.MinusOp:
+			return evalDecimal{dec: l.dec.Sub(r.dec), length: max(l.length, r.length)}, nil
+		}
+		return evalDecimal{dec: l.dec.Mul(r.dec), length: min(l.length+r.length, maxDecimalScale)}, nil
+	}
+
+	switch l := left.(type) {
+	case evalInt64:
+		switch r := right.(type) {
+		case evalInt64:
+			return a.intInt(l.i, r.i)
+		case evalUint64:
+			return a.intUint(l.i, r.u)
+		}
+	case evalUint64:
+		switch r := right.(type) {
+		case evalInt64:
+			return a.uintInt(l.u, r.i)
+		case evalUint64:
+			return a.uintUint(l.u, r.u)
+		}
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected operands of %s", a.sql)
+}
+
+func (a *arithmeticExpr) intInt(l, r int64) (eval, error) {
+	var result int64
+	overflow := false
+	switch a.op {
+	case sqlparser.PlusOp:
+		result = l + r
+		overflow = (l > 0 && r > 0 && result < 0) || (l < 0 && r < 0 && result >= 0)
+	case sqlparser.MinusOp:
+		result = l - r
+		overflow = (l >= 0 && r < 0 && result < 0) || (l < 0 && r > 0 && result >= 0)
+	case sqlparser.MultOp:
+		result = l * r
+		overflow = l != 0 && (result/l != r || (l == -1 && r == math.MinInt64) || (r == -1 && l == math.MinInt64))
+	}
+	if overflow {
+		return nil, errOutOfRange("BIGINT", a.sql)
+	}
+	return evalInt64{i: result}, nil
+}
+
+func (a *arithmeticExpr) uintUint(l, r uint64) (eval, error) {
+	var result uint64
+	var carry uint64
+	switch a.op {
+	case sqlparser.PlusOp:
+		result, carry = bits.Add64(l, r, 0)
+	case sqlparser.MinusOp:
+		result, carry = bits.Sub64(l, r, 0)
+	case sqlparser.MultOp:
+		carry, result = bits.Mul64(l, r)
+	}
+	if carry != 0 {
+		return nil, errOutOfRange("BIGINT UNSIGNED", a.sql)
+	}
+	return evalUint64{u: result}, nil
+}
+
+// intUint is an operation between a signed and an unsigned integer, whose
+// result is unsigned.
+func (a *arithmeticExpr) intUint(l int64, r uint64) (eval, error) {
+	if l >= 0 {
+		return a.uintUint(uint64(l), r)
+	}
+	switch a.op {
+	case sqlparser.PlusOp:
+		return a.uintInt(r, l)
+	case sqlparser.MultOp:
+		if r == 0 {
+			return evalUint64{}, nil
+		}
+	}
+	return nil, errOutOfRange("BIGINT UNSIGNED", a.sql)
+}
+
+func (a *arithmeticExpr) uintInt(l uint64, r int64) (eval, error) {
+	if r >= 0 {
+		return a.uintUint(l, uint64(r))
+	}
+	abs := uint64(-r)
+	switch a.op {
+	case sqlparser.PlusOp:
+		if abs > l {
+			return nil, errOutOfRange("BIGINT UNSIGNED", a.sql)
+		}
+		return evalUint64{u: l - abs}, nil
+	case sqlparser.MinusOp:
+		result, carry := bits.Add64(l, abs, 0)
+		if carry != 0 {
+			return nil, errOutOfRange("BIGINT UNSIGNED", a.sql)
+		}
+		return evalUint64{u: result}, nil
+	}
+	if l == 0 {
+		return evalUint64{}, nil
+	}
+	return nil, errOutOfRange("BIGINT UNSIGNED", a.sql)
+}
+
+// div is /, whose result is a decimal with four more digits after the decimal
+// point than its dividend, or a double when either operand is one. Division
+// by zero is NULL.
+func (a *arithmeticExpr) div(env *ExpressionEnv, left, right eval) (eval, error) {
+	if isFloat(left) || isFloat(right) {
+		r := env.toFloat(right)
+		if r == 0 {
+			env.warn(WarnDivisionByZero, "Division by 0")
+			return nil, nil
+		}
+		f := env.toFloat(left) / r
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, errOutOfRange("DOUBLE", a.sql)
+		}
+		return evalFloat{f: f}, nil
+	}
+	l, r := env.toDecimal(left), env.toDecimal(right)
+	if r.dec.IsZero() {
+		env.warn(WarnDivisionByZero, "Division by 0")
+		return nil, nil
+	}
+	length := min(l.length+divPrecisionIncrement, maxDecimalScale)
+	return evalDecimal{dec: l.dec.Div(r.dec, divPrecisionIncrement).Round(length), length: length}, nil
+}
+
+// intDiv is DIV, the integer part of a division. Operands that are not
+// integers are divided as decimals.
+func (a *arithmeticExpr) intDiv(env *ExpressionEnv, left, right eval) (eval, error) {
+	l, lok := left.(evalInt64)
+	r, rok := right.(evalInt64)
+	if lok && rok {
+		switch {
+		case r.i == 0:
+			env.warn(WarnDivisionByZero, "Division by 0")
+			return nil, nil
+		case l.i == math.MinInt64 && r.i == -1:
+			return nil, errOutOfRange("BIGINT", a.sql)
+		}
+		return evalInt64{i: l.i / r.i}, nil
+	}
+	if !isFloat(left) && !isFloat(right) && !isDecimal(left) && !isDecimal(right) {
+		lu, lneg := unsignedAbs(left)
+		ru, rneg := unsignedAbs(right)
+		if ru == 0 {
+			env.warn(WarnDivisionByZero, "Division by 0")
+			return nil, nil
+		}
+		q := lu / ru
+		if lneg != rneg && q != 0 {
+			return nil, errOutOfRange("BIGINT UNSIGNED", a.sql)
+		}
+		return evalUint64{u: q}, nil
+	}
+	ld, rd := env.toDecimal(left), env.toDecimal(right)
+	if rd.dec.IsZero() {
+		env.warn(WarnDivisionByZero, "Division by 0")
+		return nil, nil
+	}
+	q, _ := ld.dec.QuoRem(rd.dec, 0)
+	i, ok := q.Int64()
+	if !ok {
+		return nil, errOutOfRange("BIGINT", a.sql)
+	}
+	return evalInt64{i: i}, nil
+}
+
+// mod is MOD and %, whose result has the sign of the dividend. Modulo zero is
+// NULL.
+func (a *arithmeticExpr) mod(env *ExpressionEnv, left, right eval) (eval, error) {
+	switch {
+	case isFloat(left) || isFloat(right):
+		r := env.toFloat(right)
+		if r == 0 {
+			env.warn(WarnDivisionByZero, "Division by 0")
+			return nil, nil
+		}
+		return evalFloat{f: math.Mod(env.toFloat(left), r)}, nil
+	case isDecimal(left) || isDecimal(right):
+		l, r := env.toDecimal(left), env.toDecimal(right)
+		if r.dec.IsZero() {
+			env.warn(WarnDivisionByZero, "Division by 0")
+			return nil, nil
+		}
+		_, rem := l.dec.QuoRem(r.dec, 0)
+		return evalDecimal{dec: rem, length: max(l.length, r.length)}, nil
+	}
+	lu, lneg := unsignedAbs(left)
+	ru, _ := unsignedAbs(right)
+	if ru == 0 {
+		env.warn(WarnDivisionByZero, "Division by 0")
+		return nil, nil
+	}
+	rem := lu % ru
+	if _, ok := left.(evalUint64); ok {
+		return evalUint64{u: rem}, nil
+	}
+	if lneg {
+		return evalInt64{i: -int64(rem)}, nil
+	}
+	return evalInt64{i: int64(rem)}, nil
+}
+
+func (a *arithmeticExpr) typeof() (querypb.Type, bool) {
+	left, lok := a.left.typeof()
+	right, rok := a.right.typeof()
+	if !lok || !rok {
+		return 0, false
+	}
+	switch {
+	case left == sqltypes.Null || right == sqltypes.Null:
+		return sqltypes.Null, true
+	case a.op == sqlparser.IntDivOp:
+		if sqltypes.IsUnsigned(left) || sqltypes.IsUnsigned(right) {
+			return sqltypes.Uint64, true
+		}
+		return sqltypes.Int64, true
+	case isFloatType(left) || isFloatType(right):
+		return sqltypes.Float64, true
+	case a.op == sqlparser.DivOp || left == sqltypes.Decimal || right == sqltypes.Decimal:
+		return sqltypes.Decimal, true
+	case a.op == sqlparser.ModOp && sqltypes.IsUnsigned(left):
+		return sqltypes.Uint64, true
+	case a.op != sqlparser.ModOp && (sqltypes.IsUnsigned(left) || sqltypes.IsUnsigned(right)):
+		return sqltypes.Uint64, true
+	}
+	return sqltypes.Int64, true
+}
+
+func (n *negateExpr) eval(env *ExpressionEnv) (eval, error) {
+	e, err := n.expr.eval(env)
+	if err != nil || e == nil {
+		return nil, err
+	}
+	switch e := env.toNumber(e).(type) {
+	case evalInt64:
+		if e.i == math.MinInt64 {
+			return nil, errOutOfRange("BIGINT", n.sql)
+		}
+		return evalInt64{i: -e.i}, nil
+	case evalUint64:
+		if e.u > math.MaxInt64+1 {
+			return evalDecimal{dec: decimal.NewFromUint(e.u).Neg()}, nil
+		}
+		return evalInt64{i: -int64(e.u)}, nil
+	case evalFloat:
+		return evalFloat{f: -e.f}, nil
+	case evalDecimal:
+		return evalDecimal{dec: e.dec.Neg(), length: e.length}, nil
+	}
+	return nil, nil
+}
+
+func (n *negateExpr) typeof() (querypb.Type, bool) {
+	typ, ok := n.expr.typeof()
+	switch {
+	case !ok:
+		return 0, false
+	case sqltypes.IsIntegral(typ):
+		return sqltypes.Int64, true
+	case sqltypes.IsNumber(typ), typ == sqltypes.Null:
+		return typ, true
+	}
+	return sqltypes.Float64, true
+}
+
+// unsignedAbs returns the absolute value of an integer and whether it is
+// negative.
+func unsignedAbs(e eval) (uint64, bool) {
+	switch e := e.(type) {
+	case evalInt64:
+		if e.i < 0 {
+			return uint64(-e.i), true
+		}
+		return uint64(e.i), false
+	case evalUint64:
+		return e.u, false
+	}
+	return 0, false
+}
+
+func isFloat(e eval) bool {
+	_, ok := e.(evalFloat)
+	return ok
+}
+
+func isDecimal(e eval) bool {
+	_, ok := e.(evalDecimal)
+	return ok
+}
+
+// isFloatType reports whether values of a type are doubles in arithmetic,
+// which strings are.
+func isFloatType(typ querypb.Type) bool {
+	return sqltypes.IsFloat(typ) || (sqltypes.IsTextOrBinary(typ) && typ != sqltypes.HexNum && typ != sqltypes.HexVal && typ != sqltypes.BitNum)
+}
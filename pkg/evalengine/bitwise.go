@@ -0,0 +1,156 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// bitwiseExpr is &, |, ^, << or >>. Its operands are unsigned integers,
+// unless they are binary strings, which are operated on byte by byte.
+type bitwiseExpr struct {
+	op          sqlparser.BinaryExprOperator
+	left, right Expr
+}
+
+// bitNotExpr is ~.
+type bitNotExpr struct {
+	expr Expr
+}
+
+func (b *bitwiseExpr) eval(env *ExpressionEnv) (eval, error) {
+	left, err := b.left.eval(env)
+	if err != nil || left == nil {
+		return nil, err
+	}
+	right, err := b.right.eval(env)
+	if err != nil || right == nil {
+		return nil, err
+	}
+
+	if l, ok := left.(evalBytes); ok && l.isBinary() {
+		switch b.op {
+		case sqlparser.ShiftLeftOp, sqlparser.ShiftRightOp:
+			return shiftBytes(l.bytes, env.toUint64(right), b.op == sqlparser.ShiftLeftOp), nil
+		}
+		if r, ok := right.(evalBytes); ok && r.isBinary() {
+			if len(l.bytes) != len(r.bytes) {
+				return nil, vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongArguments, "Binary operands of bitwise operators must be of equal length")
+			}
+			result := make([]byte, len(l.bytes))
+			for i := range result {
+				switch b.op {
+				case sqlparser.BitAndOp:
+					result[i] = l.bytes[i] & r.bytes[i]
+				case sqlparser.BitOrOp:
+					result[i] = l.bytes[i] | r.bytes[i]
+				case sqlparser.BitXorOp:
+					result[i] = l.bytes[i] ^ r.bytes[i]
+				}
+			}
+			return newEvalBinary(result), nil
+		}
+	}
+
+	l, r := env.toUint64(left), env.toUint64(right)
+	var result uint64
+	switch b.op {
+	case sqlparser.BitAndOp:
+		result = l & r
+	case sqlparser.BitOrOp:
+		result = l | r
+	case sqlparser.BitXorOp:
+		result = l ^ r
+	case sqlparser.ShiftLeftOp:
+		if r < 64 {
+			result = l << r
+		}
+	case sqlparser.ShiftRightOp:
+		if r < 64 {
+			result = l >> r
+		}
+	}
+	return evalUint64{u: result}, nil
+}
+
+func (b *bitwiseExpr) typeof() (querypb.Type, bool) {
+	left, ok := b.left.typeof()
+	if ok && sqltypes.IsBinary(left) {
+		return sqltypes.VarBinary, true
+	}
+	return sqltypes.Uint64, ok
+}
+
+// shiftBytes shifts a binary string as a big endian integer of its length.
+func shiftBytes(in []byte, shift uint64, left bool) eval {
+	out := make([]byte, len(in))
+	size := uint64(len(in))
+	bytesShift, bitsShift := shift/8, shift%8
+	for i := uint64(0); i < size; i++ {
+		var src uint64
+		if left {
+			src = i + bytesShift
+		} else {
+			if i < bytesShift {
+				continue
+			}
+			src = i - bytesShift
+		}
+		if src >= size {
+			continue
+		}
+		if left {
+			out[i] = in[src] << bitsShift
+			if bitsShift != 0 && src+1 < size {
+				out[i] |= in[src+1] >> (8 - bitsShift)
+			}
+		} else {
+			out[i] = in[src] >> bitsShift
+			if bitsShift != 0 && src >= 1 {
+				out[i] |= in[src-1] << (8 - bitsShift)
+			}
+		}
+	}
+	return newEvalBinary(out)
+}
+
+func (n *bitNotExpr) eval(env *ExpressionEnv) (eval, error) {
+	e, err := n.expr.eval(env)
+	if err != nil || e == nil {
+		return nil, err
+	}
+	if b, ok := e.(evalBytes); ok && b.isBinary() {
+		out := make([]byte, len(b.bytes))
+		for i, c := range b.bytes {
+			out[i] = ^c
+		}
+		return newEvalBinary(out), nil
+	}
+	return evalUint64{u: ^env.toUint64(e)}, nil
+}
+
+func (n *bitNotExpr) typeof() (querypb.Type, bool) {
+	typ, ok := n.expr.typeof()
+	if ok && sqltypes.IsBinary(typ) {
+		return sqltypes.VarBinary, true
+	}
+	return sqltypes.Uint64, ok
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/datetime"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+// maxDecimalPrecision is the largest number of digits a decimal can have.
+const maxDecimalPrecision = 65
+
+// castExpr is CAST and CONVERT to a type.
+type castExpr struct {
+	expr Expr
+	// typ is the type cast to, a querypb type among Int64, Uint64, Decimal,
+	// Float64, VarChar, VarBinary, Date, Datetime, Time and TypeJSON.
+	typ querypb.Type
+	// length is the length of strings, the precision of decimals or the
+	// fractional seconds of temporal values, and -1 when there is none.
+	length int
+	// scale is the scale of decimals.
+	scale int
+	// name is the type as written, for warnings.
+	name string
+}
+
+// textExpr makes a value a string, binary or not, like CONVERT ... USING,
+// character set introducers and COLLATE do. Strings that are not binary get
+// the collation col, which COLLATE gives explicitly and only to strings of its
+// character set.
+type textExpr struct {
+	expr     Expr
+	binary   bool
+	col      collations.ID
+	explicit bool
+}
+
+func (c *castExpr) eval(env *ExpressionEnv) (eval, error) {
+	e, err := c.expr.eval(env)
+	if err != nil || e == nil {
+		return nil, err
+	}
+	return c.convert(env, e, isBoolean(c.expr))
+}
+
+// convert converts a value to the type cast to. boolean is set when the value
+// is the result of a boolean expression, which is true or false in JSON.
+func (c *castExpr) convert(env *ExpressionEnv, e eval, boolean bool) (eval, error) {
+	if e == nil {
+		return nil, nil
+	}
+	switch c.typ {
+	case sqltypes.Int64:
+		return evalInt64{i: env.toInt64(e)}, nil
+	case sqltypes.Uint64:
+		return evalUint64{u: env.toUint64(e)}, nil
+	case sqltypes.Float64:
+		return evalFloat{f: env.toFloat(e)}, nil
+	case sqltypes.Decimal:
+		return env.toDecimalType(e, c.length, c.scale), nil
+	case sqltypes.VarChar, sqltypes.VarBinary:
+		return c.castText(env, e), nil
+	case sqltypes.Date:
+		if t, ok := env.toDate(e); ok {
+			return t, nil
+		}
+	case sqltypes.Datetime:
+		if t, ok := env.toDateTime(e, c.length); ok {
+			return t, nil
+		}
+	case sqltypes.Time:
+		if t, ok := env.toTime(e, c.length); ok {
+			return t, nil
+		}
+	case sqltypes.TypeJSON:
+		return castToJSON(e, boolean)
+	}
+	return nil, nil
+}
+
+func (c *castExpr) castText(env *ExpressionEnv, e eval) eval {
+	b := toBytes(e)
+	if c.typ == sqltypes.VarBinary {
+		if c.length >= 0 {
+			switch {
+			case len(b) > c.length:
+				env.warn(WarnTruncatedWrongValue, fmt.Sprintf("Truncated incorrect BINARY(%d) value: '%s'", c.length, b))
+				b = b[:c.length]
+			case len(b) < c.length:
+				b = append(append([]byte{}, b...), make([]byte, c.length-len(b))...)
+			}
+		}
+		return newEvalBinary(b)
+	}
+	if c.length >= 0 && utf8.RuneCount(b) > c.length {
+		env.warn(WarnTruncatedWrongValue, fmt.Sprintf("Truncated incorrect %s(%d) value: '%s'", c.name, c.length, b))
+		n := 0
+		for i := range string(b) {
+			if n == c.length {
+				b = b[:i]
+				break
+			}
+			n++
+		}
+	}
+	return newEvalText(b)
+}
+
+func (c *castExpr) typeof() (querypb.Type, bool) {
+	return c.typ, true
+}
+
+// toDecimalType returns a value as a DECIMAL(precision, scale), which it is
+// clamped to with a warning when out of range.
+func (env *ExpressionEnv) toDecimalType(e eval, precision, scale int) eval {
+	d := env.toDecimal(e)
+	rounded := d.dec.Round(int32(scale))
+	clamped := rounded.Clamp(int32(precision-scale), int32(scale))
+	if !clamped.Equal(rounded) {
+		env.warn(WarnDataOutOfRange, "Out of range value for column 'cast' at row 1")
+	}
+	return evalDecimal{dec: clamped, length: int32(scale)}
+}
+
+// toDate returns a value as a DATE, and false with a warning when it is not
+// one.
+func (env *ExpressionEnv) toDate(e eval) (evalTemporal, bool) {
+	if j, ok := e.(evalJSON); ok {
+		e = j.scalar()
+	}
+	var d datetime.Date
+	ok := false
+	switch e := e.(type) {
+	case evalTemporal:
+		return evalTemporal{t: sqltypes.Date, dt: datetime.DateTime{Date: e.toDateTime(env).dt.Date}}, true
+	case evalBytes:
+		s := strings.TrimSpace(e.string())
+		if d, ok = datetime.ParseDate(s); !ok {
+			var dt datetime.DateTime
+			dt, _, ok = datetime.ParseDateTime(s, -1)
+			d = dt.Date
+		}
+	case evalInt64:
+		d, ok = datetime.ParseDateInt64(e.i)
+	case evalUint64:
+		d, ok = datetime.ParseDateInt64(int64(e.u))
+	case evalFloat:
+		d, ok = datetime.ParseDateFloat(e.f)
+	case evalDecimal:
+		d, ok = datetime.ParseDateDecimal(e.dec)
+	}
+	if !ok {
+		env.warn(WarnTruncatedWrongValue, fmt.Sprintf("Incorrect datetime value: '%s'", toBytes(e)))
+		return evalTemporal{}, false
+	}
+	return evalTemporal{t: sqltypes.Date, dt: datetime.DateTime{Date: d}}, true
+}
+
+// toDateTime returns a value as a DATETIME with prec fractional digits, or
+// the ones it has when prec is -1, and false with a warning when it is not
+// one.
+func (env *ExpressionEnv) toDateTime(e eval, prec int) (evalTemporal, bool) {
+	if j, ok := e.(evalJSON); ok {
+		e = j.scalar()
+	}
+	var dt datetime.DateTime
+	l := 0
+	ok := false
+	switch e := e.(type) {
+	case evalTemporal:
+		t := e.toDateTime(env)
+		if prec >= 0 {
+			t.dt, t.prec = t.dt.Round(prec), uint8(prec)
+		}
+		return t, true
+	case evalBytes:
+		s := strings.TrimSpace(e.string())
+		if dt, l, ok = datetime.ParseDateTime(s, prec); !ok {
+			dt.Date, ok = datetime.ParseDate(s)
+			l = max(prec, 0)
+		}
+	case evalInt64:
+		dt, ok = datetime.ParseDateTimeInt64(e.i)
+		l = max(prec, 0)
+	case evalUint64:
+		dt, ok = datetime.ParseDateTimeInt64(int64(e.u))
+		l = max(prec, 0)
+	case evalFloat:
+		dt, l, ok = datetime.ParseDateTimeFloat(e.f, prec)
+	case evalDecimal:
+		dt, l, ok = datetime.ParseDateTimeDecimal(e.dec, e.length, prec)
+	}
+	if !ok {
+		env.warn(WarnTruncatedWrongValue, fmt.Sprintf("Incorrect datetime value: '%s'", toBytes(e)))
+		return evalTemporal{}, false
+	}
+	return evalTemporal{t: sqltypes.Datetime, dt: dt, prec: uint8(l)}, true
+}
+
+// toTime returns a value as a TIME with prec fractional digits, or the ones it
+// has when prec is -1, and false with a warning when it is not one.
+func (env *ExpressionEnv) toTime(e eval, prec int) (evalTemporal, bool) {
+	if j, ok := e.(evalJSON); ok {
+		e = j.scalar()
+	}
+	var t datetime.Time
+	l := 0
+	ok := false
+	switch e := e.(type) {
+	case evalTemporal:
+		if e.t == sqltypes.Date {
+			return evalTemporal{t: sqltypes.Time, prec: uint8(max(prec, 0))}, true
+		}
+		t, l = e.dt.Time, int(e.prec)
+		if prec >= 0 {
+			t, l = t.Round(prec), prec
+		}
+		ok = true
+	case evalBytes:
+		s := strings.TrimSpace(e.string())
+		var state datetime.TimeState
+		t, l, state = datetime.ParseTime(s, prec)
+		if state != datetime.TimeOK {
+			if dt, dl, dok := datetime.ParseDateTime(s, prec); dok {
+				t, l, state = dt.Time, dl, datetime.TimeOK
+			}
+		}
+		ok = state != datetime.TimeInvalid
+		if state == datetime.TimePartial {
+			env.warn(WarnTruncatedWrongValue, fmt.Sprintf("Truncated incorrect time value: '%s'", e.bytes))
+		}
+	case evalInt64:
+		t, ok = datetime.ParseTimeInt64(e.i)
+		l = max(prec, 0)
+	case evalUint64:
+		t, ok = datetime.ParseTimeInt64(int64(e.u))
+		l = max(prec, 0)
+	case evalFloat:
+		t, l, ok = datetime.ParseTimeFloat(e.f, prec)
+	case evalDecimal:
+		t, l, ok = datetime.ParseTimeDecimal(e.dec, e.length, prec)
+	}
+	if !ok {
+		env.warn(WarnTruncatedWrongValue, fmt.Sprintf("Truncated incorrect time value: '%s'", toBytes(e)))
+		return evalTemporal{}, false
+	}
+	return evalTemporal{t: sqltypes.Time, dt: datetime.DateTime{Time: t}, prec: uint8(l)}, true
+}
+
+func (t *textExpr) eval(env *ExpressionEnv) (eval, error) {
+	e, err := t.expr.eval(env)
+	if err != nil || e == nil {
+		return nil, err
+	}
+	if t.binary {
+		return newEvalBinary(toBytes(e)), nil
+	}
+	text := toText(e)
+	if !t.explicit {
+		return evalBytes{tt: sqltypes.VarChar, bytes: text.bytes, col: t.col}, nil
+	}
+	col := collations.Get(t.col)
+	if charset := text.collation().Charset(); charset != col.Charset() {
+		return nil, errCollationCharset(col.Name(), charset)
+	}
+	return evalBytes{tt: sqltypes.VarChar, bytes: text.bytes, col: t.col, derivation: explicit}, nil
+}
+
+func (t *textExpr) typeof() (querypb.Type, bool) {
+	if t.binary {
+		return sqltypes.VarBinary, true
+	}
+	return sqltypes.VarChar, true
+}
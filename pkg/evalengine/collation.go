@@ -0,0 +1,119 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// derivation is how a string got its collation, which decides whose
+// collation two strings are compared in: the one with the stronger
+// derivation.
+type derivation uint8
+
+const (
+	// coercible is the collation of literals and of the results of functions.
+	coercible derivation = iota
+	// implicit is the collation of columns.
+	implicit
+	// explicit is a collation given with COLLATE.
+	explicit
+)
+
+func (d derivation) String() string {
+	switch d {
+	case implicit:
+		return "IMPLICIT"
+	case explicit:
+		return "EXPLICIT"
+	}
+	return "COERCIBLE"
+}
+
+// collation returns the collation of a string.
+func (e evalBytes) collation() *collations.Collation {
+	if e.isBinary() {
+		return collations.Get(collations.Binary)
+	}
+	if c := collations.Get(e.col); c != nil {
+		return c
+	}
+	return collations.Get(collations.Default)
+}
+
+// withCollation returns a string that is not binary with a collation.
+func (e evalBytes) withCollation(col collations.ID, d derivation) evalBytes {
+	e.col, e.derivation = col, d
+	return e
+}
+
+// mergeCollations returns the collation two strings are compared in by an
+// operation, which is binary when either of them is. Otherwise it is the
+// collation of the string with the stronger derivation, or, when they are
+// as strong, of the one whose character set has all the characters of the
+// other's, which only utf8mb4 has of latin1. Strings with different
+// collations of the same character set and as strong a derivation cannot be
+// compared, nor can strings with different explicit collations.
+func mergeCollations(a, b evalBytes, op string) (*collations.Collation, error) {
+	ca, cb := a.collation(), b.collation()
+	switch {
+	case ca.ID() == cb.ID() || ca.IsBinary():
+		return ca, nil
+	case cb.IsBinary():
+		return cb, nil
+	case a.derivation > b.derivation:
+		return ca, nil
+	case a.derivation < b.derivation:
+		return cb, nil
+	case a.derivation != explicit && ca.Charset() != cb.Charset():
+		if ca.Charset() == "utf8mb4" {
+			return ca, nil
+		}
+		if cb.Charset() == "utf8mb4" {
+			return cb, nil
+		}
+	}
+	return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.CantAggregateCollations,
+		"Illegal mix of collations (%s,%s) and (%s,%s) for operation '%s'", ca.Name(), a.derivation, cb.Name(), b.derivation, op)
+}
+
+// lookupCollation returns the collation a COLLATE clause names.
+func lookupCollation(name string) (*collations.Collation, error) {
+	c, ok := collations.Lookup(name)
+	if !ok {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.UnknownCollation, "Unknown collation: '%s'", name)
+	}
+	return c, nil
+}
+
+// charsetCollation returns the default collation of the character set a
+// character set introducer or CONVERT ... USING names, and the default
+// collation when it is not one there is a collation of.
+func charsetCollation(charset string) collations.ID {
+	if c, ok := collations.DefaultForCharset(strings.TrimPrefix(charset, "_")); ok {
+		return c.ID()
+	}
+	return collations.Default
+}
+
+func errCollationCharset(collation, charset string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.CollationCharsetMismatch, "COLLATION '%s' is not valid for CHARACTER SET '%s'", collation, charset)
+}
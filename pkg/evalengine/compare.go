@@ -0,0 +1,316 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"cmp"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/json"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+// comparisonExpr is =, !=, <, <=, >, >= or <=>.
+type comparisonExpr struct {
+	op          sqlparser.ComparisonExprOperator
+	left, right Expr
+}
+
+// inExpr is IN and NOT IN.
+type inExpr struct {
+	left  Expr
+	list  []Expr
+	tuple Expr // a list bind variable, when list is nil
+	not   bool
+}
+
+// betweenExpr is BETWEEN and NOT BETWEEN.
+type betweenExpr struct {
+	left, from, to Expr
+	not            bool
+}
+
+func (c *comparisonExpr) eval(env *ExpressionEnv) (eval, error) {
+	left, err := c.left.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	right, err := c.right.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	return env.compareOp(c.op, left, right)
+}
+
+func (c *comparisonExpr) typeof() (querypb.Type, bool) {
+	return sqltypes.Int64, true
+}
+
+// compareOp applies a comparison operator to two values.
+func (env *ExpressionEnv) compareOp(op sqlparser.ComparisonExprOperator, left, right eval) (eval, error) {
+	switch op {
+	case sqlparser.NullSafeEqualOp:
+		eq, null, err := env.equal(left, right, true)
+		if err != nil {
+			return nil, err
+		}
+		return newEvalBool(eq && !null), nil
+	case sqlparser.EqualOp, sqlparser.NotEqualOp:
+		eq, null, err := env.equal(left, right, false)
+		if err != nil || null {
+			return nil, err
+		}
+		return newEvalBool(eq == (op == sqlparser.EqualOp)), nil
+	}
+	n, null, err := env.compare(left, right)
+	if err != nil || null {
+		return nil, err
+	}
+	switch op {
+	case sqlparser.LessThanOp:
+		return newEvalBool(n < 0), nil
+	case sqlparser.LessEqualOp:
+		return newEvalBool(n <= 0), nil
+	case sqlparser.GreaterThanOp:
+		return newEvalBool(n > 0), nil
+	}
+	return newEvalBool(n >= 0), nil
+}
+
+// equal reports whether two values are equal, and whether that is NULL. Rows
+// are equal when all their columns are, and not equal when any of them is
+// not, even if others are NULL. When nullSafe is set, NULL equals NULL.
+func (env *ExpressionEnv) equal(left, right eval, nullSafe bool) (eq, null bool, err error) {
+	lt, lok := left.(evalTuple)
+	rt, rok := right.(evalTuple)
+	switch {
+	case lok && rok:
+		if len(lt.t) != len(rt.t) {
+			return false, false, errOperandColumns(len(lt.t))
+		}
+		for i := range lt.t {
+			eq, isNull, err := env.equal(lt.t[i], rt.t[i], nullSafe)
+			if err != nil {
+				return false, false, err
+			}
+			if !eq && !isNull {
+				return false, false, nil
+			}
+			null = null || isNull
+		}
+		return !null, null, nil
+	case lok:
+		return false, false, errOperandColumns(len(lt.t))
+	case rok:
+		return false, false, errOperandColumns(1)
+	case left == nil || right == nil:
+		if nullSafe {
+			return left == nil && right == nil, false, nil
+		}
+		return false, true, nil
+	}
+	n, err := env.compareScalars(left, right)
+	return n == 0, false, err
+}
+
+// compare returns how two values order, and whether that is NULL. Rows order
+// by their first columns that are not equal.
+func (env *ExpressionEnv) compare(left, right eval) (int, bool, error) {
+	lt, lok := left.(evalTuple)
+	rt, rok := right.(evalTuple)
+	switch {
+	case lok && rok:
+		if len(lt.t) != len(rt.t) {
+			return 0, false, errOperandColumns(len(lt.t))
+		}
+		for i := range lt.t {
+			n, null, err := env.compare(lt.t[i], rt.t[i])
+			if err != nil || null || n != 0 {
+				return n, null, err
+			}
+		}
+		return 0, false, nil
+	case lok:
+		return 0, false, errOperandColumns(len(lt.t))
+	case rok:
+		return 0, false, errOperandColumns(1)
+	case left == nil || right == nil:
+		return 0, true, nil
+	}
+	n, err := env.compareScalars(left, right)
+	return n, false, err
+}
+
+// compareScalars compares two values that are not NULL in the type MySQL
+// compares them in:
+//
+//   - two strings compare as strings, in the collation of mergeCollations;
+//   - two integers compare as integers;
+//   - two temporal values compare as temporal values, and so does a temporal
+//     value with a string that is one;
+//   - a decimal compares with a decimal, an integer or a temporal value as a
+//     decimal;
+//   - anything else compares as doubles.
+func (env *ExpressionEnv) compareScalars(left, right eval) (int, error) {
+	_, ljson := left.(evalJSON)
+	_, rjson := right.(evalJSON)
+	if ljson || rjson {
+		return json.Compare(toJSON(left, false), toJSON(right, false)), nil
+	}
+
+	lb, lbytes := left.(evalBytes)
+	rb, rbytes := right.(evalBytes)
+	if lbytes && rbytes {
+		col, err := mergeCollations(lb, rb, "comparison")
+		if err != nil {
+			return 0, err
+		}
+		return col.Compare(lb.bytes, rb.bytes), nil
+	}
+
+	lt, ltemporal := left.(evalTemporal)
+	rt, rtemporal := right.(evalTemporal)
+	switch {
+	case ltemporal && rtemporal:
+		return compareTemporal(env, lt, rt), nil
+	case ltemporal && rbytes && !rb.literal:
+		if parsed, ok := parseTemporal(rb.string()); ok {
+			return compareTemporal(env, lt, parsed), nil
+		}
+		return rb.collation().Compare(lt.format(), rb.bytes), nil
+	case rtemporal && lbytes && !lb.literal:
+		if parsed, ok := parseTemporal(lb.string()); ok {
+			return compareTemporal(env, parsed, rt), nil
+		}
+		return lb.collation().Compare(lb.bytes, rt.format()), nil
+	}
+
+	if (lbytes && !lb.literal) || (rbytes && !rb.literal) {
+		return cmp.Compare(env.toFloat(left), env.toFloat(right)), nil
+	}
+	left, right = env.toNumber(left), env.toNumber(right)
+	switch {
+	case isFloat(left) || isFloat(right):
+		return cmp.Compare(env.toFloat(left), env.toFloat(right)), nil
+	case isDecimal(left) || isDecimal(right):
+		return env.toDecimal(left).dec.Cmp(env.toDecimal(right).dec), nil
+	}
+	return compareIntegers(left, right), nil
+}
+
+func compareIntegers(left, right eval) int {
+	lu, lneg := unsignedAbs(left)
+	ru, rneg := unsignedAbs(right)
+	switch {
+	case lneg && !rneg:
+		return -1
+	case !lneg && rneg:
+		return 1
+	case lneg:
+		return cmp.Compare(ru, lu)
+	}
+	return cmp.Compare(lu, ru)
+}
+
+// compareTemporal compares temporal values as DATETIMEs, unless both are
+// TIMEs or both are DATEs.
+func compareTemporal(env *ExpressionEnv, left, right evalTemporal) int {
+	switch {
+	case left.t == sqltypes.Time && right.t == sqltypes.Time:
+		return left.dt.Time.Compare(right.dt.Time)
+	case left.t == sqltypes.Date && right.t == sqltypes.Date:
+		return left.dt.Date.Compare(right.dt.Date)
+	}
+	return left.toDateTime(env).dt.Compare(right.toDateTime(env).dt)
+}
+
+func (in *inExpr) eval(env *ExpressionEnv) (eval, error) {
+	left, err := in.left.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	var values []eval
+	if in.tuple != nil {
+		e, err := in.tuple.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		values = e.(evalTuple).t
+	} else {
+		values = make([]eval, 0, len(in.list))
+		for _, expr := range in.list {
+			e, err := expr.eval(env)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, e)
+		}
+	}
+
+	null := false
+	for _, value := range values {
+		eq, isNull, err := env.equal(left, value, false)
+		if err != nil {
+			return nil, err
+		}
+		if eq {
+			return newEvalBool(!in.not), nil
+		}
+		null = null || isNull
+	}
+	if null {
+		return nil, nil
+	}
+	return newEvalBool(in.not), nil
+}
+
+func (in *inExpr) typeof() (querypb.Type, bool) {
+	return sqltypes.Int64, true
+}
+
+func (b *betweenExpr) eval(env *ExpressionEnv) (eval, error) {
+	left, err := b.left.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	from, err := b.from.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	to, err := b.to.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	low, err := env.compareOp(sqlparser.GreaterEqualOp, left, from)
+	if err != nil {
+		return nil, err
+	}
+	high, err := env.compareOp(sqlparser.LessEqualOp, left, to)
+	if err != nil {
+		return nil, err
+	}
+	result := and(env, low, high)
+	if b.not {
+		return not(env, result), nil
+	}
+	return result, nil
+}
+
+func (b *betweenExpr) typeof() (querypb.Type, bool) {
+	return sqltypes.Int64, true
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/datetime"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/decimal"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/fastparse"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/format"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+// toNumber returns a value as the number it is in arithmetic: numbers as they
+// are, hexadecimal and bit literals as unsigned integers, temporal values as
+// the numbers they are in numeric contexts and other strings as doubles. JSON
+// values are the numbers their SQL values are, and JSON null is 0.
+func (env *ExpressionEnv) toNumber(e eval) eval {
+	switch e := e.(type) {
+	case evalJSON:
+		if scalar := e.scalar(); scalar != nil {
+			return env.toNumber(scalar)
+		}
+		return evalInt64{}
+	case evalBytes:
+		if e.literal {
+			u, _ := e.toUint64()
+			return evalUint64{u: u}
+		}
+		return evalFloat{f: env.parseFloat(e.bytes)}
+	case evalTemporal:
+		return e.toNumber()
+	}
+	return e
+}
+
+// parseFloat returns the number at the start of a string, warning when the
+// string is not a number as a whole.
+func (env *ExpressionEnv) parseFloat(s []byte) float64 {
+	f, err := fastparse.ParseFloat64(string(s))
+	if err != nil {
+		env.warn(WarnTruncatedWrongValue, fmt.Sprintf("Truncated incorrect DOUBLE value: '%s'", s))
+	}
+	return f
+}
+
+// parseDecimal returns the decimal at the start of a string, warning when the
+// string is not a decimal as a whole.
+func (env *ExpressionEnv) parseDecimal(s []byte) evalDecimal {
+	dec, err := decimal.NewFromString(strings.TrimLeft(string(s), " \t\r\n"))
+	if err != nil {
+		env.warn(WarnTruncatedWrongValue, fmt.Sprintf("Truncated incorrect DECIMAL value: '%s'", s))
+	}
+	return evalDecimal{dec: dec, length: max(-dec.Exponent(), 0)}
+}
+
+// parseInt64 returns the integer at the start of a string, warning when the
+// string is not an integer as a whole.
+func (env *ExpressionEnv) parseInt64(s []byte) int64 {
+	i, err := fastparse.ParseInt64(strings.TrimSpace(string(s)), 10)
+	if err != nil {
+		env.warn(WarnTruncatedWrongValue, fmt.Sprintf("Truncated incorrect INTEGER value: '%s'", s))
+	}
+	return i
+}
+
+// parseUint64 is parseInt64 for unsigned integers. Negative integers wrap
+// around like they do in MySQL.
+func (env *ExpressionEnv) parseUint64(s []byte) uint64 {
+	trimmed := strings.TrimSpace(string(s))
+	if strings.HasPrefix(trimmed, "-") {
+		return uint64(env.parseInt64(s))
+	}
+	u, err := fastparse.ParseUint64(trimmed, 10)
+	if err != nil {
+		env.warn(WarnTruncatedWrongValue, fmt.Sprintf("Truncated incorrect INTEGER value: '%s'", s))
+	}
+	return u
+}
+
+// toFloat returns a value as a double.
+func (env *ExpressionEnv) toFloat(e eval) float64 {
+	switch e := env.toNumber(e).(type) {
+	case evalInt64:
+		return float64(e.i)
+	case evalUint64:
+		return float64(e.u)
+	case evalFloat:
+		return e.f
+	case evalDecimal:
+		f, _ := e.dec.Float64()
+		return f
+	}
+	return 0
+}
+
+// toDecimal returns a value as a decimal, with the number of digits it has
+// after the decimal point.
+func (env *ExpressionEnv) toDecimal(e eval) evalDecimal {
+	switch e := e.(type) {
+	case evalBytes:
+		if !e.literal {
+			return env.parseDecimal(e.bytes)
+		}
+	case evalFloat:
+		dec := decimal.NewFromFloat(e.f)
+		return evalDecimal{dec: dec, length: max(-dec.Exponent(), 0)}
+	}
+	switch e := env.toNumber(e).(type) {
+	case evalInt64:
+		return evalDecimal{dec: decimal.NewFromInt(e.i)}
+	case evalUint64:
+		return evalDecimal{dec: decimal.NewFromUint(e.u)}
+	case evalDecimal:
+		return e
+	}
+	return evalDecimal{dec: decimal.Zero}
+}
+
+// toInt64 returns a value as a signed integer, rounding doubles and decimals
+// and clamping the ones out of range.
+func (env *ExpressionEnv) toInt64(e eval) int64 {
+	switch e := e.(type) {
+	case evalBytes:
+		if !e.literal {
+			return env.parseInt64(e.bytes)
+		}
+	}
+	switch e := env.toNumber(e).(type) {
+	case evalInt64:
+		return e.i
+	case evalUint64:
+		return int64(e.u)
+	case evalFloat:
+		return floatToInt64(e.f)
+	case evalDecimal:
+		i, ok := e.dec.Round(0).Int64()
+		if !ok {
+			if e.dec.Sign() < 0 {
+				return math.MinInt64
+			}
+			return math.MaxInt64
+		}
+		return i
+	}
+	return 0
+}
+
+// toUint64 returns a value as an unsigned integer. Negative integers wrap
+// around.
+func (env *ExpressionEnv) toUint64(e eval) uint64 {
+	switch e := e.(type) {
+	case evalBytes:
+		if !e.literal {
+			return env.parseUint64(e.bytes)
+		}
+	}
+	switch e := env.toNumber(e).(type) {
+	case evalInt64:
+		return uint64(e.i)
+	case evalUint64:
+		return e.u
+	case evalFloat:
+		if e.f < 0 {
+			return uint64(floatToInt64(e.f))
+		}
+		if e.f >= math.MaxUint64 {
+			return math.MaxUint64
+		}
+		return uint64(math.Round(e.f))
+	case evalDecimal:
+		rounded := e.dec.Round(0)
+		if rounded.Sign() < 0 {
+			i, _ := rounded.Int64()
+			return uint64(i)
+		}
+		u, ok := rounded.Uint64()
+		if !ok {
+			return math.MaxUint64
+		}
+		return u
+	}
+	return 0
+}
+
+func floatToInt64(f float64) int64 {
+	switch {
+	case f >= math.MaxInt64:
+		return math.MaxInt64
+	case f <= math.MinInt64:
+		return math.MinInt64
+	}
+	return int64(math.Round(f))
+}
+
+// toBytes returns a value as the string it is in string contexts.
+func toBytes(e eval) []byte {
+	switch e := e.(type) {
+	case evalBytes:
+		return e.bytes
+	case evalInt64:
+		return fmt.Appendf(nil, "%d", e.i)
+	case evalUint64:
+		return fmt.Appendf(nil, "%d", e.u)
+	case evalFloat:
+		return format.FormatFloat(e.f)
+	case evalDecimal:
+		return e.dec.FormatMySQL(e.length)
+	case evalTemporal:
+		return e.format()
+	case evalJSON:
+		return e.v.Marshal()
+	}
+	return nil
+}
+
+// toText returns a value as a string, which is binary when the value is.
+func toText(e eval) evalBytes {
+	if b, ok := e.(evalBytes); ok {
+		return evalBytes{tt: b.tt, bytes: b.bytes, col: b.col, derivation: b.derivation}
+	}
+	return evalBytes{tt: sqltypes.VarChar, bytes: toBytes(e)}
+}
+
+// isTrue returns whether a value is true in a boolean context: whether it is a
+// number other than zero. It returns false for NULL, which is neither true nor
+// false.
+func (env *ExpressionEnv) isTrue(e eval) bool {
+	switch e := env.toNumber(e).(type) {
+	case evalInt64:
+		return e.i != 0
+	case evalUint64:
+		return e.u != 0
+	case evalFloat:
+		return e.f != 0
+	case evalDecimal:
+		return !e.dec.IsZero()
+	}
+	return false
+}
+
+// parseTemporal parses a string as a DATETIME, a DATE or a TIME, whichever it
+// is.
+func parseTemporal(s string) (evalTemporal, bool) {
+	s = strings.TrimSpace(s)
+	if dt, prec, ok := datetime.ParseDateTime(s, -1); ok {
+		return evalTemporal{t: sqltypes.Datetime, dt: dt, prec: uint8(prec)}, true
+	}
+	if d, ok := datetime.ParseDate(s); ok {
+		return evalTemporal{t: sqltypes.Date, dt: datetime.DateTime{Date: d}}, true
+	}
+	if t, prec, state := datetime.ParseTime(s, -1); state == datetime.TimeOK {
+		return evalTemporal{t: sqltypes.Time, dt: datetime.DateTime{Time: t}, prec: uint8(prec)}, true
+	}
+	return evalTemporal{}, false
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/datetime"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/decimal"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/format"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/json"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// eval is an evaluated value. NULL is a nil eval.
+type eval interface {
+	SQLType() querypb.Type
+}
+
+type (
+	evalInt64 struct {
+		i int64
+	}
+
+	evalUint64 struct {
+		u uint64
+	}
+
+	evalFloat struct {
+		f float64
+	}
+
+	// evalDecimal is a decimal with the number of digits it has after the
+	// decimal point, which its value alone does not tell.
+	evalDecimal struct {
+		dec    decimal.Decimal
+		length int32
+	}
+
+	// evalBytes is a string. Hexadecimal and bit literals are binary strings
+	// that are numbers in numeric contexts. Strings that are not binary have
+	// the collation col, the default when it is Unknown, which they got with
+	// a derivation. Geometries are binary strings in MySQL's internal format.
+	evalBytes struct {
+		tt         querypb.Type
+		bytes      []byte
+		literal    bool
+		col        collations.ID
+		derivation derivation
+	}
+
+	// evalTemporal is a DATE, a DATETIME, a TIMESTAMP or a TIME, which is held
+	// in the Time of dt.
+	evalTemporal struct {
+		t    querypb.Type
+		dt   datetime.DateTime
+		prec uint8
+	}
+
+	evalTuple struct {
+		t []eval
+	}
+
+	// evalJSON is a JSON document.
+	evalJSON struct {
+		v *json.Value
+	}
+)
+
+func (e evalInt64) SQLType() querypb.Type    { return sqltypes.Int64 }
+func (e evalUint64) SQLType() querypb.Type   { return sqltypes.Uint64 }
+func (e evalFloat) SQLType() querypb.Type    { return sqltypes.Float64 }
+func (e evalDecimal) SQLType() querypb.Type  { return sqltypes.Decimal }
+func (e evalBytes) SQLType() querypb.Type    { return e.tt }
+func (e evalTemporal) SQLType() querypb.Type { return e.t }
+func (e evalTuple) SQLType() querypb.Type    { return sqltypes.Tuple }
+func (e evalJSON) SQLType() querypb.Type     { return sqltypes.TypeJSON }
+
+var (
+	evalTrue  eval = evalInt64{i: 1}
+	evalFalse eval = evalInt64{i: 0}
+)
+
+func newEvalBool(b bool) eval {
+	if b {
+		return evalTrue
+	}
+	return evalFalse
+}
+
+func newEvalText(s []byte) eval {
+	return evalBytes{tt: sqltypes.VarChar, bytes: s}
+}
+
+func newEvalBinary(s []byte) eval {
+	return evalBytes{tt: sqltypes.VarBinary, bytes: s}
+}
+
+// newEvalDecimal returns a decimal with length digits after the decimal point.
+func newEvalDecimal(dec decimal.Decimal, length int32) eval {
+	return evalDecimal{dec: dec, length: length}
+}
+
+func (e evalBytes) isBinary() bool {
+	return sqltypes.IsBinary(e.tt) || e.tt == sqltypes.Geometry
+}
+
+func (e evalBytes) string() string {
+	return string(e.bytes)
+}
+
+// toUint64 returns the number a hexadecimal or bit literal stands for, which
+// is its bytes as a big endian integer.
+func (e evalBytes) toUint64() (uint64, bool) {
+	if len(e.bytes) > 8 {
+		return math.MaxUint64, false
+	}
+	var u uint64
+	for _, b := range e.bytes {
+		u = u<<8 | uint64(b)
+	}
+	return u, true
+}
+
+func (e evalTemporal) format() []byte {
+	switch e.t {
+	case sqltypes.Date:
+		return e.dt.Date.Format()
+	case sqltypes.Time:
+		return e.dt.Time.Format(e.prec)
+	}
+	return e.dt.Format(e.prec)
+}
+
+// toNumber returns the number a temporal value is in numeric contexts:
+// YYYYMMDD, YYYYMMDDhhmmss or hhmmss, with its fractional seconds.
+func (e evalTemporal) toNumber() eval {
+	switch e.t {
+	case sqltypes.Date:
+		return evalInt64{i: e.dt.Date.FormatInt64()}
+	case sqltypes.Time:
+		if e.prec == 0 {
+			return evalInt64{i: e.dt.Time.FormatInt64()}
+		}
+		return newEvalDecimal(e.dt.Time.FormatDecimal(), int32(e.prec))
+	}
+	if e.prec == 0 {
+		return evalInt64{i: e.dt.FormatInt64()}
+	}
+	return newEvalDecimal(e.dt.FormatDecimal(), int32(e.prec))
+}
+
+// toDateTime returns a temporal value as a DATETIME. A TIME is a time of the
+// current day.
+func (e evalTemporal) toDateTime(env *ExpressionEnv) evalTemporal {
+	switch e.t {
+	case sqltypes.Date:
+		return evalTemporal{t: sqltypes.Datetime, dt: datetime.DateTime{Date: e.dt.Date}}
+	case sqltypes.Time:
+		return evalTemporal{t: sqltypes.Datetime, dt: e.dt.Time.ToDateTime(env.Now.In(env.location())), prec: e.prec}
+	}
+	return evalTemporal{t: sqltypes.Datetime, dt: e.dt, prec: e.prec}
+}
+
+func errOperandColumns(n int) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.OperandColumns, "Operand should contain %d column(s)", n)
+}
+
+// evalToValue returns the value of an evaluated value.
+func evalToValue(e eval) (sqltypes.Value, error) {
+	switch e := e.(type) {
+	case nil:
+		return sqltypes.NULL, nil
+	case evalInt64:
+		return sqltypes.NewInt64(e.i), nil
+	case evalUint64:
+		return sqltypes.NewUint64(e.u), nil
+	case evalFloat:
+		return sqltypes.MakeTrusted(sqltypes.Float64, format.FormatFloat(e.f)), nil
+	case evalDecimal:
+		return sqltypes.MakeTrusted(sqltypes.Decimal, e.dec.FormatMySQL(e.length)), nil
+	case evalBytes:
+		return sqltypes.MakeTrusted(e.tt, e.bytes), nil
+	case evalTemporal:
+		return sqltypes.MakeTrusted(e.t, e.format()), nil
+	case evalJSON:
+		return sqltypes.MakeTrusted(sqltypes.TypeJSON, e.v.Marshal()), nil
+	case evalTuple:
+		return sqltypes.Value{}, errOperandColumns(1)
+	}
+	return sqltypes.Value{}, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected evaluated value %T", e)
+}
+
+// valueToEval returns the evaluated value of a value.
+func valueToEval(v sqltypes.Value) (eval, error) {
+	wrongValue := func(err error) error {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValue, "Incorrect %s value: '%s'", v.Type(), v.RawStr())
+	}
+	switch typ := v.Type(); {
+	case typ == sqltypes.Null:
+		return nil, nil
+	case typ == sqltypes.HexNum, typ == sqltypes.HexVal, typ == sqltypes.BitNum:
+		b, err := v.ToBytes()
+		if err != nil {
+			return nil, wrongValue(err)
+		}
+		return evalBytes{tt: sqltypes.VarBinary, bytes: b, literal: true}, nil
+	case sqltypes.IsSigned(typ), typ == sqltypes.Year:
+		i, err := strconv.ParseInt(v.RawStr(), 10, 64)
+		if err != nil {
+			return nil, wrongValue(err)
+		}
+		return evalInt64{i: i}, nil
+	case sqltypes.IsUnsigned(typ):
+		u, err := strconv.ParseUint(v.RawStr(), 10, 64)
+		if err != nil {
+			return nil, wrongValue(err)
+		}
+		return evalUint64{u: u}, nil
+	case sqltypes.IsFloat(typ):
+		f, err := strconv.ParseFloat(v.RawStr(), 64)
+		if err != nil {
+			return nil, wrongValue(err)
+		}
+		return evalFloat{f: f}, nil
+	case typ == sqltypes.Decimal:
+		dec, err := decimal.NewFromMySQL(v.Raw())
+		if err != nil {
+			return nil, wrongValue(err)
+		}
+		_, length := decimal.SizeAndScaleFromString(v.RawStr())
+		return newEvalDecimal(dec, length), nil
+	case typ == sqltypes.Date:
+		d, ok := datetime.ParseDate(v.RawStr())
+		if !ok {
+			return nil, wrongValue(nil)
+		}
+		return evalTemporal{t: typ, dt: datetime.DateTime{Date: d}}, nil
+	case typ == sqltypes.Datetime, typ == sqltypes.Timestamp:
+		dt, prec, ok := datetime.ParseDateTime(v.RawStr(), -1)
+		if !ok {
+			return nil, wrongValue(nil)
+		}
+		return evalTemporal{t: typ, dt: dt, prec: uint8(prec)}, nil
+	case typ == sqltypes.Time:
+		t, prec, state := datetime.ParseTime(v.RawStr(), -1)
+		if state != datetime.TimeOK {
+			return nil, wrongValue(nil)
+		}
+		return evalTemporal{t: typ, dt: datetime.DateTime{Time: t}, prec: uint8(prec)}, nil
+	case typ == sqltypes.Enum, typ == sqltypes.Set:
+		return evalBytes{tt: sqltypes.VarChar, bytes: v.Raw()}, nil
+	case typ == sqltypes.TypeJSON:
+		doc, err := json.Parse(v.RawStr())
+		if err != nil {
+			return nil, wrongValue(err)
+		}
+		return evalJSON{v: doc}, nil
+	case sqltypes.IsText(typ), sqltypes.IsBinary(typ), typ == sqltypes.Bit, typ == sqltypes.Geometry:
+		return evalBytes{tt: typ, bytes: v.Raw()}, nil
+	}
+	return nil, vterrors.VT12001("evaluating values of type " + v.Type().String())
+}
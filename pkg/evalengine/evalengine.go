@@ -0,0 +1,136 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package evalengine evaluates SQL expressions the way MySQL does.
+//
+// An expression is first translated from its AST with Translate, which binds
+// its column names to offsets in a row, and can then be evaluated any number
+// of times against an ExpressionEnv holding a row and bind variables.
+//
+// Evaluation follows MySQL's rules for implicit type conversion: operands of
+// arithmetic are integers, decimals or doubles as MySQL would pick, strings
+// become numbers with the warnings MySQL gives when they are not numbers, and
+// comparisons pick the type they compare in from the types of both operands.
+// NULL propagates through every operator and function except the ones that
+// exist to handle it. Integer arithmetic that overflows fails with the error
+// MySQL gives, and division by zero is NULL with a warning.
+package evalengine
+
+import (
+	"time"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+// Expr is an expression translated for evaluation.
+type Expr interface {
+	eval(env *ExpressionEnv) (eval, error)
+	// typeof returns the type the expression evaluates to, if it is known
+	// without evaluating it.
+	typeof() (querypb.Type, bool)
+}
+
+// Config tells Translate how to bind the column names of an expression.
+type Config struct {
+	// ResolveColumn returns the offset in the row of the column a name refers
+	// to. When it is nil, expressions cannot use columns.
+	ResolveColumn func(col *sqlparser.ColName) (int, error)
+	// ResolveType returns the type of the column at an offset, if it is known.
+	// Types are only needed to pick the type of the result of CASE, IF,
+	// IFNULL and COALESCE when their branches have different types.
+	ResolveType func(offset int) (querypb.Type, bool)
+	// ResolveCollation returns the collation of the column at an offset, if it
+	// is known. Strings of columns without one have the default collation.
+	ResolveCollation func(offset int) (collations.ID, bool)
+}
+
+// Warning is a warning raised while evaluating an expression, with the code
+// and the message MySQL gives it.
+type Warning struct {
+	Code    int
+	Message string
+}
+
+// The codes of the warnings raised.
+const (
+	WarnTruncatedWrongValue = 1292
+	WarnDivisionByZero      = 1365
+	WarnDataOutOfRange      = 1264
+)
+
+// ExpressionEnv is what expressions are evaluated against: a row, which
+// columns refer to by offset, and bind variables.
+type ExpressionEnv struct {
+	BindVars map[string]*querypb.BindVariable
+	Row      []sqltypes.Value
+	// Now is the time NOW() and the other current time functions return.
+	Now time.Time
+	// TimeZone is the time zone of the session, UTC when nil.
+	TimeZone *time.Location
+
+	warnings []Warning
+	// named is the row Predicate.MatchNamed puts values by name in order in.
+	named []sqltypes.Value
+}
+
+// NewExpressionEnv returns an environment for a row and bind variables, whose
+// current time is the time it is created.
+func NewExpressionEnv(bindVars map[string]*querypb.BindVariable, row []sqltypes.Value) *ExpressionEnv {
+	return &ExpressionEnv{BindVars: bindVars, Row: row, Now: time.Now()}
+}
+
+// Evaluate evaluates an expression and returns its value.
+func (env *ExpressionEnv) Evaluate(expr Expr) (sqltypes.Value, error) {
+	e, err := expr.eval(env)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return evalToValue(e)
+}
+
+// Warnings returns the warnings raised by the evaluations so far.
+func (env *ExpressionEnv) Warnings() []Warning {
+	return env.warnings
+}
+
+// ClearWarnings forgets the warnings raised so far.
+func (env *ExpressionEnv) ClearWarnings() {
+	env.warnings = nil
+}
+
+func (env *ExpressionEnv) warn(code int, message string) {
+	env.warnings = append(env.warnings, Warning{Code: code, Message: message})
+}
+
+func (env *ExpressionEnv) location() *time.Location {
+	if env.TimeZone == nil {
+		return time.UTC
+	}
+	return env.TimeZone
+}
+
+// Evaluate translates an expression that uses no columns and evaluates it
+// with the given bind variables.
+func Evaluate(expr sqlparser.Expr, bindVars map[string]*querypb.BindVariable) (sqltypes.Value, error) {
+	translated, err := Translate(expr, nil)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return NewExpressionEnv(bindVars, nil).Evaluate(translated)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"encoding/hex"
+	"math"
+	"strconv"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+// RewritePredicate is sqlparser.RewritePredicate, folding the expressions with
+// only literals as operands into their values with FoldConstant.
+func RewritePredicate(ast sqlparser.SQLNode) sqlparser.SQLNode {
+	return sqlparser.RewritePredicateWith(ast, FoldConstant)
+}
+
+// FoldConstant evaluates an expression that has only literals as operands
+// and returns its value as a literal, which evaluates to the same value with
+// the same type. Expressions that fail or raise warnings are not folded, so
+// that the error or the warnings are still raised when they are evaluated, nor
+// are expressions whose value no literal has, like JSON documents.
+//
+// It is a sqlparser.ConstantFolder, for sqlparser.RewritePredicateWith. Strings
+// are only folded in the default collation, which is the one a literal has.
+func FoldConstant(expr sqlparser.Expr) (sqlparser.Expr, bool) {
+	translated, err := Translate(expr, nil)
+	if err != nil {
+		return nil, false
+	}
+	env := NewExpressionEnv(nil, nil)
+	e, err := translated.eval(env)
+	if err != nil || len(env.warnings) > 0 {
+		return nil, false
+	}
+	var lit sqlparser.Expr
+	if i, ok := e.(evalInt64); ok && isBoolean(translated) {
+		lit = sqlparser.BoolVal(i.i != 0)
+	} else if lit, ok = evalToLiteral(e); !ok {
+		return nil, false
+	}
+
+	// A literal may read back as a value of another type, as an unsigned
+	// integer small enough to be signed does.
+	check, err := Translate(lit, nil)
+	if err != nil {
+		return nil, false
+	}
+	again, err := check.eval(env)
+	if err != nil || !sameEval(e, again) {
+		return nil, false
+	}
+	return lit, true
+}
+
+// evalToLiteral returns the literal a value is written as.
+func evalToLiteral(e eval) (sqlparser.Expr, bool) {
+	switch e := e.(type) {
+	case nil:
+		return &sqlparser.NullVal{}, true
+	case evalInt64:
+		return sqlparser.NewIntLiteral(strconv.FormatInt(e.i, 10)), true
+	case evalUint64:
+		return sqlparser.NewIntLiteral(strconv.FormatUint(e.u, 10)), true
+	case evalFloat:
+		if math.IsInf(e.f, 0) || math.IsNaN(e.f) {
+			return nil, false
+		}
+		// Without an exponent, a number with a point is a decimal.
+		return sqlparser.NewFloatLiteral(strconv.FormatFloat(e.f, 'e', -1, 64)), true
+	case evalDecimal:
+		return sqlparser.NewDecimalLiteral(string(e.dec.FormatMySQL(e.length))), true
+	case evalBytes:
+		switch {
+		case e.literal:
+			return sqlparser.NewHexLiteral(hex.EncodeToString(e.bytes)), true
+		case e.tt == sqltypes.VarChar && e.collation().ID() == collations.Default && e.derivation == coercible:
+			return sqlparser.NewStrLiteral(string(e.bytes)), true
+		case e.tt == sqltypes.VarBinary:
+			return &sqlparser.IntroducerExpr{CharacterSet: "_binary", Expr: sqlparser.NewStrLiteral(string(e.bytes))}, true
+		}
+	case evalTemporal:
+		switch e.t {
+		case sqltypes.Date:
+			return sqlparser.NewDateLiteral(string(e.format())), true
+		case sqltypes.Datetime:
+			return sqlparser.NewTimestampLiteral(string(e.format())), true
+		case sqltypes.Time:
+			return sqlparser.NewTimeLiteral(string(e.format())), true
+		}
+	}
+	return nil, false
+}
+
+// sameEval reports whether two values are the same value of the same type.
+func sameEval(a, b eval) bool {
+	va, err := evalToValue(a)
+	if err != nil {
+		return false
+	}
+	vb, err := evalToValue(b)
+	if err != nil || va.Type() != vb.Type() || va.RawStr() != vb.RawStr() {
+		return false
+	}
+	if ba, ok := a.(evalBytes); ok {
+		bb := b.(evalBytes)
+		return ba.literal == bb.literal && ba.collation().ID() == bb.collation().ID() && ba.derivation == bb.derivation
+	}
+	return true
+}
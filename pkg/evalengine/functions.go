@@ -0,0 +1,359 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// builtinExpr is a call to a function.
+type builtinExpr struct {
+	fn   *builtin
+	args []Expr
+	// sql is the call as MySQL shows it in errors.
+	sql string
+}
+
+// builtin is a function that can be called.
+type builtin struct {
+	// minArgs and maxArgs are the number of arguments the function takes.
+	// maxArgs is -1 when it takes any number of arguments.
+	minArgs, maxArgs int
+	// nullSafe is set for functions that are not NULL when one of their
+	// arguments is, and that call is called with NULL arguments.
+	nullSafe bool
+	// boolean is set for functions whose result is true or false, which JSON
+	// has as booleans rather than as 1 and 0.
+	boolean bool
+	call    func(env *ExpressionEnv, call *builtinExpr, args []eval) (eval, error)
+	// result returns the type of the result of a call from the types of its
+	// arguments, if it is known. It is nil for functions whose result always
+	// has the same type, which is resultType.
+	result     func(args []querypb.Type) (querypb.Type, bool)
+	resultType querypb.Type
+}
+
+// builtins are the functions called with FuncExpr, by lowercase name.
+var builtins = map[string]*builtin{}
+
+func init() {
+	for name, fn := range controlFlowBuiltins {
+		builtins[name] = fn
+	}
+	for name, fn := range numericBuiltins {
+		builtins[name] = fn
+	}
+	for name, fn := range stringBuiltins {
+		builtins[name] = fn
+	}
+	for name, fn := range temporalBuiltins {
+		builtins[name] = fn
+	}
+	for name, fn := range jsonBuiltins {
+		builtins[name] = fn
+	}
+	for name, fn := range geometryBuiltins {
+		builtins[name] = fn
+	}
+}
+
+func errParameterCount(name string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongParametersToNativeFct, "Incorrect parameter count in the call to native function '%s'", name)
+}
+
+func (b *builtinExpr) eval(env *ExpressionEnv) (eval, error) {
+	args := make([]eval, len(b.args))
+	for i, arg := range b.args {
+		e, err := arg.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		if e == nil && !b.fn.nullSafe {
+			return nil, nil
+		}
+		args[i] = e
+	}
+	return b.fn.call(env, b, args)
+}
+
+func (b *builtinExpr) typeof() (querypb.Type, bool) {
+	if b.fn.result == nil {
+		return b.fn.resultType, true
+	}
+	types := make([]querypb.Type, len(b.args))
+	for i, arg := range b.args {
+		typ, ok := arg.typeof()
+		if !ok {
+			return 0, false
+		}
+		types[i] = typ
+	}
+	return b.fn.result(types)
+}
+
+// aggregateTypes returns the type the result of a function that returns one
+// of several values has, like CASE, IF and COALESCE, from their types:
+//
+//   - strings when any of them is a string or when numbers and temporal
+//     values are mixed, binary when any of them is binary;
+//   - doubles, decimals or integers when they are all numbers, in that order
+//     of precedence, where signed and unsigned integers are decimals;
+//   - the type of temporal values when they all have the same, else DATETIME.
+//
+// NULLs are ignored.
+func aggregateTypes(types []querypb.Type) querypb.Type {
+	var text, binary, float, dec, signed, unsigned, temporal, numbers bool
+	var first querypb.Type = sqltypes.Null
+	sameTemporal := true
+	for _, typ := range types {
+		switch {
+		case typ == sqltypes.Null:
+			continue
+		case sqltypes.IsBinary(typ), typ == sqltypes.HexNum, typ == sqltypes.HexVal, typ == sqltypes.BitNum:
+			text, binary = true, true
+		case sqltypes.IsText(typ), typ == sqltypes.TypeJSON, typ == sqltypes.Enum, typ == sqltypes.Set:
+			text = true
+		case sqltypes.IsFloat(typ):
+			float, numbers = true, true
+		case typ == sqltypes.Decimal:
+			dec, numbers = true, true
+		case sqltypes.IsUnsigned(typ):
+			unsigned, numbers = true, true
+		case sqltypes.IsIntegral(typ):
+			signed, numbers = true, true
+		case sqltypes.IsDateOrTime(typ):
+			temporal = true
+			if first != sqltypes.Null && first != typ {
+				sameTemporal = false
+			}
+			first = typ
+		}
+	}
+	switch {
+	case binary:
+		return sqltypes.VarBinary
+	case text, numbers && temporal:
+		return sqltypes.VarChar
+	case temporal && sameTemporal:
+		return first
+	case temporal:
+		return sqltypes.Datetime
+	case float:
+		return sqltypes.Float64
+	case dec, signed && unsigned:
+		return sqltypes.Decimal
+	case unsigned:
+		return sqltypes.Uint64
+	case signed:
+		return sqltypes.Int64
+	}
+	return sqltypes.Null
+}
+
+// coerce converts a value to a type aggregated with aggregateTypes.
+func (env *ExpressionEnv) coerce(e eval, typ querypb.Type) eval {
+	if e == nil {
+		return nil
+	}
+	switch typ {
+	case sqltypes.VarChar:
+		if b, ok := e.(evalBytes); ok && !b.literal {
+			return b
+		}
+		return newEvalText(toBytes(e))
+	case sqltypes.VarBinary:
+		return newEvalBinary(toBytes(e))
+	case sqltypes.Float64:
+		return evalFloat{f: env.toFloat(e)}
+	case sqltypes.Decimal:
+		return env.toDecimal(e)
+	case sqltypes.Int64:
+		if _, ok := e.(evalInt64); !ok {
+			return evalInt64{i: env.toInt64(e)}
+		}
+	case sqltypes.Uint64:
+		if _, ok := e.(evalUint64); !ok {
+			return evalUint64{u: env.toUint64(e)}
+		}
+	case sqltypes.Datetime:
+		if t, ok := env.toDateTime(e, -1); ok {
+			return t
+		}
+		return nil
+	}
+	return e
+}
+
+// typedBranches holds the expressions a function returns one of, and the type
+// they are aggregated to when all their types are known.
+type typedBranches struct {
+	typ   querypb.Type
+	typed bool
+}
+
+func newTypedBranches(branches ...Expr) typedBranches {
+	types := make([]querypb.Type, 0, len(branches))
+	for _, branch := range branches {
+		if branch == nil {
+			types = append(types, sqltypes.Null)
+			continue
+		}
+		typ, ok := branch.typeof()
+		if !ok {
+			return typedBranches{}
+		}
+		types = append(types, typ)
+	}
+	return typedBranches{typ: aggregateTypes(types), typed: true}
+}
+
+func (t typedBranches) coerce(env *ExpressionEnv, e eval) eval {
+	if !t.typed {
+		return e
+	}
+	return env.coerce(e, t.typ)
+}
+
+func (t typedBranches) typeof() (querypb.Type, bool) {
+	return t.typ, t.typed
+}
+
+// caseExpr is CASE, with an operand or without one.
+type caseExpr struct {
+	operand Expr
+	whens   []caseWhen
+	// els is the ELSE branch, nil when there is none.
+	els   Expr
+	types typedBranches
+}
+
+type caseWhen struct {
+	cond, val Expr
+}
+
+func (c *caseExpr) eval(env *ExpressionEnv) (eval, error) {
+	var operand eval
+	if c.operand != nil {
+		var err error
+		if operand, err = c.operand.eval(env); err != nil {
+			return nil, err
+		}
+	}
+	for _, when := range c.whens {
+		cond, err := when.cond.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		matched := false
+		if c.operand != nil {
+			eq, null, err := env.equal(operand, cond, false)
+			if err != nil {
+				return nil, err
+			}
+			matched = eq && !null
+		} else {
+			matched = cond != nil && env.isTrue(cond)
+		}
+		if matched {
+			e, err := when.val.eval(env)
+			if err != nil {
+				return nil, err
+			}
+			return c.types.coerce(env, e), nil
+		}
+	}
+	if c.els == nil {
+		return nil, nil
+	}
+	e, err := c.els.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	return c.types.coerce(env, e), nil
+}
+
+func (c *caseExpr) typeof() (querypb.Type, bool) {
+	return c.types.typeof()
+}
+
+// ifExpr is IF(cond, then, else).
+type ifExpr struct {
+	cond, then, els Expr
+	types           typedBranches
+}
+
+func (i *ifExpr) eval(env *ExpressionEnv) (eval, error) {
+	cond, err := i.cond.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	branch := i.els
+	if cond != nil && env.isTrue(cond) {
+		branch = i.then
+	}
+	e, err := branch.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	return i.types.coerce(env, e), nil
+}
+
+func (i *ifExpr) typeof() (querypb.Type, bool) {
+	return i.types.typeof()
+}
+
+// coalesceExpr is COALESCE and IFNULL, which return their first argument that
+// is not NULL, evaluating no further.
+type coalesceExpr struct {
+	args  []Expr
+	types typedBranches
+}
+
+func (c *coalesceExpr) eval(env *ExpressionEnv) (eval, error) {
+	for _, arg := range c.args {
+		e, err := arg.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		if e != nil {
+			return c.types.coerce(env, e), nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *coalesceExpr) typeof() (querypb.Type, bool) {
+	return c.types.typeof()
+}
+
+var controlFlowBuiltins = map[string]*builtin{
+	"nullif": {minArgs: 2, maxArgs: 2, nullSafe: true, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		eq, null, err := env.equal(args[0], args[1], false)
+		if err != nil || (eq && !null) {
+			return nil, err
+		}
+		return args[0], nil
+	}, result: func(args []querypb.Type) (querypb.Type, bool) {
+		return args[0], true
+	}},
+	"isnull": {minArgs: 1, maxArgs: 1, nullSafe: true, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		return newEvalBool(args[0] == nil), nil
+	}, resultType: sqltypes.Int64},
+}
@@ -0,0 +1,728 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/format"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/geometry"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// Geometries are GEOMETRY strings in MySQL's internal format, with their
+// points longitude first in geographic spatial reference systems. The
+// functions that read or write WKT and WKB swap the axes of the systems that
+// have the latitude first, unless their options ask for another axis order.
+
+func errGISInvalidData(fn string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.GISInvalidData, "Invalid GIS data provided to function %s.", fn)
+}
+
+func errSRSNotFound(srid uint32) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.SRSNotFound, "There's no spatial reference system with SRID %d.", srid)
+}
+
+func errGISDifferentSRIDs(fn string, a, b uint32) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.GISDifferentSRIDs, "Binary geometry function %s given two geometries of different srids: %d and %d, which should have been identical.", fn, a, b)
+}
+
+func errUnexpectedGeometryType(want string, got geometry.Type, fn string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.UnexpectedGeometryType, "%s value is a geometry of unexpected type %s in %s.", want, got, fn)
+}
+
+func errGeographicNotImplemented(fn string, g *geometry.Geometry) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.OnlyImplementedForCartesianSRS, "%s(%s) has not been implemented for geographic spatial reference systems.", fn, g.Type)
+}
+
+func errNotGeographic(fn string, srid uint32) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.OnlyImplementedForGeographicSRS, "Function %s is only defined for geographic spatial reference systems, but its argument is in SRID %d, which is not geographic.", fn, srid)
+}
+
+func errCoordinateRange(latitude bool, value float64, fn string) error {
+	if latitude {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.LatitudeOutOfRange, "Latitude %f is out of range in function %s. It must be within [-90.000000, 90.000000].", value, fn)
+	}
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.LongitudeOutOfRange, "Longitude %f is out of range in function %s. It must be within (-180.000000, 180.000000].", value, fn)
+}
+
+func errGeometryArguments(fn string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongArguments, "Incorrect arguments to %s", fn)
+}
+
+func errWrongValueForFunction(what, value, fn string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValue, "Incorrect %s value: '%s' for function %s", what, value, fn)
+}
+
+// errGeometry returns the error a function gets from the geometry package.
+func errGeometry(err error, fn string) error {
+	var rangeErr *geometry.RangeError
+	var memberErr *geometry.MemberError
+	var dimErr *geometry.DimensionError
+	switch {
+	case errors.As(err, &rangeErr):
+		return errCoordinateRange(rangeErr.Latitude, rangeErr.Value, fn)
+	case errors.As(err, &memberErr) && memberErr.Type == "":
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.InvalidGeoJSON, "Invalid GeoJSON data provided to function %s: Missing required member '%s'", fn, memberErr.Member)
+	case errors.As(err, &memberErr):
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.InvalidGeoJSON, "Invalid GeoJSON data provided to function %s: Member '%s' must be of type '%s'", fn, memberErr.Member, memberErr.Type)
+	case errors.As(err, &dimErr):
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DimensionUnsupported, "Unsupported number of coordinate dimensions in function %s: Found %d, expected 2", fn, dimErr.Found)
+	case errors.Is(err, geometry.ErrInvalidGeoJSON):
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.InvalidGeoJSON, "Invalid GeoJSON data provided to function %s", fn)
+	}
+	return errGISInvalidData(fn)
+}
+
+func newEvalGeometry(g *geometry.Geometry) eval {
+	return evalBytes{tt: sqltypes.Geometry, bytes: g.Marshal()}
+}
+
+// geometryArg returns an argument that is a geometry, which any string in
+// MySQL's internal format is, and its spatial reference system.
+func geometryArg(e eval, fn string) (*geometry.Geometry, *geometry.SRS, error) {
+	b, ok := e.(evalBytes)
+	if !ok {
+		return nil, nil, errGISInvalidData(fn)
+	}
+	g, err := geometry.Parse(b.bytes)
+	if err != nil {
+		return nil, nil, errGISInvalidData(fn)
+	}
+	srs, ok := geometry.GetSRS(g.SRID)
+	if !ok {
+		return nil, nil, errSRSNotFound(g.SRID)
+	}
+	return g, srs, nil
+}
+
+// sridArg returns the spatial reference system an argument is the SRID of.
+func (env *ExpressionEnv) sridArg(e eval, fn string) (*geometry.SRS, error) {
+	srid := env.toInt64(e)
+	if srid < 0 || srid > math.MaxUint32 {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "SRID value is out of range in '%s'", fn)
+	}
+	srs, ok := geometry.GetSRS(uint32(srid))
+	if !ok {
+		return nil, errSRSNotFound(uint32(srid))
+	}
+	return srs, nil
+}
+
+// axisOrder is the axis-order option of the functions that read and write
+// WKT and WKB.
+type axisOrder uint8
+
+const (
+	axisSRIDDefined axisOrder = iota
+	axisLatLong
+	axisLongLat
+)
+
+// axisOrderArg returns the axis order an argument of options sets, which is
+// a comma separated list of key=value pairs.
+func axisOrderArg(e eval, fn string) (axisOrder, error) {
+	order, set := axisSRIDDefined, false
+	options := string(toBytes(e))
+	if strings.TrimSpace(options) == "" {
+		return order, nil
+	}
+	for _, option := range strings.Split(options, ",") {
+		key, value, ok := strings.Cut(option, "=")
+		if !ok {
+			return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.InvalidOption, "The string '%s' is not a valid key %c value pair in function %s.", strings.TrimSpace(option), '=', fn)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !strings.EqualFold(key, "axis-order") {
+			return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.InvalidOption, "Invalid option key '%s' in function %s.", key, fn)
+		}
+		if set {
+			return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.InvalidOption, "Duplicate option key '%s' in funtion '%s'.", key, fn)
+		}
+		switch strings.ToLower(value) {
+		case "srid-defined":
+			order = axisSRIDDefined
+		case "lat-long":
+			order = axisLatLong
+		case "long-lat":
+			order = axisLongLat
+		default:
+			return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.InvalidOption, "Invalid value '%s' for option '%s' in function '%s'.", value, key, fn)
+		}
+		set = true
+	}
+	return order, nil
+}
+
+// swapAxes reports whether the points of a geometry in a spatial reference
+// system have their axes swapped in an axis order.
+func (order axisOrder) swapAxes(srs *geometry.SRS) bool {
+	return srs.Geographic && (order == axisLatLong || order == axisSRIDDefined && srs.LatitudeFirst)
+}
+
+// inSRS returns a geometry read from WKT or WKB in the spatial reference
+// system and the axis order args, which are the optional SRID and options of
+// the function, give.
+func (env *ExpressionEnv) inSRS(g *geometry.Geometry, args []eval, fn string) (eval, error) {
+	srs, _ := geometry.GetSRS(0)
+	order := axisSRIDDefined
+	var err error
+	if len(args) > 0 {
+		if srs, err = env.sridArg(args[0], fn); err != nil {
+			return nil, err
+		}
+	}
+	if len(args) > 1 {
+		if order, err = axisOrderArg(args[1], fn); err != nil {
+			return nil, err
+		}
+	}
+	if order.swapAxes(srs) {
+		g.SwapAxes()
+	}
+	g.SetSRID(srs.ID)
+	if err := g.CheckRange(srs); err != nil {
+		return nil, errGeometry(err, fn)
+	}
+	return newEvalGeometry(g), nil
+}
+
+// geomFromTextTypes and geomFromWKBTypes are the types of geometries the
+// functions that read WKT and WKB return, where 0 is any.
+var geomFromTextTypes = map[sqlparser.GeomFromWktType]geometry.Type{
+	sqlparser.GeometryFromText:           0,
+	sqlparser.GeometryCollectionFromText: geometry.TypeGeometryCollection,
+	sqlparser.PointFromText:              geometry.TypePoint,
+	sqlparser.LineStringFromText:         geometry.TypeLineString,
+	sqlparser.PolygonFromText:            geometry.TypePolygon,
+	sqlparser.MultiPointFromText:         geometry.TypeMultiPoint,
+	sqlparser.MultiPolygonFromText:       geometry.TypeMultiPolygon,
+	sqlparser.MultiLinestringFromText:    geometry.TypeMultiLineString,
+}
+
+var geomFromWKBTypes = map[sqlparser.GeomFromWkbType]geometry.Type{
+	sqlparser.GeometryFromWKB:           0,
+	sqlparser.GeometryCollectionFromWKB: geometry.TypeGeometryCollection,
+	sqlparser.PointFromWKB:              geometry.TypePoint,
+	sqlparser.LineStringFromWKB:         geometry.TypeLineString,
+	sqlparser.PolygonFromWKB:            geometry.TypePolygon,
+	sqlparser.MultiPointFromWKB:         geometry.TypeMultiPoint,
+	sqlparser.MultiPolygonFromWKB:       geometry.TypeMultiPolygon,
+	sqlparser.MultiLinestringFromWKB:    geometry.TypeMultiLineString,
+}
+
+// geomFrom returns the function that reads a geometry of a type, or of any
+// type when it is 0, with parse.
+func geomFrom(name string, typ geometry.Type, parse func(b []byte) (*geometry.Geometry, error)) *builtin {
+	return &builtin{minArgs: 1, maxArgs: 3, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		g, err := parse(toBytes(args[0]))
+		if err != nil || typ != 0 && g.Type != typ {
+			return nil, errGISInvalidData(name)
+		}
+		return env.inSRS(g, args[1:], name)
+	}, resultType: sqltypes.Geometry}
+}
+
+// collect returns the function that makes a geometry of a type of geometries
+// of another, like LINESTRING makes a linestring of points.
+func collect(name string, typ, member geometry.Type) *builtin {
+	return &builtin{minArgs: 1, maxArgs: -1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		g := &geometry.Geometry{Type: typ}
+		for i, arg := range args {
+			m, _, err := geometryArg(arg, name)
+			if err != nil {
+				return nil, err
+			}
+			if m.Type != member {
+				return nil, errGeometryArguments(name)
+			}
+			if i > 0 && m.SRID != g.SRID {
+				return nil, errGISDifferentSRIDs(name, g.SRID, m.SRID)
+			}
+			g.SRID = m.SRID
+			switch typ {
+			case geometry.TypeLineString:
+				g.Points = append(g.Points, m.Point())
+			case geometry.TypePolygon:
+				g.Rings = append(g.Rings, m.Points)
+			default:
+				g.Geoms = append(g.Geoms, m)
+			}
+		}
+		if err := g.Validate(); err != nil {
+			return nil, errGISInvalidData(name)
+		}
+		return newEvalGeometry(g), nil
+	}, resultType: sqltypes.Geometry}
+}
+
+// geomProperty returns a function of a geometry and the arguments after it.
+func geomProperty(name string, maxArgs int, resultType querypb.Type, fn func(env *ExpressionEnv, g *geometry.Geometry, srs *geometry.SRS, args []eval) (eval, error)) *builtin {
+	return &builtin{minArgs: 1, maxArgs: maxArgs, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		g, srs, err := geometryArg(args[0], name)
+		if err != nil {
+			return nil, err
+		}
+		return fn(env, g, srs, args[1:])
+	}, resultType: resultType}
+}
+
+// expectType returns an error unless a geometry has one of some types, the
+// first of which is the one the error names.
+func expectType(g *geometry.Geometry, fn string, types ...geometry.Type) error {
+	for _, typ := range types {
+		if g.Type == typ {
+			return nil
+		}
+	}
+	return errUnexpectedGeometryType(types[0].String(), g.Type, fn)
+}
+
+// cartesian returns an error for functions that are only implemented for
+// Cartesian spatial reference systems.
+func cartesian(g *geometry.Geometry, srs *geometry.SRS, fn string) error {
+	if srs.Geographic {
+		return errGeographicNotImplemented(fn, g)
+	}
+	return nil
+}
+
+// nth returns the nth of n things counting from 1, if there is one.
+func (env *ExpressionEnv) nth(e eval, n int) (int, bool) {
+	i := env.toInt64(e)
+	return int(i - 1), i >= 1 && i <= int64(n)
+}
+
+// pointCoordinate returns the function that gets or sets a coordinate of a
+// point: its Y or latitude when y is set, else its X or longitude. The X and
+// Y are in the axis order of its system, and the latitude and longitude are
+// only defined for geographic systems.
+func pointCoordinate(name string, y, geographic bool) *builtin {
+	fn := geomProperty(name, 2, 0, func(env *ExpressionEnv, g *geometry.Geometry, srs *geometry.SRS, args []eval) (eval, error) {
+		if err := expectType(g, name, geometry.TypePoint); err != nil {
+			return nil, err
+		}
+		if geographic && !srs.Geographic {
+			return nil, errNotGeographic(name, g.SRID)
+		}
+		coordinate := &g.Points[0].X
+		if y != (!geographic && srs.LatitudeFirst) {
+			coordinate = &g.Points[0].Y
+		}
+		if len(args) == 0 {
+			return evalFloat{f: *coordinate}, nil
+		}
+		*coordinate = env.toFloat(args[0])
+		if err := g.CheckRange(srs); err != nil {
+			return nil, errGeometry(err, name)
+		}
+		return newEvalGeometry(g), nil
+	})
+	fn.result = func(args []querypb.Type) (querypb.Type, bool) {
+		if len(args) > 1 {
+			return sqltypes.Geometry, true
+		}
+		return sqltypes.Float64, true
+	}
+	return fn
+}
+
+// sridProperty returns ST_SRID, which returns the SRID of a geometry, or with
+// a second argument the geometry in another spatial reference system, whose
+// coordinates are not transformed.
+func sridProperty() *builtin {
+	fn := geomProperty("st_srid", 2, 0, func(env *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, args []eval) (eval, error) {
+		if len(args) == 0 {
+			return evalUint64{u: uint64(g.SRID)}, nil
+		}
+		srs, err := env.sridArg(args[0], "st_srid")
+		if err != nil {
+			return nil, err
+		}
+		g.SetSRID(srs.ID)
+		if err := g.CheckRange(srs); err != nil {
+			return nil, errGeometry(err, "st_srid")
+		}
+		return newEvalGeometry(g), nil
+	})
+	fn.result = func(args []querypb.Type) (querypb.Type, bool) {
+		if len(args) > 1 {
+			return sqltypes.Geometry, true
+		}
+		return sqltypes.Uint64, true
+	}
+	return fn
+}
+
+var geometryBuiltins = newGeometryBuiltins()
+
+func newGeometryBuiltins() map[string]*builtin {
+	builtins := map[string]*builtin{
+		"point": {minArgs: 2, maxArgs: 2, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+			return newEvalGeometry(geometry.NewPoint(0, env.toFloat(args[0]), env.toFloat(args[1]))), nil
+		}, resultType: sqltypes.Geometry},
+		"linestring":      collect("linestring", geometry.TypeLineString, geometry.TypePoint),
+		"polygon":         collect("polygon", geometry.TypePolygon, geometry.TypeLineString),
+		"multipoint":      collect("multipoint", geometry.TypeMultiPoint, geometry.TypePoint),
+		"multilinestring": collect("multilinestring", geometry.TypeMultiLineString, geometry.TypeLineString),
+		"multipolygon":    collect("multipolygon", geometry.TypeMultiPolygon, geometry.TypePolygon),
+
+		"st_astext": geomProperty("st_astext", 2, sqltypes.VarChar, func(_ *ExpressionEnv, g *geometry.Geometry, srs *geometry.SRS, args []eval) (eval, error) {
+			if err := swapForOutput(g, srs, args, "st_astext"); err != nil {
+				return nil, err
+			}
+			return newEvalText([]byte(g.WKT())), nil
+		}),
+		"st_asbinary": geomProperty("st_asbinary", 2, sqltypes.VarBinary, func(_ *ExpressionEnv, g *geometry.Geometry, srs *geometry.SRS, args []eval) (eval, error) {
+			if err := swapForOutput(g, srs, args, "st_asbinary"); err != nil {
+				return nil, err
+			}
+			return newEvalBinary(g.AppendWKB(nil)), nil
+		}),
+
+		"st_issimple": geomProperty("st_issimple", 1, sqltypes.Int64, func(_ *ExpressionEnv, g *geometry.Geometry, srs *geometry.SRS, _ []eval) (eval, error) {
+			if err := cartesian(g, srs, "st_issimple"); err != nil {
+				return nil, err
+			}
+			return newEvalBool(g.IsSimple()), nil
+		}),
+		"st_isempty": geomProperty("st_isempty", 1, sqltypes.Int64, func(_ *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, _ []eval) (eval, error) {
+			return newEvalBool(g.IsEmpty()), nil
+		}),
+		"st_dimension": geomProperty("st_dimension", 1, sqltypes.Int64, func(_ *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, _ []eval) (eval, error) {
+			return evalInt64{i: int64(g.Dimension())}, nil
+		}),
+		"st_geometrytype": geomProperty("st_geometrytype", 1, sqltypes.VarChar, func(_ *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, _ []eval) (eval, error) {
+			return newEvalText([]byte(g.Type.String())), nil
+		}),
+		"st_envelope": geomProperty("st_envelope", 1, sqltypes.Geometry, func(_ *ExpressionEnv, g *geometry.Geometry, srs *geometry.SRS, _ []eval) (eval, error) {
+			if err := cartesian(g, srs, "st_envelope"); err != nil {
+				return nil, err
+			}
+			return newEvalGeometry(g.Envelope()), nil
+		}),
+		"st_swapxy": geomProperty("st_swapxy", 1, sqltypes.Geometry, func(_ *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, _ []eval) (eval, error) {
+			g.SwapAxes()
+			return newEvalGeometry(g), nil
+		}),
+		"st_srid": sridProperty(),
+
+		"st_x":         pointCoordinate("st_x", false, false),
+		"st_y":         pointCoordinate("st_y", true, false),
+		"st_latitude":  pointCoordinate("st_latitude", true, true),
+		"st_longitude": pointCoordinate("st_longitude", false, true),
+
+		"st_startpoint": geomProperty("st_startpoint", 1, sqltypes.Geometry, func(_ *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, _ []eval) (eval, error) {
+			if err := expectType(g, "st_startpoint", geometry.TypeLineString); err != nil {
+				return nil, err
+			}
+			return newEvalGeometry(geometry.NewPoint(g.SRID, g.Points[0].X, g.Points[0].Y)), nil
+		}),
+		"st_endpoint": geomProperty("st_endpoint", 1, sqltypes.Geometry, func(_ *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, _ []eval) (eval, error) {
+			if err := expectType(g, "st_endpoint", geometry.TypeLineString); err != nil {
+				return nil, err
+			}
+			end := g.Points[len(g.Points)-1]
+			return newEvalGeometry(geometry.NewPoint(g.SRID, end.X, end.Y)), nil
+		}),
+		"st_pointn": geomProperty("st_pointn", 2, sqltypes.Geometry, func(env *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, args []eval) (eval, error) {
+			if len(args) == 0 {
+				return nil, errParameterCount("st_pointn")
+			}
+			if err := expectType(g, "st_pointn", geometry.TypeLineString); err != nil {
+				return nil, err
+			}
+			i, ok := env.nth(args[0], len(g.Points))
+			if !ok {
+				return nil, nil
+			}
+			return newEvalGeometry(geometry.NewPoint(g.SRID, g.Points[i].X, g.Points[i].Y)), nil
+		}),
+		"st_isclosed": geomProperty("st_isclosed", 1, sqltypes.Int64, func(_ *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, _ []eval) (eval, error) {
+			if err := expectType(g, "st_isclosed", geometry.TypeLineString, geometry.TypeMultiLineString); err != nil {
+				return nil, err
+			}
+			return newEvalBool(g.IsClosed()), nil
+		}),
+		"st_length": geomProperty("st_length", 1, sqltypes.Float64, func(_ *ExpressionEnv, g *geometry.Geometry, srs *geometry.SRS, _ []eval) (eval, error) {
+			if err := expectType(g, "st_length", geometry.TypeLineString, geometry.TypeMultiLineString); err != nil {
+				return nil, err
+			}
+			if err := cartesian(g, srs, "st_length"); err != nil {
+				return nil, err
+			}
+			return evalFloat{f: g.Length()}, nil
+		}),
+		"st_numpoints": geomProperty("st_numpoints", 1, sqltypes.Int64, func(_ *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, _ []eval) (eval, error) {
+			if err := expectType(g, "st_numpoints", geometry.TypeLineString); err != nil {
+				return nil, err
+			}
+			return evalInt64{i: int64(len(g.Points))}, nil
+		}),
+
+		"st_area": geomProperty("st_area", 1, sqltypes.Float64, func(_ *ExpressionEnv, g *geometry.Geometry, srs *geometry.SRS, _ []eval) (eval, error) {
+			if err := expectType(g, "st_area", geometry.TypePolygon, geometry.TypeMultiPolygon); err != nil {
+				return nil, err
+			}
+			if err := cartesian(g, srs, "st_area"); err != nil {
+				return nil, err
+			}
+			return evalFloat{f: g.Area()}, nil
+		}),
+		"st_centroid": geomProperty("st_centroid", 1, sqltypes.Geometry, func(_ *ExpressionEnv, g *geometry.Geometry, srs *geometry.SRS, _ []eval) (eval, error) {
+			if err := cartesian(g, srs, "st_centroid"); err != nil {
+				return nil, err
+			}
+			centroid := g.Centroid()
+			if centroid == nil {
+				return nil, nil
+			}
+			return newEvalGeometry(centroid), nil
+		}),
+		"st_exteriorring": geomProperty("st_exteriorring", 1, sqltypes.Geometry, func(_ *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, _ []eval) (eval, error) {
+			if err := expectType(g, "st_exteriorring", geometry.TypePolygon); err != nil {
+				return nil, err
+			}
+			return newEvalGeometry(&geometry.Geometry{Type: geometry.TypeLineString, SRID: g.SRID, Points: g.Rings[0]}), nil
+		}),
+		"st_interiorringn": geomProperty("st_interiorringn", 2, sqltypes.Geometry, func(env *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, args []eval) (eval, error) {
+			if len(args) == 0 {
+				return nil, errParameterCount("st_interiorringn")
+			}
+			if err := expectType(g, "st_interiorringn", geometry.TypePolygon); err != nil {
+				return nil, err
+			}
+			i, ok := env.nth(args[0], len(g.Rings)-1)
+			if !ok {
+				return nil, nil
+			}
+			return newEvalGeometry(&geometry.Geometry{Type: geometry.TypeLineString, SRID: g.SRID, Points: g.Rings[i+1]}), nil
+		}),
+		"st_numinteriorrings": geomProperty("st_numinteriorrings", 1, sqltypes.Int64, func(_ *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, _ []eval) (eval, error) {
+			if err := expectType(g, "st_numinteriorrings", geometry.TypePolygon); err != nil {
+				return nil, err
+			}
+			return evalInt64{i: int64(len(g.Rings) - 1)}, nil
+		}),
+
+		"st_geometryn": geomProperty("st_geometryn", 2, sqltypes.Geometry, func(env *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, args []eval) (eval, error) {
+			if len(args) == 0 {
+				return nil, errParameterCount("st_geometryn")
+			}
+			if err := expectType(g, "st_geometryn", geometry.TypeGeometryCollection, geometry.TypeMultiPoint, geometry.TypeMultiLineString, geometry.TypeMultiPolygon); err != nil {
+				return nil, err
+			}
+			i, ok := env.nth(args[0], len(g.Geoms))
+			if !ok {
+				return nil, nil
+			}
+			return newEvalGeometry(g.Geoms[i]), nil
+		}),
+		"st_numgeometries": geomProperty("st_numgeometries", 1, sqltypes.Int64, func(_ *ExpressionEnv, g *geometry.Geometry, _ *geometry.SRS, _ []eval) (eval, error) {
+			if err := expectType(g, "st_numgeometries", geometry.TypeGeometryCollection, geometry.TypeMultiPoint, geometry.TypeMultiLineString, geometry.TypeMultiPolygon); err != nil {
+				return nil, err
+			}
+			return evalInt64{i: int64(len(g.Geoms))}, nil
+		}),
+
+		"st_geohash": {minArgs: 2, maxArgs: 3, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+			var longitude, latitude float64
+			if len(args) == 3 {
+				longitude, latitude = env.toFloat(args[0]), env.toFloat(args[1])
+			} else {
+				g, _, err := geometryArg(args[0], "st_geohash")
+				if err != nil {
+					return nil, err
+				}
+				if err := expectType(g, "st_geohash", geometry.TypePoint); err != nil {
+					return nil, err
+				}
+				longitude, latitude = g.Point().X, g.Point().Y
+			}
+			if longitude < -180 || longitude > 180 {
+				return nil, errWrongValueForFunction("longitude", string(format.FormatFloat(longitude)), "st_geohash")
+			}
+			if latitude < -90 || latitude > 90 {
+				return nil, errWrongValueForFunction("latitude", string(format.FormatFloat(latitude)), "st_geohash")
+			}
+			length := env.toInt64(args[len(args)-1])
+			if length < 1 || length > geometry.MaxGeoHashLength {
+				return nil, errWrongValueForFunction("max_length", string(toBytes(args[len(args)-1])), "st_geohash")
+			}
+			return newEvalText([]byte(geometry.EncodeGeoHash(longitude, latitude, int(length)))), nil
+		}, resultType: sqltypes.VarChar},
+		"st_latfromgeohash": geoHash("st_latfromgeohash", 1, sqltypes.Float64, func(_ *ExpressionEnv, box geometry.GeoHashBox, _ []eval) (eval, error) {
+			return evalFloat{f: box.Latitude()}, nil
+		}),
+		"st_longfromgeohash": geoHash("st_longfromgeohash", 1, sqltypes.Float64, func(_ *ExpressionEnv, box geometry.GeoHashBox, _ []eval) (eval, error) {
+			return evalFloat{f: box.Longitude()}, nil
+		}),
+		"st_pointfromgeohash": geoHash("st_pointfromgeohash", 2, sqltypes.Geometry, func(env *ExpressionEnv, box geometry.GeoHashBox, args []eval) (eval, error) {
+			srs, err := env.sridArg(args[0], "st_pointfromgeohash")
+			if err != nil {
+				return nil, err
+			}
+			return newEvalGeometry(geometry.NewPoint(srs.ID, box.Longitude(), box.Latitude())), nil
+		}),
+
+		"st_asgeojson": {minArgs: 1, maxArgs: 3, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+			g, _, err := geometryArg(args[0], "st_asgeojson")
+			if err != nil {
+				return nil, err
+			}
+			maxDigits, options := int64(math.MaxInt32), int64(0)
+			if len(args) > 1 {
+				if maxDigits = env.toInt64(args[1]); maxDigits < 0 {
+					return nil, errWrongValueForFunction("max_dec_digits", string(toBytes(args[1])), "st_asgeojson")
+				}
+			}
+			if len(args) > 2 {
+				if options = env.toInt64(args[2]); options < 0 || options > 7 {
+					return nil, errWrongValueForFunction("options", string(toBytes(args[2])), "st_asgeojson")
+				}
+			}
+			return evalJSON{v: g.GeoJSON(int(min(maxDigits, math.MaxInt32)), int(options))}, nil
+		}, resultType: sqltypes.TypeJSON},
+		"st_geomfromgeojson": {minArgs: 1, maxArgs: 3, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+			doc, err := jsonDoc(args[0], 1, "st_geomfromgeojson")
+			if err != nil {
+				return nil, err
+			}
+			dimensions := int64(geometry.DimensionsReject)
+			if len(args) > 1 {
+				if dimensions = env.toInt64(args[1]); dimensions < geometry.DimensionsReject || dimensions > geometry.DimensionsStripFor4 {
+					return nil, errWrongValueForFunction("options", string(toBytes(args[1])), "st_geomfromgeojson")
+				}
+			}
+			g, err := geometry.ParseGeoJSON(doc, int(dimensions))
+			if err != nil {
+				return nil, errGeometry(err, "st_geomfromgeojson")
+			}
+			if g == nil {
+				return nil, nil
+			}
+			srs, ok := geometry.GetSRS(g.SRID)
+			if len(args) > 2 {
+				if srs, err = env.sridArg(args[2], "st_geomfromgeojson"); err != nil {
+					return nil, err
+				}
+			} else if !ok {
+				return nil, errSRSNotFound(g.SRID)
+			}
+			g.SetSRID(srs.ID)
+			if err := g.CheckRange(srs); err != nil {
+				return nil, errGeometry(err, "st_geomfromgeojson")
+			}
+			return newEvalGeometry(g), nil
+		}, resultType: sqltypes.Geometry},
+	}
+	for typ, geomType := range geomFromTextTypes {
+		builtins[typ.ToString()] = geomFrom(typ.ToString(), geomType, func(b []byte) (*geometry.Geometry, error) {
+			return geometry.ParseWKT(string(b))
+		})
+	}
+	for typ, geomType := range geomFromWKBTypes {
+		builtins[typ.ToString()] = geomFrom(typ.ToString(), geomType, geometry.ParseWKB)
+	}
+	return builtins
+}
+
+// swapForOutput swaps the axes of a geometry written as WKT or WKB in the axis
+// order of its system or of the options in args.
+func swapForOutput(g *geometry.Geometry, srs *geometry.SRS, args []eval, fn string) error {
+	order := axisSRIDDefined
+	if len(args) > 0 {
+		var err error
+		if order, err = axisOrderArg(args[0], fn); err != nil {
+			return err
+		}
+	}
+	if order.swapAxes(srs) {
+		g.SwapAxes()
+	}
+	return nil
+}
+
+// geoHash returns a function of a GeoHash and the arguments after it.
+func geoHash(name string, args int, resultType querypb.Type, fn func(env *ExpressionEnv, box geometry.GeoHashBox, args []eval) (eval, error)) *builtin {
+	return &builtin{minArgs: args, maxArgs: args, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		hash := string(toBytes(args[0]))
+		box, err := geometry.DecodeGeoHash(hash)
+		if err != nil {
+			return nil, errWrongValueForFunction("geohash", hash, name)
+		}
+		return fn(env, box, args[1:])
+	}, resultType: resultType}
+}
+
+// geometryFunc translates the spatial functions the parser has nodes for.
+func (t *translator) geometryFunc(expr sqlparser.Expr) (Expr, error) {
+	call := func(name string, args ...sqlparser.Expr) (Expr, error) {
+		return t.call(expr, builtins[name], name, args...)
+	}
+	switch expr := expr.(type) {
+	case *sqlparser.PointExpr:
+		return call("point", expr.XCordinate, expr.YCordinate)
+	case *sqlparser.LineStringExpr:
+		return call("linestring", expr.PointParams...)
+	case *sqlparser.PolygonExpr:
+		return call("polygon", expr.LinestringParams...)
+	case *sqlparser.MultiPointExpr:
+		return call("multipoint", expr.PointParams...)
+	case *sqlparser.MultiLinestringExpr:
+		return call("multilinestring", expr.LinestringParams...)
+	case *sqlparser.MultiPolygonExpr:
+		return call("multipolygon", expr.PolygonParams...)
+	case *sqlparser.GeomFromTextExpr:
+		return call(expr.Type.ToString(), expr.WktText, expr.Srid, expr.AxisOrderOpt)
+	case *sqlparser.GeomFromWKBExpr:
+		return call(expr.Type.ToString(), expr.WkbBlob, expr.Srid, expr.AxisOrderOpt)
+	case *sqlparser.GeomFormatExpr:
+		return call(expr.FormatType.ToString(), expr.Geom, expr.AxisOrderOpt)
+	case *sqlparser.GeomPropertyFuncExpr:
+		return call(expr.Property.ToString(), expr.Geom)
+	case *sqlparser.PointPropertyFuncExpr:
+		return call(expr.Property.ToString(), expr.Point, expr.ValueToSet)
+	case *sqlparser.LinestrPropertyFuncExpr:
+		if expr.Property == sqlparser.Length && expr.PropertyDefArg != nil {
+			// The unit of ST_Length is only for geographic systems.
+			return nil, errUnsupported(expr)
+		}
+		return call(expr.Property.ToString(), expr.Linestring, expr.PropertyDefArg)
+	case *sqlparser.PolygonPropertyFuncExpr:
+		return call(strings.ToLower(expr.Property.ToString()), expr.Polygon, expr.PropertyDefArg)
+	case *sqlparser.GeomCollPropertyFuncExpr:
+		return call(expr.Property.ToString(), expr.GeomColl, expr.PropertyDefArg)
+	case *sqlparser.GeoHashFromLatLongExpr:
+		return call("st_geohash", expr.Longitude, expr.Latitude, expr.MaxLength)
+	case *sqlparser.GeoHashFromPointExpr:
+		return call("st_geohash", expr.Point, expr.MaxLength)
+	case *sqlparser.GeomFromGeoHashExpr:
+		return call(expr.GeomType.ToString(), expr.GeoHash, expr.SridOpt)
+	case *sqlparser.GeoJSONFromGeomExpr:
+		return call("st_asgeojson", expr.Geom, expr.MaxDecimalDigits, expr.Bitmask)
+	case *sqlparser.GeomFromGeoJSONExpr:
+		return call("st_geomfromgeojson", expr.GeoJSON, expr.HigherDimHandlerOpt, expr.Srid)
+	}
+	return nil, errUnsupported(expr)
+}
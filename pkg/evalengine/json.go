@@ -0,0 +1,768 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/json"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// jsonValueExpr is JSON_VALUE.
+type jsonValueExpr struct {
+	doc, path Expr
+	// cast is the type returned, VARCHAR(512) unless RETURNING says otherwise.
+	cast             *castExpr
+	onEmpty, onError onResponse
+}
+
+// onResponse is what JSON_VALUE and the columns of JSON_TABLE return when no
+// value is found or when the one found cannot be returned: NULL, a default or
+// an error.
+type onResponse struct {
+	err bool
+	def Expr
+}
+
+func errInvalidJSONText(arg int, fn string, err error) error {
+	var syntax *json.SyntaxError
+	if errors.As(err, &syntax) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON text in argument %d to function %s: %s.", arg, fn, syntax)
+	}
+	return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error())
+}
+
+func errInvalidJSONType(arg int, fn string) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid data type for JSON data in argument %d to function %s; a JSON string or JSON type is required.", arg, fn)
+}
+
+var (
+	errJSONBinary       = vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "Cannot create a JSON value from a string with CHARACTER SET 'binary'.")
+	errJSONPathWildcard = vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "In this situation, path expressions may not contain the * and ** tokens or an array range.")
+	errJSONVacuousPath  = vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "The path expression '$' is not allowed in this context.")
+	errJSONNotArrayCell = vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "A path expression is not a path to a cell in an array.")
+	errJSONNullKey      = vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "JSON documents may not contain NULL member names.")
+)
+
+// jsonDoc returns an argument that is a JSON document: a JSON value, or JSON
+// text in a string. arg is the position of the argument, for errors.
+func jsonDoc(e eval, arg int, fn string) (*json.Value, error) {
+	switch e := e.(type) {
+	case evalJSON:
+		return e.v, nil
+	case evalBytes:
+		if e.isBinary() {
+			return nil, errJSONBinary
+		}
+		v, err := json.Parse(e.string())
+		if err != nil {
+			return nil, errInvalidJSONText(arg, fn, err)
+		}
+		return v, nil
+	}
+	return nil, errInvalidJSONType(arg, fn)
+}
+
+// jsonPath returns an argument that is a path, which cannot have wildcards
+// unless wildcards is set.
+func jsonPath(e eval, wildcards bool) (*json.Path, error) {
+	p, err := json.ParsePath(string(toBytes(e)))
+	if err != nil {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error())
+	}
+	if !wildcards && p.ContainsWildcard() {
+		return nil, errJSONPathWildcard
+	}
+	return p, nil
+}
+
+func jsonPaths(args []eval, wildcards bool) ([]*json.Path, error) {
+	paths := make([]*json.Path, 0, len(args))
+	for _, arg := range args {
+		p, err := jsonPath(arg, wildcards)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
+
+// toJSON returns a value as the JSON value it is in JSON functions: strings are
+// JSON strings, binary strings are opaque values, and integers are booleans
+// when they are the result of a boolean expression.
+func toJSON(e eval, boolean bool) *json.Value {
+	switch e := e.(type) {
+	case evalJSON:
+		return e.v
+	case evalInt64:
+		if boolean {
+			return json.NewBool(e.i != 0)
+		}
+		return json.NewInt64(e.i)
+	case evalUint64:
+		return json.NewUint64(e.u)
+	case evalFloat:
+		return json.NewFloat64(e.f)
+	case evalDecimal:
+		return json.NewDecimal(e.dec, e.length)
+	case evalBytes:
+		switch {
+		case e.tt == sqltypes.Bit:
+			return json.NewOpaque(json.OpaqueBit, e.bytes)
+		case e.tt == sqltypes.Blob:
+			return json.NewOpaque(json.OpaqueBlob, e.bytes)
+		case e.isBinary():
+			return json.NewOpaque(json.OpaqueVarString, e.bytes)
+		}
+		return json.NewString(e.string())
+	case evalTemporal:
+		switch e.t {
+		case sqltypes.Date:
+			return json.NewDate(e.dt.Date)
+		case sqltypes.Time:
+			return json.NewTime(e.dt.Time)
+		}
+		return json.NewDateTime(e.dt)
+	}
+	return json.NewNull()
+}
+
+// castToJSON returns a value as CAST(... AS JSON) does, which parses strings
+// as JSON text.
+func castToJSON(e eval, boolean bool) (eval, error) {
+	switch e := e.(type) {
+	case nil:
+		return nil, nil
+	case evalBytes:
+		if e.literal || e.isBinary() {
+			return nil, errJSONBinary
+		}
+		v, err := json.Parse(e.string())
+		if err != nil {
+			return nil, errInvalidJSONText(1, "cast_as_json", err)
+		}
+		return evalJSON{v: v}, nil
+	}
+	return evalJSON{v: toJSON(e, boolean)}, nil
+}
+
+// scalar returns a JSON value as the SQL value it is in SQL contexts: strings
+// as their contents, numbers as numbers, booleans as 1 and 0 and temporal
+// values as temporal values. JSON null is NULL, and other values are their
+// JSON text.
+func (e evalJSON) scalar() eval {
+	v := e.v
+	switch v.Type() {
+	case json.TypeNull:
+		return nil
+	case json.TypeBoolean:
+		return newEvalBool(v.Bool())
+	case json.TypeInteger:
+		return evalInt64{i: v.Int64()}
+	case json.TypeUnsigned:
+		return evalUint64{u: v.Uint64()}
+	case json.TypeDouble:
+		return evalFloat{f: v.Float64()}
+	case json.TypeDecimal:
+		dec, scale := v.Decimal()
+		return newEvalDecimal(dec, scale)
+	case json.TypeString:
+		return newEvalText([]byte(v.Text()))
+	case json.TypeDate:
+		return evalTemporal{t: sqltypes.Date, dt: v.DateTime()}
+	case json.TypeTime:
+		return evalTemporal{t: sqltypes.Time, dt: v.DateTime(), prec: maxTimePrecision}
+	case json.TypeDateTime:
+		return evalTemporal{t: sqltypes.Datetime, dt: v.DateTime(), prec: maxTimePrecision}
+	case json.TypeOpaque:
+		_, b := v.Opaque()
+		return newEvalBinary(b)
+	}
+	return newEvalText(v.Marshal())
+}
+
+// isBoolean reports whether an expression is a boolean, which is true or
+// false rather than 1 or 0 in JSON.
+func isBoolean(expr Expr) bool {
+	switch expr := expr.(type) {
+	case *comparisonExpr, *inExpr, *betweenExpr, *isExpr, *andExpr, *orExpr, *xorExpr, *notExpr, *likeExpr:
+		return true
+	case *regexpExpr:
+		return expr.fn == regexpLike
+	case *literalExpr:
+		return expr.boolean
+	case *builtinExpr:
+		return expr.fn.boolean
+	}
+	return false
+}
+
+// jsonMatch returns the value a path without wildcards matches in a document,
+// if there is one.
+func jsonMatch(doc *json.Value, p *json.Path) (*json.Value, bool) {
+	matches := p.Match(doc)
+	if len(matches) == 0 {
+		return nil, false
+	}
+	return matches[0], true
+}
+
+func jsonOneOrAll(e eval, fn string) (bool, error) {
+	switch strings.ToLower(toText(e).string()) {
+	case "one":
+		return true, nil
+	case "all":
+		return false, nil
+	}
+	return false, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "The oneOrAll argument to %s may take these values: 'one' or 'all'.", fn)
+}
+
+// jsonModify applies a modification of a document at paths to the document of
+// JSON_SET and the other functions whose arguments are a document followed by
+// pairs of a path and a value. The document is NULL when it or a path is.
+func jsonModify(fn string, modify func(doc *json.Value, p *json.Path, v *json.Value) (*json.Value, error)) func(env *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+	return func(env *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+		if len(args)%2 == 0 {
+			return nil, errParameterCount(fn)
+		}
+		if args[0] == nil {
+			return nil, nil
+		}
+		doc, err := jsonDoc(args[0], 1, fn)
+		if err != nil {
+			return nil, err
+		}
+		doc = doc.Clone()
+		for i := 1; i+1 < len(args); i += 2 {
+			if args[i] == nil {
+				return nil, nil
+			}
+			p, err := jsonPath(args[i], false)
+			if err != nil {
+				return nil, err
+			}
+			if doc, err = modify(doc, p, toJSON(args[i+1], isBoolean(call.args[i+1])).Clone()); err != nil {
+				return nil, err
+			}
+		}
+		return evalJSON{v: doc}, nil
+	}
+}
+
+func jsonSet(mode json.SetMode) func(doc *json.Value, p *json.Path, v *json.Value) (*json.Value, error) {
+	return func(doc *json.Value, p *json.Path, v *json.Value) (*json.Value, error) {
+		return json.Set(doc, p, v, mode), nil
+	}
+}
+
+// jsonMerge merges the documents of JSON_MERGE_PRESERVE and JSON_MERGE_PATCH.
+func jsonMerge(fn string, merge func(a, b *json.Value) *json.Value) func(env *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+	return func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		var merged *json.Value
+		for i, arg := range args {
+			doc, err := jsonDoc(arg, i+1, fn)
+			if err != nil {
+				return nil, err
+			}
+			if merged == nil {
+				merged = doc.Clone()
+				continue
+			}
+			merged = merge(merged, doc.Clone())
+		}
+		return evalJSON{v: merged}, nil
+	}
+}
+
+var jsonBuiltins = map[string]*builtin{
+	"json_extract": {minArgs: 2, maxArgs: -1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		doc, err := jsonDoc(args[0], 1, "json_extract")
+		if err != nil {
+			return nil, err
+		}
+		paths, err := jsonPaths(args[1:], true)
+		if err != nil {
+			return nil, err
+		}
+		// With several paths or with wildcards, the values found are in an
+		// array, even when there is only one.
+		wrap := len(paths) > 1
+		var found []*json.Value
+		for _, p := range paths {
+			wrap = wrap || p.ContainsWildcard()
+			found = append(found, p.Match(doc)...)
+		}
+		switch {
+		case len(found) == 0:
+			return nil, nil
+		case !wrap:
+			return evalJSON{v: found[0]}, nil
+		}
+		return evalJSON{v: json.NewArray(found...)}, nil
+	}, resultType: sqltypes.TypeJSON},
+	"json_unquote": {minArgs: 1, maxArgs: 1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		if j, ok := args[0].(evalJSON); ok {
+			return newEvalText(j.v.Unquote()), nil
+		}
+		s := toBytes(args[0])
+		if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+			return newEvalText(s), nil
+		}
+		v, err := json.Parse(string(s))
+		if err != nil {
+			return nil, errInvalidJSONText(1, "json_unquote", err)
+		}
+		return newEvalText(v.Unquote()), nil
+	}, resultType: sqltypes.VarChar},
+	"json_quote": {minArgs: 1, maxArgs: 1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		b, ok := args[0].(evalBytes)
+		if !ok || b.literal || b.isBinary() {
+			return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect type for argument 1 in function json_quote.")
+		}
+		return newEvalText(json.NewString(b.string()).Marshal()), nil
+	}, resultType: sqltypes.VarChar},
+	"json_array": {minArgs: 0, maxArgs: -1, nullSafe: true, call: func(_ *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+		values := make([]*json.Value, len(args))
+		for i, arg := range args {
+			values[i] = toJSON(arg, isBoolean(call.args[i])).Clone()
+		}
+		return evalJSON{v: json.NewArray(values...)}, nil
+	}, resultType: sqltypes.TypeJSON},
+	"json_object": {minArgs: 0, maxArgs: -1, nullSafe: true, call: func(_ *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+		if len(args)%2 != 0 {
+			return nil, errParameterCount("json_object")
+		}
+		obj := json.NewObject()
+		for i := 0; i < len(args); i += 2 {
+			if args[i] == nil {
+				return nil, errJSONNullKey
+			}
+			obj.Object().Set(toText(args[i]).string(), toJSON(args[i+1], isBoolean(call.args[i+1])).Clone())
+		}
+		return evalJSON{v: obj}, nil
+	}, resultType: sqltypes.TypeJSON},
+	"json_contains": {minArgs: 2, maxArgs: 3, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		doc, err := jsonDoc(args[0], 1, "json_contains")
+		if err != nil {
+			return nil, err
+		}
+		candidate, err := jsonDoc(args[1], 2, "json_contains")
+		if err != nil {
+			return nil, err
+		}
+		if len(args) == 3 {
+			p, err := jsonPath(args[2], false)
+			if err != nil {
+				return nil, err
+			}
+			var ok bool
+			if doc, ok = jsonMatch(doc, p); !ok {
+				return nil, nil
+			}
+		}
+		return newEvalBool(json.Contains(doc, candidate)), nil
+	}, resultType: sqltypes.Int64},
+	"json_contains_path": {minArgs: 3, maxArgs: -1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		doc, err := jsonDoc(args[0], 1, "json_contains_path")
+		if err != nil {
+			return nil, err
+		}
+		one, err := jsonOneOrAll(args[1], "json_contains_path")
+		if err != nil {
+			return nil, err
+		}
+		paths, err := jsonPaths(args[2:], true)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range paths {
+			if p.Exists(doc) == one {
+				return newEvalBool(one), nil
+			}
+		}
+		return newEvalBool(!one), nil
+	}, resultType: sqltypes.Int64},
+	"json_keys": {minArgs: 1, maxArgs: 2, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		doc, err := jsonDoc(args[0], 1, "json_keys")
+		if err != nil {
+			return nil, err
+		}
+		if len(args) == 2 {
+			p, err := jsonPath(args[1], false)
+			if err != nil {
+				return nil, err
+			}
+			var ok bool
+			if doc, ok = jsonMatch(doc, p); !ok {
+				return nil, nil
+			}
+		}
+		if doc.Type() != json.TypeObject {
+			return nil, nil
+		}
+		var keys []*json.Value
+		for _, key := range doc.Object().Keys() {
+			keys = append(keys, json.NewString(key))
+		}
+		return evalJSON{v: json.NewArray(keys...)}, nil
+	}, resultType: sqltypes.TypeJSON},
+	"json_overlaps": {minArgs: 2, maxArgs: 2, boolean: true, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		a, err := jsonDoc(args[0], 1, "json_overlaps")
+		if err != nil {
+			return nil, err
+		}
+		b, err := jsonDoc(args[1], 2, "json_overlaps")
+		if err != nil {
+			return nil, err
+		}
+		return newEvalBool(json.Overlaps(a, b)), nil
+	}, resultType: sqltypes.Int64},
+	"json_search": {minArgs: 3, maxArgs: -1, nullSafe: true, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		for i, arg := range args {
+			// A NULL escape character is the default one.
+			if arg == nil && i != 3 {
+				return nil, nil
+			}
+		}
+		doc, err := jsonDoc(args[0], 1, "json_search")
+		if err != nil {
+			return nil, err
+		}
+		one, err := jsonOneOrAll(args[1], "json_search")
+		if err != nil {
+			return nil, err
+		}
+		escape := '\\'
+		if len(args) > 3 && args[3] != nil {
+			s := toBytes(args[3])
+			switch utf8.RuneCount(s) {
+			case 0:
+			case 1:
+				escape, _ = utf8.DecodeRune(s)
+			default:
+				return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongArguments, "Incorrect arguments to ESCAPE")
+			}
+		}
+		var paths []*json.Path
+		if len(args) > 4 {
+			if paths, err = jsonPaths(args[4:], true); err != nil {
+				return nil, err
+			}
+		}
+		search := toText(args[2])
+		pattern, equal := []rune(search.string()), search.collation().EqualRune
+		found := json.Search(doc, paths, func(s string) bool {
+			return likeMatch([]rune(s), pattern, escape, equal)
+		}, one)
+		switch len(found) {
+		case 0:
+			return nil, nil
+		case 1:
+			return evalJSON{v: json.NewString(found[0])}, nil
+		}
+		values := make([]*json.Value, len(found))
+		for i, path := range found {
+			values[i] = json.NewString(path)
+		}
+		return evalJSON{v: json.NewArray(values...)}, nil
+	}, resultType: sqltypes.TypeJSON},
+	"member of": {minArgs: 2, maxArgs: 2, boolean: true, call: func(_ *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+		doc, err := jsonDoc(args[1], 2, "member of")
+		if err != nil {
+			return nil, err
+		}
+		value := toJSON(args[0], isBoolean(call.args[0]))
+		if doc.Type() != json.TypeArray {
+			return newEvalBool(json.Compare(doc, value) == 0), nil
+		}
+		for _, elem := range doc.Array() {
+			if json.Compare(elem, value) == 0 {
+				return evalTrue, nil
+			}
+		}
+		return evalFalse, nil
+	}, resultType: sqltypes.Int64},
+	"json_depth": {minArgs: 1, maxArgs: 1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		doc, err := jsonDoc(args[0], 1, "json_depth")
+		if err != nil {
+			return nil, err
+		}
+		return evalInt64{i: int64(doc.Depth())}, nil
+	}, resultType: sqltypes.Int64},
+	"json_valid": {minArgs: 1, maxArgs: 1, boolean: true, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		switch e := args[0].(type) {
+		case evalJSON:
+			return evalTrue, nil
+		case evalBytes:
+			if e.isBinary() {
+				return evalFalse, nil
+			}
+			_, err := json.Parse(e.string())
+			return newEvalBool(err == nil), nil
+		}
+		return nil, errInvalidJSONType(1, "json_valid")
+	}, resultType: sqltypes.Int64},
+	"json_type": {minArgs: 1, maxArgs: 1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		doc, err := jsonDoc(args[0], 1, "json_type")
+		if err != nil {
+			return nil, err
+		}
+		return newEvalText([]byte(doc.TypeName())), nil
+	}, resultType: sqltypes.VarChar},
+	"json_length": {minArgs: 1, maxArgs: 2, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		doc, err := jsonDoc(args[0], 1, "json_length")
+		if err != nil {
+			return nil, err
+		}
+		if len(args) == 2 {
+			p, err := jsonPath(args[1], false)
+			if err != nil {
+				return nil, err
+			}
+			var ok bool
+			if doc, ok = jsonMatch(doc, p); !ok {
+				return nil, nil
+			}
+		}
+		return evalInt64{i: int64(doc.Len())}, nil
+	}, resultType: sqltypes.Int64},
+	"json_pretty": {minArgs: 1, maxArgs: 1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		doc, err := jsonDoc(args[0], 1, "json_pretty")
+		if err != nil {
+			return nil, err
+		}
+		return newEvalText(doc.MarshalPretty()), nil
+	}, resultType: sqltypes.VarChar},
+	"json_set":     {minArgs: 3, maxArgs: -1, nullSafe: true, call: jsonModify("json_set", jsonSet(json.Insert|json.Replace)), resultType: sqltypes.TypeJSON},
+	"json_insert":  {minArgs: 3, maxArgs: -1, nullSafe: true, call: jsonModify("json_insert", jsonSet(json.Insert)), resultType: sqltypes.TypeJSON},
+	"json_replace": {minArgs: 3, maxArgs: -1, nullSafe: true, call: jsonModify("json_replace", jsonSet(json.Replace)), resultType: sqltypes.TypeJSON},
+	"json_array_append": {minArgs: 3, maxArgs: -1, nullSafe: true, call: jsonModify("json_array_append", func(doc *json.Value, p *json.Path, v *json.Value) (*json.Value, error) {
+		return json.ArrayAppend(doc, p, v), nil
+	}), resultType: sqltypes.TypeJSON},
+	"json_array_insert": {minArgs: 3, maxArgs: -1, nullSafe: true, call: jsonModify("json_array_insert", func(doc *json.Value, p *json.Path, v *json.Value) (*json.Value, error) {
+		if !p.EndsWithIndex() {
+			return nil, errJSONNotArrayCell
+		}
+		return json.ArrayInsert(doc, p, v), nil
+	}), resultType: sqltypes.TypeJSON},
+	"json_remove": {minArgs: 2, maxArgs: -1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		doc, err := jsonDoc(args[0], 1, "json_remove")
+		if err != nil {
+			return nil, err
+		}
+		paths, err := jsonPaths(args[1:], false)
+		if err != nil {
+			return nil, err
+		}
+		doc = doc.Clone()
+		for _, p := range paths {
+			if p.IsRoot() {
+				return nil, errJSONVacuousPath
+			}
+			doc = json.Remove(doc, p)
+		}
+		return evalJSON{v: doc}, nil
+	}, resultType: sqltypes.TypeJSON},
+	"json_merge":          {minArgs: 2, maxArgs: -1, call: jsonMerge("json_merge", json.MergePreserve), resultType: sqltypes.TypeJSON},
+	"json_merge_preserve": {minArgs: 2, maxArgs: -1, call: jsonMerge("json_merge_preserve", json.MergePreserve), resultType: sqltypes.TypeJSON},
+	"json_merge_patch":    {minArgs: 2, maxArgs: -1, call: jsonMerge("json_merge_patch", json.MergePatch), resultType: sqltypes.TypeJSON},
+}
+
+// jsonParams returns a document followed by the pairs of arguments of
+// JSON_OBJECT and JSON_SET and the other functions that take pairs.
+func jsonParams(doc sqlparser.Expr, params []*sqlparser.JSONObjectParam) []sqlparser.Expr {
+	var args []sqlparser.Expr
+	if doc != nil {
+		args = append(args, doc)
+	}
+	for _, param := range params {
+		args = append(args, param.Key, param.Value)
+	}
+	return args
+}
+
+// jsonFunc translates the JSON functions with their own nodes in the AST.
+func (t *translator) jsonFunc(expr sqlparser.Expr) (Expr, error) {
+	call := func(name string, args ...sqlparser.Expr) (Expr, error) {
+		return t.call(expr, builtins[name], name, args...)
+	}
+	switch expr := expr.(type) {
+	case *sqlparser.JSONExtractExpr:
+		return call("json_extract", append([]sqlparser.Expr{expr.JSONDoc}, expr.PathList...)...)
+	case *sqlparser.JSONUnquoteExpr:
+		return call("json_unquote", expr.JSONValue)
+	case *sqlparser.JSONQuoteExpr:
+		return call("json_quote", expr.StringArg)
+	case *sqlparser.JSONArrayExpr:
+		return call("json_array", expr.Params...)
+	case *sqlparser.JSONObjectExpr:
+		return call("json_object", jsonParams(nil, expr.Params)...)
+	case *sqlparser.JSONContainsExpr:
+		return call("json_contains", append([]sqlparser.Expr{expr.Target, expr.Candidate}, expr.PathList...)...)
+	case *sqlparser.JSONContainsPathExpr:
+		return call("json_contains_path", append([]sqlparser.Expr{expr.JSONDoc, expr.OneOrAll}, expr.PathList...)...)
+	case *sqlparser.JSONKeysExpr:
+		return call("json_keys", expr.JSONDoc, expr.Path)
+	case *sqlparser.JSONOverlapsExpr:
+		return call("json_overlaps", expr.JSONDoc1, expr.JSONDoc2)
+	case *sqlparser.JSONSearchExpr:
+		escape := expr.EscapeChar
+		if escape == nil && len(expr.PathList) > 0 {
+			escape = &sqlparser.NullVal{}
+		}
+		return call("json_search", append([]sqlparser.Expr{expr.JSONDoc, expr.OneOrAll, expr.SearchStr, escape}, expr.PathList...)...)
+	case *sqlparser.MemberOfExpr:
+		return call("member of", expr.Value, expr.JSONArr)
+	case *sqlparser.JSONAttributesExpr:
+		return call(expr.Type.ToString(), expr.JSONDoc, expr.Path)
+	case *sqlparser.JSONPrettyExpr:
+		return call("json_pretty", expr.JSONVal)
+	case *sqlparser.JSONValueModifierExpr:
+		return call(expr.Type.ToString(), jsonParams(expr.JSONDoc, expr.Params)...)
+	case *sqlparser.JSONValueMergeExpr:
+		return call(expr.Type.ToString(), append([]sqlparser.Expr{expr.JSONDoc}, expr.JSONDocList...)...)
+	case *sqlparser.JSONRemoveExpr:
+		return call("json_remove", append([]sqlparser.Expr{expr.JSONDoc}, expr.PathList...)...)
+	case *sqlparser.JSONValueExpr:
+		return t.jsonValue(expr)
+	}
+	return nil, errUnsupported(expr)
+}
+
+func (t *translator) jsonValue(expr *sqlparser.JSONValueExpr) (Expr, error) {
+	doc, path, err := t.two(expr.JSONDoc, expr.Path)
+	if err != nil {
+		return nil, err
+	}
+	j := &jsonValueExpr{doc: doc, path: path}
+	if expr.ReturningType != nil {
+		if j.cast, err = newCastExpr(nil, expr.ReturningType, "json_value"); err != nil {
+			return nil, err
+		}
+	} else {
+		j.cast = &castExpr{typ: sqltypes.VarChar, length: 512, name: "CHAR"}
+	}
+	if j.onEmpty, err = t.onResponse(expr.EmptyOnResponse); err != nil {
+		return nil, err
+	}
+	if j.onError, err = t.onResponse(expr.ErrorOnResponse); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (t *translator) onResponse(resp *sqlparser.JtOnResponse) (onResponse, error) {
+	if resp == nil {
+		return onResponse{}, nil
+	}
+	switch resp.ResponseType {
+	case sqlparser.ErrorJSONType:
+		return onResponse{err: true}, nil
+	case sqlparser.DefaultJSONType:
+		def, err := t.expr(resp.Expr)
+		return onResponse{def: def}, err
+	}
+	return onResponse{}, nil
+}
+
+func (j *jsonValueExpr) eval(env *ExpressionEnv) (eval, error) {
+	docArg, err := j.doc.eval(env)
+	if err != nil || docArg == nil {
+		return nil, err
+	}
+	pathArg, err := j.path.eval(env)
+	if err != nil || pathArg == nil {
+		return nil, err
+	}
+	doc, err := jsonDoc(docArg, 1, "json_value")
+	if err != nil {
+		return nil, err
+	}
+	p, err := jsonPath(pathArg, true)
+	if err != nil {
+		return nil, err
+	}
+	matches := p.Match(doc)
+	switch {
+	case len(matches) == 0:
+		return j.respond(env, j.onEmpty, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "No value was found by 'json_value' on the specified path."))
+	case len(matches) > 1:
+		return j.respond(env, j.onError, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "More than one value was found by 'json_value' on the specified path."))
+	}
+	e, ok, err := env.convertJSON(matches[0], j.cast)
+	if err != nil || ok {
+		return e, err
+	}
+	return j.respond(env, j.onError, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON value for CAST to %s from column json_value at row 1", j.cast.name))
+}
+
+func (j *jsonValueExpr) respond(env *ExpressionEnv, resp onResponse, err error) (eval, error) {
+	switch {
+	case resp.err:
+		return nil, err
+	case resp.def == nil:
+		return nil, nil
+	}
+	def, err := resp.def.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	return j.cast.convert(env, def, false)
+}
+
+func (j *jsonValueExpr) typeof() (querypb.Type, bool) {
+	return j.cast.typ, true
+}
+
+// convertJSON converts a JSON value to a type, and reports whether it could
+// be converted: arrays and objects can only be JSON, and values that give
+// warnings when they are converted cannot be converted, without the warnings.
+func (env *ExpressionEnv) convertJSON(v *json.Value, c *castExpr) (eval, bool, error) {
+	if c.typ == sqltypes.TypeJSON {
+		return evalJSON{v: v}, true, nil
+	}
+	if !v.IsScalar() {
+		return nil, false, nil
+	}
+	var e eval
+	switch c.typ {
+	case sqltypes.VarChar, sqltypes.VarBinary:
+		if v.Type() != json.TypeNull {
+			e = newEvalText(v.Unquote())
+		}
+	default:
+		e = evalJSON{v: v}.scalar()
+	}
+	warnings := len(env.warnings)
+	converted, err := c.convert(env, e, false)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(env.warnings) > warnings {
+		env.warnings = env.warnings[:warnings]
+		return nil, false, nil
+	}
+	return converted, true, nil
+}
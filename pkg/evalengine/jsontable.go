@@ -0,0 +1,393 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/decimal"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/json"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// JSONTable is a JSON_TABLE translated for evaluation, which produces the rows
+// of the table for the document its expression evaluates to.
+//
+// Each value the path of the table matches in the document is a row, with
+// columns whose values are at paths from it. A NESTED PATH makes a row for each
+// value it matches instead, joined with the columns of the row it is nested
+// in, and sibling NESTED PATHs make their rows in turn, with the columns of the
+// others NULL.
+type JSONTable struct {
+	doc    Expr
+	alias  string
+	root   *jtScope
+	fields []*querypb.Field
+}
+
+// jtScope is the table itself or a NESTED PATH in it.
+type jtScope struct {
+	path    *json.Path
+	columns []*jtColumn
+	nested  []*jtScope
+}
+
+type jtColumn struct {
+	// offset is the offset of the column in the rows of the table.
+	offset int
+	// name is the name of the column, and table the alias of its table.
+	name, table string
+	ordinal     bool
+	exists      bool
+	path        *json.Path
+	cast        *castExpr
+	typ         querypb.Type
+	typeName    string
+	// integer is set for integer columns, whose values are cast to decimals
+	// and must be between min and max.
+	integer  bool
+	min, max decimal.Decimal
+	// onEmpty and onError are what the column is when its path matches no
+	// value and when the value cannot be stored in it.
+	onEmpty, onError jtResponse
+}
+
+// jtResponse is NULL, a default value or an error.
+type jtResponse struct {
+	err bool
+	def eval
+}
+
+// TranslateJSONTable translates a JSON_TABLE for evaluation, binding the
+// columns its expression uses with the Config, which may be nil when it uses
+// none. Its paths must be string literals.
+func TranslateJSONTable(jt *sqlparser.JSONTableExpr, cfg *Config) (*JSONTable, error) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	tr := &translator{cfg: cfg}
+	doc, err := tr.expr(jt.Expr)
+	if err != nil {
+		return nil, err
+	}
+	t := &JSONTable{doc: doc, alias: jt.Alias.String()}
+	if t.root, err = t.scope(jt.Filter, jt.Columns, map[string]bool{}); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func jtPath(expr sqlparser.Expr) (*json.Path, error) {
+	lit, ok := expr.(*sqlparser.Literal)
+	if !ok || lit.Type != sqlparser.StrVal {
+		return nil, errUnsupported(expr)
+	}
+	p, err := json.ParsePath(lit.Val)
+	if err != nil {
+		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error())
+	}
+	return p, nil
+}
+
+func (t *JSONTable) scope(path sqlparser.Expr, defs []*sqlparser.JtColumnDefinition, names map[string]bool) (*jtScope, error) {
+	p, err := jtPath(path)
+	if err != nil {
+		return nil, err
+	}
+	s := &jtScope{path: p}
+	for _, def := range defs {
+		if def.JtNestedPath != nil {
+			nested, err := t.scope(def.JtNestedPath.Path, def.JtNestedPath.Columns, names)
+			if err != nil {
+				return nil, err
+			}
+			s.nested = append(s.nested, nested)
+			continue
+		}
+		var c *jtColumn
+		if def.JtOrdinal != nil {
+			c = &jtColumn{name: def.JtOrdinal.Name.String(), ordinal: true, typ: sqltypes.Uint32}
+		} else if c, err = t.column(def.JtPath); err != nil {
+			return nil, err
+		}
+		if names[strings.ToLower(c.name)] {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DupFieldName, "Duplicate column name '%s'", c.name)
+		}
+		names[strings.ToLower(c.name)] = true
+		c.offset, c.table = len(t.fields), t.alias
+		t.fields = append(t.fields, &querypb.Field{Name: c.name, Type: c.typ, Table: t.alias})
+		s.columns = append(s.columns, c)
+	}
+	return s, nil
+}
+
+func (t *JSONTable) column(def *sqlparser.JtPathColDef) (*jtColumn, error) {
+	c := &jtColumn{name: def.Name.String(), exists: def.JtColExists, typ: def.Type.SQLType(), typeName: strings.ToUpper(def.Type.Type)}
+	var err error
+	if c.path, err = jtPath(def.Path); err != nil {
+		return nil, err
+	}
+	if err = c.setCast(def.Type); err != nil {
+		return nil, err
+	}
+	if c.onEmpty, err = c.response(def.EmptyOnResponse); err != nil {
+		return nil, err
+	}
+	if c.onError, err = c.response(def.ErrorOnResponse); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// setCast sets the cast that converts the values of a column to its type.
+func (c *jtColumn) setCast(ct *sqlparser.ColumnType) error {
+	cast := &castExpr{length: -1, name: c.typeName}
+	if ct.Length != nil {
+		cast.length = *ct.Length
+	}
+	switch typ := c.typ; {
+	case sqltypes.IsIntegral(typ), typ == sqltypes.Year:
+		cast.typ, cast.length = sqltypes.Decimal, maxDecimalPrecision
+		c.integer = true
+		c.min, c.max = integerRange(typ)
+	case sqltypes.IsFloat(typ):
+		cast.typ = sqltypes.Float64
+	case typ == sqltypes.Decimal:
+		cast.typ = sqltypes.Decimal
+		if cast.length < 0 {
+			cast.length = 10
+		}
+		if ct.Scale != nil {
+			cast.scale = *ct.Scale
+		}
+	case sqltypes.IsText(typ):
+		cast.typ = sqltypes.VarChar
+	case sqltypes.IsBinary(typ):
+		cast.typ = sqltypes.VarBinary
+		if typ != sqltypes.Binary {
+			cast.length = -1
+		}
+	case typ == sqltypes.Date:
+		cast.typ = sqltypes.Date
+	case typ == sqltypes.Datetime, typ == sqltypes.Timestamp:
+		cast.typ, cast.length = sqltypes.Datetime, max(cast.length, 0)
+	case typ == sqltypes.Time:
+		cast.typ, cast.length = sqltypes.Time, max(cast.length, 0)
+	case typ == sqltypes.TypeJSON:
+		cast.typ = sqltypes.TypeJSON
+	default:
+		return vterrors.VT12001("JSON_TABLE columns of type " + c.typeName)
+	}
+	c.cast = cast
+	return nil
+}
+
+// integerRange returns the range of values of an integer type.
+func integerRange(typ querypb.Type) (decimal.Decimal, decimal.Decimal) {
+	signed := func(bits uint) (decimal.Decimal, decimal.Decimal) {
+		return decimal.NewFromInt(-1 << (bits - 1)), decimal.NewFromInt(1<<(bits-1) - 1)
+	}
+	unsigned := func(bits uint) (decimal.Decimal, decimal.Decimal) {
+		return decimal.Zero, decimal.NewFromUint(math.MaxUint64 >> (64 - bits))
+	}
+	switch typ {
+	case sqltypes.Int8:
+		return signed(8)
+	case sqltypes.Uint8:
+		return unsigned(8)
+	case sqltypes.Int16:
+		return signed(16)
+	case sqltypes.Uint16:
+		return unsigned(16)
+	case sqltypes.Int24:
+		return signed(24)
+	case sqltypes.Uint24:
+		return unsigned(24)
+	case sqltypes.Int32:
+		return signed(32)
+	case sqltypes.Uint32:
+		return unsigned(32)
+	case sqltypes.Uint64:
+		return unsigned(64)
+	case sqltypes.Year:
+		return decimal.Zero, decimal.NewFromInt(2155)
+	}
+	return signed(64)
+}
+
+// response returns what a column is ON EMPTY or ON ERROR. A default is JSON
+// text, which must be a value of the type of the column.
+func (c *jtColumn) response(resp *sqlparser.JtOnResponse) (jtResponse, error) {
+	if resp == nil {
+		return jtResponse{}, nil
+	}
+	switch resp.ResponseType {
+	case sqlparser.ErrorJSONType:
+		return jtResponse{err: true}, nil
+	case sqlparser.DefaultJSONType:
+		errDefault := vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid default value for '%s'", c.name)
+		lit, ok := resp.Expr.(*sqlparser.Literal)
+		if !ok || lit.Type != sqlparser.StrVal {
+			return jtResponse{}, errDefault
+		}
+		v, err := json.Parse(lit.Val)
+		if err != nil {
+			return jtResponse{}, errDefault
+		}
+		def, err := c.convert(NewExpressionEnv(nil, nil), v, 0)
+		if err != nil {
+			return jtResponse{}, errDefault
+		}
+		return jtResponse{def: def}, nil
+	}
+	return jtResponse{}, nil
+}
+
+// Fields returns the columns of the table.
+func (t *JSONTable) Fields() []*querypb.Field {
+	return t.fields
+}
+
+// Rows returns the rows of the table for the document its expression
+// evaluates to in an environment. There are none when the document is NULL.
+func (t *JSONTable) Rows(env *ExpressionEnv) ([][]sqltypes.Value, error) {
+	e, err := t.doc.eval(env)
+	if err != nil || e == nil {
+		return nil, err
+	}
+	doc, err := jsonDoc(e, 1, "json_table")
+	if err != nil {
+		return nil, err
+	}
+	var rows [][]sqltypes.Value
+	for i, v := range t.root.path.Match(doc) {
+		scoped, err := t.rows(env, t.root, v, i+1)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, scoped...)
+	}
+	return rows, nil
+}
+
+// rows returns the rows of a scope for a value its path matches, which is the
+// ordinal-th one.
+func (t *JSONTable) rows(env *ExpressionEnv, s *jtScope, v *json.Value, ordinal int) ([][]sqltypes.Value, error) {
+	base := make([]sqltypes.Value, len(t.fields))
+	for _, c := range s.columns {
+		e, err := c.value(env, v, ordinal)
+		if err != nil {
+			return nil, err
+		}
+		if base[c.offset], err = evalToValue(e); err != nil {
+			return nil, err
+		}
+		if !base[c.offset].IsNull() {
+			base[c.offset] = sqltypes.MakeTrusted(c.typ, base[c.offset].Raw())
+		}
+	}
+	var rows [][]sqltypes.Value
+	for _, nested := range s.nested {
+		for i, match := range nested.path.Match(v) {
+			scoped, err := t.rows(env, nested, match, i+1)
+			if err != nil {
+				return nil, err
+			}
+			for _, row := range scoped {
+				for _, c := range s.columns {
+					row[c.offset] = base[c.offset]
+				}
+			}
+			rows = append(rows, scoped...)
+		}
+	}
+	if len(rows) == 0 {
+		rows = append(rows, base)
+	}
+	return rows, nil
+}
+
+// value returns the value of a column for a value the path of its scope
+// matches.
+func (c *jtColumn) value(env *ExpressionEnv, v *json.Value, ordinal int) (eval, error) {
+	switch {
+	case c.ordinal:
+		return evalUint64{u: uint64(ordinal)}, nil
+	case c.exists:
+		exists := int64(0)
+		if c.path.Exists(v) {
+			exists = 1
+		}
+		return c.respond(env, c.onError, json.NewInt64(exists), ordinal)
+	}
+	matches := c.path.Match(v)
+	switch len(matches) {
+	case 0:
+		if c.onEmpty.err {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Missing value for JSON_TABLE column '%s'", c.name)
+		}
+		return c.onEmpty.def, nil
+	case 1:
+		return c.respond(env, c.onError, matches[0], ordinal)
+	}
+	return c.respond(env, c.onError, json.NewArray(matches...), ordinal)
+}
+
+// respond returns a value converted to the type of a column, or what the
+// column is on error when it cannot be.
+func (c *jtColumn) respond(env *ExpressionEnv, onError jtResponse, v *json.Value, row int) (eval, error) {
+	e, err := c.convert(env, v, row)
+	if err == nil {
+		return e, nil
+	}
+	if onError.err {
+		return nil, err
+	}
+	return onError.def, nil
+}
+
+// convert converts a value to the type of a column, failing when it cannot.
+func (c *jtColumn) convert(env *ExpressionEnv, v *json.Value, row int) (eval, error) {
+	if !v.IsScalar() && c.cast.typ != sqltypes.TypeJSON {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Can't store an array or an object in the scalar column '%s' of JSON_TABLE '%s'.", c.name, c.table)
+	}
+	e, ok, err := env.convertJSON(v, c.cast)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON value for CAST to %s from column %s at row %d", c.typeName, c.name, row)
+	}
+	if !c.integer || e == nil {
+		return e, nil
+	}
+	dec := e.(evalDecimal).dec
+	if dec.Cmp(c.min) < 0 || dec.Cmp(c.max) > 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Value is out of range for JSON_TABLE's column '%s'", c.name)
+	}
+	if dec.Sign() < 0 {
+		i, _ := dec.Int64()
+		return evalInt64{i: i}, nil
+	}
+	u, _ := dec.Uint64()
+	return evalUint64{u: u}, nil
+}
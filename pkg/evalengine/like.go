@@ -0,0 +1,134 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"unicode/utf8"
+
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// likeExpr is LIKE and NOT LIKE, which match characters that are equal in
+// the collation the string and the pattern are compared in.
+type likeExpr struct {
+	left, pattern Expr
+	// escape is the ESCAPE clause, if any. The escape character is \ without
+	// one.
+	escape Expr
+	not    bool
+}
+
+func (l *likeExpr) eval(env *ExpressionEnv) (eval, error) {
+	left, err := l.left.eval(env)
+	if err != nil || left == nil {
+		return nil, err
+	}
+	pattern, err := l.pattern.eval(env)
+	if err != nil || pattern == nil {
+		return nil, err
+	}
+	escape := '\\'
+	if l.escape != nil {
+		e, err := l.escape.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		if e != nil {
+			b := toBytes(e)
+			switch utf8.RuneCount(b) {
+			case 0:
+				escape = -1
+			case 1:
+				escape, _ = utf8.DecodeRune(b)
+			default:
+				return nil, vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongArguments, "Incorrect arguments to ESCAPE")
+			}
+		}
+	}
+
+	s, p := toText(left), toText(pattern)
+	col, err := mergeCollations(s, p, "like")
+	if err != nil {
+		return nil, err
+	}
+	var matched bool
+	if col.IsBinary() {
+		matched = likeMatch(bytesToRunes(s.bytes), bytesToRunes(p.bytes), escape, col.EqualRune)
+	} else {
+		matched = likeMatch([]rune(s.string()), []rune(p.string()), escape, col.EqualRune)
+	}
+	return newEvalBool(matched != l.not), nil
+}
+
+func (l *likeExpr) typeof() (querypb.Type, bool) {
+	return sqltypes.Int64, true
+}
+
+func bytesToRunes(b []byte) []rune {
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return runes
+}
+
+// likeMatch reports whether a string matches a LIKE pattern, where % matches
+// any sequence of characters, _ matches any character and the escape
+// character makes the character after it match itself. Characters match the
+// characters equal reports they are equal to.
+func likeMatch(s, pattern []rune, escape rune, equal func(a, b rune) bool) bool {
+	si, pi := 0, 0
+	// Where the last % seen is in the pattern, and where in the string what it
+	// matches ends so far.
+	starP, starS := -1, 0
+	for si < len(s) {
+		if pi < len(pattern) {
+			c := pattern[pi]
+			switch {
+			case c == escape && pi+1 < len(pattern):
+				if equal(s[si], pattern[pi+1]) {
+					si, pi = si+1, pi+2
+					continue
+				}
+			case c == '%':
+				starP, starS = pi, si
+				pi++
+				continue
+			case c == '_':
+				si, pi = si+1, pi+1
+				continue
+			default:
+				if equal(s[si], c) {
+					si, pi = si+1, pi+1
+					continue
+				}
+			}
+		}
+		if starP < 0 {
+			return false
+		}
+		starS++
+		si, pi = starS, starP+1
+	}
+	for pi < len(pattern) && pattern[pi] == '%' {
+		pi++
+	}
+	return pi == len(pattern)
+}
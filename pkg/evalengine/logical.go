@@ -0,0 +1,154 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+type (
+	andExpr struct {
+		left, right Expr
+	}
+
+	orExpr struct {
+		left, right Expr
+	}
+
+	xorExpr struct {
+		left, right Expr
+	}
+
+	notExpr struct {
+		expr Expr
+	}
+
+	// isExpr is IS [NOT] NULL, IS [NOT] TRUE and IS [NOT] FALSE, which are
+	// never NULL.
+	isExpr struct {
+		expr Expr
+		op   sqlparser.IsExprOperator
+	}
+)
+
+// and is AND: false when either operand is false, else NULL when either is
+// NULL.
+func and(env *ExpressionEnv, left, right eval) eval {
+	switch {
+	case left != nil && !env.isTrue(left), right != nil && !env.isTrue(right):
+		return evalFalse
+	case left == nil || right == nil:
+		return nil
+	}
+	return evalTrue
+}
+
+// or is OR: true when either operand is true, else NULL when either is NULL.
+func or(env *ExpressionEnv, left, right eval) eval {
+	switch {
+	case left != nil && env.isTrue(left), right != nil && env.isTrue(right):
+		return evalTrue
+	case left == nil || right == nil:
+		return nil
+	}
+	return evalFalse
+}
+
+func not(env *ExpressionEnv, e eval) eval {
+	if e == nil {
+		return nil
+	}
+	return newEvalBool(!env.isTrue(e))
+}
+
+func (a *andExpr) eval(env *ExpressionEnv) (eval, error) {
+	left, err := a.left.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	if left != nil && !env.isTrue(left) {
+		return evalFalse, nil
+	}
+	right, err := a.right.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	return and(env, left, right), nil
+}
+
+func (o *orExpr) eval(env *ExpressionEnv) (eval, error) {
+	left, err := o.left.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	if left != nil && env.isTrue(left) {
+		return evalTrue, nil
+	}
+	right, err := o.right.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	return or(env, left, right), nil
+}
+
+func (x *xorExpr) eval(env *ExpressionEnv) (eval, error) {
+	left, err := x.left.eval(env)
+	if err != nil || left == nil {
+		return nil, err
+	}
+	right, err := x.right.eval(env)
+	if err != nil || right == nil {
+		return nil, err
+	}
+	return newEvalBool(env.isTrue(left) != env.isTrue(right)), nil
+}
+
+func (n *notExpr) eval(env *ExpressionEnv) (eval, error) {
+	e, err := n.expr.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	return not(env, e), nil
+}
+
+func (i *isExpr) eval(env *ExpressionEnv) (eval, error) {
+	e, err := i.expr.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	switch i.op {
+	case sqlparser.IsNullOp:
+		return newEvalBool(e == nil), nil
+	case sqlparser.IsNotNullOp:
+		return newEvalBool(e != nil), nil
+	case sqlparser.IsTrueOp:
+		return newEvalBool(e != nil && env.isTrue(e)), nil
+	case sqlparser.IsNotTrueOp:
+		return newEvalBool(e == nil || !env.isTrue(e)), nil
+	case sqlparser.IsFalseOp:
+		return newEvalBool(e != nil && !env.isTrue(e)), nil
+	}
+	return newEvalBool(e == nil || env.isTrue(e)), nil
+}
+
+func (a *andExpr) typeof() (querypb.Type, bool) { return sqltypes.Int64, true }
+func (o *orExpr) typeof() (querypb.Type, bool)  { return sqltypes.Int64, true }
+func (x *xorExpr) typeof() (querypb.Type, bool) { return sqltypes.Int64, true }
+func (n *notExpr) typeof() (querypb.Type, bool) { return sqltypes.Int64, true }
+func (i *isExpr) typeof() (querypb.Type, bool)  { return sqltypes.Int64, true }
@@ -0,0 +1,312 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/decimal"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+// WarnInvalidLogarithm is the code of the warning raised when the logarithm
+// of a number that is not positive is taken.
+const WarnInvalidLogarithm = 3020
+
+// numericResult is the type of the result of functions whose result has the
+// type of their first argument as a number.
+func numericResult(args []querypb.Type) (querypb.Type, bool) {
+	switch typ := args[0]; {
+	case typ == sqltypes.Null:
+		return sqltypes.Null, true
+	case sqltypes.IsUnsigned(typ):
+		return sqltypes.Uint64, true
+	case sqltypes.IsIntegral(typ):
+		return sqltypes.Int64, true
+	case typ == sqltypes.Decimal:
+		return sqltypes.Decimal, true
+	}
+	return sqltypes.Float64, true
+}
+
+// floatFunc returns a function of doubles that is NULL where fn is NaN, like
+// the square root of a negative number, and fails where it is infinite.
+func floatFunc(fn func(args []float64) float64) *builtin {
+	return &builtin{minArgs: 1, maxArgs: 1, call: func(env *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+		floats := make([]float64, len(args))
+		for i, arg := range args {
+			floats[i] = env.toFloat(arg)
+		}
+		f := fn(floats)
+		switch {
+		case math.IsNaN(f):
+			return nil, nil
+		case math.IsInf(f, 0):
+			return nil, errOutOfRange("DOUBLE", call.sql)
+		}
+		return evalFloat{f: f}, nil
+	}, resultType: sqltypes.Float64}
+}
+
+func floatFunc2(fn func(x, y float64) float64) *builtin {
+	b := floatFunc(func(args []float64) float64 { return fn(args[0], args[1]) })
+	b.minArgs, b.maxArgs = 2, 2
+	return b
+}
+
+func floatFunc1(fn func(x float64) float64) *builtin {
+	return floatFunc(func(args []float64) float64 { return fn(args[0]) })
+}
+
+// logFunc returns a logarithm, which is NULL with a warning for numbers that
+// are not positive.
+func logFunc(fn func(x float64) float64) *builtin {
+	return &builtin{minArgs: 1, maxArgs: 1, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		x := env.toFloat(args[0])
+		if x <= 0 {
+			env.warn(WarnInvalidLogarithm, "Invalid argument for logarithm")
+			return nil, nil
+		}
+		return evalFloat{f: fn(x)}, nil
+	}, resultType: sqltypes.Float64}
+}
+
+var numericBuiltins = map[string]*builtin{
+	"abs": {minArgs: 1, maxArgs: 1, call: func(env *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+		switch e := env.toNumber(args[0]).(type) {
+		case evalInt64:
+			if e.i == math.MinInt64 {
+				return nil, errOutOfRange("BIGINT", call.sql)
+			}
+			if e.i < 0 {
+				return evalInt64{i: -e.i}, nil
+			}
+			return e, nil
+		case evalFloat:
+			return evalFloat{f: math.Abs(e.f)}, nil
+		case evalDecimal:
+			return evalDecimal{dec: e.dec.Abs(), length: e.length}, nil
+		default:
+			return e, nil
+		}
+	}, result: numericResult},
+	"ceil":     {minArgs: 1, maxArgs: 1, call: ceilFloor(true), result: ceilFloorResult},
+	"ceiling":  {minArgs: 1, maxArgs: 1, call: ceilFloor(true), result: ceilFloorResult},
+	"floor":    {minArgs: 1, maxArgs: 1, call: ceilFloor(false), result: ceilFloorResult},
+	"round":    {minArgs: 1, maxArgs: 2, call: roundTruncate(true), result: numericResult},
+	"truncate": {minArgs: 2, maxArgs: 2, call: roundTruncate(false), result: numericResult},
+	"sign": {minArgs: 1, maxArgs: 1, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		var sign int
+		switch e := env.toNumber(args[0]).(type) {
+		case evalInt64:
+			sign = cmpZero(e.i)
+		case evalUint64:
+			if e.u > 0 {
+				sign = 1
+			}
+		case evalFloat:
+			switch {
+			case e.f > 0:
+				sign = 1
+			case e.f < 0:
+				sign = -1
+			}
+		case evalDecimal:
+			sign = e.dec.Sign()
+		}
+		return evalInt64{i: int64(sign)}, nil
+	}, resultType: sqltypes.Int64},
+	"pow":     floatFunc2(math.Pow),
+	"power":   floatFunc2(math.Pow),
+	"sqrt":    floatFunc1(math.Sqrt),
+	"exp":     floatFunc1(math.Exp),
+	"sin":     floatFunc1(math.Sin),
+	"cos":     floatFunc1(math.Cos),
+	"tan":     floatFunc1(math.Tan),
+	"asin":    floatFunc1(math.Asin),
+	"acos":    floatFunc1(math.Acos),
+	"atan2":   floatFunc2(math.Atan2),
+	"cot":     floatFunc1(func(x float64) float64 { return 1 / math.Tan(x) }),
+	"degrees": floatFunc1(func(x float64) float64 { return x * 180 / math.Pi }),
+	"radians": floatFunc1(func(x float64) float64 { return x * math.Pi / 180 }),
+	"atan": {minArgs: 1, maxArgs: 2, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		if len(args) == 2 {
+			return evalFloat{f: math.Atan2(env.toFloat(args[0]), env.toFloat(args[1]))}, nil
+		}
+		return evalFloat{f: math.Atan(env.toFloat(args[0]))}, nil
+	}, resultType: sqltypes.Float64},
+	"ln":    logFunc(math.Log),
+	"log2":  logFunc(math.Log2),
+	"log10": logFunc(math.Log10),
+	"log": {minArgs: 1, maxArgs: 2, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		x := env.toFloat(args[len(args)-1])
+		base := math.E
+		if len(args) == 2 {
+			base = env.toFloat(args[0])
+		}
+		if x <= 0 || base <= 0 || base == 1 {
+			env.warn(WarnInvalidLogarithm, "Invalid argument for logarithm")
+			return nil, nil
+		}
+		return evalFloat{f: math.Log(x) / math.Log(base)}, nil
+	}, resultType: sqltypes.Float64},
+	"pi": {minArgs: 0, maxArgs: 0, call: func(*ExpressionEnv, *builtinExpr, []eval) (eval, error) {
+		return evalFloat{f: math.Pi}, nil
+	}, resultType: sqltypes.Float64},
+	"greatest": {minArgs: 2, maxArgs: -1, call: extremum(1), result: extremumResult},
+	"least":    {minArgs: 2, maxArgs: -1, call: extremum(-1), result: extremumResult},
+	"hex": {minArgs: 1, maxArgs: 1, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		if b, ok := args[0].(evalBytes); ok {
+			return newEvalText([]byte(strings.ToUpper(hex.EncodeToString(b.bytes)))), nil
+		}
+		return newEvalText([]byte(strings.ToUpper(strconv.FormatUint(env.toUint64(args[0]), 16)))), nil
+	}, resultType: sqltypes.VarChar},
+	"bin": {minArgs: 1, maxArgs: 1, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		return newEvalText([]byte(strconv.FormatUint(env.toUint64(args[0]), 2))), nil
+	}, resultType: sqltypes.VarChar},
+	"oct": {minArgs: 1, maxArgs: 1, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		return newEvalText([]byte(strconv.FormatUint(env.toUint64(args[0]), 8))), nil
+	}, resultType: sqltypes.VarChar},
+}
+
+func cmpZero(i int64) int {
+	switch {
+	case i > 0:
+		return 1
+	case i < 0:
+		return -1
+	}
+	return 0
+}
+
+// ceilFloor returns CEIL or FLOOR, which are integers for integers and
+// decimals, unless a decimal is out of the range of integers.
+func ceilFloor(ceil bool) func(env *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+	return func(env *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+		switch e := env.toNumber(args[0]).(type) {
+		case evalFloat:
+			if ceil {
+				return evalFloat{f: math.Ceil(e.f)}, nil
+			}
+			return evalFloat{f: math.Floor(e.f)}, nil
+		case evalDecimal:
+			dec := e.dec.Floor()
+			if ceil {
+				dec = e.dec.Ceil()
+			}
+			if i, ok := dec.Int64(); ok {
+				return evalInt64{i: i}, nil
+			}
+			return evalDecimal{dec: dec}, nil
+		default:
+			return e, nil
+		}
+	}
+}
+
+func ceilFloorResult(args []querypb.Type) (querypb.Type, bool) {
+	if args[0] == sqltypes.Decimal {
+		return 0, false
+	}
+	return numericResult(args)
+}
+
+// roundTruncate returns ROUND or TRUNCATE. Doubles round to the nearest even
+// number like they do in MySQL, and decimals away from zero.
+func roundTruncate(round bool) func(env *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+	return func(env *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+		var places int64
+		if len(args) == 2 {
+			places = env.toInt64(args[1])
+		}
+		places = max(min(places, maxDecimalScale), -maxDecimalPrecision)
+		switch e := env.toNumber(args[0]).(type) {
+		case evalFloat:
+			p := math.Pow(10, float64(places))
+			if round {
+				return evalFloat{f: math.RoundToEven(e.f*p) / p}, nil
+			}
+			return evalFloat{f: math.Trunc(e.f*p) / p}, nil
+		case evalDecimal:
+			length := int32(max(places, 0))
+			if round {
+				return evalDecimal{dec: e.dec.Round(int32(places)), length: length}, nil
+			}
+			return evalDecimal{dec: e.dec.Truncate(int32(places)), length: length}, nil
+		case evalInt64:
+			if places >= 0 {
+				return e, nil
+			}
+			dec := decimal.NewFromInt(e.i)
+			if round {
+				dec = dec.Round(int32(places))
+			} else {
+				dec = dec.Truncate(int32(places))
+			}
+			i, ok := dec.Int64()
+			if !ok {
+				return nil, errOutOfRange("BIGINT", call.sql)
+			}
+			return evalInt64{i: i}, nil
+		case evalUint64:
+			if places >= 0 {
+				return e, nil
+			}
+			dec := decimal.NewFromUint(e.u)
+			if round {
+				dec = dec.Round(int32(places))
+			} else {
+				dec = dec.Truncate(int32(places))
+			}
+			u, ok := dec.Uint64()
+			if !ok {
+				return nil, errOutOfRange("BIGINT UNSIGNED", call.sql)
+			}
+			return evalUint64{u: u}, nil
+		}
+		return nil, nil
+	}
+}
+
+// extremum returns GREATEST, for a sign of 1, or LEAST, for -1. They are NULL
+// when any argument is.
+func extremum(sign int) func(env *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+	return func(env *ExpressionEnv, call *builtinExpr, args []eval) (eval, error) {
+		best := args[0]
+		for _, arg := range args[1:] {
+			n, err := env.compareScalars(arg, best)
+			if err != nil {
+				return nil, err
+			}
+			if n*sign > 0 {
+				best = arg
+			}
+		}
+		if typ, ok := call.typeof(); ok {
+			return env.coerce(best, typ), nil
+		}
+		return best, nil
+	}
+}
+
+func extremumResult(args []querypb.Type) (querypb.Type, bool) {
+	return aggregateTypes(args), true
+}
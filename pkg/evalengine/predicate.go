@@ -0,0 +1,190 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// Truth is the value of a condition in SQL's three-valued logic.
+type Truth int8
+
+const (
+	// Unknown is the value of conditions that are NULL.
+	Unknown Truth = iota
+	False
+	True
+)
+
+func (t Truth) String() string {
+	switch t {
+	case False:
+		return "FALSE"
+	case True:
+		return "TRUE"
+	}
+	return "UNKNOWN"
+}
+
+// Predicate is a condition compiled against the fields of rows, like the
+// WHERE clause of a query, which can be tested against any number of rows
+// with those fields.
+//
+// A row matches a predicate when the condition is TRUE for it. Rows for which
+// it is FALSE or NULL do not match, like they do not in a WHERE clause.
+type Predicate struct {
+	expr   Expr
+	fields []*querypb.Field
+}
+
+// FieldsConfig returns a Config that binds column names to the offsets of
+// fields with the same name, and to the types and collations of those
+// fields. Qualified
+// names must also have the table of the field, and unqualified names must
+// match only one field.
+func FieldsConfig(fields []*querypb.Field) *Config {
+	return &Config{
+		ResolveColumn: func(col *sqlparser.ColName) (int, error) {
+			return fieldOffset(fields, col)
+		},
+		ResolveType: func(offset int) (querypb.Type, bool) {
+			return fields[offset].Type, true
+		},
+		ResolveCollation: func(offset int) (collations.ID, bool) {
+			return fieldCollation(fields[offset])
+		},
+	}
+}
+
+// fieldCollation returns the collation of a field, which its Charset holds
+// the ID of, if it is one there is.
+func fieldCollation(field *querypb.Field) (collations.ID, bool) {
+	id := collations.ID(field.Charset)
+	return id, collations.Get(id) != nil
+}
+
+func fieldOffset(fields []*querypb.Field, col *sqlparser.ColName) (int, error) {
+	offset := -1
+	for i, f := range fields {
+		if !col.Name.EqualString(f.Name) {
+			continue
+		}
+		if !col.Qualifier.IsEmpty() {
+			if col.Qualifier.Name.String() != f.Table && col.Qualifier.Name.String() != f.OrgTable {
+				continue
+			}
+			if col.Qualifier.Qualifier.NotEmpty() && f.Database != "" && col.Qualifier.Qualifier.String() != f.Database {
+				continue
+			}
+		}
+		if offset >= 0 {
+			return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUniqError, "Column '%s' in where clause is ambiguous", sqlparser.String(col))
+		}
+		offset = i
+	}
+	if offset < 0 {
+		return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in 'where clause'", sqlparser.String(col))
+	}
+	return offset, nil
+}
+
+// CompilePredicate compiles a condition against the fields of the rows it is
+// tested against, binding its column names to them like FieldsConfig does.
+// Bind variables are given when rows are tested.
+func CompilePredicate(expr sqlparser.Expr, fields []*querypb.Field) (*Predicate, error) {
+	translated, err := Translate(expr, FieldsConfig(fields))
+	if err != nil {
+		return nil, err
+	}
+	return &Predicate{expr: translated, fields: fields}, nil
+}
+
+// Eval returns the truth of the condition for a row, evaluated in env with
+// its bind variables and current time. The environment of an evaluation, like
+// that of a statement, is meant to be made once and shared by all the rows it
+// tests, so that NOW() is the same for all of them.
+func (p *Predicate) Eval(env *ExpressionEnv, row []sqltypes.Value) (Truth, error) {
+	env.Row = row
+	return env.Truth(p.expr)
+}
+
+// Truth evaluates a condition and returns its truth.
+func (env *ExpressionEnv) Truth(expr Expr) (Truth, error) {
+	e, err := expr.eval(env)
+	switch {
+	case err != nil:
+		return Unknown, err
+	case e == nil:
+		return Unknown, nil
+	case env.isTrue(e):
+		return True, nil
+	}
+	return False, nil
+}
+
+// Match reports whether the condition is TRUE for a row.
+func (p *Predicate) Match(env *ExpressionEnv, row []sqltypes.Value) (bool, error) {
+	t, err := p.Eval(env, row)
+	return t == True, err
+}
+
+// MatchNamed is Match for a row whose values are by field name. Fields
+// missing from the row are NULL. The values are put in order in a buffer of
+// env, which later calls with the same env reuse.
+func (p *Predicate) MatchNamed(env *ExpressionEnv, row sqltypes.RowNamedValues) (bool, error) {
+	if cap(env.named) < len(p.fields) {
+		env.named = make([]sqltypes.Value, len(p.fields))
+	}
+	values := env.named[:len(p.fields)]
+	for i, f := range p.fields {
+		v, ok := row[f.Name]
+		if !ok {
+			for name, value := range row {
+				if strings.EqualFold(name, f.Name) {
+					v = value
+					break
+				}
+			}
+		}
+		values[i] = v
+	}
+	return p.Match(env, values)
+}
+
+// Filter returns a result with the fields of another and the rows of it that
+// match the condition.
+func (p *Predicate) Filter(result *sqltypes.Result, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	filtered := &sqltypes.Result{Fields: result.Fields}
+	env := NewExpressionEnv(bindVars, nil)
+	for _, row := range result.Rows {
+		ok, err := p.Match(env, row)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			filtered.Rows = append(filtered.Rows, row)
+		}
+	}
+	return filtered, nil
+}
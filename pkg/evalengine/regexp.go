@@ -0,0 +1,633 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// MySQL evaluates regular expressions with ICU, and Go with RE2, which has a
+// syntax of its own and lacks some of ICU's features. Patterns are translated
+// from ICU's syntax to RE2's, and the features RE2 does not have, like
+// back-references, look-around assertions and possessive quantifiers, are
+// errors rather than patterns that match something else.
+
+// regexpFunc is the function a regexpExpr is.
+type regexpFunc uint8
+
+const (
+	regexpLike regexpFunc = iota
+	regexpInstr
+	regexpSubstr
+	regexpReplace
+)
+
+var regexpFuncNames = [...]string{
+	regexpLike:    "regexp_like",
+	regexpInstr:   "regexp_instr",
+	regexpSubstr:  "regexp_substr",
+	regexpReplace: "regexp_replace",
+}
+
+// regexpExpr is REGEXP, NOT REGEXP, REGEXP_LIKE, REGEXP_INSTR, REGEXP_SUBSTR
+// and REGEXP_REPLACE. The arguments a function does not take or that are not
+// given are nil.
+type regexpExpr struct {
+	fn               regexpFunc
+	subject, pattern Expr
+	// repl is the replacement of REGEXP_REPLACE.
+	repl                                          Expr
+	position, occurrence, returnOption, matchType Expr
+	not                                           bool
+	// compiled holds the pattern compiled case sensitively and insensitively,
+	// when it and the match type are literals.
+	compiled map[bool]*regexp.Regexp
+}
+
+// regexpFlags are the options a pattern is compiled with, which the match
+// type of the REGEXP_ functions sets.
+type regexpFlags struct {
+	// fold is set by i and unset by c, and is the default of the collation
+	// the subject and the pattern are compared in.
+	fold bool
+	// multiline is set by m: ^ and $ match at the start and end of lines.
+	multiline bool
+	// dotAll is set by n: . matches line terminators.
+	dotAll bool
+	// unixLines is set by u: only \n is a line terminator.
+	unixLines bool
+}
+
+// parseMatchType returns the flags a match type sets. The last of c and i
+// wins, and fold is the case sensitivity without either.
+func parseMatchType(matchType string, fold bool) (regexpFlags, error) {
+	flags := regexpFlags{fold: fold}
+	for _, c := range matchType {
+		switch c {
+		case 'c':
+			flags.fold = false
+		case 'i':
+			flags.fold = true
+		case 'm':
+			flags.multiline = true
+		case 'n':
+			flags.dotAll = true
+		case 'u':
+			flags.unixLines = true
+		default:
+			return regexpFlags{}, vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpInvalidFlag, "Invalid match mode flag in regular expression.")
+		}
+	}
+	return flags, nil
+}
+
+func (t *translator) regexp(fn regexpFunc, subject, pattern, repl, position, occurrence, returnOption, matchType sqlparser.Expr) (*regexpExpr, error) {
+	args := []sqlparser.Expr{subject, pattern, repl, position, occurrence, returnOption, matchType}
+	translated := make([]Expr, len(args))
+	for i, arg := range args {
+		if arg == nil {
+			continue
+		}
+		var err error
+		if translated[i], err = t.expr(arg); err != nil {
+			return nil, err
+		}
+	}
+	re := &regexpExpr{
+		fn:           fn,
+		subject:      translated[0],
+		pattern:      translated[1],
+		repl:         translated[2],
+		position:     translated[3],
+		occurrence:   translated[4],
+		returnOption: translated[5],
+		matchType:    translated[6],
+	}
+
+	lit, ok := pattern.(*sqlparser.Literal)
+	if !ok || lit.Type != sqlparser.StrVal {
+		return re, nil
+	}
+	var mode string
+	if matchType != nil {
+		modeLit, ok := matchType.(*sqlparser.Literal)
+		if !ok || modeLit.Type != sqlparser.StrVal {
+			return re, nil
+		}
+		mode = modeLit.Val
+	}
+	re.compiled = map[bool]*regexp.Regexp{}
+	for _, fold := range []bool{false, true} {
+		flags, err := parseMatchType(mode, fold)
+		if err != nil {
+			return nil, err
+		}
+		if re.compiled[fold], err = compileRegexp(lit.Val, flags); err != nil {
+			return nil, err
+		}
+	}
+	return re, nil
+}
+
+func (r *regexpExpr) eval(env *ExpressionEnv) (eval, error) {
+	args := make([]eval, 0, 7)
+	for _, arg := range []Expr{r.subject, r.pattern, r.repl, r.position, r.occurrence, r.returnOption, r.matchType} {
+		if arg == nil {
+			args = append(args, nil)
+			continue
+		}
+		e, err := arg.eval(env)
+		if err != nil || e == nil {
+			return nil, err
+		}
+		args = append(args, e)
+	}
+	name := regexpFuncNames[r.fn]
+
+	subject, pattern := toText(args[0]), toText(args[1])
+	var binary, fold bool
+	switch {
+	case subject.isBinary() && pattern.isBinary():
+		binary = true
+	case subject.isBinary():
+		return nil, errCharsetMismatch("binary", pattern.collation().Name(), name)
+	case pattern.isBinary():
+		return nil, errCharsetMismatch("binary", subject.collation().Name(), name)
+	default:
+		col, err := mergeCollations(subject, pattern, name)
+		if err != nil {
+			return nil, err
+		}
+		fold = col.EqualRune('a', 'A')
+	}
+
+	re, ok := r.compiled[fold]
+	if !ok || binary {
+		var mode string
+		if args[6] != nil {
+			mode = string(toBytes(args[6]))
+		}
+		flags, err := parseMatchType(mode, fold)
+		if err != nil {
+			return nil, err
+		}
+		p := pattern.string()
+		if binary {
+			p = string(bytesToRunes(pattern.bytes))
+		}
+		if re, err = compileRegexp(p, flags); err != nil {
+			return nil, err
+		}
+	}
+
+	var chars []rune
+	if binary {
+		chars = bytesToRunes(subject.bytes)
+	} else {
+		chars = []rune(subject.string())
+	}
+	if r.fn == regexpLike {
+		return newEvalBool(re.MatchString(string(chars)) != r.not), nil
+	}
+
+	pos := int64(1)
+	if args[3] != nil {
+		pos = env.toInt64(args[3])
+	}
+	if pos < 1 || pos > int64(len(chars))+1 {
+		return nil, vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpIndexOutOfBounds, "Index out of bounds in regular expression search.")
+	}
+	occurrence := int64(1)
+	if r.fn == regexpReplace {
+		occurrence = 0
+	}
+	if args[4] != nil {
+		occurrence = env.toInt64(args[4])
+	}
+	matches := findMatches(re, chars, int(pos-1))
+
+	switch r.fn {
+	case regexpInstr:
+		returnEnd := false
+		if args[5] != nil {
+			switch env.toInt64(args[5]) {
+			case 0:
+			case 1:
+				returnEnd = true
+			default:
+				return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongArguments, "Incorrect arguments to %s: return_option must be 1 or 0.", name)
+			}
+		}
+		match := nthMatch(matches, occurrence)
+		switch {
+		case match == nil:
+			return evalInt64{}, nil
+		case returnEnd:
+			return evalInt64{i: int64(match[1] + 1)}, nil
+		}
+		return evalInt64{i: int64(match[0] + 1)}, nil
+	case regexpSubstr:
+		match := nthMatch(matches, occurrence)
+		if match == nil {
+			return nil, nil
+		}
+		return fromCharacters(chars[match[0]:match[1]], binary), nil
+	}
+
+	repl, _ := characters(args[2])
+	result := append([]rune(nil), chars[:pos-1]...)
+	last := int(pos - 1)
+	for i, match := range matches {
+		if occurrence > 0 && int64(i+1) != occurrence {
+			continue
+		}
+		result = append(result, chars[last:match[0]]...)
+		var err error
+		if result, err = expandReplacement(result, repl, re, chars, match); err != nil {
+			return nil, err
+		}
+		last = match[1]
+	}
+	return fromCharacters(append(result, chars[last:]...), binary), nil
+}
+
+func (r *regexpExpr) typeof() (querypb.Type, bool) {
+	switch r.fn {
+	case regexpSubstr, regexpReplace:
+		typ, ok := r.subject.typeof()
+		if !ok {
+			return 0, false
+		}
+		return firstTextResult([]querypb.Type{typ})
+	}
+	return sqltypes.Int64, true
+}
+
+// findMatches returns the matches of a regular expression in characters from
+// an offset, as the offsets of the characters where they and their groups
+// start and end.
+func findMatches(re *regexp.Regexp, chars []rune, from int) [][]int {
+	s := string(chars[from:])
+	locs := re.FindAllStringSubmatchIndex(s, -1)
+	if len(locs) == 0 {
+		return nil
+	}
+	// offsets maps the offsets of the bytes of s that start characters to
+	// the offsets of those characters in chars.
+	offsets := make([]int, len(s)+1)
+	n := from
+	for i := range s {
+		offsets[i] = n
+		n++
+	}
+	offsets[len(s)] = n
+	for _, loc := range locs {
+		for i, off := range loc {
+			if off >= 0 {
+				loc[i] = offsets[off]
+			}
+		}
+	}
+	return locs
+}
+
+// nthMatch returns the nth of matches, counting from 1, where occurrences
+// below 1 are the first.
+func nthMatch(matches [][]int, occurrence int64) []int {
+	occurrence = max(occurrence, 1)
+	if occurrence > int64(len(matches)) {
+		return nil
+	}
+	return matches[occurrence-1]
+}
+
+// expandReplacement appends a replacement of REGEXP_REPLACE with the groups of
+// a match in it, which it refers to as ICU does: $n is the group n, with as
+// many digits as there are groups, ${name} is a named group, and \ makes the
+// character after it stand for itself.
+func expandReplacement(dst, repl []rune, re *regexp.Regexp, chars []rune, match []int) ([]rune, error) {
+	group := func(n int) []rune {
+		if match[2*n] < 0 {
+			return nil
+		}
+		return chars[match[2*n]:match[2*n+1]]
+	}
+	groups := re.NumSubexp()
+	for i := 0; i < len(repl); i++ {
+		c := repl[i]
+		switch {
+		case c == '\\':
+			if i+1 < len(repl) {
+				i++
+				dst = append(dst, repl[i])
+			}
+		case c == '$' && i+1 < len(repl) && repl[i+1] == '{':
+			end := i + 2
+			for end < len(repl) && repl[end] != '}' {
+				end++
+			}
+			n := -1
+			if end < len(repl) {
+				n = re.SubexpIndex(string(repl[i+2 : end]))
+			}
+			if n < 0 {
+				return nil, errCaptureGroupName()
+			}
+			dst = append(dst, group(n)...)
+			i = end
+		case c == '$' && i+1 < len(repl) && repl[i+1] >= '0' && repl[i+1] <= '9':
+			n := int(repl[i+1] - '0')
+			if n > groups {
+				return nil, vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpIndexOutOfBounds, "Index out of bounds in regular expression search.")
+			}
+			i++
+			for i+1 < len(repl) && repl[i+1] >= '0' && repl[i+1] <= '9' && n*10+int(repl[i+1]-'0') <= groups {
+				i++
+				n = n*10 + int(repl[i]-'0')
+			}
+			dst = append(dst, group(n)...)
+		case c == '$':
+			return nil, errCaptureGroupName()
+		default:
+			dst = append(dst, c)
+		}
+	}
+	return dst, nil
+}
+
+// compileRegexp compiles a pattern in ICU's syntax with flags. MySQL refuses
+// empty patterns.
+func compileRegexp(pattern string, flags regexpFlags) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, errIllegalArgument()
+	}
+	translated, err := translateICU(pattern, flags)
+	if err != nil {
+		return nil, err
+	}
+	var modes string
+	if flags.fold {
+		modes += "i"
+	}
+	if flags.multiline {
+		modes += "m"
+	}
+	if flags.dotAll {
+		modes += "s"
+	}
+	if modes != "" {
+		translated = "(?" + modes + ")" + translated
+	}
+	re, err := regexp.Compile(translated)
+	if err != nil {
+		return nil, regexpSyntaxError(pattern, err)
+	}
+	return re, nil
+}
+
+// lineTerminators are the characters that end lines for ICU, which . does
+// not match unless the n or u flag is given.
+const lineTerminators = `\n\x{b}\f\r\x{85}\x{2028}\x{2029}`
+
+// translateICU translates a pattern from ICU's syntax to RE2's.
+func translateICU(pattern string, flags regexpFlags) (string, error) {
+	var b strings.Builder
+	p := []rune(pattern)
+	inClass := false
+	for i := 0; i < len(p); i++ {
+		c := p[i]
+		switch {
+		case c == '\\':
+			if i+1 == len(p) {
+				b.WriteRune(c)
+				continue
+			}
+			last, err := translateEscape(&b, p, i+1, inClass)
+			if err != nil {
+				return "", err
+			}
+			i = last
+		case inClass:
+			switch {
+			case c == ']':
+				inClass = false
+				b.WriteRune(c)
+			case c == '[' && i+1 < len(p) && p[i+1] == ':':
+				end := strings.Index(string(p[i:]), ":]")
+				if end < 0 {
+					b.WriteRune(c)
+					continue
+				}
+				end = i + utf8.RuneCountInString(string(p[i:])[:end]) + 1
+				b.WriteString(string(p[i : end+1]))
+				i = end
+			case c == '[':
+				return "", errRegexpUnsupported("nested character sets")
+			case c == '&' && i+1 < len(p) && p[i+1] == '&', c == '-' && i+2 < len(p) && p[i+1] == '-' && p[i+2] == '[':
+				return "", errRegexpUnsupported("set operations in character classes")
+			default:
+				b.WriteRune(c)
+			}
+		case c == '[':
+			inClass = true
+			b.WriteRune(c)
+		case c == '(' && i+1 < len(p) && p[i+1] == '?':
+			rest := string(p[i+2:])
+			switch {
+			case strings.HasPrefix(rest, "="), strings.HasPrefix(rest, "!"):
+				return "", errRegexpUnsupported("look-ahead assertions")
+			case strings.HasPrefix(rest, "<="), strings.HasPrefix(rest, "<!"):
+				return "", errRegexpUnsupported("look-behind assertions")
+			case strings.HasPrefix(rest, ">"):
+				return "", errRegexpUnsupported("atomic groups")
+			case strings.HasPrefix(rest, "#"):
+				end := strings.IndexRune(rest, ')')
+				if end < 0 {
+					return "", errMismatchedParen()
+				}
+				i += 2 + utf8.RuneCountInString(rest[:end])
+			default:
+				b.WriteString("(?")
+				i++
+			}
+		case (c == '*' || c == '+' || c == '?' || c == '}') && i+1 < len(p) && p[i+1] == '+':
+			return "", errRegexpUnsupported("possessive quantifiers")
+		case c == '.' && !flags.dotAll && !flags.unixLines:
+			b.WriteString(`[^` + lineTerminators + `]`)
+		default:
+			b.WriteRune(c)
+		}
+	}
+	return b.String(), nil
+}
+
+// translateEscape translates the escape sequence whose character after the
+// backslash is at i, and returns where it ends.
+func translateEscape(b *strings.Builder, p []rune, i int, inClass bool) (int, error) {
+	c := p[i]
+	codePoint := func(digits int) (int, error) {
+		if i+digits >= len(p) {
+			return 0, errBadEscape()
+		}
+		r, err := strconv.ParseUint(string(p[i+1:i+1+digits]), 16, 32)
+		if err != nil || r > utf8.MaxRune {
+			return 0, errBadEscape()
+		}
+		fmt.Fprintf(b, `\x{%x}`, r)
+		return i + digits, nil
+	}
+	switch c {
+	case '1', '2', '3', '4', '5', '6', '7', '8', '9', 'k':
+		return 0, errRegexpUnsupported("back-references")
+	case 'X':
+		return 0, errRegexpUnsupported("grapheme clusters")
+	case 'N':
+		return 0, errRegexpUnsupported("named characters")
+	case 'G':
+		return 0, errRegexpUnsupported(`\G`)
+	case 'Z':
+		return 0, errRegexpUnsupported(`\Z`)
+	case 'Q':
+		end := strings.Index(string(p[i:]), `\E`)
+		if end < 0 {
+			b.WriteString(regexp.QuoteMeta(string(p[i+1:])))
+			return len(p) - 1, nil
+		}
+		end = i + utf8.RuneCountInString(string(p[i:])[:end])
+		b.WriteString(regexp.QuoteMeta(string(p[i+1 : end])))
+		return end + 1, nil
+	case 'u':
+		return codePoint(4)
+	case 'U':
+		return codePoint(8)
+	case 'e':
+		b.WriteString(`\x{1b}`)
+	case 'c':
+		if i+1 == len(p) {
+			return 0, errBadEscape()
+		}
+		fmt.Fprintf(b, `\x{%x}`, p[i+1]&0x1F)
+		return i + 1, nil
+	case '0':
+		end, r := i, 0
+		for end+1 < len(p) && end < i+3 && p[end+1] >= '0' && p[end+1] <= '7' {
+			end++
+			r = r*8 + int(p[end]-'0')
+		}
+		fmt.Fprintf(b, `\x{%x}`, r)
+		return end, nil
+	case 'h', 'v':
+		chars := `\t\p{Zs}`
+		if c == 'v' {
+			chars = lineTerminators
+		}
+		if inClass {
+			b.WriteString(chars)
+		} else {
+			b.WriteString("[" + chars + "]")
+		}
+	case 'H', 'V', 'R':
+		if inClass {
+			return 0, errBadEscape()
+		}
+		switch c {
+		case 'H':
+			b.WriteString(`[^\t\p{Zs}]`)
+		case 'V':
+			b.WriteString(`[^` + lineTerminators + `]`)
+		default:
+			b.WriteString(`(?:\r\n|[` + lineTerminators + `])`)
+		}
+	default:
+		b.WriteRune('\\')
+		b.WriteRune(c)
+	}
+	return i, nil
+}
+
+// regexpSyntaxError returns the error MySQL raises for a pattern RE2 fails to
+// compile.
+func regexpSyntaxError(pattern string, err error) error {
+	var serr *syntax.Error
+	if !errors.As(err, &serr) {
+		return vterrors.NewError(vtrpcpb.Code_INTERNAL, vterrors.RegexpInternal, "Internal error in the regular expression library.")
+	}
+	switch serr.Code {
+	case syntax.ErrMissingParen, syntax.ErrUnexpectedParen:
+		return errMismatchedParen()
+	case syntax.ErrMissingBracket:
+		return vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpMissingCloseBracket, "The regular expression contains an unclosed bracket expression.")
+	case syntax.ErrInvalidCharRange:
+		return vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpInvalidRange, "The regular expression contains an [x-y] character range where x comes after y.")
+	case syntax.ErrInvalidEscape:
+		return errBadEscape()
+	case syntax.ErrInvalidNamedCapture:
+		return errCaptureGroupName()
+	case syntax.ErrLarge, syntax.ErrNestingDepth:
+		return vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpPatternTooBig, "Pattern exceeds the limits on size or complexity.")
+	case syntax.ErrInvalidRepeatSize:
+		var lo, hi int
+		if n, _ := fmt.Sscanf(serr.Expr, "{%d,%d}", &lo, &hi); n == 2 && hi < lo {
+			return vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpMaxLtMin, "The maximum is less than the minumum in a {x,y} specifier.")
+		}
+		if lo > 1000 || hi > 1000 {
+			return errRegexpUnsupported("repetitions of more than 1000")
+		}
+		return vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpBadInterval, "Incorrect description of a {min,max} interval.")
+	}
+	position := 1
+	if i := strings.Index(pattern, serr.Expr); i >= 0 {
+		position = utf8.RuneCountInString(pattern[:i]) + 1
+	}
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpRuleSyntax, "Syntax error in regular expression on line 1, character %d.", position)
+}
+
+func errRegexpUnsupported(feature string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpUnimplemented, "The regular expression contains a feature that is not implemented in this library: %s.", feature)
+}
+
+func errIllegalArgument() error {
+	return vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpIllegalArgument, "Illegal argument to a regular expression.")
+}
+
+func errBadEscape() error {
+	return vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpBadEscapeSequence, "Unrecognized escape sequence in regular expression.")
+}
+
+func errMismatchedParen() error {
+	return vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpMismatchParen, "Mismatched parenthesis in regular expression.")
+}
+
+func errCaptureGroupName() error {
+	return vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.RegexpInvalidCaptureGroup, "A capture group has an invalid name.")
+}
+
+func errCharsetMismatch(charset, collation, fn string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.CharacterSetMismatch, "Character set '%s' cannot be used in conjunction with '%s' in call to %s.", charset, collation, fn)
+}
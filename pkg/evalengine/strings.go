@@ -0,0 +1,447 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+// maxStringLength bounds the strings REPEAT, LPAD, RPAD and SPACE make, which
+// are NULL beyond it like they are beyond max_allowed_packet in MySQL.
+const maxStringLength = 64 << 20
+
+// textResult is the type of the result of functions that return a string,
+// which is binary when any of their arguments is.
+func textResult(args []querypb.Type) (querypb.Type, bool) {
+	for _, typ := range args {
+		if sqltypes.IsBinary(typ) || typ == sqltypes.HexNum || typ == sqltypes.HexVal || typ == sqltypes.BitNum {
+			return sqltypes.VarBinary, true
+		}
+	}
+	return sqltypes.VarChar, true
+}
+
+// firstTextResult is textResult for functions whose result is binary when
+// their first argument is.
+func firstTextResult(args []querypb.Type) (querypb.Type, bool) {
+	return textResult(args[:1])
+}
+
+func anyBinary(args []eval) bool {
+	for _, arg := range args {
+		if b, ok := arg.(evalBytes); ok && b.isBinary() {
+			return true
+		}
+	}
+	return false
+}
+
+func newEvalString(b []byte, binary bool) eval {
+	if binary {
+		return newEvalBinary(b)
+	}
+	return newEvalText(b)
+}
+
+// characters returns the characters of a string, which are its bytes when it
+// is binary.
+func characters(e eval) ([]rune, bool) {
+	b := toText(e)
+	if b.isBinary() {
+		return bytesToRunes(b.bytes), true
+	}
+	return []rune(b.string()), false
+}
+
+func fromCharacters(chars []rune, binary bool) eval {
+	if binary {
+		b := make([]byte, len(chars))
+		for i, c := range chars {
+			b[i] = byte(c)
+		}
+		return newEvalBinary(b)
+	}
+	return newEvalText([]byte(string(chars)))
+}
+
+func stringFunc(minArgs, maxArgs int, call func(env *ExpressionEnv, args []eval) (eval, error)) *builtin {
+	return &builtin{minArgs: minArgs, maxArgs: maxArgs, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		return call(env, args)
+	}, result: textResult}
+}
+
+func lengthFunc(length func(e evalBytes) int) *builtin {
+	return &builtin{minArgs: 1, maxArgs: 1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		return evalInt64{i: int64(length(toText(args[0])))}, nil
+	}, resultType: sqltypes.Int64}
+}
+
+func charLength(e evalBytes) int {
+	if e.isBinary() {
+		return len(e.bytes)
+	}
+	return utf8.RuneCount(e.bytes)
+}
+
+func caseFunc(fn func(string) string) *builtin {
+	return &builtin{minArgs: 1, maxArgs: 1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		b := toText(args[0])
+		if b.isBinary() {
+			return b, nil
+		}
+		return newEvalText([]byte(fn(b.string()))), nil
+	}, result: firstTextResult}
+}
+
+// pad returns LPAD or RPAD, which are NULL when the string needs padding and
+// the padding is empty.
+func pad(left bool) *builtin {
+	return &builtin{minArgs: 3, maxArgs: 3, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		binary := anyBinary(args)
+		s, _ := characters(args[0])
+		length := env.toInt64(args[1])
+		padding, _ := characters(args[2])
+		switch {
+		case length < 0 || length > maxStringLength:
+			return nil, nil
+		case int64(len(s)) >= length:
+			return fromCharacters(s[:length], binary), nil
+		case len(padding) == 0:
+			return nil, nil
+		}
+		fill := make([]rune, 0, length-int64(len(s)))
+		for int64(len(fill)) < length-int64(len(s)) {
+			fill = append(fill, padding[len(fill)%len(padding)])
+		}
+		if left {
+			return fromCharacters(append(fill, s...), binary), nil
+		}
+		return fromCharacters(append(s, fill...), binary), nil
+	}, result: textResult}
+}
+
+// locate returns the position of the first occurrence of a substring in a
+// string at or after a position, counting from 1, and 0 when there is none.
+// Characters are compared in the collation the strings are compared in by
+// the function op.
+func locate(sub, s eval, pos int64, op string) (eval, error) {
+	subText, text := toText(sub), toText(s)
+	col, err := mergeCollations(subText, text, op)
+	if err != nil {
+		return nil, err
+	}
+	var subChars, chars []rune
+	if col.IsBinary() {
+		subChars, chars = bytesToRunes(subText.bytes), bytesToRunes(text.bytes)
+	} else {
+		subChars, chars = []rune(subText.string()), []rune(text.string())
+	}
+	if pos < 1 || pos > int64(len(chars))+1 {
+		return evalInt64{}, nil
+	}
+	for i := int(pos - 1); i+len(subChars) <= len(chars); i++ {
+		if slices.EqualFunc(chars[i:i+len(subChars)], subChars, col.EqualRune) {
+			return evalInt64{i: int64(i + 1)}, nil
+		}
+	}
+	return evalInt64{}, nil
+}
+
+// substr returns the characters of a string from a position, counting from 1
+// or from the end when negative, and up to a length when there is one.
+func substr(env *ExpressionEnv, args []eval) eval {
+	chars, binary := characters(args[0])
+	pos := env.toInt64(args[1])
+	length := int64(len(chars))
+	if len(args) == 3 {
+		length = env.toInt64(args[2])
+	}
+	switch {
+	case pos < 0:
+		pos += int64(len(chars))
+	case pos > 0:
+		pos--
+	default:
+		return fromCharacters(nil, binary)
+	}
+	if pos < 0 || pos >= int64(len(chars)) || length <= 0 {
+		return fromCharacters(nil, binary)
+	}
+	end := min(pos+length, int64(len(chars)))
+	return fromCharacters(chars[pos:end], binary)
+}
+
+// trimFunc returns TRIM, LTRIM or RTRIM, which remove a string, spaces by
+// default, from either end of a string.
+func trimFunc(leading, trailing bool) *builtin {
+	return &builtin{minArgs: 1, maxArgs: 2, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		s := toText(args[0])
+		remove := []byte(" ")
+		if len(args) == 2 {
+			remove = toBytes(args[1])
+		}
+		b := s.bytes
+		if len(remove) > 0 {
+			for leading && bytes.HasPrefix(b, remove) {
+				b = b[len(remove):]
+			}
+			for trailing && bytes.HasSuffix(b, remove) {
+				b = b[:len(b)-len(remove)]
+			}
+		}
+		return newEvalString(b, anyBinary(args)), nil
+	}, result: textResult}
+}
+
+var (
+	trimBoth     = trimFunc(true, true)
+	trimLeading  = trimFunc(true, false)
+	trimTrailing = trimFunc(false, true)
+)
+
+// trimBuiltin returns the function a TRIM, LTRIM or RTRIM is.
+func trimBuiltin(expr *sqlparser.TrimFuncExpr) *builtin {
+	switch {
+	case expr.TrimFuncType == sqlparser.LTrimType, expr.Type == sqlparser.LeadingTrimType:
+		return trimLeading
+	case expr.TrimFuncType == sqlparser.RTrimType, expr.Type == sqlparser.TrailingTrimType:
+		return trimTrailing
+	}
+	return trimBoth
+}
+
+// weightString returns the function a WEIGHT_STRING is, which weighs a string
+// in its collation. AS CHAR(n) pads it with spaces to n characters or cuts it
+// to them, and AS BINARY(n) pads it with zero bytes to n bytes or cuts it to
+// them, first. Other values are weighed as their text in the binary collation,
+// which numbers have.
+func weightString(as *sqlparser.ConvertType) *builtin {
+	return &builtin{minArgs: 1, maxArgs: 1, resultType: sqltypes.VarBinary, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		b := toText(args[0])
+		col := b.collation()
+		if _, ok := args[0].(evalBytes); !ok {
+			col = collations.Get(collations.Binary)
+		}
+		switch {
+		case as == nil:
+			return newEvalBinary(col.WeightString(nil, b.bytes)), nil
+		case strings.EqualFold(as.Type, "binary"):
+			w := make([]byte, *as.Length)
+			copy(w, b.bytes)
+			return newEvalBinary(w), nil
+		}
+		return newEvalBinary(col.WeightStringPadded(nil, b.bytes, *as.Length)), nil
+	}}
+}
+
+var stringBuiltins = map[string]*builtin{
+	"collation": {minArgs: 1, maxArgs: 1, nullSafe: true, resultType: sqltypes.VarChar, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		if b, ok := args[0].(evalBytes); ok {
+			return newEvalText([]byte(b.collation().Name())), nil
+		}
+		return newEvalText([]byte("binary")), nil
+	}},
+	"concat": stringFunc(1, -1, func(_ *ExpressionEnv, args []eval) (eval, error) {
+		var b []byte
+		for _, arg := range args {
+			b = append(b, toBytes(arg)...)
+		}
+		return newEvalString(b, anyBinary(args)), nil
+	}),
+	"concat_ws": {minArgs: 2, maxArgs: -1, nullSafe: true, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		if args[0] == nil {
+			return nil, nil
+		}
+		sep := toBytes(args[0])
+		var parts [][]byte
+		for _, arg := range args[1:] {
+			if arg != nil {
+				parts = append(parts, toBytes(arg))
+			}
+		}
+		return newEvalString(bytes.Join(parts, sep), anyBinary(args)), nil
+	}, result: textResult},
+	"lower":            caseFunc(strings.ToLower),
+	"lcase":            caseFunc(strings.ToLower),
+	"upper":            caseFunc(strings.ToUpper),
+	"ucase":            caseFunc(strings.ToUpper),
+	"length":           lengthFunc(func(e evalBytes) int { return len(e.bytes) }),
+	"octet_length":     lengthFunc(func(e evalBytes) int { return len(e.bytes) }),
+	"bit_length":       lengthFunc(func(e evalBytes) int { return 8 * len(e.bytes) }),
+	"char_length":      lengthFunc(charLength),
+	"character_length": lengthFunc(charLength),
+	"repeat": {minArgs: 2, maxArgs: 2, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		s := toText(args[0])
+		count := env.toInt64(args[1])
+		switch {
+		case count < 1:
+			return newEvalString(nil, s.isBinary()), nil
+		case int64(len(s.bytes))*count > maxStringLength:
+			return nil, nil
+		}
+		return newEvalString(bytes.Repeat(s.bytes, int(count)), s.isBinary()), nil
+	}, result: firstTextResult},
+	"left": {minArgs: 2, maxArgs: 2, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		chars, binary := characters(args[0])
+		n := max(min(env.toInt64(args[1]), int64(len(chars))), 0)
+		return fromCharacters(chars[:n], binary), nil
+	}, result: firstTextResult},
+	"right": {minArgs: 2, maxArgs: 2, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		chars, binary := characters(args[0])
+		n := max(min(env.toInt64(args[1]), int64(len(chars))), 0)
+		return fromCharacters(chars[int64(len(chars))-n:], binary), nil
+	}, result: firstTextResult},
+	"lpad": pad(true),
+	"rpad": pad(false),
+	"reverse": {minArgs: 1, maxArgs: 1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		chars, binary := characters(args[0])
+		for i, j := 0, len(chars)-1; i < j; i, j = i+1, j-1 {
+			chars[i], chars[j] = chars[j], chars[i]
+		}
+		return fromCharacters(chars, binary), nil
+	}, result: firstTextResult},
+	"replace": stringFunc(3, 3, func(_ *ExpressionEnv, args []eval) (eval, error) {
+		return newEvalString(bytes.ReplaceAll(toBytes(args[0]), toBytes(args[1]), toBytes(args[2])), anyBinary(args)), nil
+	}),
+	"space": {minArgs: 1, maxArgs: 1, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		n := env.toInt64(args[0])
+		if n > maxStringLength {
+			return nil, nil
+		}
+		return newEvalText(bytes.Repeat([]byte(" "), int(max(n, 0)))), nil
+	}, resultType: sqltypes.VarChar},
+	"strcmp": {minArgs: 2, maxArgs: 2, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		a, b := toText(args[0]), toText(args[1])
+		col, err := mergeCollations(a, b, "strcmp")
+		if err != nil {
+			return nil, err
+		}
+		return evalInt64{i: int64(col.Compare(a.bytes, b.bytes))}, nil
+	}, resultType: sqltypes.Int64},
+	"ascii": {minArgs: 1, maxArgs: 1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		b := toBytes(args[0])
+		if len(b) == 0 {
+			return evalInt64{}, nil
+		}
+		return evalInt64{i: int64(b[0])}, nil
+	}, resultType: sqltypes.Int64},
+	"instr": {minArgs: 2, maxArgs: 2, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		return locate(args[1], args[0], 1, "instr")
+	}, resultType: sqltypes.Int64},
+	"locate": {minArgs: 2, maxArgs: 3, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		pos := int64(1)
+		if len(args) == 3 {
+			pos = env.toInt64(args[2])
+		}
+		return locate(args[0], args[1], pos, "locate")
+	}, resultType: sqltypes.Int64},
+	"substr": {minArgs: 2, maxArgs: 3, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		return substr(env, args), nil
+	}, result: firstTextResult},
+	"substring_index": {minArgs: 3, maxArgs: 3, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		s, delim := toText(args[0]), toBytes(args[1])
+		count := env.toInt64(args[2])
+		if len(delim) == 0 || count == 0 {
+			return newEvalString(nil, s.isBinary()), nil
+		}
+		parts := bytes.Split(s.bytes, delim)
+		if count > 0 {
+			if count < int64(len(parts)) {
+				parts = parts[:count]
+			}
+		} else if -count < int64(len(parts)) {
+			parts = parts[int64(len(parts))+count:]
+		}
+		return newEvalString(bytes.Join(parts, delim), s.isBinary()), nil
+	}, result: firstTextResult},
+	"insert": {minArgs: 4, maxArgs: 4, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		chars, binary := characters(args[0])
+		pos, length := env.toInt64(args[1]), env.toInt64(args[2])
+		replacement, _ := characters(args[3])
+		binary = binary || anyBinary(args[3:])
+		if pos < 1 || pos > int64(len(chars)) {
+			return fromCharacters(chars, binary), nil
+		}
+		end := int64(len(chars))
+		if length >= 0 && pos-1+length < end {
+			end = pos - 1 + length
+		}
+		out := append(append(append([]rune{}, chars[:pos-1]...), replacement...), chars[end:]...)
+		return fromCharacters(out, binary), nil
+	}, result: textResult},
+	"field": {minArgs: 2, maxArgs: -1, nullSafe: true, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		if args[0] == nil {
+			return evalInt64{}, nil
+		}
+		for i, arg := range args[1:] {
+			if arg == nil {
+				continue
+			}
+			if n, err := env.compareScalars(args[0], arg); err != nil || n == 0 {
+				return evalInt64{i: int64(i + 1)}, err
+			}
+		}
+		return evalInt64{}, nil
+	}, resultType: sqltypes.Int64},
+	"elt": {minArgs: 2, maxArgs: -1, nullSafe: true, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		if args[0] == nil {
+			return nil, nil
+		}
+		n := env.toInt64(args[0])
+		if n < 1 || n >= int64(len(args)) || args[n] == nil {
+			return nil, nil
+		}
+		return toText(args[n]), nil
+	}, result: func(args []querypb.Type) (querypb.Type, bool) {
+		return textResult(args[1:])
+	}},
+	"unhex": {minArgs: 1, maxArgs: 1, call: func(_ *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		s := toBytes(args[0])
+		if len(s)%2 == 1 {
+			s = append([]byte{'0'}, s...)
+		}
+		b, err := hex.DecodeString(string(s))
+		if err != nil {
+			return nil, nil
+		}
+		return newEvalBinary(b), nil
+	}, resultType: sqltypes.VarBinary},
+	"char": {minArgs: 1, maxArgs: -1, nullSafe: true, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		var b []byte
+		for _, arg := range args {
+			if arg == nil {
+				continue
+			}
+			u := env.toUint64(arg)
+			var chunk []byte
+			for ; u > 0; u >>= 8 {
+				chunk = append([]byte{byte(u)}, chunk...)
+			}
+			b = append(b, chunk...)
+		}
+		return newEvalBinary(b), nil
+	}, resultType: sqltypes.VarBinary},
+}
@@ -0,0 +1,609 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/datetime"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/decimal"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// The codes of the warnings raised by temporal functions.
+const (
+	WarnWrongValueForFunction = 1411
+	WarnDatetimeOverflow      = 1441
+)
+
+// maxUnixTimestamp is the largest timestamp MySQL handles, which is
+// 3001-01-18 23:59:59 UTC.
+const maxUnixTimestamp = 32536771199
+
+// maxDayNumber is the day number of 9999-12-31.
+const maxDayNumber = 3652424
+
+// nowExpr is NOW and the other functions that return the current time, which
+// is the Now of the environment, in its time zone or in UTC.
+type nowExpr struct {
+	typ  querypb.Type
+	prec uint8
+	utc  bool
+}
+
+func (n *nowExpr) now(env *ExpressionEnv) time.Time {
+	if n.utc {
+		return env.Now.UTC()
+	}
+	return env.Now.In(env.location())
+}
+
+func (n *nowExpr) eval(env *ExpressionEnv) (eval, error) {
+	dt := datetime.NewDateTimeFromStd(n.now(env)).Round(int(n.prec))
+	switch n.typ {
+	case sqltypes.Date:
+		return evalTemporal{t: sqltypes.Date, dt: datetime.DateTime{Date: dt.Date}}, nil
+	case sqltypes.Time:
+		return evalTemporal{t: sqltypes.Time, dt: datetime.DateTime{Time: dt.Time}, prec: n.prec}, nil
+	}
+	return evalTemporal{t: sqltypes.Datetime, dt: dt, prec: n.prec}, nil
+}
+
+func (n *nowExpr) typeof() (querypb.Type, bool) {
+	return n.typ, true
+}
+
+// nowFunctions are the functions that return the current time, by name, with
+// the type they return it as and whether they return it in UTC.
+var nowFunctions = map[string]nowExpr{
+	"now":               {typ: sqltypes.Datetime},
+	"current_timestamp": {typ: sqltypes.Datetime},
+	"localtime":         {typ: sqltypes.Datetime},
+	"localtimestamp":    {typ: sqltypes.Datetime},
+	"sysdate":           {typ: sqltypes.Datetime},
+	"utc_timestamp":     {typ: sqltypes.Datetime, utc: true},
+	"curtime":           {typ: sqltypes.Time},
+	"current_time":      {typ: sqltypes.Time},
+	"utc_time":          {typ: sqltypes.Time, utc: true},
+	"curdate":           {typ: sqltypes.Date},
+	"current_date":      {typ: sqltypes.Date},
+	"utc_date":          {typ: sqltypes.Date, utc: true},
+}
+
+// dateAddExpr is DATE_ADD, DATE_SUB and the other ways of adding an interval
+// to a date: ADDDATE, SUBDATE, TIMESTAMPADD and + or - INTERVAL.
+type dateAddExpr struct {
+	date, interval Expr
+	unit           datetime.IntervalType
+	sub            bool
+}
+
+// timestampDiffExpr is TIMESTAMPDIFF.
+type timestampDiffExpr struct {
+	from, to Expr
+	unit     datetime.IntervalType
+}
+
+// extractExpr is EXTRACT.
+type extractExpr struct {
+	expr Expr
+	unit datetime.IntervalType
+}
+
+func warnDatetimeOverflow(env *ExpressionEnv, typ querypb.Type) {
+	field := "datetime"
+	if typ == sqltypes.Time {
+		field = "time"
+	}
+	env.warn(WarnDatetimeOverflow, fmt.Sprintf("Datetime function: %s field overflow", field))
+}
+
+func warnIncorrectDatetime(env *ExpressionEnv, e eval) {
+	env.warn(WarnTruncatedWrongValue, fmt.Sprintf("Incorrect datetime value: '%s'", toBytes(e)))
+}
+
+// toTemporal returns a value as the temporal value it is, which is a DATE for
+// strings and numbers that hold only a date, and false with a warning when it
+// is not one.
+func (env *ExpressionEnv) toTemporal(e eval) (evalTemporal, bool) {
+	if j, ok := e.(evalJSON); ok {
+		e = j.scalar()
+	}
+	switch e := e.(type) {
+	case evalTemporal:
+		return e, true
+	case evalBytes:
+		if t, ok := parseTemporal(e.string()); ok {
+			return t, true
+		}
+	case evalInt64:
+		if e.i < 100000000 {
+			return env.toDate(e)
+		}
+		return env.toDateTime(e, -1)
+	case evalUint64:
+		if e.u < 100000000 {
+			return env.toDate(e)
+		}
+		return env.toDateTime(e, -1)
+	case evalFloat, evalDecimal:
+		return env.toDateTime(e, -1)
+	}
+	warnIncorrectDatetime(env, e)
+	return evalTemporal{}, false
+}
+
+// toInterval returns the interval a value stands for in a unit, which is nil
+// when it does not stand for one.
+func toInterval(e eval, unit datetime.IntervalType, negate bool) *datetime.Interval {
+	switch e := e.(type) {
+	case evalInt64:
+		return datetime.ParseIntervalInt64(e.i, unit, negate)
+	case evalUint64:
+		return datetime.ParseIntervalInt64(int64(e.u), unit, negate)
+	case evalFloat:
+		return datetime.ParseIntervalFloat(e.f, unit, negate)
+	case evalDecimal:
+		return datetime.ParseIntervalDecimal(e.dec, e.length, unit, negate)
+	}
+	return datetime.ParseInterval(string(toBytes(e)), unit, negate)
+}
+
+// addInterval adds an interval to a temporal value. A DATE stays one unless
+// the interval has time parts, and a TIME stays one unless it has date parts;
+// every other sum is a DATETIME.
+func (e evalTemporal) addInterval(env *ExpressionEnv, itv *datetime.Interval, text bool) (evalTemporal, bool) {
+	unit := itv.Unit()
+	switch {
+	case e.t == sqltypes.Date && !unit.HasTimeParts():
+		d, ok := e.dt.Date.AddInterval(itv)
+		return evalTemporal{t: sqltypes.Date, dt: datetime.DateTime{Date: d}}, ok && !d.IsZero()
+	case e.t == sqltypes.Time && !unit.HasDateParts():
+		t, prec, ok := e.dt.Time.AddInterval(itv, text)
+		return evalTemporal{t: sqltypes.Time, dt: datetime.DateTime{Time: t}, prec: max(e.prec, prec)}, ok
+	}
+	dt := e.toDateTime(env)
+	var ok bool
+	dt.dt, dt.prec, ok = dt.dt.AddInterval(itv, dt.prec, text)
+	return dt, ok && !dt.dt.Date.IsZero()
+}
+
+func (d *dateAddExpr) eval(env *ExpressionEnv) (eval, error) {
+	date, err := d.date.eval(env)
+	if err != nil || date == nil {
+		return nil, err
+	}
+	interval, err := d.interval.eval(env)
+	if err != nil || interval == nil {
+		return nil, err
+	}
+	itv := toInterval(interval, d.unit, d.sub)
+	if itv == nil {
+		return nil, nil
+	}
+	t, ok := env.toTemporal(date)
+	if !ok {
+		return nil, nil
+	}
+	// Sums of strings and numbers are strings, with as many fractional
+	// digits as the interval needs.
+	_, temporal := date.(evalTemporal)
+	result, ok := t.addInterval(env, itv, !temporal)
+	if !ok {
+		warnDatetimeOverflow(env, result.t)
+		return nil, nil
+	}
+	if !temporal {
+		return newEvalText(result.format()), nil
+	}
+	return result, nil
+}
+
+func (d *dateAddExpr) typeof() (querypb.Type, bool) {
+	typ, ok := d.date.typeof()
+	switch {
+	case !ok:
+		return 0, false
+	case typ == sqltypes.Null:
+		return sqltypes.Null, true
+	case typ == sqltypes.Date && !d.unit.HasTimeParts():
+		return sqltypes.Date, true
+	case typ == sqltypes.Time && !d.unit.HasDateParts():
+		return sqltypes.Time, true
+	case sqltypes.IsDateOrTime(typ):
+		return sqltypes.Datetime, true
+	}
+	return sqltypes.VarChar, true
+}
+
+// dayMicroseconds returns the number of microseconds since the start of the
+// day of a time.
+func dayMicroseconds(t datetime.Time) int64 {
+	return (int64(t.Hour())*3600+int64(t.Minute())*60+int64(t.Second()))*1e6 + int64(t.Nanosecond()/1000)
+}
+
+// monthsBetween returns the number of whole months from one DATETIME to
+// another, the way MySQL counts them.
+func monthsBetween(from, to datetime.DateTime) int64 {
+	sign := int64(1)
+	if from.Compare(to) > 0 {
+		from, to, sign = to, from, -1
+	}
+	yearBeg, monthBeg, dayBeg := from.Date.Year(), from.Date.Month(), from.Date.Day()
+	yearEnd, monthEnd, dayEnd := to.Date.Year(), to.Date.Month(), to.Date.Day()
+
+	years := yearEnd - yearBeg
+	earlier := monthEnd < monthBeg || (monthEnd == monthBeg && dayEnd < dayBeg)
+	if earlier {
+		years--
+	}
+	months := 12 * years
+	if earlier {
+		months += 12 - (monthBeg - monthEnd)
+	} else {
+		months += monthEnd - monthBeg
+	}
+	if dayEnd < dayBeg || (dayEnd == dayBeg && dayMicroseconds(to.Time) < dayMicroseconds(from.Time)) {
+		months--
+	}
+	return sign * int64(months)
+}
+
+// timestampDiff returns the number of whole units from one DATETIME to
+// another.
+func timestampDiff(from, to datetime.DateTime, unit datetime.IntervalType) int64 {
+	switch unit {
+	case datetime.IntervalMonth:
+		return monthsBetween(from, to)
+	case datetime.IntervalQuarter:
+		return monthsBetween(from, to) / 3
+	case datetime.IntervalYear:
+		return monthsBetween(from, to) / 12
+	}
+	days := datetime.MysqlDayNumber(to.Date.Year(), to.Date.Month(), to.Date.Day()) -
+		datetime.MysqlDayNumber(from.Date.Year(), from.Date.Month(), from.Date.Day())
+	usec := int64(days)*86400*1e6 + dayMicroseconds(to.Time) - dayMicroseconds(from.Time)
+	switch unit {
+	case datetime.IntervalSecond:
+		return usec / 1e6
+	case datetime.IntervalMinute:
+		return usec / (60 * 1e6)
+	case datetime.IntervalHour:
+		return usec / (3600 * 1e6)
+	case datetime.IntervalDay:
+		return usec / (86400 * 1e6)
+	case datetime.IntervalWeek:
+		return usec / (7 * 86400 * 1e6)
+	}
+	return usec
+}
+
+func (d *timestampDiffExpr) eval(env *ExpressionEnv) (eval, error) {
+	from, err := d.from.eval(env)
+	if err != nil || from == nil {
+		return nil, err
+	}
+	to, err := d.to.eval(env)
+	if err != nil || to == nil {
+		return nil, err
+	}
+	fromDateTime, ok := env.toDateTime(from, -1)
+	if !ok {
+		return nil, nil
+	}
+	toDateTime, ok := env.toDateTime(to, -1)
+	if !ok {
+		return nil, nil
+	}
+	return evalInt64{i: timestampDiff(fromDateTime.dt, toDateTime.dt, d.unit)}, nil
+}
+
+func (d *timestampDiffExpr) typeof() (querypb.Type, bool) {
+	return sqltypes.Int64, true
+}
+
+func (x *extractExpr) eval(env *ExpressionEnv) (eval, error) {
+	e, err := x.expr.eval(env)
+	if err != nil || e == nil {
+		return nil, err
+	}
+	var t evalTemporal
+	var ok bool
+	if x.unit.HasDateParts() {
+		t, ok = env.toDateTime(e, -1)
+	} else {
+		t, ok = env.toTime(e, -1)
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	date, tm := t.dt.Date, t.dt.Time
+	day := int64(date.Day())
+	hms := int64(tm.Hour())*10000 + int64(tm.Minute())*100 + int64(tm.Second())
+	usec := int64(tm.Nanosecond() / 1000)
+	var n int64
+	switch x.unit {
+	case datetime.IntervalYear:
+		n = int64(date.Year())
+	case datetime.IntervalQuarter:
+		n = int64(date.Quarter())
+	case datetime.IntervalMonth:
+		n = int64(date.Month())
+	case datetime.IntervalWeek:
+		n = int64(date.Week(datetime.DefaultWeekMode))
+	case datetime.IntervalDay:
+		n = day
+	case datetime.IntervalHour:
+		n = int64(tm.Hour())
+	case datetime.IntervalMinute:
+		n = int64(tm.Minute())
+	case datetime.IntervalSecond:
+		n = int64(tm.Second())
+	case datetime.IntervalMicrosecond:
+		n = usec
+	case datetime.IntervalYearMonth:
+		n = int64(date.Year())*100 + int64(date.Month())
+	case datetime.IntervalDayHour:
+		n = day*100 + int64(tm.Hour())
+	case datetime.IntervalDayMinute:
+		n = day*10000 + hms/100
+	case datetime.IntervalDaySecond:
+		n = day*1000000 + hms
+	case datetime.IntervalDayMicrosecond:
+		n = (day*1000000+hms)*1e6 + usec
+	case datetime.IntervalHourMinute:
+		n = hms / 100
+	case datetime.IntervalHourSecond:
+		n = hms
+	case datetime.IntervalHourMicrosecond:
+		n = hms*1e6 + usec
+	case datetime.IntervalMinuteSecond:
+		n = hms % 10000
+	case datetime.IntervalMinuteMicrosecond:
+		n = (hms%10000)*1e6 + usec
+	case datetime.IntervalSecondMicrosecond:
+		n = (hms%100)*1e6 + usec
+	}
+	if tm.Neg() && !x.unit.HasDateParts() {
+		n = -n
+	}
+	return evalInt64{i: n}, nil
+}
+
+func (x *extractExpr) typeof() (querypb.Type, bool) {
+	return sqltypes.Int64, true
+}
+
+// stdTime returns a DATETIME as a time in a location.
+func stdTime(dt datetime.DateTime, loc *time.Location) time.Time {
+	return time.Date(dt.Date.Year(), time.Month(dt.Date.Month()), dt.Date.Day(),
+		dt.Time.Hour(), dt.Time.Minute(), dt.Time.Second(), dt.Time.Nanosecond(), loc)
+}
+
+// validDate reports whether a date has a month and a day, which the functions
+// that compute with dates need.
+func validDate(d datetime.Date) bool {
+	return d.Month() != 0 && d.Day() != 0
+}
+
+// strToDateParts returns whether a pattern of STR_TO_DATE has date and time
+// specifiers, which tell the type of its result.
+func strToDateParts(format string) (date, time bool) {
+	for i := 0; i < len(format)-1; i++ {
+		if format[i] != '%' {
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'a', 'b', 'c', 'D', 'd', 'e', 'j', 'M', 'm', 'U', 'u', 'V', 'v', 'W', 'w', 'X', 'x', 'Y', 'y':
+			date = true
+		case 'f', 'H', 'h', 'I', 'i', 'k', 'l', 'p', 'r', 'S', 's', 'T':
+			time = true
+		}
+	}
+	return date, time
+}
+
+func weekMode(env *ExpressionEnv, args []eval) int {
+	if len(args) < 2 {
+		return datetime.DefaultWeekMode
+	}
+	return int(env.toInt64(args[1]) & 7)
+}
+
+var temporalBuiltins = map[string]*builtin{
+	"date_format": {minArgs: 2, maxArgs: 2, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		t, ok := env.toDateTime(args[0], -1)
+		if !ok {
+			return nil, nil
+		}
+		b, err := datetime.Format(toText(args[1]).string(), t.dt, t.prec)
+		if err != nil {
+			return nil, nil
+		}
+		return newEvalText(b), nil
+	}, resultType: sqltypes.VarChar},
+	"str_to_date": {minArgs: 2, maxArgs: 2, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		s, format := toText(args[0]).string(), toText(args[1]).string()
+		if f, err := datetime.New(format); err == nil {
+			if dt, prec, rest, ok := f.StrToDate(s, -1); ok {
+				// Like MySQL, text after the pattern is ignored with a
+				// warning.
+				if strings.TrimSpace(rest) != "" {
+					env.warn(WarnTruncatedWrongValue, fmt.Sprintf("Truncated incorrect datetime value: '%s'", s))
+				}
+				date, time := strToDateParts(format)
+				switch {
+				case !time:
+					return evalTemporal{t: sqltypes.Date, dt: datetime.DateTime{Date: dt.Date}}, nil
+				case !date:
+					return evalTemporal{t: sqltypes.Time, dt: datetime.DateTime{Time: dt.Time}, prec: uint8(prec)}, nil
+				}
+				return evalTemporal{t: sqltypes.Datetime, dt: dt, prec: uint8(prec)}, nil
+			}
+		}
+		env.warn(WarnWrongValueForFunction, fmt.Sprintf("Incorrect datetime value: '%s' for function str_to_date", s))
+		return nil, nil
+	}, result: func([]querypb.Type) (querypb.Type, bool) {
+		// The type depends on the pattern.
+		return sqltypes.Datetime, false
+	}},
+	"convert_tz": {minArgs: 3, maxArgs: 3, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		t, ok := env.toDateTime(args[0], -1)
+		if !ok {
+			return nil, nil
+		}
+		from, err := datetime.ParseTimeZone(toText(args[1]).string())
+		if err != nil {
+			return nil, nil
+		}
+		to, err := datetime.ParseTimeZone(toText(args[2]).string())
+		if err != nil {
+			return nil, nil
+		}
+		// Like MySQL, times a TIMESTAMP cannot hold are not converted.
+		std := stdTime(t.dt, from)
+		if std.Unix() < 0 || std.Unix() > maxUnixTimestamp {
+			return t, nil
+		}
+		return evalTemporal{t: sqltypes.Datetime, dt: datetime.NewDateTimeFromStd(std.In(to)), prec: t.prec}, nil
+	}, resultType: sqltypes.Datetime},
+	"unix_timestamp": {minArgs: 0, maxArgs: 1, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		if len(args) == 0 {
+			return evalInt64{i: env.Now.Unix()}, nil
+		}
+		t, ok := env.toDateTime(args[0], -1)
+		if !ok {
+			return nil, nil
+		}
+		std := stdTime(t.dt, env.location())
+		if std.Unix() < 0 || std.Unix() > maxUnixTimestamp {
+			return evalInt64{}, nil
+		}
+		if t.prec == 0 {
+			return evalInt64{i: std.Unix()}, nil
+		}
+		dec := decimal.NewFromInt(std.Unix()).Add(decimal.New(int64(std.Nanosecond()), -9))
+		return newEvalDecimal(dec, int32(t.prec)), nil
+	}, result: func(args []querypb.Type) (querypb.Type, bool) {
+		// Fractional seconds make it a decimal.
+		if len(args) == 0 || args[0] == sqltypes.Date || sqltypes.IsIntegral(args[0]) {
+			return sqltypes.Int64, true
+		}
+		return sqltypes.Decimal, false
+	}},
+	"from_unixtime": {minArgs: 1, maxArgs: 2, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		var sec, nsec int64
+		prec := 0
+		switch n := env.toNumber(args[0]).(type) {
+		case evalInt64:
+			sec = n.i
+		case evalUint64:
+			if n.u > maxUnixTimestamp {
+				return nil, nil
+			}
+			sec = int64(n.u)
+		case evalFloat:
+			if n.f < 0 || n.f > maxUnixTimestamp {
+				return nil, nil
+			}
+			integral, frac := math.Modf(n.f)
+			sec, nsec, prec = int64(integral), int64(math.Round(frac*1e6))*1000, maxTimePrecision
+		case evalDecimal:
+			if n.dec.Sign() < 0 {
+				return nil, nil
+			}
+			integral, frac := n.dec.QuoRem(decimal.NewFromInt(1), 0)
+			var ok bool
+			if sec, ok = integral.Int64(); !ok {
+				return nil, nil
+			}
+			nsec, _ = frac.Mul(decimal.NewFromInt(1e9)).Int64()
+			prec = min(int(n.length), maxTimePrecision)
+		}
+		if sec < 0 || sec > maxUnixTimestamp {
+			return nil, nil
+		}
+		dt := datetime.NewDateTimeFromStd(time.Unix(sec, nsec).In(env.location())).Round(prec)
+		if len(args) == 1 {
+			return evalTemporal{t: sqltypes.Datetime, dt: dt, prec: uint8(prec)}, nil
+		}
+		b, err := datetime.Format(toText(args[1]).string(), dt, uint8(prec))
+		if err != nil {
+			return nil, nil
+		}
+		return newEvalText(b), nil
+	}, result: func(args []querypb.Type) (querypb.Type, bool) {
+		if len(args) == 2 {
+			return sqltypes.VarChar, true
+		}
+		return sqltypes.Datetime, true
+	}},
+	"week": {minArgs: 1, maxArgs: 2, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		d, ok := env.toDate(args[0])
+		if !ok || !validDate(d.dt.Date) {
+			return nil, nil
+		}
+		return evalInt64{i: int64(d.dt.Date.Week(weekMode(env, args)))}, nil
+	}, resultType: sqltypes.Int64},
+	"yearweek": {minArgs: 1, maxArgs: 2, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		d, ok := env.toDate(args[0])
+		if !ok || !validDate(d.dt.Date) {
+			return nil, nil
+		}
+		return evalInt64{i: int64(d.dt.Date.YearWeek(weekMode(env, args)))}, nil
+	}, resultType: sqltypes.Int64},
+	"last_day": {minArgs: 1, maxArgs: 1, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		d, ok := env.toDate(args[0])
+		if !ok || d.dt.Date.Month() == 0 {
+			return nil, nil
+		}
+		last := time.Date(d.dt.Date.Year(), time.Month(d.dt.Date.Month()+1), 0, 0, 0, 0, 0, time.UTC)
+		return evalTemporal{t: sqltypes.Date, dt: datetime.DateTime{Date: datetime.NewDateFromStd(last)}}, nil
+	}, resultType: sqltypes.Date},
+	"makedate": {minArgs: 2, maxArgs: 2, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		year, yday := env.toInt64(args[0]), env.toInt64(args[1])
+		if yday <= 0 || yday > maxDayNumber || year < 0 || year > 9999 {
+			return nil, nil
+		}
+		switch {
+		case year < 70:
+			year += 2000
+		case year < 100:
+			year += 1900
+		}
+		daynr := datetime.MysqlDayNumber(int(year), 1, 1) + int(yday) - 1
+		if daynr > maxDayNumber {
+			return nil, nil
+		}
+		return evalTemporal{t: sqltypes.Date, dt: datetime.DateTime{Date: datetime.DateFromDayNumber(daynr)}}, nil
+	}, resultType: sqltypes.Date},
+	"period_add": {minArgs: 2, maxArgs: 2, call: func(env *ExpressionEnv, _ *builtinExpr, args []eval) (eval, error) {
+		period, months := env.toInt64(args[0]), env.toInt64(args[1])
+		if !datetime.ValidatePeriod(period) {
+			return nil, vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongArguments, "Incorrect arguments to period_add")
+		}
+		return evalInt64{i: datetime.MonthsToPeriod(datetime.PeriodToMonths(period) + months)}, nil
+	}, resultType: sqltypes.Int64},
+}
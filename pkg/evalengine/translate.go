@@ -0,0 +1,725 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/datetime"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/decimal"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// maxTimePrecision is the largest number of fractional digits of seconds.
+const maxTimePrecision = 6
+
+type (
+	literalExpr struct {
+		value eval
+		// boolean is set for TRUE and FALSE.
+		boolean bool
+	}
+
+	// columnExpr is a column, whose strings have the collation col, the
+	// default when it is Unknown.
+	columnExpr struct {
+		offset int
+		typ    querypb.Type
+		typed  bool
+		col    collations.ID
+	}
+
+	bindVarExpr struct {
+		name string
+		// list is set for list arguments, which are tuples.
+		list bool
+	}
+
+	tupleExpr struct {
+		exprs []Expr
+	}
+)
+
+func (l *literalExpr) eval(*ExpressionEnv) (eval, error) {
+	return l.value, nil
+}
+
+func (l *literalExpr) typeof() (querypb.Type, bool) {
+	if l.value == nil {
+		return sqltypes.Null, true
+	}
+	if b, ok := l.value.(evalBytes); ok && b.literal {
+		return sqltypes.HexNum, true
+	}
+	return l.value.SQLType(), true
+}
+
+func (c *columnExpr) eval(env *ExpressionEnv) (eval, error) {
+	if c.offset >= len(env.Row) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "column %d is out of the range of a row of %d columns", c.offset, len(env.Row))
+	}
+	e, err := valueToEval(env.Row[c.offset])
+	if b, ok := e.(evalBytes); ok && !b.isBinary() && !b.literal {
+		return b.withCollation(c.col, implicit), err
+	}
+	return e, err
+}
+
+func (c *columnExpr) typeof() (querypb.Type, bool) {
+	return c.typ, c.typed
+}
+
+func (b *bindVarExpr) eval(env *ExpressionEnv) (eval, error) {
+	bv, ok := env.BindVars[b.name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "missing bind variable :%s", b.name)
+	}
+	if bv.Type == sqltypes.Tuple {
+		if !b.list {
+			return nil, errOperandColumns(1)
+		}
+		values := make([]eval, 0, len(bv.Values))
+		for _, value := range bv.Values {
+			e, err := valueToEval(sqltypes.ProtoToValue(value))
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, e)
+		}
+		return evalTuple{t: values}, nil
+	}
+	if b.list {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "bind variable ::%s is not a list", b.name)
+	}
+	value, err := sqltypes.BindVariableToValue(bv)
+	if err != nil {
+		return nil, err
+	}
+	return valueToEval(value)
+}
+
+func (b *bindVarExpr) typeof() (querypb.Type, bool) {
+	return 0, false
+}
+
+func (t *tupleExpr) eval(env *ExpressionEnv) (eval, error) {
+	values := make([]eval, 0, len(t.exprs))
+	for _, expr := range t.exprs {
+		e, err := expr.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, e)
+	}
+	return evalTuple{t: values}, nil
+}
+
+func (t *tupleExpr) typeof() (querypb.Type, bool) {
+	return sqltypes.Tuple, true
+}
+
+// Translate translates an expression for evaluation, binding its columns
+// with the Config, which may be nil for expressions without columns.
+//
+// It fails for expressions that cannot be evaluated on their own, like
+// subqueries and aggregations, and for functions it does not know.
+func Translate(expr sqlparser.Expr, cfg *Config) (Expr, error) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	t := &translator{cfg: cfg}
+	return t.expr(expr)
+}
+
+type translator struct {
+	cfg *Config
+}
+
+func errUnsupported(expr sqlparser.Expr) error {
+	return vterrors.VT12001("evaluating '" + sqlparser.String(expr) + "'")
+}
+
+func (t *translator) exprs(exprs []sqlparser.Expr) ([]Expr, error) {
+	translated := make([]Expr, 0, len(exprs))
+	for _, expr := range exprs {
+		e, err := t.expr(expr)
+		if err != nil {
+			return nil, err
+		}
+		translated = append(translated, e)
+	}
+	return translated, nil
+}
+
+func (t *translator) expr(expr sqlparser.Expr) (Expr, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.Literal:
+		return translateLiteral(expr)
+	case *sqlparser.NullVal:
+		return &literalExpr{}, nil
+	case sqlparser.BoolVal:
+		return &literalExpr{value: newEvalBool(bool(expr)), boolean: true}, nil
+	case *sqlparser.Argument:
+		return &bindVarExpr{name: expr.Name}, nil
+	case sqlparser.ListArg:
+		return &bindVarExpr{name: string(expr), list: true}, nil
+	case *sqlparser.ColName:
+		if t.cfg.ResolveColumn == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "cannot evaluate column '%s' without a row", sqlparser.String(expr))
+		}
+		offset, err := t.cfg.ResolveColumn(expr)
+		if err != nil {
+			return nil, err
+		}
+		return t.column(offset), nil
+	case *sqlparser.Offset:
+		return t.column(expr.V), nil
+	case sqlparser.ValTuple:
+		exprs, err := t.exprs(expr)
+		if err != nil {
+			return nil, err
+		}
+		return &tupleExpr{exprs: exprs}, nil
+	case *sqlparser.BinaryExpr:
+		return t.binary(expr)
+	case *sqlparser.UnaryExpr:
+		return t.unary(expr)
+	case *sqlparser.ComparisonExpr:
+		return t.comparison(expr)
+	case *sqlparser.BetweenExpr:
+		left, from, to, err := t.three(expr.Left, expr.From, expr.To)
+		if err != nil {
+			return nil, err
+		}
+		return &betweenExpr{left: left, from: from, to: to, not: !expr.IsBetween}, nil
+	case *sqlparser.IsExpr:
+		left, err := t.expr(expr.Left)
+		if err != nil {
+			return nil, err
+		}
+		return &isExpr{expr: left, op: expr.Right}, nil
+	case *sqlparser.AndExpr:
+		left, right, err := t.two(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &andExpr{left: left, right: right}, nil
+	case *sqlparser.OrExpr:
+		left, right, err := t.two(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &orExpr{left: left, right: right}, nil
+	case *sqlparser.XorExpr:
+		left, right, err := t.two(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &xorExpr{left: left, right: right}, nil
+	case *sqlparser.NotExpr:
+		inner, err := t.expr(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &notExpr{expr: inner}, nil
+	case *sqlparser.CaseExpr:
+		return t.caseExpr(expr)
+	case *sqlparser.CastExpr:
+		if expr.Array {
+			return nil, errUnsupported(expr)
+		}
+		return t.cast(expr.Expr, expr.Type)
+	case *sqlparser.ConvertExpr:
+		return t.cast(expr.Expr, expr.Type)
+	case *sqlparser.ConvertUsingExpr:
+		inner, err := t.expr(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &textExpr{expr: inner, binary: strings.EqualFold(expr.Type, "binary"), col: charsetCollation(expr.Type)}, nil
+	case *sqlparser.CollateExpr:
+		inner, err := t.expr(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		col, err := lookupCollation(expr.Collation)
+		if err != nil {
+			return nil, err
+		}
+		return &textExpr{expr: inner, binary: col.IsBinary(), col: col.ID(), explicit: true}, nil
+	case *sqlparser.IntroducerExpr:
+		inner, err := t.expr(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &textExpr{expr: inner, binary: strings.EqualFold(expr.CharacterSet, "_binary"), col: charsetCollation(expr.CharacterSet)}, nil
+	case *sqlparser.FuncExpr:
+		return t.funcExpr(expr)
+	case *sqlparser.RegexpLikeExpr:
+		return t.regexp(regexpLike, expr.Expr, expr.Pattern, nil, nil, nil, nil, expr.MatchType)
+	case *sqlparser.RegexpInstrExpr:
+		return t.regexp(regexpInstr, expr.Expr, expr.Pattern, nil, expr.Position, expr.Occurrence, expr.ReturnOption, expr.MatchType)
+	case *sqlparser.RegexpSubstrExpr:
+		return t.regexp(regexpSubstr, expr.Expr, expr.Pattern, nil, expr.Position, expr.Occurrence, nil, expr.MatchType)
+	case *sqlparser.RegexpReplaceExpr:
+		return t.regexp(regexpReplace, expr.Expr, expr.Pattern, expr.Repl, expr.Position, expr.Occurrence, nil, expr.MatchType)
+	case *sqlparser.CurTimeFuncExpr:
+		if expr.Fsp > maxTimePrecision {
+			return nil, errTooBigPrecision(expr.Fsp, expr.Name.Lowered())
+		}
+		now, ok := nowFunctions[expr.Name.Lowered()]
+		if !ok {
+			return nil, errUnsupported(expr)
+		}
+		now.prec = uint8(expr.Fsp)
+		return &now, nil
+	case *sqlparser.IntervalDateExpr:
+		date, interval, err := t.two(expr.Date, expr.Interval)
+		if err != nil {
+			return nil, err
+		}
+		return &dateAddExpr{date: date, interval: interval, unit: expr.NormalizedUnit(), sub: expr.IsSubtraction()}, nil
+	case *sqlparser.TimestampDiffExpr:
+		switch expr.Unit {
+		case sqlparser.IntervalMicrosecond, sqlparser.IntervalSecond, sqlparser.IntervalMinute, sqlparser.IntervalHour,
+			sqlparser.IntervalDay, sqlparser.IntervalWeek, sqlparser.IntervalMonth, sqlparser.IntervalQuarter, sqlparser.IntervalYear:
+		default:
+			return nil, errUnsupported(expr)
+		}
+		from, to, err := t.two(expr.Expr1, expr.Expr2)
+		if err != nil {
+			return nil, err
+		}
+		return &timestampDiffExpr{from: from, to: to, unit: expr.Unit}, nil
+	case *sqlparser.ExtractFuncExpr:
+		inner, err := t.expr(expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &extractExpr{expr: inner, unit: expr.IntervalType}, nil
+	case *sqlparser.SubstrExpr:
+		return t.call(expr, builtins["substr"], "substr", expr.Name, expr.From, expr.To)
+	case *sqlparser.TrimFuncExpr:
+		return t.call(expr, trimBuiltin(expr), "trim", expr.StringArg, expr.TrimArg)
+	case *sqlparser.WeightStringFuncExpr:
+		return t.call(expr, weightString(expr.As), "weight_string", expr.Expr)
+	case *sqlparser.LocateExpr:
+		return t.call(expr, builtins["locate"], "locate", expr.SubStr, expr.Str, expr.Pos)
+	case *sqlparser.InsertExpr:
+		return t.call(expr, builtins["insert"], "insert", expr.Str, expr.Pos, expr.Len, expr.NewStr)
+	case *sqlparser.CharExpr:
+		call, err := t.call(expr, builtins["char"], "char", expr.Exprs...)
+		if err != nil || expr.Charset == "" || strings.EqualFold(expr.Charset, "binary") {
+			return call, err
+		}
+		return &textExpr{expr: call}, nil
+	case *sqlparser.JSONExtractExpr, *sqlparser.JSONUnquoteExpr, *sqlparser.JSONQuoteExpr, *sqlparser.JSONArrayExpr,
+		*sqlparser.JSONObjectExpr, *sqlparser.JSONContainsExpr, *sqlparser.JSONContainsPathExpr, *sqlparser.JSONKeysExpr,
+		*sqlparser.JSONOverlapsExpr, *sqlparser.JSONSearchExpr, *sqlparser.MemberOfExpr, *sqlparser.JSONAttributesExpr,
+		*sqlparser.JSONPrettyExpr, *sqlparser.JSONValueModifierExpr, *sqlparser.JSONValueMergeExpr, *sqlparser.JSONRemoveExpr,
+		*sqlparser.JSONValueExpr:
+		return t.jsonFunc(expr)
+	case *sqlparser.PointExpr, *sqlparser.LineStringExpr, *sqlparser.PolygonExpr, *sqlparser.MultiPointExpr,
+		*sqlparser.MultiLinestringExpr, *sqlparser.MultiPolygonExpr, *sqlparser.GeomFromTextExpr, *sqlparser.GeomFromWKBExpr,
+		*sqlparser.GeomFormatExpr, *sqlparser.GeomPropertyFuncExpr, *sqlparser.PointPropertyFuncExpr,
+		*sqlparser.LinestrPropertyFuncExpr, *sqlparser.PolygonPropertyFuncExpr, *sqlparser.GeomCollPropertyFuncExpr,
+		*sqlparser.GeoHashFromLatLongExpr, *sqlparser.GeoHashFromPointExpr, *sqlparser.GeomFromGeoHashExpr,
+		*sqlparser.GeoJSONFromGeomExpr, *sqlparser.GeomFromGeoJSONExpr:
+		return t.geometryFunc(expr)
+	}
+	return nil, errUnsupported(expr)
+}
+
+func (t *translator) two(a, b sqlparser.Expr) (Expr, Expr, error) {
+	left, err := t.expr(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err := t.expr(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+func (t *translator) three(a, b, c sqlparser.Expr) (Expr, Expr, Expr, error) {
+	first, second, err := t.two(a, b)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	third, err := t.expr(c)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return first, second, third, nil
+}
+
+func (t *translator) column(offset int) Expr {
+	column := &columnExpr{offset: offset}
+	if t.cfg.ResolveType != nil {
+		column.typ, column.typed = t.cfg.ResolveType(offset)
+	}
+	if t.cfg.ResolveCollation != nil {
+		column.col, _ = t.cfg.ResolveCollation(offset)
+	}
+	return column
+}
+
+func translateLiteral(lit *sqlparser.Literal) (Expr, error) {
+	wrongValue := func(typ string) error {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValue, "Incorrect %s value: '%s'", typ, lit.Val)
+	}
+	var value eval
+	switch lit.Type {
+	case sqlparser.StrVal:
+		value = newEvalText([]byte(lit.Val))
+	case sqlparser.IntVal:
+		if i, err := strconv.ParseInt(lit.Val, 10, 64); err == nil {
+			value = evalInt64{i: i}
+		} else if u, err := strconv.ParseUint(lit.Val, 10, 64); err == nil {
+			value = evalUint64{u: u}
+		} else {
+			dec, err := decimal.NewFromMySQL([]byte(lit.Val))
+			if err != nil {
+				return nil, wrongValue("DECIMAL")
+			}
+			value = evalDecimal{dec: dec}
+		}
+	case sqlparser.FloatVal:
+		f, err := strconv.ParseFloat(lit.Val, 64)
+		if err != nil {
+			return nil, wrongValue("DOUBLE")
+		}
+		value = evalFloat{f: f}
+	case sqlparser.DecimalVal:
+		dec, err := decimal.NewFromMySQL([]byte(lit.Val))
+		if err != nil {
+			return nil, wrongValue("DECIMAL")
+		}
+		_, length := decimal.SizeAndScaleFromString(lit.Val)
+		value = evalDecimal{dec: dec, length: length}
+	case sqlparser.HexNum:
+		digits := strings.TrimPrefix(strings.TrimPrefix(lit.Val, "0x"), "0X")
+		if len(digits)%2 == 1 {
+			digits = "0" + digits
+		}
+		b, err := hex.DecodeString(digits)
+		if err != nil {
+			return nil, wrongValue("hexadecimal")
+		}
+		value = evalBytes{tt: sqltypes.VarBinary, bytes: b, literal: true}
+	case sqlparser.HexVal:
+		b, err := lit.HexDecode()
+		if err != nil {
+			return nil, wrongValue("hexadecimal")
+		}
+		value = evalBytes{tt: sqltypes.VarBinary, bytes: b, literal: true}
+	case sqlparser.BitNum:
+		b, err := bitsToBytes(strings.TrimPrefix(strings.TrimPrefix(lit.Val, "0b"), "0B"))
+		if err != nil {
+			return nil, wrongValue("bit")
+		}
+		value = evalBytes{tt: sqltypes.VarBinary, bytes: b, literal: true}
+	case sqlparser.DateVal:
+		d, ok := datetime.ParseDate(lit.Val)
+		if !ok {
+			return nil, wrongValue("DATE")
+		}
+		value = evalTemporal{t: sqltypes.Date, dt: datetime.DateTime{Date: d}}
+	case sqlparser.TimeVal:
+		t, prec, state := datetime.ParseTime(lit.Val, -1)
+		if state != datetime.TimeOK {
+			return nil, wrongValue("TIME")
+		}
+		value = evalTemporal{t: sqltypes.Time, dt: datetime.DateTime{Time: t}, prec: uint8(prec)}
+	case sqlparser.TimestampVal:
+		dt, prec, ok := datetime.ParseDateTime(lit.Val, -1)
+		if !ok {
+			return nil, wrongValue("DATETIME")
+		}
+		value = evalTemporal{t: sqltypes.Datetime, dt: dt, prec: uint8(prec)}
+	default:
+		return nil, errUnsupported(lit)
+	}
+	return &literalExpr{value: value}, nil
+}
+
+// bitsToBytes returns the bytes a string of binary digits stands for, as a
+// big endian integer.
+func bitsToBytes(bits string) ([]byte, error) {
+	b := make([]byte, (len(bits)+7)/8)
+	for i := range bits {
+		bit := bits[len(bits)-1-i]
+		switch bit {
+		case '0':
+		case '1':
+			b[len(b)-1-i/8] |= 1 << (i % 8)
+		default:
+			return nil, strconv.ErrSyntax
+		}
+	}
+	return b, nil
+}
+
+func (t *translator) binary(expr *sqlparser.BinaryExpr) (Expr, error) {
+	left, right, err := t.two(expr.Left, expr.Right)
+	if err != nil {
+		return nil, err
+	}
+	switch expr.Operator {
+	case sqlparser.BitAndOp, sqlparser.BitOrOp, sqlparser.BitXorOp, sqlparser.ShiftLeftOp, sqlparser.ShiftRightOp:
+		return &bitwiseExpr{op: expr.Operator, left: left, right: right}, nil
+	}
+	return &arithmeticExpr{op: expr.Operator, left: left, right: right, sql: "(" + sqlparser.String(expr) + ")"}, nil
+}
+
+func (t *translator) unary(expr *sqlparser.UnaryExpr) (Expr, error) {
+	inner, err := t.expr(expr.Expr)
+	if err != nil {
+		return nil, err
+	}
+	switch expr.Operator {
+	case sqlparser.UPlusOp:
+		return inner, nil
+	case sqlparser.UMinusOp:
+		return &negateExpr{expr: inner, sql: "-(" + sqlparser.String(expr.Expr) + ")"}, nil
+	case sqlparser.TildaOp:
+		return &bitNotExpr{expr: inner}, nil
+	case sqlparser.BangOp:
+		return &notExpr{expr: inner}, nil
+	case sqlparser.NStringOp:
+		return &textExpr{expr: inner}, nil
+	}
+	return nil, errUnsupported(expr)
+}
+
+func (t *translator) comparison(expr *sqlparser.ComparisonExpr) (Expr, error) {
+	if expr.Modifier != sqlparser.Missing {
+		return nil, errUnsupported(expr)
+	}
+	if expr.Operator == sqlparser.RegexpOp || expr.Operator == sqlparser.NotRegexpOp {
+		re, err := t.regexp(regexpLike, expr.Left, expr.Right, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		re.not = expr.Operator == sqlparser.NotRegexpOp
+		return re, nil
+	}
+	left, err := t.expr(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	switch expr.Operator {
+	case sqlparser.InOp, sqlparser.NotInOp:
+		in := &inExpr{left: left, not: expr.Operator == sqlparser.NotInOp}
+		switch right := expr.Right.(type) {
+		case sqlparser.ValTuple:
+			if in.list, err = t.exprs(right); err != nil {
+				return nil, err
+			}
+		case sqlparser.ListArg:
+			in.tuple = &bindVarExpr{name: string(right), list: true}
+		default:
+			return nil, errUnsupported(expr)
+		}
+		return in, nil
+	}
+
+	right, err := t.expr(expr.Right)
+	if err != nil {
+		return nil, err
+	}
+	switch expr.Operator {
+	case sqlparser.LikeOp, sqlparser.NotLikeOp:
+		like := &likeExpr{left: left, pattern: right, not: expr.Operator == sqlparser.NotLikeOp}
+		if expr.Escape != nil {
+			if like.escape, err = t.expr(expr.Escape); err != nil {
+				return nil, err
+			}
+		}
+		return like, nil
+	}
+	return &comparisonExpr{op: expr.Operator, left: left, right: right}, nil
+}
+
+func (t *translator) caseExpr(expr *sqlparser.CaseExpr) (Expr, error) {
+	c := &caseExpr{}
+	var err error
+	if expr.Expr != nil {
+		if c.operand, err = t.expr(expr.Expr); err != nil {
+			return nil, err
+		}
+	}
+	branches := make([]Expr, 0, len(expr.Whens)+1)
+	for _, when := range expr.Whens {
+		cond, val, err := t.two(when.Cond, when.Val)
+		if err != nil {
+			return nil, err
+		}
+		c.whens = append(c.whens, caseWhen{cond: cond, val: val})
+		branches = append(branches, val)
+	}
+	if expr.Else != nil {
+		if c.els, err = t.expr(expr.Else); err != nil {
+			return nil, err
+		}
+	}
+	c.types = newTypedBranches(append(branches, c.els)...)
+	return c, nil
+}
+
+func (t *translator) funcExpr(expr *sqlparser.FuncExpr) (Expr, error) {
+	if expr.Qualifier.NotEmpty() {
+		return nil, errUnsupported(expr)
+	}
+	name := expr.Name.Lowered()
+	args, err := t.exprs(expr.Exprs)
+	if err != nil {
+		return nil, err
+	}
+	switch name {
+	case "if":
+		if len(args) != 3 {
+			return nil, errParameterCount(name)
+		}
+		return &ifExpr{cond: args[0], then: args[1], els: args[2], types: newTypedBranches(args[1], args[2])}, nil
+	case "ifnull", "coalesce":
+		if (name == "ifnull" && len(args) != 2) || len(args) == 0 {
+			return nil, errParameterCount(name)
+		}
+		return &coalesceExpr{args: args, types: newTypedBranches(args...)}, nil
+	case "mod":
+		if len(args) != 2 {
+			return nil, errParameterCount(name)
+		}
+		return &arithmeticExpr{op: sqlparser.ModOp, left: args[0], right: args[1], sql: sqlparser.String(expr)}, nil
+	case "mid", "substring":
+		name = "substr"
+	case "position":
+		name = "locate"
+	}
+	if now, ok := nowFunctions[name]; ok {
+		if len(args) != 0 {
+			return nil, errParameterCount(name)
+		}
+		return &now, nil
+	}
+	fn, ok := builtins[name]
+	if !ok {
+		return nil, errUnsupported(expr)
+	}
+	return newBuiltinExpr(expr, fn, name, args)
+}
+
+// call translates a function with its own node in the AST, whose arguments
+// are nil when they are left out.
+func (t *translator) call(expr sqlparser.Expr, fn *builtin, name string, args ...sqlparser.Expr) (Expr, error) {
+	var present []sqlparser.Expr
+	for _, arg := range args {
+		if arg != nil {
+			present = append(present, arg)
+		}
+	}
+	translated, err := t.exprs(present)
+	if err != nil {
+		return nil, err
+	}
+	return newBuiltinExpr(expr, fn, name, translated)
+}
+
+func newBuiltinExpr(expr sqlparser.Expr, fn *builtin, name string, args []Expr) (Expr, error) {
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, errParameterCount(name)
+	}
+	return &builtinExpr{fn: fn, args: args, sql: sqlparser.String(expr)}, nil
+}
+
+func errTooBigPrecision(prec int, name string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongArguments, "Too-big precision %d specified for '%s'. Maximum is %d.", prec, name, maxTimePrecision)
+}
+
+// cast translates CAST and CONVERT.
+func (t *translator) cast(expr sqlparser.Expr, typ *sqlparser.ConvertType) (Expr, error) {
+	inner, err := t.expr(expr)
+	if err != nil {
+		return nil, err
+	}
+	return newCastExpr(inner, typ, sqlparser.String(expr))
+}
+
+// newCastExpr returns a cast of an expression to a type. column is what
+// errors about the type call the value cast.
+func newCastExpr(inner Expr, typ *sqlparser.ConvertType, column string) (*castExpr, error) {
+	c := &castExpr{expr: inner, length: -1, name: strings.ToUpper(typ.Type)}
+	if typ.Length != nil {
+		c.length = *typ.Length
+	}
+	switch strings.ToLower(typ.Type) {
+	case "signed":
+		c.typ = sqltypes.Int64
+	case "unsigned":
+		c.typ = sqltypes.Uint64
+	case "double", "real", "float":
+		c.typ = sqltypes.Float64
+	case "decimal":
+		c.typ = sqltypes.Decimal
+		if c.length < 0 {
+			c.length = 10
+		}
+		if typ.Scale != nil {
+			c.scale = *typ.Scale
+		}
+		switch {
+		case c.length > maxDecimalPrecision:
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongArguments, "Too-big precision %d specified for '%s'. Maximum is %d.", c.length, column, maxDecimalPrecision)
+		case c.scale > maxDecimalScale:
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongArguments, "Too big scale %d specified for column '%s'. Maximum is %d.", c.scale, column, maxDecimalScale)
+		case c.scale > c.length:
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongArguments, "For float(M,D), double(M,D) or decimal(M,D), M must be >= D (column '%s').", column)
+		}
+	case "char", "nchar":
+		c.typ = sqltypes.VarChar
+		if strings.EqualFold(typ.Charset.Name, "binary") || typ.Charset.Binary {
+			c.typ = sqltypes.VarBinary
+		}
+	case "binary":
+		c.typ = sqltypes.VarBinary
+	case "date":
+		c.typ = sqltypes.Date
+	case "datetime", "time":
+		c.typ = sqltypes.Datetime
+		if strings.EqualFold(typ.Type, "time") {
+			c.typ = sqltypes.Time
+		}
+		if c.length > maxTimePrecision {
+			return nil, errTooBigPrecision(c.length, "CAST")
+		}
+		c.length = max(c.length, 0)
+	case "json":
+		c.typ = sqltypes.TypeJSON
+	default:
+		return nil, vterrors.VT12001("casting to " + c.name)
+	}
+	return c, nil
+}
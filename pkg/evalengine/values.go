@@ -0,0 +1,228 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"encoding/binary"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/decimal"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+// TypeOf returns the type an expression evaluates to, if it is known without
+// evaluating it.
+func TypeOf(expr Expr) (querypb.Type, bool) {
+	return expr.typeof()
+}
+
+// TypedValue is a value with the type and the collation it was evaluated
+// with, which is what ORDER BY, GROUP BY, DISTINCT, the partitions of windows
+// and hash joins compare and group values by. The zero TypedValue is NULL.
+type TypedValue struct {
+	e eval
+}
+
+// NewTypedValue returns the typed value of a value of a column with a
+// collation, which its strings that are not binary have. Unknown stands for
+// the default collation.
+func NewTypedValue(v sqltypes.Value, col collations.ID) (TypedValue, error) {
+	e, err := valueToEval(v)
+	if b, ok := e.(evalBytes); ok && !b.isBinary() && !b.literal {
+		e = b.withCollation(col, implicit)
+	}
+	return TypedValue{e: e}, err
+}
+
+// EvaluateTyped evaluates an expression and returns its value with its type
+// and collation.
+func (env *ExpressionEnv) EvaluateTyped(expr Expr) (TypedValue, error) {
+	e, err := expr.eval(env)
+	if err != nil {
+		return TypedValue{}, err
+	}
+	if _, ok := e.(evalTuple); ok {
+		return TypedValue{}, errOperandColumns(1)
+	}
+	return TypedValue{e: e}, nil
+}
+
+// IsNull reports whether the value is NULL.
+func (v TypedValue) IsNull() bool {
+	return v.e == nil
+}
+
+// Value returns the value without its collation.
+func (v TypedValue) Value() (sqltypes.Value, error) {
+	return evalToValue(v.e)
+}
+
+// WithCollation returns the value with its string in a collation, unless it
+// is binary.
+func (v TypedValue) WithCollation(col collations.ID) TypedValue {
+	if b, ok := v.e.(evalBytes); ok && !b.isBinary() && !b.literal {
+		v.e = b.withCollation(col, b.derivation)
+	}
+	return v
+}
+
+// KeyCollation returns the collation = compares the strings of two
+// expressions in, when it is known without evaluating them: the expressions
+// are columns or have a COLLATE clause, and their collations can be compared.
+// Hash joins key the strings of both sides in it.
+func KeyCollation(a, b Expr) (collations.ID, bool) {
+	left, ok := staticCollation(a)
+	if !ok {
+		return 0, false
+	}
+	right, ok := staticCollation(b)
+	if !ok {
+		return 0, false
+	}
+	col, err := mergeCollations(left, right, "=")
+	if err != nil {
+		return 0, false
+	}
+	return col.ID(), true
+}
+
+// CollationOf returns the collation of the strings of an expression, if it
+// is known without evaluating it, which it is for columns and for COLLATE.
+func CollationOf(expr Expr) (collations.ID, bool) {
+	e, ok := staticCollation(expr)
+	return e.col, ok
+}
+
+// staticCollation returns a string with the collation an expression's
+// strings have, if it is known without evaluating it.
+func staticCollation(expr Expr) (evalBytes, bool) {
+	switch expr := expr.(type) {
+	case *columnExpr:
+		return evalBytes{tt: sqltypes.VarChar, col: expr.col, derivation: implicit}, true
+	case *textExpr:
+		if expr.explicit && !expr.binary {
+			return evalBytes{tt: sqltypes.VarChar, col: expr.col, derivation: explicit}, true
+		}
+	}
+	return evalBytes{}, false
+}
+
+// NullsafeCompare compares two values the way ORDER BY orders them: NULL
+// before any other value, and other values like comparisons compare them,
+// strings in the collation of the two.
+func (env *ExpressionEnv) NullsafeCompare(a, b TypedValue) (int, error) {
+	switch {
+	case a.e == nil && b.e == nil:
+		return 0, nil
+	case a.e == nil:
+		return -1, nil
+	case b.e == nil:
+		return 1, nil
+	}
+	return env.compareScalars(a.e, b.e)
+}
+
+// AppendKey appends to b a key of a value that is the same for values that
+// are equal, which is what GROUP BY, DISTINCT and hash joins group values by.
+// Keys of values of a row can be appended one after the other.
+//
+// NULLs all have the same key, which no other value has. Whether values are
+// equal depends on the type they are compared in, so keys are only the same
+// for equal values of types of the same class: numbers, strings, binary
+// strings and temporal values. Strings are equal when they are in their
+// collation.
+func AppendKey(b []byte, v TypedValue) []byte {
+	switch e := v.e.(type) {
+	case nil:
+		return append(b, 0)
+	case evalInt64:
+		return appendKey(b, 'n', []byte(canonicalNumber(decimal.NewFromInt(e.i).String())))
+	case evalUint64:
+		return appendKey(b, 'n', []byte(canonicalNumber(decimal.NewFromUint(e.u).String())))
+	case evalFloat:
+		return appendKey(b, 'n', []byte(canonicalNumber(decimal.NewFromFloat(e.f).String())))
+	case evalDecimal:
+		return appendKey(b, 'n', []byte(canonicalNumber(e.dec.String())))
+	case evalBytes:
+		if e.isBinary() || e.literal {
+			return appendKey(b, 'b', e.bytes)
+		}
+		return appendKey(b, 't', e.collation().WeightString(nil, e.bytes))
+	case evalTemporal:
+		if e.t == sqltypes.Time {
+			return appendKey(b, 'T', e.dt.Time.Format(maxTimePrecision))
+		}
+		return appendKey(b, 'd', e.dt.Format(maxTimePrecision))
+	case evalJSON:
+		return appendKey(b, 'j', e.v.Marshal())
+	}
+	return appendKey(b, '?', nil)
+}
+
+func appendKey(b []byte, class byte, key []byte) []byte {
+	b = append(b, class)
+	b = binary.AppendUvarint(b, uint64(len(key)))
+	return append(b, key...)
+}
+
+// canonicalNumber strips the zeros at the end of the fraction of a number, so
+// that numbers that are equal have the same text.
+func canonicalNumber(s string) string {
+	if strings.IndexByte(s, '.') >= 0 {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// ToFloat64 returns the number a value is in numeric contexts, as a double.
+// NULL is 0.
+func ToFloat64(v sqltypes.Value) (float64, error) {
+	e, err := valueToEval(v)
+	if err != nil {
+		return 0, err
+	}
+	env := &ExpressionEnv{}
+	return env.toFloat(e), nil
+}
+
+// ToUint64 returns the number a value is in numeric contexts, as an unsigned
+// integer like the bitwise operators take it. NULL is 0.
+func ToUint64(v sqltypes.Value) (uint64, error) {
+	e, err := valueToEval(v)
+	if err != nil {
+		return 0, err
+	}
+	env := &ExpressionEnv{}
+	return env.toUint64(e), nil
+}
+
+// ToDecimal returns the number a value is in numeric contexts, as a decimal,
+// with the number of digits it has after the decimal point. NULL is 0.
+func ToDecimal(v sqltypes.Value) (decimal.Decimal, int32, error) {
+	e, err := valueToEval(v)
+	if err != nil || e == nil {
+		return decimal.Zero, 0, err
+	}
+	env := &ExpressionEnv{}
+	dec := env.toDecimal(e)
+	return dec.dec, dec.length, nil
+}
@@ -0,0 +1,541 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"bytes"
+	"math"
+	"slices"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/decimal"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/format"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+)
+
+// groupConcatMaxLen is the length GROUP_CONCAT truncates its result to, like
+// MySQL's default group_concat_max_len.
+const groupConcatMaxLen = 1024
+
+// maxDecimalScale is the largest number of digits a decimal can have after
+// the decimal point.
+const maxDecimalScale = 30
+
+// hasAggregates reports whether an expression has aggregate functions that
+// are not in subqueries. Aggregate functions used as window functions do not
+// count, but the ones in their arguments and windows do.
+func hasAggregates(e sqlparser.Expr) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery, *sqlparser.Offset:
+			return false, nil
+		case sqlparser.AggrFunc:
+			found = overClause(node) == nil
+		}
+		return !found, nil
+	}, e)
+	return found
+}
+
+// aggregate is an aggregate function of a query.
+type aggregate struct {
+	name string
+	// args are the arguments of the function, none for COUNT(*).
+	args     []*expr
+	distinct bool
+	// orderBy and separator are the ones of GROUP_CONCAT.
+	orderBy   []ordering
+	separator string
+	// typ is the type of the value of the function, which resultTyped tells is
+	// known, and argType the type of its first argument when typed tells it is
+	// known.
+	typ         querypb.Type
+	resultTyped bool
+	argType     querypb.Type
+	typed       bool
+}
+
+// aggregation collects the aggregate functions of a query, whose values are
+// after the columns of the rows of the FROM clause in the rows of groups.
+type aggregation struct {
+	// source is the scope of the arguments of the functions, and post the one
+	// of the rows of groups.
+	source, post *scope
+	aggregates   []*aggregate
+	keys         []string
+}
+
+// replace replaces the aggregate functions of an expression with the offsets
+// of their values in the rows of groups.
+func (a *aggregation) replace(e sqlparser.Expr) (sqlparser.Expr, error) {
+	var err error
+	rewritten := sqlparser.Rewrite(sqlparser.CloneExpr(e), func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Subquery, *sqlparser.Offset:
+			return false
+		case sqlparser.AggrFunc:
+			if overClause(node) != nil {
+				return true
+			}
+			var offset int
+			if offset, err = a.add(node); err == nil {
+				cursor.Replace(&sqlparser.Offset{V: offset, Original: node})
+			}
+			return false
+		}
+		return true
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return rewritten.(sqlparser.Expr), nil
+}
+
+// add adds an aggregate function, unless the same one was added before, and
+// returns the offset of its value.
+func (a *aggregation) add(fn sqlparser.AggrFunc) (int, error) {
+	key := sqlparser.String(fn)
+	if i := slices.Index(a.keys, key); i >= 0 {
+		return len(a.source.columns) + i, nil
+	}
+
+	agg, err := newAggregate(fn, a.source)
+	if err != nil {
+		return 0, err
+	}
+	a.keys = append(a.keys, key)
+	a.aggregates = append(a.aggregates, agg)
+	a.post.columns = append(a.post.columns, column{name: key, field: &querypb.Field{Name: key, Type: agg.typ}, typed: agg.resultTyped})
+	return len(a.post.columns) - 1, nil
+}
+
+// newAggregate compiles an aggregate function whose arguments are evaluated
+// against rows of a scope.
+func newAggregate(fn sqlparser.AggrFunc, s *scope) (*aggregate, error) {
+	agg := &aggregate{name: fn.AggrName()}
+	if d, ok := fn.(sqlparser.DistinctableAggr); ok {
+		agg.distinct = d.IsDistinct()
+	}
+	var args []sqlparser.Expr
+	switch fn := fn.(type) {
+	case *sqlparser.CountStar:
+	case *sqlparser.GroupConcatExpr:
+		if fn.Limit != nil {
+			return nil, vterrors.VT12001("GROUP_CONCAT with LIMIT")
+		}
+		args = fn.Exprs
+		agg.separator = ","
+		if fn.Separator != "" {
+			// The parser keeps the separator as a SQL string literal.
+			separator, err := sqltypes.DecodeStringSQL(fn.Separator)
+			if err != nil {
+				return nil, err
+			}
+			agg.separator = separator
+		}
+		for _, order := range fn.OrderBy {
+			x, err := s.compile(order.Expr, orderClause)
+			if err != nil {
+				return nil, err
+			}
+			agg.orderBy = append(agg.orderBy, ordering{expr: x, column: -1, desc: order.Direction == sqlparser.DescOrder})
+		}
+	case *sqlparser.JSONArrayAgg, *sqlparser.JSONObjectAgg:
+		return nil, vterrors.VT12001("aggregating with " + agg.name)
+	default:
+		args = fn.GetArgs()
+	}
+	for _, arg := range args {
+		if hasAggregates(arg) {
+			return nil, invalidGroupFuncUse()
+		}
+		if name, ok := windowFunction(arg); ok {
+			return nil, invalidWindowFuncUse(name)
+		}
+		x, err := s.compile(arg, fieldList)
+		if err != nil {
+			return nil, err
+		}
+		agg.args = append(agg.args, x)
+	}
+	if len(agg.args) > 0 {
+		agg.argType, agg.typed = s.typeOf(agg.args[0])
+	}
+
+	agg.resultTyped = true
+	switch agg.name {
+	case "count":
+		agg.typ = sqltypes.Int64
+	case "sum", "avg":
+		agg.typ = sqltypes.Decimal
+		if agg.typed && !sqltypes.IsIntegral(agg.argType) && agg.argType != sqltypes.Decimal {
+			agg.typ = sqltypes.Float64
+		}
+	case "min", "max", "any_value":
+		agg.typ, agg.resultTyped = agg.argType, agg.typed
+	case "bit_and", "bit_or", "bit_xor":
+		agg.typ = sqltypes.Uint64
+	case "group_concat":
+		agg.typ = sqltypes.Text
+		for _, x := range agg.args {
+			if typ, ok := s.typeOf(x); ok && sqltypes.IsBinary(typ) {
+				agg.typ = sqltypes.Blob
+			}
+		}
+	default:
+		agg.typ = sqltypes.Float64
+	}
+	return agg, nil
+}
+
+// group puts rows in groups by the values of GROUP BY and returns the rows of
+// the groups. Queries without GROUP BY have a single group, even when they
+// have no rows.
+func (p *selectPlan) group(ctx *execution, rows []sqltypes.Row) ([]sqltypes.Row, error) {
+	type group struct {
+		first  sqltypes.Row
+		states []*aggState
+	}
+	newGroup := func(first sqltypes.Row) *group {
+		g := &group{first: first}
+		for _, agg := range p.aggregates {
+			g.states = append(g.states, newAggState(ctx, agg))
+		}
+		return g
+	}
+
+	index := map[string]*group{}
+	var groups []*group
+	for _, row := range rows {
+		var key []byte
+		for _, x := range p.groupBy {
+			v, err := x.typed(ctx, row)
+			if err != nil {
+				return nil, err
+			}
+			key = evalengine.AppendKey(key, v)
+		}
+		g, ok := index[string(key)]
+		if !ok {
+			g = newGroup(row)
+			index[string(key)] = g
+			groups = append(groups, g)
+		}
+		for _, state := range g.states {
+			if err := state.add(ctx, row); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(groups) == 0 && len(p.groupBy) == 0 {
+		groups = append(groups, newGroup(make(sqltypes.Row, p.width)))
+	}
+
+	result := make([]sqltypes.Row, len(groups))
+	for i, g := range groups {
+		row := make(sqltypes.Row, p.width+len(g.states))
+		copy(row, g.first)
+		for k, state := range g.states {
+			v, err := state.acc.result()
+			if err != nil {
+				return nil, err
+			}
+			row[p.width+k] = v
+		}
+		result[i] = row
+	}
+	return result, nil
+}
+
+// accumulator computes the value of an aggregate function from the values of
+// its arguments for the rows of a group, which it is given as the row of a
+// sortRow, with their typed values and the values of its ORDER BY.
+type accumulator interface {
+	add(args sortRow) error
+	result() (sqltypes.Value, error)
+}
+
+// aggState is the state of an aggregate function for a group. It skips the
+// rows for which any argument is NULL, and for DISTINCT the rows whose
+// arguments are the same as the ones of a row before.
+type aggState struct {
+	agg  *aggregate
+	acc  accumulator
+	seen map[string]bool
+}
+
+func newAggState(ctx *execution, agg *aggregate) *aggState {
+	state := &aggState{agg: agg}
+	if agg.distinct {
+		state.seen = map[string]bool{}
+	}
+	switch agg.name {
+	case "count":
+		state.acc = &countAcc{}
+	case "sum", "avg":
+		state.acc = &sumAcc{avg: agg.name == "avg", float: agg.typ == sqltypes.Float64}
+	case "min":
+		state.acc = &extremumAcc{sign: -1, env: ctx.compareEnv()}
+	case "max":
+		state.acc = &extremumAcc{sign: 1, env: ctx.compareEnv()}
+	case "any_value":
+		state.acc = &extremumAcc{}
+	case "bit_and":
+		state.acc = &bitAcc{op: '&', bits: math.MaxUint64}
+	case "bit_or":
+		state.acc = &bitAcc{op: '|'}
+	case "bit_xor":
+		state.acc = &bitAcc{op: '^'}
+	case "group_concat":
+		state.acc = &groupConcatAcc{ctx: ctx, agg: agg}
+	case "var_samp", "stddev_samp":
+		state.acc = &varianceAcc{sample: true, sqrt: agg.name == "stddev_samp"}
+	default:
+		state.acc = &varianceAcc{sqrt: agg.name != "var_pop" && agg.name != "variance"}
+	}
+	return state
+}
+
+func (s *aggState) add(ctx *execution, row sqltypes.Row) error {
+	args := sortRow{row: make(sqltypes.Row, len(s.agg.args)), values: make([]evalengine.TypedValue, len(s.agg.args))}
+	for i, x := range s.agg.args {
+		v, typed, err := x.evaluate(ctx, row)
+		if err != nil {
+			return err
+		}
+		if v.IsNull() {
+			return nil
+		}
+		args.row[i], args.values[i] = v, typed
+	}
+	if s.seen != nil {
+		key := rowKey(args.values)
+		if s.seen[string(key)] {
+			return nil
+		}
+		s.seen[string(key)] = true
+	}
+	for _, o := range s.agg.orderBy {
+		v, err := o.expr.typed(ctx, row)
+		if err != nil {
+			return err
+		}
+		args.keys = append(args.keys, v)
+	}
+	return s.acc.add(args)
+}
+
+type countAcc struct {
+	n int64
+}
+
+func (c *countAcc) add(sortRow) error {
+	c.n++
+	return nil
+}
+
+func (c *countAcc) result() (sqltypes.Value, error) {
+	return sqltypes.NewInt64(c.n), nil
+}
+
+// sumAcc is SUM and AVG, which add up integers and decimals as decimals, and
+// anything else as doubles.
+type sumAcc struct {
+	avg   bool
+	float bool
+	n     int64
+	dec   decimal.Decimal
+	scale int32
+	f     float64
+}
+
+func (s *sumAcc) add(args sortRow) error {
+	v := args.row[0]
+	s.n++
+	if !s.float && !v.IsIntegral() && v.Type() != sqltypes.Decimal {
+		s.float = true
+		s.f, _ = s.dec.Float64()
+	}
+	if s.float {
+		f, err := evalengine.ToFloat64(v)
+		s.f += f
+		return err
+	}
+	dec, scale, err := evalengine.ToDecimal(v)
+	if err != nil {
+		return err
+	}
+	if s.n == 1 {
+		s.dec = dec
+	} else {
+		s.dec = s.dec.Add(dec)
+	}
+	s.scale = max(s.scale, scale)
+	return nil
+}
+
+func (s *sumAcc) result() (sqltypes.Value, error) {
+	switch {
+	case s.n == 0:
+		return sqltypes.NULL, nil
+	case s.float && s.avg:
+		return sqltypes.MakeTrusted(sqltypes.Float64, format.FormatFloat(s.f/float64(s.n))), nil
+	case s.float:
+		return sqltypes.MakeTrusted(sqltypes.Float64, format.FormatFloat(s.f)), nil
+	case s.avg:
+		scale := min(s.scale+4, maxDecimalScale)
+		avg := s.dec.Div(decimal.NewFromInt(s.n), 4).Round(scale)
+		return sqltypes.MakeTrusted(sqltypes.Decimal, avg.FormatMySQL(scale)), nil
+	}
+	return sqltypes.MakeTrusted(sqltypes.Decimal, s.dec.FormatMySQL(s.scale)), nil
+}
+
+// extremumAcc is MIN when sign is -1, MAX when it is 1 and ANY_VALUE when it
+// is 0. MIN and MAX compare strings in the collation of their argument.
+type extremumAcc struct {
+	sign  int
+	env   *evalengine.ExpressionEnv
+	value sqltypes.Value
+	typed evalengine.TypedValue
+	has   bool
+}
+
+func (e *extremumAcc) add(args sortRow) error {
+	if !e.has {
+		e.value, e.typed, e.has = args.row[0], args.values[0], true
+		return nil
+	}
+	if e.sign == 0 {
+		return nil
+	}
+	n, err := e.env.NullsafeCompare(args.values[0], e.typed)
+	if err != nil {
+		return err
+	}
+	if n*e.sign > 0 {
+		e.value, e.typed = args.row[0], args.values[0]
+	}
+	return nil
+}
+
+func (e *extremumAcc) result() (sqltypes.Value, error) {
+	return e.value, nil
+}
+
+// bitAcc is BIT_AND, BIT_OR and BIT_XOR.
+type bitAcc struct {
+	op   byte
+	bits uint64
+}
+
+func (b *bitAcc) add(args sortRow) error {
+	u, err := evalengine.ToUint64(args.row[0])
+	if err != nil {
+		return err
+	}
+	switch b.op {
+	case '&':
+		b.bits &= u
+	case '|':
+		b.bits |= u
+	default:
+		b.bits ^= u
+	}
+	return nil
+}
+
+func (b *bitAcc) result() (sqltypes.Value, error) {
+	return sqltypes.NewUint64(b.bits), nil
+}
+
+// varianceAcc is the variance of the population or of a sample, or its square
+// root, computed with Welford's algorithm.
+type varianceAcc struct {
+	sample, sqrt bool
+	n            int64
+	mean, m2     float64
+}
+
+func (v *varianceAcc) add(args sortRow) error {
+	f, err := evalengine.ToFloat64(args.row[0])
+	if err != nil {
+		return err
+	}
+	v.n++
+	delta := f - v.mean
+	v.mean += delta / float64(v.n)
+	v.m2 += delta * (f - v.mean)
+	return nil
+}
+
+func (v *varianceAcc) result() (sqltypes.Value, error) {
+	n := v.n
+	if v.sample {
+		n--
+	}
+	if n <= 0 {
+		return sqltypes.NULL, nil
+	}
+	variance := v.m2 / float64(n)
+	if v.sqrt {
+		variance = math.Sqrt(variance)
+	}
+	return sqltypes.MakeTrusted(sqltypes.Float64, format.FormatFloat(variance)), nil
+}
+
+// groupConcatAcc is GROUP_CONCAT, which concatenates its arguments for each
+// row, in the order of its ORDER BY, with its separator between rows.
+type groupConcatAcc struct {
+	ctx  *execution
+	agg  *aggregate
+	rows []sortRow
+}
+
+func (g *groupConcatAcc) add(args sortRow) error {
+	g.rows = append(g.rows, args)
+	return nil
+}
+
+func (g *groupConcatAcc) result() (sqltypes.Value, error) {
+	if len(g.rows) == 0 {
+		return sqltypes.NULL, nil
+	}
+	rows, err := finish(g.ctx, g.rows, false, g.agg.orderBy, nil)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	var buf bytes.Buffer
+	for i, row := range rows {
+		if i > 0 {
+			buf.WriteString(g.agg.separator)
+		}
+		for _, v := range row {
+			buf.Write(v.Raw())
+		}
+		if buf.Len() >= groupConcatMaxLen {
+			break
+		}
+	}
+	return sqltypes.MakeTrusted(g.agg.typ, buf.Bytes()[:min(buf.Len(), groupConcatMaxLen)]), nil
+}
@@ -0,0 +1,453 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"fmt"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// Names of the clauses as MySQL reports them in errors.
+const (
+	fieldList   = "field list"
+	whereClause = "where clause"
+	onClause    = "on clause"
+	fromClause  = "from clause"
+	groupClause = "group statement"
+	havingCl    = "having clause"
+	orderClause = "order clause"
+
+	windowPartitionClause = "window partition by"
+	windowOrderClause     = "window order by"
+)
+
+func unknownColumn(name, clause string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in '%s'", name, clause)
+}
+
+func ambiguousColumn(name, clause string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUniqError, "Column '%s' in %s is ambiguous", name, clause)
+}
+
+// compiler compiles a statement and the queries in it.
+type compiler struct {
+	tables Tables
+	// source is the text the statement was parsed from, if it is known.
+	source *sqlparser.SourceTree
+	// n is the number of bind variables made for subqueries and for columns of
+	// enclosing queries, which names the next one.
+	n int
+}
+
+func (c *compiler) bindVar(prefix string) string {
+	c.n++
+	return fmt.Sprintf("__%s%d", prefix, c.n)
+}
+
+// text returns an expression as it is written in the statement, which is what
+// MySQL names the columns of expressions without an alias, or formatted when
+// the text of the statement is not known.
+func (c *compiler) text(e sqlparser.Expr) string {
+	if c.source != nil {
+		if text, ok := c.source.Source(e); ok {
+			return text
+		}
+	}
+	return sqlparser.String(e)
+}
+
+func (c *compiler) statement(stmt sqlparser.TableStatement, q *query) (plan, error) {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		return c.selectPlan(stmt, q)
+	case *sqlparser.Union:
+		return c.union(stmt, q)
+	}
+	return nil, vterrors.VT12001(fmt.Sprintf("executing %s", sqlparser.String(stmt)))
+}
+
+// query is what the SELECTs of a statement share: the common table
+// expressions they can read from, and the columns of the query the statement
+// is a subquery of that they use.
+type query struct {
+	c *compiler
+	// parent is the scope the statement is in when it is a subquery whose
+	// expressions can use the columns of the query it is in.
+	parent *scope
+	// outer are the columns of enclosing queries the statement uses, which are
+	// bound to bind variables when it is executed for a row of its parent.
+	outer []*outerRef
+	ctes  map[string]*cte
+}
+
+type outerRef struct {
+	// key is the column as it is written, and name the bind variable it is.
+	key, name string
+	// expr is the column compiled in the parent scope.
+	expr *expr
+}
+
+// subquery returns the query of a subquery in a scope, which can use the
+// columns of the scope.
+func (q *query) subquery(parent *scope) *query {
+	return &query{c: q.c, parent: parent, ctes: q.ctes}
+}
+
+// outerRef returns the name of the bind variable that is a column of an
+// enclosing query.
+func (q *query) outerRef(col *sqlparser.ColName, clause string) (string, error) {
+	key := sqlparser.String(col)
+	for _, ref := range q.outer {
+		if ref.key == key {
+			return ref.name, nil
+		}
+	}
+	x, err := q.parent.compile(col, clause)
+	if err != nil {
+		return "", err
+	}
+	ref := &outerRef{key: key, name: q.c.bindVar("outer"), expr: x}
+	q.outer = append(q.outer, ref)
+	return ref.name, nil
+}
+
+// bind returns the execution of the statement for a row of its parent, with
+// the columns of enclosing queries it uses bound.
+func (q *query) bind(ctx *execution, row sqltypes.Row) (*execution, error) {
+	if len(q.outer) == 0 {
+		return ctx, nil
+	}
+	bindVars := make(map[string]*querypb.BindVariable, len(ctx.bindVars)+len(q.outer))
+	for name, bv := range ctx.bindVars {
+		bindVars[name] = bv
+	}
+	for _, ref := range q.outer {
+		v, err := ref.expr.value(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		bindVars[ref.name] = sqltypes.ValueBindVariable(v)
+	}
+	sub := newExecution(bindVars)
+	sub.now = ctx.now
+	return sub, nil
+}
+
+// scope is what the names of an expression refer to: the columns of the rows
+// it is evaluated against.
+type scope struct {
+	q       *query
+	columns []column
+}
+
+// find returns the offset of the column a name refers to, or -1 when there is
+// none.
+func (s *scope) find(col *sqlparser.ColName, clause string) (int, error) {
+	offset := -1
+	for i, c := range s.columns {
+		if !col.Name.EqualString(c.name) {
+			continue
+		}
+		if col.Qualifier.IsEmpty() {
+			if c.hidden {
+				continue
+			}
+		} else {
+			if col.Qualifier.Name.String() != c.table {
+				continue
+			}
+			if col.Qualifier.Qualifier.NotEmpty() && c.field.Database != "" && col.Qualifier.Qualifier.String() != c.field.Database {
+				continue
+			}
+		}
+		if offset >= 0 {
+			return 0, ambiguousColumn(sqlparser.String(col), clause)
+		}
+		offset = i
+	}
+	return offset, nil
+}
+
+func (s *scope) config(clause string) *evalengine.Config {
+	return &evalengine.Config{
+		ResolveColumn: func(col *sqlparser.ColName) (int, error) {
+			offset, err := s.find(col, clause)
+			if err == nil && offset < 0 {
+				err = unknownColumn(sqlparser.String(col), clause)
+			}
+			return offset, err
+		},
+		ResolveType: func(offset int) (querypb.Type, bool) {
+			if offset >= len(s.columns) {
+				return 0, false
+			}
+			return s.columns[offset].field.Type, s.columns[offset].typed
+		},
+		ResolveCollation: func(offset int) (collations.ID, bool) {
+			if offset >= len(s.columns) {
+				return 0, false
+			}
+			id := collations.ID(s.columns[offset].field.Charset)
+			return id, collations.Get(id) != nil
+		},
+	}
+}
+
+// expr is an expression compiled in a scope.
+type expr struct {
+	eval       evalengine.Expr
+	subqueries []*subquery
+	// column is the offset of the column the expression is, or -1 when it is
+	// not a column, and collation the collation of the column.
+	column    int
+	collation collations.ID
+}
+
+// compile compiles an expression in a scope. Names of columns the scope does
+// not have refer to enclosing queries, and subqueries are compiled to bind
+// variables whose values are given when the expression is evaluated.
+func (s *scope) compile(e sqlparser.Expr, clause string) (*expr, error) {
+	e, subqueries, err := s.prepare(e, clause)
+	if err != nil {
+		return nil, err
+	}
+	eval, err := evalengine.Translate(e, s.config(clause))
+	if err != nil {
+		return nil, err
+	}
+	x := &expr{eval: eval, subqueries: subqueries, column: -1}
+	switch e := e.(type) {
+	case *sqlparser.ColName:
+		x.column, _ = s.find(e, clause)
+	case *sqlparser.Offset:
+		x.column = e.V
+	}
+	if x.column >= 0 && x.column < len(s.columns) {
+		x.collation = collations.ID(s.columns[x.column].field.Charset)
+	}
+	return x, nil
+}
+
+// prepare rewrites the subqueries of an expression and the columns of
+// enclosing queries it uses to bind variables.
+func (s *scope) prepare(e sqlparser.Expr, clause string) (sqlparser.Expr, []*subquery, error) {
+	var subqueries []*subquery
+	var err error
+	add := func(sq *sqlparser.Subquery, kind subqueryKind) string {
+		var compiled *subquery
+		if compiled, err = s.subquery(sq, kind); err != nil {
+			return ""
+		}
+		subqueries = append(subqueries, compiled)
+		return compiled.name
+	}
+	rewritten := sqlparser.Rewrite(sqlparser.CloneExpr(e), func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch node := cursor.Node().(type) {
+		case *sqlparser.ExistsExpr:
+			cursor.Replace(sqlparser.NewArgument(add(node.Subquery, existsSubquery)))
+			return false
+		case *sqlparser.ComparisonExpr:
+			if sq, ok := node.Right.(*sqlparser.Subquery); ok && (node.Operator == sqlparser.InOp || node.Operator == sqlparser.NotInOp) {
+				node.Right = sqlparser.ListArg(add(sq, listSubquery))
+			}
+		case *sqlparser.Subquery:
+			cursor.Replace(sqlparser.NewArgument(add(node, scalarSubquery)))
+			return false
+		case *sqlparser.ColName:
+			var offset int
+			if offset, err = s.find(node, clause); err != nil || offset >= 0 || s.q.parent == nil {
+				return false
+			}
+			var name string
+			if name, err = s.q.outerRef(node, clause); err == nil {
+				cursor.Replace(sqlparser.NewArgument(name))
+			}
+			return false
+		}
+		return true
+	}, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rewritten.(sqlparser.Expr), subqueries, nil
+}
+
+// env returns the environment to evaluate an expression in for a row, with
+// the values of its subqueries bound.
+func (x *expr) env(ctx *execution, row sqltypes.Row) (*evalengine.ExpressionEnv, error) {
+	bindVars := ctx.bindVars
+	if len(x.subqueries) > 0 {
+		bindVars = make(map[string]*querypb.BindVariable, len(ctx.bindVars)+len(x.subqueries))
+		for name, bv := range ctx.bindVars {
+			bindVars[name] = bv
+		}
+		for _, sq := range x.subqueries {
+			bv, err := sq.bind(ctx, row)
+			if err != nil {
+				return nil, err
+			}
+			bindVars[sq.name] = bv
+		}
+	}
+	env := evalengine.NewExpressionEnv(bindVars, row)
+	env.Now = ctx.now
+	return env, nil
+}
+
+// value evaluates an expression for a row.
+func (x *expr) value(ctx *execution, row sqltypes.Row) (sqltypes.Value, error) {
+	if x.column >= 0 {
+		return row[x.column], nil
+	}
+	env, err := x.env(ctx, row)
+	if err != nil {
+		return sqltypes.Value{}, err
+	}
+	return env.Evaluate(x.eval)
+}
+
+// typed evaluates an expression for a row with its type and collation, which
+// is what rows are ordered and grouped by.
+func (x *expr) typed(ctx *execution, row sqltypes.Row) (evalengine.TypedValue, error) {
+	_, typed, err := x.evaluate(ctx, row)
+	return typed, err
+}
+
+// evaluate evaluates an expression for a row, and returns its value both as
+// value does and as typed does.
+func (x *expr) evaluate(ctx *execution, row sqltypes.Row) (sqltypes.Value, evalengine.TypedValue, error) {
+	if x.column >= 0 {
+		typed, err := evalengine.NewTypedValue(row[x.column], x.collation)
+		return row[x.column], typed, err
+	}
+	env, err := x.env(ctx, row)
+	if err != nil {
+		return sqltypes.Value{}, evalengine.TypedValue{}, err
+	}
+	typed, err := env.EvaluateTyped(x.eval)
+	if err != nil {
+		return sqltypes.Value{}, evalengine.TypedValue{}, err
+	}
+	v, err := typed.Value()
+	return v, typed, err
+}
+
+// truth evaluates a condition for a row.
+func (x *expr) truth(ctx *execution, row sqltypes.Row) (evalengine.Truth, error) {
+	env, err := x.env(ctx, row)
+	if err != nil {
+		return evalengine.Unknown, err
+	}
+	return env.Truth(x.eval)
+}
+
+// typeOf returns the type of a compiled expression and whether it is known.
+func (s *scope) typeOf(x *expr) (querypb.Type, bool) {
+	if x.column >= 0 && x.column < len(s.columns) {
+		return s.columns[x.column].field.Type, s.columns[x.column].typed
+	}
+	return evalengine.TypeOf(x.eval)
+}
+
+type subqueryKind int8
+
+const (
+	// scalarSubquery is a subquery that is a value.
+	scalarSubquery subqueryKind = iota
+	// listSubquery is the subquery of IN, which is a list of values.
+	listSubquery
+	// existsSubquery is the subquery of EXISTS.
+	existsSubquery
+)
+
+// subquery is a subquery of an expression, which is the bind variable name
+// when the expression is evaluated.
+type subquery struct {
+	name string
+	kind subqueryKind
+	q    *query
+	plan plan
+}
+
+func (s *scope) subquery(sq *sqlparser.Subquery, kind subqueryKind) (*subquery, error) {
+	q := s.q.subquery(s)
+	p, err := s.q.c.statement(sq.Select, q)
+	if err != nil {
+		return nil, err
+	}
+	if kind != existsSubquery && len(p.columns()) != 1 {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.OperandColumns, "Operand should contain 1 column(s)")
+	}
+	return &subquery{name: s.q.c.bindVar("sq"), kind: kind, q: q, plan: p}, nil
+}
+
+// bind executes a subquery for a row of its scope and returns its value.
+// Subqueries that do not use the columns of the row are executed once.
+func (sq *subquery) bind(ctx *execution, row sqltypes.Row) (*querypb.BindVariable, error) {
+	cached := len(sq.q.outer) == 0
+	if cached {
+		ctx.mu.Lock()
+		bv, ok := ctx.values[sq]
+		ctx.mu.Unlock()
+		if ok {
+			return bv, nil
+		}
+	}
+	sub, err := sq.q.bind(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := sq.plan.execute(sub)
+	if err != nil {
+		return nil, err
+	}
+	var bv *querypb.BindVariable
+	switch sq.kind {
+	case existsSubquery:
+		bv = sqltypes.BoolBindVariable(len(rows) > 0)
+	case listSubquery:
+		bv = &querypb.BindVariable{Type: sqltypes.Tuple}
+		for _, row := range rows {
+			bv.Values = append(bv.Values, sqltypes.ValueToProto(row[0]))
+		}
+	default:
+		if len(rows) > 1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Subquery returns more than 1 row")
+		}
+		v := sqltypes.NULL
+		if len(rows) == 1 {
+			v = rows[0][0]
+		}
+		bv = sqltypes.ValueBindVariable(v)
+	}
+	if cached {
+		ctx.mu.Lock()
+		ctx.values[sq] = bv
+		ctx.mu.Unlock()
+	}
+	return bv, nil
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package executor executes SELECT statements against in-memory tables.
+//
+// Tables are results, whose fields are the columns of the table and whose rows
+// are its rows. A statement is compiled against tables with Compile, which
+// resolves its names and translates its expressions for the evalengine, and
+// can then be executed any number of times, with different bind variables and
+// with the rows of its tables changed in between.
+//
+// Statements are executed the way MySQL executes them: joins, including the
+// hash joins and the parallel joins of the grammar, WHERE, GROUP BY with
+// aggregate functions, HAVING, DISTINCT, ORDER BY, LIMIT, UNION, subqueries
+// anywhere an expression can be, derived tables, JSON_TABLE and common table
+// expressions that are not recursive. Expressions are evaluated by the
+// evalengine, and compare, group and order the way it compares values.
+package executor
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+// Tables are the tables statements read from, by name. The name of a table
+// of a database is qualified with it, like "db.t". Names are looked up as
+// they are first, and then case insensitively.
+type Tables map[string]*sqltypes.Result
+
+func (t Tables) lookup(name sqlparser.TableName) (*sqltypes.Result, bool) {
+	key := name.Name.String()
+	if name.Qualifier.NotEmpty() {
+		key = name.Qualifier.String() + "." + key
+	}
+	if result, ok := t[key]; ok {
+		return result, true
+	}
+	for k, result := range t {
+		if strings.EqualFold(k, key) {
+			return result, true
+		}
+	}
+	return nil, false
+}
+
+// Query is a compiled statement.
+type Query struct {
+	plan plan
+}
+
+// Compile compiles a SELECT or a UNION against tables. It fails for names
+// that are not of any table or column, and for what cannot be executed.
+func Compile(stmt sqlparser.TableStatement, tables Tables) (*Query, error) {
+	return CompileSource(stmt, nil, tables)
+}
+
+// CompileSource is Compile for a statement parsed with ParseLossless. The
+// columns of the result for expressions without an alias are named after
+// their text in the query, like MySQL names them, rather than after the
+// expressions formatted.
+func CompileSource(stmt sqlparser.TableStatement, source *sqlparser.SourceTree, tables Tables) (*Query, error) {
+	c := &compiler{tables: tables, source: source}
+	p, err := c.statement(stmt, &query{c: c})
+	if err != nil {
+		return nil, err
+	}
+	return &Query{plan: p}, nil
+}
+
+// Execute executes the query with bind variables.
+func (q *Query) Execute(bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rows, err := q.plan.execute(newExecution(bindVars))
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: resultFields(q.plan.columns(), rows), Rows: rows}, nil
+}
+
+// Execute compiles a statement against tables and executes it with bind
+// variables.
+func Execute(stmt sqlparser.TableStatement, tables Tables, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	q, err := Compile(stmt, tables)
+	if err != nil {
+		return nil, err
+	}
+	return q.Execute(bindVars)
+}
+
+// plan is a compiled SELECT or UNION.
+type plan interface {
+	columns() []column
+	execute(ctx *execution) ([]sqltypes.Row, error)
+}
+
+// column is a column of a table, of a join of tables or of the result of a
+// query.
+type column struct {
+	// table is the alias of the table of the column, and name its name.
+	table, name string
+	field       *querypb.Field
+	// typed is set when the type of the field is known. The type of the
+	// columns of expressions whose type is only known when they are evaluated,
+	// like bind variables, is the type of their first value that is not NULL.
+	typed bool
+	// hidden is set for the columns of joins with USING that unqualified
+	// names do not refer to, because the other table has the same column.
+	hidden bool
+}
+
+// resultFields returns the fields of a result with columns and rows.
+func resultFields(cols []column, rows []sqltypes.Row) []*querypb.Field {
+	fields := make([]*querypb.Field, len(cols))
+	for i, col := range cols {
+		fields[i] = col.field
+		if col.typed {
+			continue
+		}
+		field := col.field.CloneVT()
+		for _, row := range rows {
+			if !row[i].IsNull() {
+				field.Type = row[i].Type()
+				break
+			}
+		}
+		fields[i] = field
+	}
+	return fields
+}
+
+// execution is the state of an execution of a query: its bind variables, the
+// current time and the values of the subqueries that do not depend on the row
+// they are evaluated for.
+type execution struct {
+	bindVars map[string]*querypb.BindVariable
+	now      time.Time
+
+	mu     sync.Mutex
+	values map[*subquery]*querypb.BindVariable
+}
+
+func newExecution(bindVars map[string]*querypb.BindVariable) *execution {
+	return &execution{bindVars: bindVars, now: time.Now(), values: map[*subquery]*querypb.BindVariable{}}
+}
+
+// compareEnv returns an environment to compare the values of the execution
+// in, which a sort shares for all its comparisons.
+func (ctx *execution) compareEnv() *evalengine.ExpressionEnv {
+	return &evalengine.ExpressionEnv{BindVars: ctx.bindVars, Now: ctx.now}
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// node is a table of the FROM clause: a table, a derived table, a JSON_TABLE
+// or a join of them.
+type node interface {
+	columns() []column
+	// star returns the offsets of the columns * stands for.
+	star() []int
+	// lateral reports whether the rows of the table depend on the row of the
+	// table it is joined to, like the ones of LATERAL derived tables do.
+	lateral() bool
+	// rows returns the rows of the table. left is the row of the table it is
+	// joined to when it is lateral.
+	rows(ctx *execution, left sqltypes.Row) ([]sqltypes.Row, error)
+}
+
+func allColumns(n int) []int {
+	star := make([]int, n)
+	for i := range star {
+		star[i] = i
+	}
+	return star
+}
+
+// dualNode is DUAL, and the table of queries without FROM, which has a single
+// row without columns.
+type dualNode struct{}
+
+func (dualNode) columns() []column { return nil }
+func (dualNode) star() []int       { return nil }
+func (dualNode) lateral() bool     { return false }
+
+func (dualNode) rows(*execution, sqltypes.Row) ([]sqltypes.Row, error) {
+	return []sqltypes.Row{{}}, nil
+}
+
+// tableNode is a table of the Tables.
+type tableNode struct {
+	result *sqltypes.Result
+	cols   []column
+}
+
+func (t *tableNode) columns() []column { return t.cols }
+func (t *tableNode) star() []int       { return allColumns(len(t.cols)) }
+func (t *tableNode) lateral() bool     { return false }
+
+func (t *tableNode) rows(*execution, sqltypes.Row) ([]sqltypes.Row, error) {
+	return t.result.Rows, nil
+}
+
+// derivedNode is a derived table or a common table expression.
+type derivedNode struct {
+	q    *query
+	plan plan
+	cols []column
+	// isLateral is set for LATERAL derived tables, whose query can use the
+	// columns of the tables before them.
+	isLateral bool
+}
+
+func (d *derivedNode) columns() []column { return d.cols }
+func (d *derivedNode) star() []int       { return allColumns(len(d.cols)) }
+func (d *derivedNode) lateral() bool     { return d.isLateral }
+
+func (d *derivedNode) rows(ctx *execution, left sqltypes.Row) ([]sqltypes.Row, error) {
+	sub, err := d.q.bind(ctx, left)
+	if err != nil {
+		return nil, err
+	}
+	return d.plan.execute(sub)
+}
+
+// jsonTableNode is a JSON_TABLE. Its expression can use the columns of the
+// tables before it, which makes it lateral.
+type jsonTableNode struct {
+	table *evalengine.JSONTable
+	// doc is the expression of the table, which holds the subqueries it has.
+	doc  *expr
+	cols []column
+}
+
+func (j *jsonTableNode) columns() []column { return j.cols }
+func (j *jsonTableNode) star() []int       { return allColumns(len(j.cols)) }
+func (j *jsonTableNode) lateral() bool     { return true }
+
+func (j *jsonTableNode) rows(ctx *execution, left sqltypes.Row) ([]sqltypes.Row, error) {
+	env, err := j.doc.env(ctx, left)
+	if err != nil {
+		return nil, err
+	}
+	return j.table.Rows(env)
+}
+
+// cte is a common table expression.
+type cte struct {
+	q       *query
+	plan    plan
+	columns []string
+}
+
+// with compiles the common table expressions of a WITH, which the statement
+// it is of and the ones after them can read from, and returns a function that
+// restores the ones the query could read from before.
+func (c *compiler) with(with *sqlparser.With, q *query) (func(), error) {
+	saved := q.ctes
+	restore := func() { q.ctes = saved }
+	if with == nil {
+		return restore, nil
+	}
+	if with.Recursive {
+		return nil, vterrors.VT12001("recursive common table expressions")
+	}
+	ctes := make(map[string]*cte, len(saved)+len(with.CTEs))
+	for name, def := range saved {
+		ctes[name] = def
+	}
+	for _, def := range with.CTEs {
+		sub := &query{c: c, ctes: ctes}
+		p, err := c.statement(def.Subquery, sub)
+		if err != nil {
+			return nil, err
+		}
+		compiled := &cte{q: sub, plan: p}
+		for _, col := range def.Columns {
+			compiled.columns = append(compiled.columns, col.String())
+		}
+		ctes[def.ID.String()] = compiled
+	}
+	q.ctes = ctes
+	return restore, nil
+}
+
+// from compiles the tables of a FROM clause, which are joined to each other
+// like with CROSS JOIN. Tables after the first can be lateral to the ones
+// before them. aliases are the aliases of the tables of the clause so far.
+func (c *compiler) from(exprs []sqlparser.TableExpr, q *query, aliases map[string]bool) (node, error) {
+	var from node = dualNode{}
+	for i, te := range exprs {
+		left := &scope{q: q}
+		if i > 0 {
+			left.columns = from.columns()
+		}
+		right, err := c.tableExpr(te, q, left, aliases)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			from = right
+			continue
+		}
+		from = newJoin(from, right)
+	}
+	return from, nil
+}
+
+// tableExpr compiles a table of a FROM clause. Lateral tables can use the
+// columns of the left scope.
+func (c *compiler) tableExpr(te sqlparser.TableExpr, q *query, left *scope, aliases map[string]bool) (node, error) {
+	switch te := te.(type) {
+	case *sqlparser.AliasedTableExpr:
+		switch expr := te.Expr.(type) {
+		case sqlparser.TableName:
+			return c.table(expr, te.As.String(), q, aliases)
+		case *sqlparser.DerivedTable:
+			return c.derived(expr, te, q, left, aliases)
+		}
+	case *sqlparser.ParenTableExpr:
+		return c.from(te.Exprs, q, aliases)
+	case *sqlparser.JoinTableExpr:
+		return c.join(te, q, aliases)
+	case *sqlparser.JSONTableExpr:
+		return c.jsonTable(te, left, aliases)
+	}
+	return nil, vterrors.VT12001("reading from " + sqlparser.String(te))
+}
+
+// alias adds the alias of a table of a FROM clause to the ones of the clause,
+// which must all be different.
+func alias(name string, aliases map[string]bool) error {
+	if aliases[name] {
+		return vterrors.VT03013(name)
+	}
+	aliases[name] = true
+	return nil
+}
+
+func (c *compiler) table(name sqlparser.TableName, as string, q *query, aliases map[string]bool) (node, error) {
+	if as == "" {
+		as = name.Name.String()
+	}
+	if name.Qualifier.IsEmpty() {
+		if def, ok := q.ctes[name.Name.String()]; ok {
+			if err := alias(as, aliases); err != nil {
+				return nil, err
+			}
+			cols, err := derivedColumns(def.plan.columns(), def.columns, as)
+			if err != nil {
+				return nil, err
+			}
+			return &derivedNode{q: def.q, plan: def.plan, cols: cols}, nil
+		}
+		if strings.EqualFold(name.Name.String(), "dual") {
+			return dualNode{}, nil
+		}
+	}
+	result, ok := c.tables.lookup(name)
+	if !ok {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.NoSuchTable, "Table '%s' doesn't exist", sqlparser.String(name))
+	}
+	if err := alias(as, aliases); err != nil {
+		return nil, err
+	}
+	t := &tableNode{result: result}
+	for _, f := range result.Fields {
+		field := f.CloneVT()
+		if field.OrgTable == "" {
+			field.OrgTable = name.Name.String()
+		}
+		if field.OrgName == "" {
+			field.OrgName = field.Name
+		}
+		field.Table = as
+		t.cols = append(t.cols, column{table: as, name: f.Name, field: field, typed: true})
+	}
+	return t, nil
+}
+
+func (c *compiler) derived(dt *sqlparser.DerivedTable, te *sqlparser.AliasedTableExpr, q *query, left *scope, aliases map[string]bool) (node, error) {
+	if te.As.IsEmpty() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Every derived table must have its own alias")
+	}
+	as := te.As.String()
+	// Derived tables cannot use the columns of the tables next to them, unless
+	// they are LATERAL.
+	sub := &query{c: c, ctes: q.ctes}
+	if dt.Lateral {
+		sub.parent = left
+	}
+	p, err := c.statement(dt.Select, sub)
+	if err != nil {
+		return nil, err
+	}
+	if err := alias(as, aliases); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, col := range te.Columns {
+		names = append(names, col.String())
+	}
+	cols, err := derivedColumns(p.columns(), names, as)
+	if err != nil {
+		return nil, err
+	}
+	return &derivedNode{q: sub, plan: p, cols: cols, isLateral: dt.Lateral}, nil
+}
+
+// derivedColumns returns the columns of a derived table with the columns of
+// its query, which are renamed when it has names for them.
+func derivedColumns(cols []column, names []string, as string) ([]column, error) {
+	if len(names) > 0 && len(names) != len(cols) {
+		return nil, vterrors.VT03033()
+	}
+	derived := make([]column, len(cols))
+	seen := map[string]bool{}
+	for i, col := range cols {
+		name := col.name
+		if len(names) > 0 {
+			name = names[i]
+		}
+		if seen[strings.ToLower(name)] {
+			return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DupFieldName, "Duplicate column name '%s'", name)
+		}
+		seen[strings.ToLower(name)] = true
+		field := col.field.CloneVT()
+		field.Name = name
+		field.Table = as
+		derived[i] = column{table: as, name: name, field: field, typed: col.typed}
+	}
+	return derived, nil
+}
+
+func (c *compiler) jsonTable(jt *sqlparser.JSONTableExpr, left *scope, aliases map[string]bool) (node, error) {
+	as := jt.Alias.String()
+	doc, subqueries, err := left.prepare(jt.Expr, fromClause)
+	if err != nil {
+		return nil, err
+	}
+	rewritten := *jt
+	rewritten.Expr = doc
+	table, err := evalengine.TranslateJSONTable(&rewritten, left.config(fromClause))
+	if err != nil {
+		return nil, err
+	}
+	if err := alias(as, aliases); err != nil {
+		return nil, err
+	}
+	n := &jsonTableNode{table: table, doc: &expr{subqueries: subqueries, column: -1}}
+	for _, f := range table.Fields() {
+		n.cols = append(n.cols, column{table: as, name: f.Name, field: f, typed: true})
+	}
+	return n, nil
+}
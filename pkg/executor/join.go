@@ -0,0 +1,492 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+)
+
+type joinKind int8
+
+const (
+	innerJoin joinKind = iota
+	// leftJoin keeps the rows of the left table that match no row of the
+	// right one, with NULLs for the columns of the right one, and rightJoin
+	// the other way around.
+	leftJoin
+	rightJoin
+)
+
+// joinNode is a join of two tables.
+//
+// Joins are nested loops, unless they are hash joins with conditions that
+// compare columns of one table to columns of the other for equality. Hash
+// joins put the rows of the inner table in a hash table by the values they
+// are compared with, and only test the condition for the rows with the same
+// values. Parallel joins split the rows of the outer table between goroutines.
+type joinNode struct {
+	left, right node
+	kind        joinKind
+	hash        bool
+	parallel    bool
+	// on is the condition of the join, nil for cross joins.
+	on *expr
+	// keys are the expressions of the condition that must be equal for rows
+	// to match, for hash joins.
+	keys     []joinKey
+	cols     []column
+	starCols []int
+}
+
+// joinKey is the expressions of the left and the right table a join compares
+// for equality.
+type joinKey struct {
+	left, right *expr
+	// text is set for keys of strings, which are keyed in collation, the one
+	// = compares them in.
+	text      bool
+	collation collations.ID
+}
+
+func newJoin(left, right node) *joinNode {
+	j := &joinNode{left: left, right: right}
+	j.cols = append(append(j.cols, left.columns()...), right.columns()...)
+	j.starCols = append(j.starCols, left.star()...)
+	for _, offset := range right.star() {
+		j.starCols = append(j.starCols, len(left.columns())+offset)
+	}
+	return j
+}
+
+func (j *joinNode) columns() []column { return j.cols }
+func (j *joinNode) star() []int       { return j.starCols }
+func (j *joinNode) lateral() bool     { return false }
+
+func (c *compiler) join(te *sqlparser.JoinTableExpr, q *query, aliases map[string]bool) (node, error) {
+	left, err := c.tableExpr(te.LeftExpr, q, &scope{q: q}, aliases)
+	if err != nil {
+		return nil, err
+	}
+	leftScope := &scope{q: q, columns: left.columns()}
+	right, err := c.tableExpr(te.RightExpr, q, leftScope, aliases)
+	if err != nil {
+		return nil, err
+	}
+	j := newJoin(left, right)
+	switch te.Join {
+	case sqlparser.LeftJoinType, sqlparser.NaturalLeftJoinType, sqlparser.LeftHashJoinType,
+		sqlparser.ParallelLeftJoinType, sqlparser.ParallelLeftHashJoinType:
+		j.kind = leftJoin
+	case sqlparser.RightJoinType, sqlparser.NaturalRightJoinType, sqlparser.RightHashJoinType,
+		sqlparser.ParallelRightJoinType, sqlparser.ParallelRightHashJoinType:
+		j.kind = rightJoin
+	}
+	switch te.Join {
+	case sqlparser.HashJoinType, sqlparser.ParallelHashJoinType, sqlparser.LeftHashJoinType,
+		sqlparser.ParallelLeftHashJoinType, sqlparser.RightHashJoinType, sqlparser.ParallelRightHashJoinType:
+		j.hash = true
+	}
+	switch te.Join {
+	case sqlparser.ParallelNormalJoinType, sqlparser.ParallelHashJoinType, sqlparser.ParallelLeftJoinType,
+		sqlparser.ParallelLeftHashJoinType, sqlparser.ParallelRightJoinType, sqlparser.ParallelRightHashJoinType:
+		j.parallel = true
+	}
+	if right.lateral() && j.kind == rightJoin {
+		return nil, vterrors.VT12001("RIGHT JOIN of a table that depends on the left one")
+	}
+
+	rightScope := &scope{q: q, columns: right.columns()}
+	var using []string
+	switch {
+	case te.Join == sqlparser.NaturalJoinType || te.Join == sqlparser.NaturalLeftJoinType || te.Join == sqlparser.NaturalRightJoinType:
+		for _, lc := range left.columns() {
+			if lc.hidden {
+				continue
+			}
+			for _, rc := range right.columns() {
+				if !rc.hidden && strings.EqualFold(lc.name, rc.name) {
+					using = append(using, lc.name)
+					break
+				}
+			}
+		}
+	case te.Condition != nil && len(te.Condition.Using) > 0:
+		for _, col := range te.Condition.Using {
+			using = append(using, col.String())
+		}
+	case te.Condition != nil && te.Condition.On != nil:
+		s := &scope{q: q, columns: j.cols}
+		if j.on, err = s.compile(te.Condition.On, onClause); err != nil {
+			return nil, err
+		}
+		if j.hash {
+			if err := j.equalities(te.Condition.On, s, leftScope, rightScope); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(using) > 0 {
+		if err := j.using(using, q, leftScope, rightScope); err != nil {
+			return nil, err
+		}
+	}
+	return j, nil
+}
+
+// using makes the condition of a join with USING or of a NATURAL join, which
+// is that the columns with the names are equal. Unqualified names of those
+// columns refer to the one of the table whose rows the join keeps, and * has
+// them first.
+func (j *joinNode) using(names []string, q *query, leftScope, rightScope *scope) error {
+	width := len(leftScope.columns)
+	var conds []sqlparser.Expr
+	var first []int
+	usedLeft, usedRight := map[int]bool{}, map[int]bool{}
+	for _, name := range names {
+		col := &sqlparser.ColName{Name: sqlparser.NewIdentifierCI(name)}
+		l, err := leftScope.find(col, fromClause)
+		if err != nil {
+			return err
+		}
+		r, err := rightScope.find(col, fromClause)
+		if err != nil {
+			return err
+		}
+		if l < 0 || r < 0 {
+			return unknownColumn(name, fromClause)
+		}
+		conds = append(conds, &sqlparser.ComparisonExpr{Operator: sqlparser.EqualOp, Left: &sqlparser.Offset{V: l}, Right: &sqlparser.Offset{V: width + r}})
+		lx, err := leftScope.compile(&sqlparser.Offset{V: l}, fromClause)
+		if err != nil {
+			return err
+		}
+		rx, err := rightScope.compile(&sqlparser.Offset{V: r}, fromClause)
+		if err != nil {
+			return err
+		}
+		j.addKey(lx, rx, leftScope, rightScope)
+		usedLeft[l], usedRight[r] = true, true
+		if j.kind == rightJoin {
+			j.cols[l].hidden = true
+			first = append(first, width+r)
+		} else {
+			j.cols[width+r].hidden = true
+			first = append(first, l)
+		}
+	}
+	s := &scope{q: q, columns: j.cols}
+	var err error
+	if j.on, err = s.compile(sqlparser.AndExpressions(conds...), onClause); err != nil {
+		return err
+	}
+	if !j.hash {
+		j.keys = nil
+	}
+	j.starCols = first
+	for _, offset := range j.left.star() {
+		if !usedLeft[offset] {
+			j.starCols = append(j.starCols, offset)
+		}
+	}
+	for _, offset := range j.right.star() {
+		if !usedRight[offset] {
+			j.starCols = append(j.starCols, width+offset)
+		}
+	}
+	return nil
+}
+
+type side int8
+
+const (
+	noSide side = iota
+	leftSide
+	rightSide
+	bothSides
+)
+
+// equalities finds the conditions of the ON of a hash join that compare an
+// expression of the left table with an expression of the right one for
+// equality, in types whose values are equal when their keys are.
+func (j *joinNode) equalities(on sqlparser.Expr, s, leftScope, rightScope *scope) error {
+	for _, cond := range sqlparser.SplitAndExpression(nil, on) {
+		cmp, ok := cond.(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualOp {
+			continue
+		}
+		l, r := cmp.Left, cmp.Right
+		switch {
+		case j.sideOf(l, s) == leftSide && j.sideOf(r, s) == rightSide:
+		case j.sideOf(l, s) == rightSide && j.sideOf(r, s) == leftSide:
+			l, r = r, l
+		default:
+			continue
+		}
+		lx, err := leftScope.compile(l, onClause)
+		if err != nil {
+			return err
+		}
+		rx, err := rightScope.compile(r, onClause)
+		if err != nil {
+			return err
+		}
+		j.addKey(lx, rx, leftScope, rightScope)
+	}
+	return nil
+}
+
+// addKey adds the key of a condition that an expression of the left table is
+// equal to one of the right table, when the types of the expressions tell
+// that equal values have the same keys. The keys of strings are only the
+// same in the collation the strings are compared in, which has to be known.
+func (j *joinNode) addKey(lx, rx *expr, leftScope, rightScope *scope) {
+	class := keyClass(leftScope.typeOf(lx))
+	if class == 0 || class != keyClass(rightScope.typeOf(rx)) {
+		return
+	}
+	key := joinKey{left: lx, right: rx}
+	if class == textClass {
+		col, ok := evalengine.KeyCollation(lx.eval, rx.eval)
+		if !ok {
+			return
+		}
+		key.text, key.collation = true, col
+	}
+	j.keys = append(j.keys, key)
+}
+
+// sideOf returns the table of a join whose columns an expression uses, which
+// is noSide when it uses none, or uses columns of enclosing queries or
+// subqueries, and bothSides when it uses the columns of both tables.
+func (j *joinNode) sideOf(e sqlparser.Expr, s *scope) side {
+	found := noSide
+	valid := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery:
+			valid = false
+		case *sqlparser.ColName:
+			offset, err := s.find(node, onClause)
+			switch {
+			case err != nil || offset < 0:
+				valid = false
+			case offset < len(j.left.columns()):
+				found |= leftSide
+			default:
+				found |= rightSide
+			}
+		}
+		return valid, nil
+	}, e)
+	if !valid {
+		return noSide
+	}
+	return found
+}
+
+// textClass is the class of strings that are not binary.
+const textClass = 4
+
+// keyClass returns the class of types whose values have the same keys when
+// they are equal, or 0 for types whose keys do not tell.
+func keyClass(typ querypb.Type, known bool) int {
+	switch {
+	case !known:
+		return 0
+	case sqltypes.IsNumber(typ), typ == sqltypes.Year:
+		return 1
+	case typ == sqltypes.Time:
+		return 2
+	case sqltypes.IsDateOrTime(typ):
+		return 3
+	case sqltypes.IsText(typ), typ == sqltypes.Enum, typ == sqltypes.Set:
+		return textClass
+	case sqltypes.IsBinary(typ):
+		return 5
+	}
+	return 0
+}
+
+func (j *joinNode) rows(ctx *execution, _ sqltypes.Row) ([]sqltypes.Row, error) {
+	leftRows, err := j.left.rows(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	var rightRows []sqltypes.Row
+	if !j.right.lateral() {
+		if rightRows, err = j.right.rows(ctx, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	// The outer table is the one whose rows are matched with the rows of the
+	// inner one, which is the one whose rows a left or right join keeps.
+	outer, inner := leftRows, rightRows
+	outerLeft := true
+	if j.kind == rightJoin {
+		outer, inner = inner, outer
+		outerLeft = false
+	}
+
+	var table map[string][]sqltypes.Row
+	if len(j.keys) > 0 && !j.right.lateral() {
+		table = make(map[string][]sqltypes.Row, len(inner))
+		for _, row := range inner {
+			key, err := joinKeyOf(ctx, row, j.keys, !outerLeft)
+			if err != nil {
+				return nil, err
+			}
+			if key != nil {
+				table[string(key)] = append(table[string(key)], row)
+			}
+		}
+	}
+
+	return j.fanOut(outer, func(row sqltypes.Row) ([]sqltypes.Row, error) {
+		candidates := inner
+		switch {
+		case j.right.lateral():
+			lateral, err := j.right.rows(ctx, row)
+			if err != nil {
+				return nil, err
+			}
+			candidates = lateral
+		case table != nil:
+			key, err := joinKeyOf(ctx, row, j.keys, outerLeft)
+			if err != nil {
+				return nil, err
+			}
+			candidates = table[string(key)]
+		}
+		var matched []sqltypes.Row
+		for _, other := range candidates {
+			joined := j.concat(row, other)
+			if j.on != nil {
+				t, err := j.on.truth(ctx, joined)
+				if err != nil {
+					return nil, err
+				}
+				if t != evalengine.True {
+					continue
+				}
+			}
+			matched = append(matched, joined)
+		}
+		if len(matched) == 0 && j.kind != innerJoin {
+			matched = append(matched, j.concat(row, nil))
+		}
+		return matched, nil
+	})
+}
+
+// joinKeyOf returns the key of the values of the expressions of keys for a
+// row, which is nil when any of them is NULL, because NULL equals nothing.
+func joinKeyOf(ctx *execution, row sqltypes.Row, keys []joinKey, left bool) ([]byte, error) {
+	var key []byte
+	for _, k := range keys {
+		x := k.right
+		if left {
+			x = k.left
+		}
+		v, err := x.typed(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if v.IsNull() {
+			return nil, nil
+		}
+		if k.text {
+			v = v.WithCollation(k.collation)
+		}
+		key = evalengine.AppendKey(key, v)
+	}
+	return key, nil
+}
+
+// concat returns the joined row of a row of the outer table and a row of the
+// inner one, which has NULLs for the columns of the inner table when it is
+// nil.
+func (j *joinNode) concat(row, other sqltypes.Row) sqltypes.Row {
+	width := len(j.left.columns())
+	joined := make(sqltypes.Row, len(j.cols))
+	if j.kind == rightJoin {
+		copy(joined, other)
+		copy(joined[width:], row)
+	} else {
+		copy(joined, row)
+		copy(joined[width:], other)
+	}
+	return joined
+}
+
+// fanOut returns the rows matched with rows of the outer table, in the order
+// of those rows. Parallel joins match them in goroutines.
+func (j *joinNode) fanOut(rows []sqltypes.Row, match func(sqltypes.Row) ([]sqltypes.Row, error)) ([]sqltypes.Row, error) {
+	workers := 1
+	if j.parallel {
+		workers = min(runtime.GOMAXPROCS(0), len(rows))
+	}
+	if workers <= 1 {
+		var result []sqltypes.Row
+		for _, row := range rows {
+			matched, err := match(row)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, matched...)
+		}
+		return result, nil
+	}
+
+	size := (len(rows) + workers - 1) / workers
+	results := make([][]sqltypes.Row, workers)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for w := 0; w*size < len(rows); w++ {
+		chunk := rows[w*size : min((w+1)*size, len(rows))]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, row := range chunk {
+				matched, err := match(row)
+				if err != nil {
+					errs[w] = err
+					return
+				}
+				results[w] = append(results[w], matched...)
+			}
+		}()
+	}
+	wg.Wait()
+	var result []sqltypes.Row
+	for w := range results {
+		if errs[w] != nil {
+			return nil, errs[w]
+		}
+		result = append(result, results[w]...)
+	}
+	return result, nil
+}
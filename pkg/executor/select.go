@@ -0,0 +1,656 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// selectPlan is a compiled SELECT.
+//
+// The rows of its FROM clause are filtered by WHERE, and then put in groups
+// when it has GROUP BY or aggregate functions. The rows of groups are the
+// first row of the group followed by the values of the aggregate functions,
+// which expressions refer to by offset. The values of the window functions
+// are added after the columns of the rows that HAVING keeps, which are then
+// projected, made DISTINCT, ordered and limited.
+type selectPlan struct {
+	from  node
+	where *expr
+	// width is the number of columns of the rows of the FROM clause.
+	width      int
+	grouped    bool
+	groupBy    []*expr
+	aggregates []*aggregate
+	having     *expr
+	// windows are the windows of the window functions, and windowFuncs the
+	// number of functions.
+	windows     []*window
+	windowFuncs int
+	project     []*expr
+	cols        []column
+	distinct    bool
+	orderBy     []ordering
+	limit       *limit
+}
+
+// selectItem is an expression of the select list, with * expanded.
+type selectItem struct {
+	name string
+	expr sqlparser.Expr
+}
+
+func (p *selectPlan) columns() []column { return p.cols }
+
+func (c *compiler) selectPlan(sel *sqlparser.Select, q *query) (*selectPlan, error) {
+	restore, err := c.with(sel.With, q)
+	if err != nil {
+		return nil, err
+	}
+	defer restore()
+	if sel.Into != nil {
+		return nil, vterrors.VT12001("SELECT ... INTO")
+	}
+	from, err := c.from(sel.From, q, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+	p := &selectPlan{from: from, width: len(from.columns()), distinct: sel.Distinct}
+	s := &scope{q: q, columns: from.columns()}
+
+	if sel.Where != nil {
+		if hasAggregates(sel.Where.Expr) {
+			return nil, invalidGroupFuncUse()
+		}
+		if name, ok := windowFunction(sel.Where.Expr); ok {
+			return nil, invalidWindowFuncUse(name)
+		}
+		if p.where, err = s.compile(sel.Where.Expr, whereClause); err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := q.c.selectItems(sel, from)
+	if err != nil {
+		return nil, err
+	}
+	defs, err := namedWindows(sel)
+	if err != nil {
+		return nil, err
+	}
+	windowed := false
+	for i := range items {
+		if items[i].expr, err = defs.inline(items[i].expr); err != nil {
+			return nil, err
+		}
+		_, ok := windowFunction(items[i].expr)
+		windowed = windowed || ok
+	}
+	orderExprs := make([]sqlparser.Expr, len(sel.OrderBy))
+	for i, order := range sel.OrderBy {
+		if orderExprs[i], err = defs.inline(order.Expr); err != nil {
+			return nil, err
+		}
+		_, ok := windowFunction(orderExprs[i])
+		windowed = windowed || ok
+	}
+	p.grouped = sel.GroupBy != nil && len(sel.GroupBy.Exprs) > 0
+	for _, item := range items {
+		p.grouped = p.grouped || hasAggregates(item.expr)
+	}
+	if sel.Having != nil {
+		p.grouped = p.grouped || hasAggregates(sel.Having.Expr)
+	}
+	for _, e := range orderExprs {
+		p.grouped = p.grouped || hasAggregates(e)
+	}
+
+	// post is the scope of the rows of groups, or of the rows of the FROM
+	// clause when there are no groups, which the values of the window
+	// functions are added to.
+	post := s
+	if p.grouped || windowed {
+		post = &scope{q: q, columns: slices.Clone(s.columns)}
+	}
+	agg := &aggregation{source: s, post: post}
+	if p.grouped {
+		if err := p.compileGroupBy(sel, items, s); err != nil {
+			return nil, err
+		}
+	}
+
+	// exprs are the expressions of the select list with their aggregate
+	// functions replaced by their offsets, which aliases stand for.
+	exprs := make([]sqlparser.Expr, len(items))
+	aliases := map[string]int{}
+	for i, item := range items {
+		e := item.expr
+		if p.grouped {
+			if e, err = agg.replace(e); err != nil {
+				return nil, err
+			}
+		}
+		exprs[i] = e
+		name := strings.ToLower(item.name)
+		if _, ok := aliases[name]; ok {
+			aliases[name] = -1
+		} else {
+			aliases[name] = i
+		}
+	}
+
+	if sel.Having != nil {
+		e := substituteAliases(sel.Having.Expr, aliases, exprs, post, true)
+		if name, ok := windowFunction(e); ok {
+			return nil, invalidWindowFuncUse(name)
+		}
+		if p.grouped {
+			if e, err = agg.replace(e); err != nil {
+				return nil, err
+			}
+		}
+		if p.having, err = post.compile(e, havingCl); err != nil {
+			return nil, err
+		}
+	}
+
+	// The expressions of ORDER BY are compiled after the window functions are
+	// replaced, which must be after all the aggregate functions are.
+	for i, order := range sel.OrderBy {
+		o := ordering{column: -1, desc: order.Direction == sqlparser.DescOrder}
+		orderExpr := orderExprs[i]
+		orderExprs[i] = nil
+		if pos, ok := position(order.Expr); ok {
+			if pos < 1 || pos > len(items) {
+				return nil, unknownColumn(strconv.Itoa(pos), orderClause)
+			}
+			o.column = pos - 1
+		} else if col, ok := order.Expr.(*sqlparser.ColName); ok && isAlias(aliases, col) {
+			o.column = aliases[col.Name.Lowered()]
+		} else {
+			if p.distinct {
+				if err := distinctOrder(i, orderExpr, items, s); err != nil {
+					return nil, err
+				}
+			}
+			e := substituteAliases(orderExpr, aliases, exprs, post, true)
+			if p.grouped {
+				if e, err = agg.replace(e); err != nil {
+					return nil, err
+				}
+			}
+			orderExprs[i] = e
+		}
+		p.orderBy = append(p.orderBy, o)
+	}
+
+	if windowed {
+		win := &windowing{post: post}
+		for i, e := range exprs {
+			if exprs[i], err = win.replace(e); err != nil {
+				return nil, err
+			}
+		}
+		for i, e := range orderExprs {
+			if e == nil {
+				continue
+			}
+			if orderExprs[i], err = win.replace(e); err != nil {
+				return nil, err
+			}
+		}
+		p.windows, p.windowFuncs = win.windows, len(win.funcs)
+	}
+	for i, e := range orderExprs {
+		if e == nil {
+			continue
+		}
+		if p.orderBy[i].expr, err = post.compile(e, orderClause); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, e := range exprs {
+		x, err := post.compile(e, fieldList)
+		if err != nil {
+			return nil, err
+		}
+		p.project = append(p.project, x)
+		p.cols = append(p.cols, resultColumn(items[i].name, x, post))
+	}
+	p.aggregates = agg.aggregates
+
+	if p.limit, err = compileLimit(sel.Limit, q); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func invalidGroupFuncUse() error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.InvalidGroupFuncUse, "Invalid use of group function")
+}
+
+// distinctOrder checks that the nth expression of the ORDER BY of a SELECT
+// DISTINCT is in the select list or only uses columns that are, since the
+// rows DISTINCT removes have other values for the rest.
+func distinctOrder(n int, e sqlparser.Expr, items []selectItem, s *scope) error {
+	selected := map[int]bool{}
+	for _, item := range items {
+		if sqlparser.Equals.Expr(e, item.expr) {
+			return nil
+		}
+		switch expr := item.expr.(type) {
+		case *sqlparser.Offset:
+			selected[expr.V] = true
+		case *sqlparser.ColName:
+			if offset, err := s.find(expr, fieldList); err == nil && offset >= 0 {
+				selected[offset] = true
+			}
+		}
+	}
+	if hasAggregates(e) {
+		return fieldInOrderNotSelect(n, "contains aggregate function")
+	}
+	var err error
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery:
+			return false, nil
+		case *sqlparser.ColName:
+			// names that are not columns of the FROM clause are aliases or
+			// outer columns, which the compilation of the expression checks
+			offset, ferr := s.find(node, orderClause)
+			if err == nil && ferr == nil && offset >= 0 && !selected[offset] {
+				col := s.columns[offset]
+				name := col.name
+				if col.table != "" {
+					name = col.table + "." + name
+				}
+				err = fieldInOrderNotSelect(n, "references column '"+name+"' which is not in SELECT list")
+			}
+		}
+		return true, nil
+	}, e)
+	return err
+}
+
+func fieldInOrderNotSelect(n int, reason string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.FieldInOrderNotSelect, "Expression #%d of ORDER BY clause is not in SELECT list, %s; this is incompatible with DISTINCT", n+1, reason)
+}
+
+// selectItems returns the expressions of the select list of a SELECT, with *
+// expanded to the columns it stands for.
+func (c *compiler) selectItems(sel *sqlparser.Select, from node) ([]selectItem, error) {
+	var items []selectItem
+	cols := from.columns()
+	for _, se := range sel.GetColumns() {
+		switch se := se.(type) {
+		case *sqlparser.AliasedExpr:
+			name := se.As.String()
+			if name == "" {
+				if col, ok := se.Expr.(*sqlparser.ColName); ok {
+					name = col.Name.String()
+				} else {
+					name = c.text(se.Expr)
+				}
+			}
+			items = append(items, selectItem{name: name, expr: se.Expr})
+		case *sqlparser.StarExpr:
+			if se.TableName.IsEmpty() {
+				if len(sel.From) == 0 {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "No tables used")
+				}
+				for _, offset := range from.star() {
+					items = append(items, selectItem{name: cols[offset].name, expr: &sqlparser.Offset{V: offset}})
+				}
+				continue
+			}
+			found := false
+			for offset, col := range cols {
+				if col.table != se.TableName.Name.String() {
+					continue
+				}
+				if se.TableName.Qualifier.NotEmpty() && col.field.Database != "" && col.field.Database != se.TableName.Qualifier.String() {
+					continue
+				}
+				items = append(items, selectItem{name: col.name, expr: &sqlparser.Offset{V: offset}})
+				found = true
+			}
+			if !found {
+				return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadTableError, "Unknown table '%s'", sqlparser.String(se.TableName))
+			}
+		default:
+			return nil, vterrors.VT12001(sqlparser.String(se))
+		}
+	}
+	return items, nil
+}
+
+// compileGroupBy compiles the GROUP BY of a SELECT, whose expressions can be
+// positions in the select list and names of its aliases that are not names of
+// columns.
+func (p *selectPlan) compileGroupBy(sel *sqlparser.Select, items []selectItem, s *scope) error {
+	if sel.GroupBy == nil {
+		return nil
+	}
+	if sel.GroupBy.WithRollup {
+		return vterrors.VT12001("GROUP BY ... WITH ROLLUP")
+	}
+	aliases := map[string]int{}
+	exprs := make([]sqlparser.Expr, len(items))
+	for i, item := range items {
+		exprs[i] = item.expr
+		name := strings.ToLower(item.name)
+		if _, ok := aliases[name]; ok {
+			aliases[name] = -1
+		} else {
+			aliases[name] = i
+		}
+	}
+	for _, e := range sel.GroupBy.Exprs {
+		if pos, ok := position(e); ok {
+			if pos < 1 || pos > len(items) {
+				return unknownColumn(strconv.Itoa(pos), groupClause)
+			}
+			e = items[pos-1].expr
+		} else {
+			e = substituteAliases(e, aliases, exprs, s, false)
+		}
+		if hasAggregates(e) {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongGroupField, "Can't group on '%s'", sqlparser.String(e))
+		}
+		if name, ok := windowFunction(e); ok {
+			return invalidWindowFuncUse(name)
+		}
+		x, err := s.compile(e, groupClause)
+		if err != nil {
+			return err
+		}
+		p.groupBy = append(p.groupBy, x)
+	}
+	return nil
+}
+
+// position returns the position an integer literal of GROUP BY or ORDER BY
+// stands for.
+func position(e sqlparser.Expr) (int, bool) {
+	lit, ok := e.(*sqlparser.Literal)
+	if !ok || lit.Type != sqlparser.IntVal {
+		return 0, false
+	}
+	pos, err := strconv.Atoi(lit.Val)
+	return pos, err == nil
+}
+
+// isAlias reports whether a name is unqualified and the name of exactly one
+// expression of the select list.
+func isAlias(aliases map[string]int, col *sqlparser.ColName) bool {
+	i, ok := aliases[col.Name.Lowered()]
+	return ok && i >= 0 && col.Qualifier.IsEmpty()
+}
+
+// substituteAliases replaces the unqualified names of an expression that are
+// names of expressions of the select list with those expressions. Names of
+// columns of the scope are only replaced when preferAlias is set.
+func substituteAliases(e sqlparser.Expr, aliases map[string]int, exprs []sqlparser.Expr, s *scope, preferAlias bool) sqlparser.Expr {
+	return sqlparser.Rewrite(sqlparser.CloneExpr(e), func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Subquery:
+			return false
+		case *sqlparser.ColName:
+			i, ok := aliases[node.Name.Lowered()]
+			if !ok || i < 0 || !node.Qualifier.IsEmpty() {
+				return false
+			}
+			if !preferAlias {
+				if offset, err := s.find(node, groupClause); err != nil || offset >= 0 {
+					return false
+				}
+			}
+			cursor.Replace(sqlparser.CloneExpr(exprs[i]))
+			return false
+		}
+		return true
+	}, nil).(sqlparser.Expr)
+}
+
+// resultColumn returns the column of the result of a query for an expression
+// of its select list.
+func resultColumn(name string, x *expr, s *scope) column {
+	if x.column >= 0 {
+		col := s.columns[x.column]
+		field := col.field.CloneVT()
+		field.Name = name
+		return column{name: name, field: field, typed: col.typed}
+	}
+	typ, typed := evalengine.TypeOf(x.eval)
+	if !typed {
+		typ = sqltypes.Null
+	}
+	field := &querypb.Field{Name: name, Type: typ}
+	if col, ok := evalengine.CollationOf(x.eval); ok && sqltypes.IsText(typ) {
+		field.Charset = uint32(col)
+	}
+	return column{name: name, field: field, typed: typed}
+}
+
+func (p *selectPlan) execute(ctx *execution) ([]sqltypes.Row, error) {
+	rows, err := p.from.rows(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if p.where != nil {
+		if rows, err = filter(ctx, rows, p.where); err != nil {
+			return nil, err
+		}
+	}
+	if p.grouped {
+		if rows, err = p.group(ctx, rows); err != nil {
+			return nil, err
+		}
+	}
+	if p.having != nil {
+		if rows, err = filter(ctx, rows, p.having); err != nil {
+			return nil, err
+		}
+	}
+	if len(p.windows) > 0 {
+		if rows, err = p.window(ctx, rows); err != nil {
+			return nil, err
+		}
+	}
+
+	result := make([]sortRow, 0, len(rows))
+	for _, row := range rows {
+		projected := make(sqltypes.Row, len(p.project))
+		values := make([]evalengine.TypedValue, len(p.project))
+		for i, x := range p.project {
+			if projected[i], values[i], err = x.evaluate(ctx, row); err != nil {
+				return nil, err
+			}
+		}
+		keys := make([]evalengine.TypedValue, len(p.orderBy))
+		for i, o := range p.orderBy {
+			if o.column >= 0 {
+				keys[i] = values[o.column]
+			} else if keys[i], err = o.expr.typed(ctx, row); err != nil {
+				return nil, err
+			}
+		}
+		result = append(result, sortRow{row: projected, values: values, keys: keys})
+	}
+	return finish(ctx, result, p.distinct, p.orderBy, p.limit)
+}
+
+// filter returns the rows a condition is TRUE for.
+func filter(ctx *execution, rows []sqltypes.Row, cond *expr) ([]sqltypes.Row, error) {
+	var filtered []sqltypes.Row
+	for _, row := range rows {
+		t, err := cond.truth(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if t == evalengine.True {
+			filtered = append(filtered, row)
+		}
+	}
+	return filtered, nil
+}
+
+// ordering is an expression of ORDER BY.
+type ordering struct {
+	expr *expr
+	// column is the offset of the column of the result the rows are ordered
+	// by, or -1 when they are ordered by an expression.
+	column int
+	desc   bool
+}
+
+// sortRow is a row of a result with its values typed, which DISTINCT tells
+// rows apart by, and the values it is ordered by.
+type sortRow struct {
+	row    sqltypes.Row
+	values []evalengine.TypedValue
+	keys   []evalengine.TypedValue
+}
+
+// finish makes the rows of a result DISTINCT, orders them and limits them.
+func finish(ctx *execution, rows []sortRow, distinct bool, orderBy []ordering, l *limit) ([]sqltypes.Row, error) {
+	if distinct {
+		seen := map[string]bool{}
+		kept := rows[:0]
+		for _, row := range rows {
+			key := rowKey(row.values)
+			if !seen[string(key)] {
+				seen[string(key)] = true
+				kept = append(kept, row)
+			}
+		}
+		rows = kept
+	}
+	if len(orderBy) > 0 {
+		var err error
+		env := ctx.compareEnv()
+		slices.SortStableFunc(rows, func(a, b sortRow) int {
+			for i, o := range orderBy {
+				n, cmpErr := env.NullsafeCompare(a.keys[i], b.keys[i])
+				if cmpErr != nil {
+					err = cmpErr
+					return 0
+				}
+				if n != 0 {
+					if o.desc {
+						return -n
+					}
+					return n
+				}
+			}
+			return 0
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	rows, err := l.apply(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]sqltypes.Row, len(rows))
+	for i, row := range rows {
+		result[i] = row.row
+	}
+	return result, nil
+}
+
+// rowKey returns the key of the values of a row, which is the same for rows
+// whose values are all equal, NULLs included.
+func rowKey(values []evalengine.TypedValue) []byte {
+	var key []byte
+	for _, v := range values {
+		key = evalengine.AppendKey(key, v)
+	}
+	return key
+}
+
+// limit is a LIMIT, whose offset and count are integer literals or bind
+// variables.
+type limit struct {
+	offset, count *expr
+}
+
+func compileLimit(l *sqlparser.Limit, q *query) (*limit, error) {
+	if l == nil {
+		return nil, nil
+	}
+	s := &scope{q: &query{c: q.c}}
+	compiled := &limit{}
+	var err error
+	if l.Offset != nil {
+		if compiled.offset, err = s.compile(l.Offset, fieldList); err != nil {
+			return nil, err
+		}
+	}
+	if l.Rowcount != nil {
+		if compiled.count, err = s.compile(l.Rowcount, fieldList); err != nil {
+			return nil, err
+		}
+	}
+	return compiled, nil
+}
+
+func (l *limit) apply(ctx *execution, rows []sortRow) ([]sortRow, error) {
+	if l == nil {
+		return rows, nil
+	}
+	if l.offset != nil {
+		offset, err := limitValue(ctx, l.offset)
+		if err != nil {
+			return nil, err
+		}
+		rows = rows[min(offset, len(rows)):]
+	}
+	if l.count != nil {
+		count, err := limitValue(ctx, l.count)
+		if err != nil {
+			return nil, err
+		}
+		rows = rows[:min(count, len(rows))]
+	}
+	return rows, nil
+}
+
+func limitValue(ctx *execution, x *expr) (int, error) {
+	v, err := x.value(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	if v.IsIntegral() {
+		if u, err := v.ToUint64(); err == nil {
+			return int(min(u, uint64(int(^uint(0)>>1)))), nil
+		}
+	}
+	return 0, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongArguments, "Incorrect arguments to LIMIT")
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"strconv"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// unionPlan is a UNION, whose columns have the names of the ones of its left
+// side and types both sides can be converted to.
+type unionPlan struct {
+	left, right plan
+	cols        []column
+	// convert is set for the columns whose values are converted to the type
+	// of the column.
+	convert  []bool
+	distinct bool
+	orderBy  []ordering
+	limit    *limit
+}
+
+func (p *unionPlan) columns() []column { return p.cols }
+
+func (c *compiler) union(u *sqlparser.Union, q *query) (*unionPlan, error) {
+	restore, err := c.with(u.With, q)
+	if err != nil {
+		return nil, err
+	}
+	defer restore()
+
+	p := &unionPlan{distinct: u.Distinct}
+	if p.left, err = c.statement(u.Left, q); err != nil {
+		return nil, err
+	}
+	if p.right, err = c.statement(u.Right, q); err != nil {
+		return nil, err
+	}
+	left, right := p.left.columns(), p.right.columns()
+	if len(left) != len(right) {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.WrongNumberOfColumnsInSelect, "The used SELECT statements have a different number of columns")
+	}
+	p.cols = make([]column, len(left))
+	p.convert = make([]bool, len(left))
+	for i := range left {
+		field := left[i].field.CloneVT()
+		field.Table, field.OrgTable, field.OrgName = "", "", ""
+		col := column{name: left[i].name, field: field}
+		if left[i].typed && right[i].typed {
+			col.typed = true
+			field.Type = unionType(left[i].field.Type, right[i].field.Type)
+			p.convert[i] = field.Type != left[i].field.Type || field.Type != right[i].field.Type
+		}
+		p.cols[i] = col
+	}
+
+	s := &scope{q: q, columns: p.cols}
+	for _, order := range u.OrderBy {
+		o := ordering{column: -1, desc: order.Direction == sqlparser.DescOrder}
+		if pos, ok := position(order.Expr); ok {
+			if pos < 1 || pos > len(p.cols) {
+				return nil, unknownColumn(strconv.Itoa(pos), orderClause)
+			}
+			o.column = pos - 1
+		} else if o.expr, err = s.compile(order.Expr, orderClause); err != nil {
+			return nil, err
+		}
+		p.orderBy = append(p.orderBy, o)
+	}
+	if p.limit, err = compileLimit(u.Limit, q); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// unionType returns the type the values of two columns of the sides of a
+// UNION are converted to.
+func unionType(a, b querypb.Type) querypb.Type {
+	switch {
+	case a == b:
+		return a
+	case a == sqltypes.Null:
+		return b
+	case b == sqltypes.Null:
+		return a
+	case sqltypes.IsNumber(a) && sqltypes.IsNumber(b):
+		switch {
+		case sqltypes.IsFloat(a) || sqltypes.IsFloat(b):
+			return sqltypes.Float64
+		case a == sqltypes.Decimal || b == sqltypes.Decimal:
+			return sqltypes.Decimal
+		case sqltypes.IsSigned(a) && sqltypes.IsSigned(b):
+			return sqltypes.Int64
+		case sqltypes.IsUnsigned(a) && sqltypes.IsUnsigned(b):
+			return sqltypes.Uint64
+		}
+		return sqltypes.Decimal
+	case sqltypes.IsDate(a) && sqltypes.IsDate(b):
+		return sqltypes.Datetime
+	case sqltypes.IsBinary(a) || sqltypes.IsBinary(b):
+		return sqltypes.VarBinary
+	}
+	return sqltypes.VarChar
+}
+
+func (p *unionPlan) execute(ctx *execution) ([]sqltypes.Row, error) {
+	var rows []sortRow
+	for _, side := range []plan{p.left, p.right} {
+		result, err := side.execute(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range result {
+			rows = append(rows, sortRow{row: p.convertRow(row)})
+		}
+	}
+	for i := range rows {
+		values := make([]evalengine.TypedValue, len(p.cols))
+		for k, v := range rows[i].row {
+			var err error
+			if values[k], err = evalengine.NewTypedValue(v, collations.ID(p.cols[k].field.Charset)); err != nil {
+				return nil, err
+			}
+		}
+		keys := make([]evalengine.TypedValue, len(p.orderBy))
+		for k, o := range p.orderBy {
+			if o.column >= 0 {
+				keys[k] = values[o.column]
+				continue
+			}
+			var err error
+			if keys[k], err = o.expr.typed(ctx, rows[i].row); err != nil {
+				return nil, err
+			}
+		}
+		rows[i].values, rows[i].keys = values, keys
+	}
+	return finish(ctx, rows, p.distinct, p.orderBy, p.limit)
+}
+
+// convertRow converts the values of a row of a side of the UNION to the types
+// of its columns.
+func (p *unionPlan) convertRow(row sqltypes.Row) sqltypes.Row {
+	converted := row
+	for i, v := range row {
+		if !p.convert[i] || v.IsNull() || v.Type() == p.cols[i].field.Type {
+			continue
+		}
+		if &converted[0] == &row[0] {
+			converted = append(sqltypes.Row(nil), row...)
+		}
+		raw := v.Raw()
+		if v.Type() == sqltypes.Date && p.cols[i].field.Type == sqltypes.Datetime {
+			raw = append(append([]byte(nil), raw...), " 00:00:00"...)
+		}
+		converted[i] = sqltypes.MakeTrusted(p.cols[i].field.Type, raw)
+	}
+	return converted
+}
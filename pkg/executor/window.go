@@ -0,0 +1,951 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package executor
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/format"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// unnamedWindow is how MySQL names windows that have no name in errors.
+const unnamedWindow = "<unnamed window>"
+
+// overClause returns the OVER clause of a window function, or nil when the
+// node is not one. Aggregate functions are window functions when they have an
+// OVER clause.
+func overClause(node sqlparser.SQLNode) *sqlparser.OverClause {
+	switch node := node.(type) {
+	case *sqlparser.ArgumentLessWindowExpr:
+		return node.OverClause
+	case *sqlparser.NtileExpr:
+		return node.OverClause
+	case *sqlparser.LagLeadExpr:
+		return node.OverClause
+	case *sqlparser.FirstOrLastValueExpr:
+		return node.OverClause
+	case *sqlparser.NTHValueExpr:
+		return node.OverClause
+	case *sqlparser.Count:
+		return node.OverClause
+	case *sqlparser.CountStar:
+		return node.OverClause
+	case *sqlparser.Sum:
+		return node.OverClause
+	case *sqlparser.Avg:
+		return node.OverClause
+	case *sqlparser.Min:
+		return node.OverClause
+	case *sqlparser.Max:
+		return node.OverClause
+	case *sqlparser.BitAnd:
+		return node.OverClause
+	case *sqlparser.BitOr:
+		return node.OverClause
+	case *sqlparser.BitXor:
+		return node.OverClause
+	case *sqlparser.Std:
+		return node.OverClause
+	case *sqlparser.StdDev:
+		return node.OverClause
+	case *sqlparser.StdPop:
+		return node.OverClause
+	case *sqlparser.StdSamp:
+		return node.OverClause
+	case *sqlparser.VarPop:
+		return node.OverClause
+	case *sqlparser.VarSamp:
+		return node.OverClause
+	case *sqlparser.Variance:
+		return node.OverClause
+	case *sqlparser.JSONArrayAgg:
+		return node.OverClause
+	case *sqlparser.JSONObjectAgg:
+		return node.OverClause
+	}
+	return nil
+}
+
+// windowFuncName returns the name of a window function.
+func windowFuncName(fn sqlparser.SQLNode) string {
+	switch fn := fn.(type) {
+	case *sqlparser.ArgumentLessWindowExpr:
+		return fn.Type.ToString()
+	case *sqlparser.NtileExpr:
+		return "ntile"
+	case *sqlparser.LagLeadExpr:
+		return fn.Type.ToString()
+	case *sqlparser.FirstOrLastValueExpr:
+		return fn.Type.ToString()
+	case *sqlparser.NTHValueExpr:
+		return "nth_value"
+	case sqlparser.AggrFunc:
+		return fn.AggrName()
+	}
+	return sqlparser.String(fn)
+}
+
+// windowFunction returns the name of a window function an expression has
+// that is not in a subquery.
+func windowFunction(e sqlparser.Expr) (string, bool) {
+	name, found := "", false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node.(type) {
+		case *sqlparser.Subquery, *sqlparser.Offset:
+			return false, nil
+		}
+		if overClause(node) != nil {
+			name, found = windowFuncName(node), true
+		}
+		return !found, nil
+	}, e)
+	return name, found
+}
+
+func invalidWindowFuncUse(name string) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "You cannot use the window function '%s' in this context.", name)
+}
+
+func windowError(format string, args ...any) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, format, args...)
+}
+
+// windowDefs are the windows of the WINDOW clause of a SELECT, which windows
+// can be based on.
+type windowDefs struct {
+	defs     map[string]*sqlparser.WindowDefinition
+	resolved map[string]*sqlparser.WindowSpecification
+	visiting map[string]bool
+}
+
+// namedWindows returns the windows of the WINDOW clause of a SELECT, all of
+// which must be valid, even when no window function uses them.
+func namedWindows(sel *sqlparser.Select) (*windowDefs, error) {
+	w := &windowDefs{
+		defs:     map[string]*sqlparser.WindowDefinition{},
+		resolved: map[string]*sqlparser.WindowSpecification{},
+		visiting: map[string]bool{},
+	}
+	var names []sqlparser.IdentifierCI
+	for _, named := range sel.Windows {
+		for _, def := range named.Windows {
+			if _, ok := w.defs[def.Name.Lowered()]; ok {
+				return nil, windowError("Window '%s' is defined twice.", def.Name.String())
+			}
+			w.defs[def.Name.Lowered()] = def
+			names = append(names, def.Name)
+		}
+	}
+	for _, name := range names {
+		if _, err := w.lookup(name); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// lookup returns the window of the WINDOW clause with a name.
+func (w *windowDefs) lookup(name sqlparser.IdentifierCI) (*sqlparser.WindowSpecification, error) {
+	key := name.Lowered()
+	if spec, ok := w.resolved[key]; ok {
+		return spec, nil
+	}
+	def, ok := w.defs[key]
+	if !ok {
+		return nil, windowError("Window name '%s' is not defined.", name.String())
+	}
+	if w.visiting[key] {
+		return nil, windowError("There is a circularity in the window dependency graph.")
+	}
+	w.visiting[key] = true
+	spec, err := w.resolve(def.WindowSpec, def.Name.String())
+	delete(w.visiting, key)
+	if err != nil {
+		return nil, err
+	}
+	w.resolved[key] = spec
+	return spec, nil
+}
+
+// resolve returns a window with the properties of the window it is based on.
+// It can only add the ones that window does not have, and not PARTITION BY.
+func (w *windowDefs) resolve(spec *sqlparser.WindowSpecification, name string) (*sqlparser.WindowSpecification, error) {
+	if spec.Name.IsEmpty() {
+		return spec, nil
+	}
+	base, err := w.lookup(spec.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(spec.PartitionClause) > 0 {
+		return nil, windowError("A window which depends on another cannot define partitioning.")
+	}
+	if base.FrameClause != nil {
+		return nil, windowError("Window '%s' has a frame definition, so cannot be referenced by another window.", spec.Name.String())
+	}
+	resolved := &sqlparser.WindowSpecification{
+		PartitionClause: base.PartitionClause,
+		OrderClause:     base.OrderClause,
+		FrameClause:     spec.FrameClause,
+	}
+	if len(spec.OrderClause) > 0 {
+		if len(base.OrderClause) > 0 {
+			return nil, windowError("Window '%s' cannot inherit '%s' since both contain an ORDER BY clause.", name, spec.Name.String())
+		}
+		resolved.OrderClause = spec.OrderClause
+	}
+	return resolved, nil
+}
+
+// inline replaces the windows of the window functions of an expression with
+// the windows they stand for, so that they have no names of windows.
+func (w *windowDefs) inline(e sqlparser.Expr) (sqlparser.Expr, error) {
+	var err error
+	rewritten := sqlparser.Rewrite(sqlparser.CloneExpr(e), func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Subquery:
+			return false
+		case *sqlparser.OverClause:
+			var spec *sqlparser.WindowSpecification
+			if !node.WindowName.IsEmpty() {
+				spec, err = w.lookup(node.WindowName)
+			} else {
+				spec, err = w.resolve(node.WindowSpec, unnamedWindow)
+			}
+			if err == nil {
+				cursor.Replace(&sqlparser.OverClause{WindowName: node.WindowName, WindowSpec: sqlparser.CloneRefOfWindowSpecification(spec)})
+			}
+			return false
+		}
+		return true
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return rewritten.(sqlparser.Expr), nil
+}
+
+// window is a window of window functions: the partitions of rows its
+// functions are computed over, the order of the rows of partitions and the
+// frame of rows of partitions the functions that have frames use.
+type window struct {
+	name      string
+	partition []*expr
+	orderBy   []ordering
+	unit      sqlparser.FrameUnitType
+	start     frameBound
+	end       frameBound
+	funcs     []*windowFunc
+}
+
+// frameBound is the start or the end of a frame.
+type frameBound struct {
+	typ sqlparser.FramePointType
+	// n is the number of rows or peer groups of ROWS and GROUPS bounds.
+	n int
+	// value is the value of the ORDER BY of RANGE bounds, evaluated for the
+	// current row.
+	value *expr
+}
+
+// windowFunc is a window function.
+type windowFunc struct {
+	name string
+	// offset is the offset of the value of the function in rows.
+	offset int
+	// arg is the expression of the functions that have one, n the number of
+	// NTILE, LAG, LEAD and NTH_VALUE, which is constant, and def the default
+	// of LAG and LEAD.
+	arg, n, def *expr
+	// agg is set for aggregate functions.
+	agg *aggregate
+}
+
+// windowing collects the window functions of a query, whose values are after
+// the columns of the rows HAVING keeps.
+type windowing struct {
+	post    *scope
+	windows []*window
+	// keys are the keys of windows, and funcKeys the ones of the functions.
+	keys     []string
+	funcs    []*windowFunc
+	funcKeys []string
+}
+
+// replace replaces the window functions of an expression with the offsets of
+// their values.
+func (w *windowing) replace(e sqlparser.Expr) (sqlparser.Expr, error) {
+	var err error
+	rewritten := sqlparser.Rewrite(sqlparser.CloneExpr(e), func(cursor *sqlparser.Cursor) bool {
+		if err != nil {
+			return false
+		}
+		switch cursor.Node().(type) {
+		case *sqlparser.Subquery, *sqlparser.Offset:
+			return false
+		}
+		over := overClause(cursor.Node())
+		if over == nil {
+			return true
+		}
+		var offset int
+		if offset, err = w.add(cursor.Node().(sqlparser.Expr), over); err == nil {
+			cursor.Replace(&sqlparser.Offset{V: offset, Original: cursor.Node().(sqlparser.Expr)})
+		}
+		return false
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return rewritten.(sqlparser.Expr), nil
+}
+
+// add adds a window function, unless the same one was added before, and
+// returns the offset of its value.
+func (w *windowing) add(fn sqlparser.Expr, over *sqlparser.OverClause) (int, error) {
+	key := sqlparser.String(fn)
+	if i := slices.Index(w.funcKeys, key); i >= 0 {
+		return w.funcs[i].offset, nil
+	}
+	win, err := w.window(over)
+	if err != nil {
+		return 0, err
+	}
+
+	f := &windowFunc{name: windowFuncName(fn)}
+	typ, typed := sqltypes.Uint64, true
+	switch fn := fn.(type) {
+	case *sqlparser.ArgumentLessWindowExpr:
+		if fn.Type == sqlparser.PercentRankExprType || fn.Type == sqlparser.CumeDistExprType {
+			typ = sqltypes.Float64
+		}
+	case *sqlparser.NtileExpr:
+		f.n, err = w.constant(fn.N)
+	case *sqlparser.LagLeadExpr:
+		if err = nullTreatment(fn.NullTreatmentClause); err != nil {
+			return 0, err
+		}
+		if f.arg, err = w.argument(fn.Expr); err != nil {
+			return 0, err
+		}
+		typ, typed = w.post.typeOf(f.arg)
+		if fn.N != nil {
+			if f.n, err = w.constant(fn.N); err != nil {
+				return 0, err
+			}
+		}
+		if fn.Default != nil {
+			f.def, err = w.argument(fn.Default)
+			typed = false
+		}
+	case *sqlparser.FirstOrLastValueExpr:
+		if err = nullTreatment(fn.NullTreatmentClause); err != nil {
+			return 0, err
+		}
+		if f.arg, err = w.argument(fn.Expr); err == nil {
+			typ, typed = w.post.typeOf(f.arg)
+		}
+	case *sqlparser.NTHValueExpr:
+		if err = nullTreatment(fn.NullTreatmentClause); err != nil {
+			return 0, err
+		}
+		if fn.FromFirstLastClause != nil && fn.FromFirstLastClause.Type == sqlparser.FromLastType {
+			return 0, vterrors.VT12001("NTH_VALUE ... FROM LAST")
+		}
+		if f.arg, err = w.argument(fn.Expr); err != nil {
+			return 0, err
+		}
+		typ, typed = w.post.typeOf(f.arg)
+		f.n, err = w.constant(fn.N)
+	case sqlparser.AggrFunc:
+		if d, ok := fn.(sqlparser.DistinctableAggr); ok && d.IsDistinct() {
+			return 0, vterrors.VT12001(fmt.Sprintf("%s(DISTINCT ...) as a window function", f.name))
+		}
+		if f.agg, err = newAggregate(fn, w.post); err == nil {
+			typ, typed = f.agg.typ, f.agg.resultTyped
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	f.offset = len(w.post.columns)
+	w.post.columns = append(w.post.columns, column{name: key, field: &querypb.Field{Name: key, Type: typ}, typed: typed})
+	w.funcKeys = append(w.funcKeys, key)
+	w.funcs = append(w.funcs, f)
+	win.funcs = append(win.funcs, f)
+	return f.offset, nil
+}
+
+// nullTreatment checks the NULL treatment of a window function, as only
+// RESPECT NULLS is supported, like in MySQL.
+func nullTreatment(clause *sqlparser.NullTreatmentClause) error {
+	if clause != nil && clause.Type == sqlparser.IgnoreNullsType {
+		return vterrors.VT12001("IGNORE NULLS")
+	}
+	return nil
+}
+
+// argument compiles an argument of a window function, which cannot have
+// window functions.
+func (w *windowing) argument(e sqlparser.Expr) (*expr, error) {
+	if name, ok := windowFunction(e); ok {
+		return nil, invalidWindowFuncUse(name)
+	}
+	return w.post.compile(e, fieldList)
+}
+
+// constant compiles an argument of a window function that is a number that
+// is the same for all rows, like the one of NTILE.
+func (w *windowing) constant(e sqlparser.Expr) (*expr, error) {
+	s := &scope{q: &query{c: w.post.q.c}}
+	return s.compile(e, fieldList)
+}
+
+// window returns the window of an OVER clause, which window functions with
+// the same window share.
+func (w *windowing) window(over *sqlparser.OverClause) (*window, error) {
+	spec := over.WindowSpec
+	key := sqlparser.String(spec)
+	if i := slices.Index(w.keys, key); i >= 0 {
+		return w.windows[i], nil
+	}
+	win := &window{name: unnamedWindow}
+	if !over.WindowName.IsEmpty() {
+		win.name = over.WindowName.String()
+	}
+	for _, e := range spec.PartitionClause {
+		if _, ok := windowFunction(e); ok {
+			return nil, windowError("You cannot nest a window function in the specification of window '%s'.", win.name)
+		}
+		x, err := w.post.compile(e, windowPartitionClause)
+		if err != nil {
+			return nil, err
+		}
+		win.partition = append(win.partition, x)
+	}
+	for _, order := range spec.OrderClause {
+		if _, ok := windowFunction(order.Expr); ok {
+			return nil, windowError("You cannot nest a window function in the specification of window '%s'.", win.name)
+		}
+		x, err := w.post.compile(order.Expr, windowOrderClause)
+		if err != nil {
+			return nil, err
+		}
+		win.orderBy = append(win.orderBy, ordering{expr: x, column: -1, desc: order.Direction == sqlparser.DescOrder})
+	}
+	if err := w.frame(win, spec); err != nil {
+		return nil, err
+	}
+	w.keys = append(w.keys, key)
+	w.windows = append(w.windows, win)
+	return win, nil
+}
+
+// frame compiles the frame of a window. Windows without one have the rows
+// from the start of the partition to the last peer of the current row when
+// they have ORDER BY, and all the rows of the partition otherwise.
+func (w *windowing) frame(win *window, spec *sqlparser.WindowSpecification) error {
+	fc := spec.FrameClause
+	if fc == nil {
+		win.unit = sqlparser.FrameRangeType
+		win.start = frameBound{typ: sqlparser.UnboundedPrecedingType}
+		win.end = frameBound{typ: sqlparser.UnboundedFollowingType}
+		if len(spec.OrderClause) > 0 {
+			win.end = frameBound{typ: sqlparser.CurrentRowType}
+		}
+		return nil
+	}
+	end := fc.End
+	if end == nil {
+		end = &sqlparser.FramePoint{Type: sqlparser.CurrentRowType}
+	}
+	if fc.Start.Type == sqlparser.UnboundedFollowingType {
+		return windowError("Window '%s': frame start cannot be UNBOUNDED FOLLOWING.", win.name)
+	}
+	if end.Type == sqlparser.UnboundedPrecedingType {
+		return windowError("Window '%s': frame end cannot be UNBOUNDED PRECEDING.", win.name)
+	}
+	win.unit = fc.Unit
+	var err error
+	if win.start, err = w.frameBound(win, spec, fc.Start); err != nil {
+		return err
+	}
+	win.end, err = w.frameBound(win, spec, end)
+	return err
+}
+
+func (w *windowing) frameBound(win *window, spec *sqlparser.WindowSpecification, point *sqlparser.FramePoint) (frameBound, error) {
+	b := frameBound{typ: point.Type}
+	if point.Type != sqlparser.ExprPrecedingType && point.Type != sqlparser.ExprFollowingType {
+		return b, nil
+	}
+	illegal := windowError("Window '%s': frame start or end is negative, NULL or of non-integral type", win.name)
+	x, err := w.constant(point.Expr)
+	if err != nil {
+		return b, err
+	}
+	v, err := x.value(newExecution(nil), nil)
+	if err != nil {
+		return b, err
+	}
+
+	if win.unit != sqlparser.FrameRangeType {
+		if point.Unit != sqlparser.IntervalNone {
+			return b, windowError("Window '%s': INTERVAL can only be used with RANGE frames.", win.name)
+		}
+		if !v.IsIntegral() {
+			return b, illegal
+		}
+		n, err := v.ToInt64()
+		if err != nil || n < 0 {
+			return b, illegal
+		}
+		b.n = int(n)
+		return b, nil
+	}
+
+	orderType := windowError("Window '%s' with RANGE N PRECEDING/FOLLOWING frame requires exactly one ORDER BY expression, of numeric or temporal type", win.name)
+	if len(win.orderBy) != 1 {
+		return b, orderType
+	}
+	typ, typed := w.post.typeOf(win.orderBy[0].expr)
+	temporal := typed && sqltypes.IsDateOrTime(typ)
+	numeric := typed && (sqltypes.IsNumber(typ) || typ == sqltypes.Year)
+	switch {
+	case typed && !temporal && !numeric:
+		return b, orderType
+	case temporal && point.Unit == sqlparser.IntervalNone:
+		return b, windowError("Window '%s' with RANGE frame has ORDER BY expression of datetime type. Only INTERVAL bound value allowed.", win.name)
+	case numeric && point.Unit != sqlparser.IntervalNone:
+		return b, windowError("Window '%s' with RANGE frame has ORDER BY expression of numeric type, INTERVAL bound value not allowed.", win.name)
+	}
+	if v.IsNull() {
+		return b, illegal
+	}
+	if point.Unit == sqlparser.IntervalNone {
+		if !sqltypes.IsNumber(v.Type()) {
+			return b, illegal
+		}
+		if f, err := evalengine.ToFloat64(v); err != nil || f < 0 {
+			return b, illegal
+		}
+	}
+
+	// The bound is the value of the ORDER BY of the current row moved by the
+	// offset towards the start or the end of the partition.
+	backwards := (point.Type == sqlparser.ExprPrecedingType) != win.orderBy[0].desc
+	key := spec.OrderClause[0].Expr
+	var bound sqlparser.Expr
+	if point.Unit == sqlparser.IntervalNone {
+		op := sqlparser.PlusOp
+		if backwards {
+			op = sqlparser.MinusOp
+		}
+		bound = &sqlparser.BinaryExpr{Operator: op, Left: key, Right: point.Expr}
+	} else {
+		syntax := sqlparser.IntervalDateExprDateAdd
+		if backwards {
+			syntax = sqlparser.IntervalDateExprDateSub
+		}
+		bound = &sqlparser.IntervalDateExpr{Syntax: syntax, Date: key, Interval: point.Expr, Unit: point.Unit}
+	}
+	b.value, err = w.post.compile(bound, windowOrderClause)
+	return b, err
+}
+
+// window computes the window functions for the rows HAVING keeps, and
+// returns the rows with their values.
+func (p *selectPlan) window(ctx *execution, rows []sqltypes.Row) ([]sqltypes.Row, error) {
+	result := make([]sqltypes.Row, len(rows))
+	for i, row := range rows {
+		result[i] = make(sqltypes.Row, len(row)+p.windowFuncs)
+		copy(result[i], row)
+	}
+	for _, win := range p.windows {
+		if err := win.compute(ctx, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// compute computes the functions of a window for rows, which it puts in
+// partitions and orders.
+func (win *window) compute(ctx *execution, rows []sqltypes.Row) error {
+	type windowRow struct {
+		row       sqltypes.Row
+		partition []evalengine.TypedValue
+		keys      []evalengine.TypedValue
+	}
+	sorted := make([]windowRow, len(rows))
+	for i, row := range rows {
+		wr := windowRow{row: row, partition: make([]evalengine.TypedValue, len(win.partition)), keys: make([]evalengine.TypedValue, len(win.orderBy))}
+		var err error
+		for k, x := range win.partition {
+			if wr.partition[k], err = x.typed(ctx, row); err != nil {
+				return err
+			}
+		}
+		for k, o := range win.orderBy {
+			if wr.keys[k], err = o.expr.typed(ctx, row); err != nil {
+				return err
+			}
+		}
+		sorted[i] = wr
+	}
+
+	var err error
+	env := ctx.compareEnv()
+	compare := func(a, b []evalengine.TypedValue, orderBy []ordering) int {
+		for i := range a {
+			n, cmpErr := env.NullsafeCompare(a[i], b[i])
+			if cmpErr != nil {
+				err = cmpErr
+				return 0
+			}
+			if n != 0 {
+				if orderBy != nil && orderBy[i].desc {
+					return -n
+				}
+				return n
+			}
+		}
+		return 0
+	}
+	slices.SortStableFunc(sorted, func(a, b windowRow) int {
+		if n := compare(a.partition, b.partition, nil); n != 0 {
+			return n
+		}
+		return compare(a.keys, b.keys, win.orderBy)
+	})
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && compare(sorted[start].partition, sorted[end].partition, nil) == 0 {
+			end++
+		}
+		if err != nil {
+			return err
+		}
+		part := &partition{ctx: ctx, env: env, win: win}
+		for _, wr := range sorted[start:end] {
+			part.rows = append(part.rows, wr.row)
+			part.keys = append(part.keys, wr.keys)
+		}
+		if err := part.peers(); err != nil {
+			return err
+		}
+		for _, f := range win.funcs {
+			if err := part.compute(f); err != nil {
+				return err
+			}
+		}
+		start = end
+	}
+	return nil
+}
+
+// partition is a partition of the rows of a window, in the order of the
+// window.
+type partition struct {
+	ctx  *execution
+	env  *evalengine.ExpressionEnv
+	win  *window
+	rows []sqltypes.Row
+	keys [][]evalengine.TypedValue
+	// group is the index of the peer group of each row: the rows whose
+	// values of ORDER BY are equal, and groups the start of each group.
+	group  []int
+	groups []int
+	// nonNull is the range of rows whose single value of ORDER BY is not
+	// NULL, for RANGE frames.
+	nonNull [2]int
+}
+
+func (p *partition) peers() error {
+	p.group = make([]int, len(p.rows))
+	for i := range p.rows {
+		peer := i > 0
+		for k := 0; peer && k < len(p.keys[i]); k++ {
+			n, err := p.env.NullsafeCompare(p.keys[i-1][k], p.keys[i][k])
+			if err != nil {
+				return err
+			}
+			peer = n == 0
+		}
+		if !peer {
+			p.groups = append(p.groups, i)
+		}
+		p.group[i] = len(p.groups) - 1
+	}
+	p.groups = append(p.groups, len(p.rows))
+
+	p.nonNull = [2]int{0, len(p.rows)}
+	if len(p.win.orderBy) == 1 {
+		for p.nonNull[0] < len(p.rows) && p.keys[p.nonNull[0]][0].IsNull() {
+			p.nonNull[0]++
+		}
+		for p.nonNull[1] > p.nonNull[0] && p.keys[p.nonNull[1]-1][0].IsNull() {
+			p.nonNull[1]--
+		}
+	}
+	return nil
+}
+
+// peerStart and peerEnd return the range of rows that are peers of a row.
+func (p *partition) peerStart(i int) int { return p.groups[p.group[i]] }
+func (p *partition) peerEnd(i int) int   { return p.groups[p.group[i]+1] }
+
+// frame returns the range of rows of the frame of a row, which is empty when
+// its end is before its start.
+func (p *partition) frame(i int) (int, int, error) {
+	start, err := p.bound(i, p.win.start, true)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := p.bound(i, p.win.end, false)
+	if err != nil {
+		return 0, 0, err
+	}
+	return start, max(start, end), nil
+}
+
+// bound returns the row a frame of a row starts at, or the one after the
+// frame ends at.
+func (p *partition) bound(i int, b frameBound, start bool) (int, error) {
+	n := len(p.rows)
+	switch b.typ {
+	case sqlparser.UnboundedPrecedingType:
+		return 0, nil
+	case sqlparser.UnboundedFollowingType:
+		return n, nil
+	case sqlparser.CurrentRowType:
+		switch {
+		case p.win.unit != sqlparser.FrameRowsType && start:
+			return p.peerStart(i), nil
+		case p.win.unit != sqlparser.FrameRowsType:
+			return p.peerEnd(i), nil
+		case start:
+			return i, nil
+		}
+		return i + 1, nil
+	}
+
+	offset := b.n
+	if b.typ == sqlparser.ExprPrecedingType {
+		offset = -offset
+	}
+	switch p.win.unit {
+	case sqlparser.FrameRowsType:
+		if !start {
+			offset++
+		}
+		return min(max(i+offset, 0), n), nil
+	case sqlparser.FrameGroupsType:
+		g := p.group[i] + offset
+		switch {
+		case g < 0:
+			return 0, nil
+		case g >= len(p.groups)-1:
+			return n, nil
+		case start:
+			return p.groups[g], nil
+		}
+		return p.groups[g+1], nil
+	}
+
+	// The frames of rows whose value of ORDER BY is NULL are their peers, and
+	// the frames of other rows do not have the rows whose value is NULL.
+	if p.keys[i][0].IsNull() {
+		if start {
+			return p.peerStart(i), nil
+		}
+		return p.peerEnd(i), nil
+	}
+	value, err := b.value.typed(p.ctx, p.rows[i])
+	if err != nil {
+		return 0, err
+	}
+	lo, hi := p.nonNull[0], p.nonNull[1]
+	pos := lo + sort.Search(hi-lo, func(k int) bool {
+		c, cmpErr := p.env.NullsafeCompare(p.keys[lo+k][0], value)
+		if cmpErr != nil {
+			err = cmpErr
+		}
+		if p.win.orderBy[0].desc {
+			c = -c
+		}
+		if start {
+			return c >= 0
+		}
+		return c > 0
+	})
+	return pos, err
+}
+
+// compute computes a window function for the rows of the partition.
+func (p *partition) compute(f *windowFunc) error {
+	n := len(p.rows)
+	switch f.name {
+	case "row_number":
+		for i, row := range p.rows {
+			row[f.offset] = sqltypes.NewUint64(uint64(i + 1))
+		}
+	case "rank":
+		for i, row := range p.rows {
+			row[f.offset] = sqltypes.NewUint64(uint64(p.peerStart(i) + 1))
+		}
+	case "dense_rank":
+		for i, row := range p.rows {
+			row[f.offset] = sqltypes.NewUint64(uint64(p.group[i] + 1))
+		}
+	case "percent_rank":
+		for i, row := range p.rows {
+			rank := 0.0
+			if n > 1 {
+				rank = float64(p.peerStart(i)) / float64(n-1)
+			}
+			row[f.offset] = sqltypes.MakeTrusted(sqltypes.Float64, format.FormatFloat(rank))
+		}
+	case "cume_dist":
+		for i, row := range p.rows {
+			dist := float64(p.peerEnd(i)) / float64(n)
+			row[f.offset] = sqltypes.MakeTrusted(sqltypes.Float64, format.FormatFloat(dist))
+		}
+	case "ntile":
+		buckets, err := p.number(f, 1)
+		if err != nil {
+			return err
+		}
+		// The first n % buckets buckets have a row more than the others.
+		size, rest := n/buckets, n%buckets
+		for i, row := range p.rows {
+			bucket := i/(size+1) + 1
+			if i >= rest*(size+1) {
+				bucket = rest + (i-rest*(size+1))/size + 1
+			}
+			row[f.offset] = sqltypes.NewUint64(uint64(bucket))
+		}
+	case "lag", "lead":
+		offset, err := p.number(f, 0)
+		if err != nil {
+			return err
+		}
+		if f.name == "lag" {
+			offset = -offset
+		}
+		for i, row := range p.rows {
+			var v sqltypes.Value
+			if j := i + offset; j >= 0 && j < n {
+				v, err = f.arg.value(p.ctx, p.rows[j])
+			} else if f.def != nil {
+				v, err = f.def.value(p.ctx, row)
+			}
+			if err != nil {
+				return err
+			}
+			row[f.offset] = v
+		}
+	case "first_value", "last_value", "nth_value":
+		nth := 1
+		if f.name == "nth_value" {
+			var err error
+			if nth, err = p.number(f, 1); err != nil {
+				return err
+			}
+		}
+		for i, row := range p.rows {
+			start, end, err := p.frame(i)
+			if err != nil {
+				return err
+			}
+			at := start + nth - 1
+			if f.name == "last_value" {
+				at = end - 1
+			}
+			v := sqltypes.NULL
+			if at >= start && at < end {
+				if v, err = f.arg.value(p.ctx, p.rows[at]); err != nil {
+					return err
+				}
+			}
+			row[f.offset] = v
+		}
+	default:
+		return p.aggregate(f)
+	}
+	return nil
+}
+
+// number returns the number of NTILE, LAG, LEAD or NTH_VALUE, which cannot be
+// less than least, or 1 when the function has none.
+func (p *partition) number(f *windowFunc, least int64) (int, error) {
+	if f.n == nil {
+		return 1, nil
+	}
+	v, err := f.n.value(p.ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	if v.IsIntegral() {
+		if n, err := v.ToInt64(); err == nil && n >= least {
+			return int(n), nil
+		}
+	}
+	return 0, vterrors.VT03025(f.name)
+}
+
+// aggregate computes an aggregate function over the frames of the rows. When
+// frames start at the start of the partition, they only grow from a row to
+// the next, and the rows are added to the same state.
+func (p *partition) aggregate(f *windowFunc) error {
+	var state *aggState
+	added := 0
+	for i, row := range p.rows {
+		start, end, err := p.frame(i)
+		if err != nil {
+			return err
+		}
+		if state == nil || p.win.start.typ != sqlparser.UnboundedPrecedingType || end < added {
+			state, added = newAggState(p.ctx, f.agg), start
+		}
+		for ; added < end; added++ {
+			if err := state.add(p.ctx, p.rows[added]); err != nil {
+				return err
+			}
+		}
+		if row[f.offset], err = state.acc.result(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package collations compares strings the way the collations of MySQL do.
+//
+// A collation turns each character of a string into a sequence of weights,
+// and strings compare as the sequences of the weights of their characters do.
+// Characters with the same weights are equal: in a case insensitive collation,
+// the cases of a letter have the same weights, and in an accent insensitive
+// one so do its accented forms. Collations with PAD SPACE compare strings as
+// if the shorter one had spaces added at its end, so that trailing spaces make
+// no difference; NO PAD collations compare them like any other character.
+//
+// Strings other than binary ones are expected in UTF-8 whatever the character
+// set of their collation, and latin1 collations weigh the latin1 character
+// each character is converted to.
+package collations
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/vedadiyan/sqlparser/pkg/vthash"
+)
+
+// ID is the number MySQL identifies a collation with.
+type ID uint16
+
+// The IDs of the collations there are.
+const (
+	Unknown          ID = 0
+	Latin1SwedishCI  ID = 8
+	Utf8mb4GeneralCI ID = 45
+	Utf8mb4Bin       ID = 46
+	Binary           ID = 63
+	Utf8mb40900AiCI  ID = 255
+)
+
+// Default is the collation of strings that are given none, the default of
+// the utf8mb4 character set.
+const Default = Utf8mb40900AiCI
+
+// Collation is a collation of MySQL.
+type Collation struct {
+	id       ID
+	name     string
+	charset  string
+	padSpace bool
+	// weigh appends the weights of a character. It is nil for binary, which
+	// weighs bytes rather than characters.
+	weigh func(dst []uint32, r rune) []uint32
+	// width is the number of bytes of each weight in weight strings.
+	width int
+}
+
+var collations = map[ID]*Collation{
+	Binary:           {id: Binary, name: "binary", charset: "binary", width: 1},
+	Utf8mb4Bin:       {id: Utf8mb4Bin, name: "utf8mb4_bin", charset: "utf8mb4", padSpace: true, weigh: weighBin, width: 3},
+	Utf8mb4GeneralCI: {id: Utf8mb4GeneralCI, name: "utf8mb4_general_ci", charset: "utf8mb4", padSpace: true, weigh: weighGeneral, width: 2},
+	Utf8mb40900AiCI:  {id: Utf8mb40900AiCI, name: "utf8mb4_0900_ai_ci", charset: "utf8mb4", weigh: weigh0900, width: 2},
+	Latin1SwedishCI:  {id: Latin1SwedishCI, name: "latin1_swedish_ci", charset: "latin1", padSpace: true, weigh: weighLatin1, width: 1},
+}
+
+// charsetCollations are the default collations of the character sets.
+var charsetCollations = map[string]ID{
+	"binary":  Binary,
+	"utf8mb4": Utf8mb40900AiCI,
+	"utf8mb3": Utf8mb4GeneralCI,
+	"utf8":    Utf8mb4GeneralCI,
+	"latin1":  Latin1SwedishCI,
+}
+
+// Get returns the collation with an ID, or nil if there is none.
+func Get(id ID) *Collation {
+	return collations[id]
+}
+
+// Lookup returns the collation with a name, which is case insensitive.
+func Lookup(name string) (*Collation, bool) {
+	for _, c := range collations {
+		if strings.EqualFold(c.name, name) {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// DefaultForCharset returns the default collation of a character set.
+func DefaultForCharset(charset string) (*Collation, bool) {
+	id, ok := charsetCollations[strings.ToLower(charset)]
+	if !ok {
+		return nil, false
+	}
+	return collations[id], true
+}
+
+// ID returns the ID of the collation.
+func (c *Collation) ID() ID {
+	return c.id
+}
+
+// Name returns the name of the collation.
+func (c *Collation) Name() string {
+	return c.name
+}
+
+// Charset returns the name of the character set of the collation.
+func (c *Collation) Charset() string {
+	return c.charset
+}
+
+// IsBinary reports whether the collation is binary, which compares bytes.
+func (c *Collation) IsBinary() bool {
+	return c.weigh == nil
+}
+
+// PadSpace reports whether the collation ignores trailing spaces.
+func (c *Collation) PadSpace() bool {
+	return c.padSpace
+}
+
+// weights returns the weights of the characters of a string.
+func (c *Collation) weights(s []byte) []uint32 {
+	w := make([]uint32, 0, len(s))
+	for len(s) > 0 {
+		r, n := utf8.DecodeRune(s)
+		w = c.weigh(w, r)
+		s = s[n:]
+	}
+	return w
+}
+
+// Compare compares two strings, and returns -1, 0 or 1 as the first orders
+// before, like or after the second.
+func (c *Collation) Compare(a, b []byte) int {
+	if c.IsBinary() {
+		return bytes.Compare(a, b)
+	}
+	wa, wb := c.weights(a), c.weights(b)
+	for i := 0; i < len(wa) && i < len(wb); i++ {
+		if wa[i] != wb[i] {
+			return cmpWeights(wa[i], wb[i])
+		}
+	}
+	if len(wa) == len(wb) {
+		return 0
+	}
+	if !c.padSpace {
+		return cmpWeights(uint32(len(wa)), uint32(len(wb)))
+	}
+	// The shorter string compares as if it ended with spaces.
+	space := c.weigh(nil, ' ')[0]
+	if len(wa) > len(wb) {
+		for _, w := range wa[len(wb):] {
+			if w != space {
+				return cmpWeights(w, space)
+			}
+		}
+		return 0
+	}
+	for _, w := range wb[len(wa):] {
+		if w != space {
+			return cmpWeights(space, w)
+		}
+	}
+	return 0
+}
+
+func cmpWeights(a, b uint32) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// WeightString appends to dst the weight string of a string, which is what
+// WEIGHT_STRING returns: the weights of its characters, big endian, without
+// the trailing spaces PAD SPACE collations ignore. Strings that are equal
+// have the same weight strings.
+func (c *Collation) WeightString(dst, src []byte) []byte {
+	if c.IsBinary() {
+		return append(dst, src...)
+	}
+	if c.padSpace {
+		src = bytes.TrimRight(src, " ")
+	}
+	var buf [4]byte
+	for _, w := range c.weights(src) {
+		binary.BigEndian.PutUint32(buf[:], w)
+		dst = append(dst, buf[4-c.width:]...)
+	}
+	return dst
+}
+
+// WeightStringPadded is like WeightString for a string cut or padded with
+// spaces to a number of characters, which is what WEIGHT_STRING(... AS
+// CHAR(n)) returns. The spaces weigh like other characters, as they do not
+// trail the string the weight string is of.
+func (c *Collation) WeightStringPadded(dst, src []byte, chars int) []byte {
+	var buf [4]byte
+	var w []uint32
+	for n := 0; n < chars; n++ {
+		r, size := ' ', 0
+		switch {
+		case len(src) == 0:
+		case c.IsBinary():
+			r, size = rune(src[0]), 1
+		default:
+			r, size = utf8.DecodeRune(src)
+		}
+		src = src[size:]
+		if c.IsBinary() {
+			dst = append(dst, byte(r))
+			continue
+		}
+		for _, x := range c.weigh(w[:0], r) {
+			binary.BigEndian.PutUint32(buf[:], x)
+			dst = append(dst, buf[4-c.width:]...)
+		}
+	}
+	return dst
+}
+
+// Hash returns a hash of a string, which is the same for strings that are
+// equal in the collation.
+func (c *Collation) Hash(src []byte) vthash.Hash {
+	h := vthash.New()
+	_, _ = h.Write(c.WeightString(nil, src))
+	return h.Sum128()
+}
+
+// EqualRune reports whether two characters are equal in the collation, which
+// is what LIKE compares the characters of strings with.
+func (c *Collation) EqualRune(a, b rune) bool {
+	if a == b {
+		return true
+	}
+	if c.IsBinary() {
+		return false
+	}
+	var wa, wb [4]uint32
+	return slices.Equal(c.weigh(wa[:0], a), c.weigh(wb[:0], b))
+}
+
+// Equivalents returns the characters that are equal to a character in the
+// collation, among its cases and the characters of the Latin blocks, which is
+// where characters that are not the same can be equal.
+func (c *Collation) Equivalents(r rune) []rune {
+	if c.IsBinary() {
+		return []rune{r}
+	}
+	var equivalents []rune
+	for candidate := rune(0); candidate < 0x250; candidate++ {
+		if c.EqualRune(r, candidate) {
+			equivalents = append(equivalents, candidate)
+		}
+	}
+	for _, candidate := range caseVariants(r) {
+		if candidate >= 0x250 && c.EqualRune(r, candidate) {
+			equivalents = append(equivalents, candidate)
+		}
+	}
+	if len(equivalents) == 0 {
+		equivalents = append(equivalents, r)
+	}
+	return equivalents
+}
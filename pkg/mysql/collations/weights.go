@@ -0,0 +1,224 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// weighBin weighs characters by their code points.
+func weighBin(dst []uint32, r rune) []uint32 {
+	return append(dst, uint32(r))
+}
+
+// weighGeneral weighs characters like utf8mb4_general_ci: by their upper case
+// without accents, one character at a time, and characters outside the Basic
+// Multilingual Plane all alike.
+func weighGeneral(dst []uint32, r rune) []uint32 {
+	switch {
+	case r > 0xFFFF:
+		return append(dst, 0xFFFD)
+	case r == 'ß' || r == 'ſ':
+		return append(dst, 'S')
+	case r == 'ı':
+		return append(dst, 'I')
+	}
+	if base, ok := latinBase(r); ok {
+		r = base
+	}
+	return append(dst, uint32(unicode.ToUpper(r)))
+}
+
+// latinBase returns the letter without accents a letter of the Latin-1
+// Supplement or Latin Extended-A blocks is, in lower case.
+func latinBase(r rune) (rune, bool) {
+	if r < 0xC0 || r >= 0x180 {
+		return 0, false
+	}
+	base := latinBases[r-0xC0]
+	return rune(base), base != '.'
+}
+
+// latinBases are the letters without accents the characters from U+00C0 to
+// U+017F are, or '.' for the ones that are not a letter with accents.
+const latinBases = "" +
+	"aaaaaa.ceeeeiiii" + // U+00C0
+	".nooooo..uuuuy.." + // U+00D0
+	"aaaaaa.ceeeeiiii" + // U+00E0
+	".nooooo..uuuuy.y" + // U+00F0
+	"aaaaaaccccccccdd" + // U+0100
+	"..eeeeeeeeeegggg" + // U+0110
+	"gggghh..iiiiiiii" + // U+0120
+	"i...jjkk.lllllll" + // U+0130
+	"l..nnnnnn...oooo" + // U+0140
+	"oo..rrrrrrssssss" + // U+0150
+	"sstttt..uuuuuuuu" + // U+0160
+	"uuuuwwyyyzzzzzzs" // U+0170
+
+// The primary weights of utf8mb4_0900_ai_ci for spaces, digits and the
+// letters of the English alphabet.
+const (
+	weightSpace = 0x0209
+	weightZero  = 0x1C3D
+)
+
+var letterWeights = [26]uint32{
+	0x1C47, 0x1C60, 0x1C7A, 0x1C8F, 0x1CAA, 0x1CE5, 0x1CF4, 0x1D18, 0x1D32, 0x1D4C, 0x1D65, 0x1D77, 0x1DAA,
+	0x1DB9, 0x1DDD, 0x1E0C, 0x1E21, 0x1E33, 0x1E71, 0x1E95, 0x1EB5, 0x1EE3, 0x1EF5, 0x1EFF, 0x1F0B, 0x1F21,
+}
+
+// expansions are the characters utf8mb4_0900_ai_ci weighs as several that
+// do not decompose into them.
+var expansions = map[rune]string{
+	'æ': "ae", 'œ': "oe", 'ß': "ss",
+}
+
+// distinctLetters are the letters of the Latin blocks that utf8mb4_0900_ai_ci
+// weighs as letters of their own rather than as accented letters, right after
+// the letter of the English alphabet they follow.
+var distinctLetters = map[rune]struct {
+	after  rune
+	offset uint32
+}{
+	'đ': {'d', 1}, 'ð': {'d', 2}, 'ħ': {'h', 1}, 'ı': {'i', 1}, 'ĸ': {'k', 1}, 'ł': {'l', 1},
+	'ŋ': {'n', 1}, 'ø': {'o', 1}, 'ŧ': {'t', 1}, 'þ': {'z', 1},
+}
+
+// weigh0900 weighs characters like utf8mb4_0900_ai_ci, which follows the
+// Unicode Collation Algorithm: cases and accents make no difference, and
+// spaces and punctuation order before digits, which order before letters.
+//
+// Characters weigh like their compatibility decomposition, without the
+// nonspacing marks, which are ignored, so that accented letters weigh like
+// their letters whether or not they are decomposed, and so do compatibility
+// characters like ① and １. Letters weigh like their lower case, and the
+// variants of a letter that are another lower case, like ς, like it too.
+//
+// Spaces, digits and the letters of the English alphabet have the weights
+// MySQL gives them. Other characters order among each other by code point,
+// punctuation and symbols before digits and letters of other scripts after
+// the Latin ones, and control characters are ignored.
+func weigh0900(dst []uint32, r rune) []uint32 {
+	r = unicode.ToLower(r)
+	if distinct, ok := distinctLetters[r]; ok {
+		return append(dst, letterWeights[distinct.after-'a']+distinct.offset)
+	}
+	if expansion, ok := expansions[r]; ok {
+		for _, c := range expansion {
+			dst = weigh0900(dst, c)
+		}
+		return dst
+	}
+	if r >= 0x80 {
+		if d := norm.NFKD.String(string(r)); d != string(r) {
+			for _, c := range d {
+				dst = weigh0900(dst, c)
+			}
+			return dst
+		}
+		if unicode.Is(unicode.Mn, r) {
+			return dst
+		}
+		r = unicode.ToLower(unicode.ToUpper(r))
+	}
+	switch {
+	case r >= 'a' && r <= 'z':
+		return append(dst, letterWeights[r-'a'])
+	case r >= '0' && r <= '9':
+		return append(dst, weightZero+uint32(r-'0'))
+	case unicode.Is(unicode.Zs, r):
+		return append(dst, weightSpace)
+	case r >= '\t' && r <= '\r':
+		return append(dst, 0x0201+uint32(r-'\t'))
+	case unicode.IsControl(r) || unicode.Is(unicode.Cf, r):
+		return dst
+	case r < 0x80:
+		return append(dst, 0x0210+uint32(r))
+	case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+		return append(dst, 0x0300+uint32(r>>15), 0x8000|uint32(r&0x7FFF))
+	}
+	return append(dst, 0x3000+uint32(r>>15), 0x8000|uint32(r&0x7FFF))
+}
+
+// weighLatin1 weighs characters like latin1_swedish_ci, by the weight of the
+// latin1 character they convert to, or of '?' when there is none.
+func weighLatin1(dst []uint32, r rune) []uint32 {
+	b, ok := toLatin1(r)
+	if !ok {
+		b = '?'
+	}
+	return append(dst, uint32(latin1SwedishWeights[b]))
+}
+
+// toLatin1 returns the latin1 character a character is. MySQL's latin1 is
+// Windows-1252, which has characters where ISO 8859-1 has control characters.
+func toLatin1(r rune) (byte, bool) {
+	if r < 0x80 || r >= 0xA0 && r <= 0xFF {
+		return byte(r), true
+	}
+	for i, c := range cp1252 {
+		if c == r && c != 0 {
+			return byte(0x80 + i), true
+		}
+	}
+	if r >= 0x80 && r < 0xA0 && cp1252[r-0x80] == 0 {
+		return byte(r), true
+	}
+	return 0, false
+}
+
+// cp1252 are the characters of Windows-1252 from 0x80 to 0x9F, or 0 where it
+// has none.
+var cp1252 = [32]rune{
+	'€', 0, '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', 0, 'Ž', 0,
+	0, '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', 0, 'ž', 'Ÿ',
+}
+
+// latin1SwedishWeights are the weights of the latin1 characters in
+// latin1_swedish_ci, MySQL's sort_order_latin1: lower case letters weigh like
+// upper case ones, and Å, Ä and Ö, which come after Z in Swedish, weigh like
+// the characters after Z.
+var latin1SwedishWeights = [256]byte{
+	0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F,
+	0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1A, 0x1B, 0x1C, 0x1D, 0x1E, 0x1F,
+	0x20, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F,
+	0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3A, 0x3B, 0x3C, 0x3D, 0x3E, 0x3F,
+	0x40, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
+	0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5A, 0x5B, 0x5C, 0x5D, 0x5E, 0x5F,
+	0x60, 0x41, 0x42, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49, 0x4A, 0x4B, 0x4C, 0x4D, 0x4E, 0x4F,
+	0x50, 0x51, 0x52, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59, 0x5A, 0x7B, 0x7C, 0x7D, 0x7E, 0x7F,
+	0x80, 0x81, 0x82, 0x83, 0x84, 0x85, 0x86, 0x87, 0x88, 0x89, 0x8A, 0x8B, 0x8C, 0x8D, 0x8E, 0x8F,
+	0x90, 0x91, 0x92, 0x93, 0x94, 0x95, 0x96, 0x97, 0x98, 0x99, 0x9A, 0x9B, 0x9C, 0x9D, 0x9E, 0x9F,
+	0xA0, 0xA1, 0xA2, 0xA3, 0xA4, 0xA5, 0xA6, 0xA7, 0xA8, 0xA9, 0xAA, 0xAB, 0xAC, 0xAD, 0xAE, 0xAF,
+	0xB0, 0xB1, 0xB2, 0xB3, 0xB4, 0xB5, 0xB6, 0xB7, 0xB8, 0xB9, 0xBA, 0xBB, 0xBC, 0xBD, 0xBE, 0xBF,
+	0x41, 0x41, 0x41, 0x41, 0x5C, 0x5B, 0x5C, 0x43, 0x45, 0x45, 0x45, 0x45, 0x49, 0x49, 0x49, 0x49,
+	0x44, 0x4E, 0x4F, 0x4F, 0x4F, 0x4F, 0x5D, 0xD7, 0xD8, 0x55, 0x55, 0x55, 0x59, 0x59, 0xDE, 0xDF,
+	0x41, 0x41, 0x41, 0x41, 0x5C, 0x5B, 0x5C, 0x43, 0x45, 0x45, 0x45, 0x45, 0x49, 0x49, 0x49, 0x49,
+	0x44, 0x4E, 0x4F, 0x4F, 0x4F, 0x4F, 0x5D, 0xF7, 0xD8, 0x55, 0x55, 0x55, 0x59, 0x59, 0xDE, 0xFF,
+}
+
+// caseVariants returns the characters that are a character in another case,
+// and the character itself.
+func caseVariants(r rune) []rune {
+	variants := []rune{r}
+	for c := unicode.SimpleFold(r); c != r; c = unicode.SimpleFold(c) {
+		variants = append(variants, c)
+	}
+	return variants
+}
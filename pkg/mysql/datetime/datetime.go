@@ -454,7 +454,7 @@ func (t Time) AddInterval(itv *Interval, stradd bool) (Time, uint8, bool) {
 }
 
 func (t Time) toDuration() time.Duration {
-	dur := time.Duration(t.hour)*time.Hour + time.Duration(t.minute)*time.Minute + time.Duration(t.second)*time.Second + time.Duration(t.nanosecond)*time.Nanosecond
+	dur := time.Duration(t.Hour())*time.Hour + time.Duration(t.minute)*time.Minute + time.Duration(t.second)*time.Second + time.Duration(t.nanosecond)*time.Nanosecond
 	if t.Neg() {
 		return -dur
 	}
@@ -617,6 +617,7 @@ func (dt *DateTime) addInterval(itv *Interval) bool {
 		dur := dt.toDuration()
 		dur += itv.toDuration()
 		days := time.Duration(0)
+		neg := false
 		if !dt.Date.IsZero() {
 			days = dur / durationPerDay
 			dur -= days * durationPerDay
@@ -625,12 +626,24 @@ func (dt *DateTime) addInterval(itv *Interval) bool {
 				dur += durationPerDay
 				days--
 			}
+		} else {
+			// without a date, this is a TIME, which keeps its sign and
+			// must stay within its range
+			if dur < 0 {
+				neg, dur = true, -dur
+			}
+			if dur/time.Hour > MaxHours {
+				return false
+			}
 		}
 
 		dt.Time.nanosecond = uint32((dur % time.Second) / time.Nanosecond)
 		dt.Time.second = uint8((dur % time.Minute) / time.Second)
 		dt.Time.minute = uint8((dur % time.Hour) / time.Minute)
 		dt.Time.hour = uint16(dur / time.Hour)
+		if neg {
+			dt.Time.hour |= negMask
+		}
 
 		daynum := MysqlDayNumber(dt.Date.Year(), dt.Date.Month(), 1) + int(days)
 		if daynum < 0 || daynum > maxDay {
@@ -643,6 +656,9 @@ func (dt *DateTime) addInterval(itv *Interval) bool {
 	case itv.unit.HasDayParts():
 		daynum := MysqlDayNumber(dt.Date.Year(), dt.Date.Month(), dt.Date.Day())
 		daynum += itv.day
+		if daynum < 0 || daynum > maxDay {
+			return false
+		}
 		dt.Date.year, dt.Date.month, dt.Date.day = mysqlDateFromDayNumber(daynum)
 		return true
 
@@ -48,6 +48,31 @@ var shortDayNames = []string{
 	"Sat",
 }
 
+var dayNames = []string{
+	"Sunday",
+	"Monday",
+	"Tuesday",
+	"Wednesday",
+	"Thursday",
+	"Friday",
+	"Saturday",
+}
+
+var monthNames = []string{
+	"January",
+	"February",
+	"March",
+	"April",
+	"May",
+	"June",
+	"July",
+	"August",
+	"September",
+	"October",
+	"November",
+	"December",
+}
+
 var shortMonthNames = []string{
 	"Jan",
 	"Feb",
@@ -69,11 +94,22 @@ func (fmtWeekdayNameShort) format(dst []byte, t DateTime, prec uint8) []byte {
 	return append(dst, t.Date.Weekday().String()[:3]...)
 }
 
-func (fmtWeekdayNameShort) parse(_ *timeparts, b string) (out string, ok bool) {
-	_, out, ok = lookup(shortDayNames, b)
+func (fmtWeekdayNameShort) parse(tp *timeparts, b string) (out string, ok bool) {
+	var day int
+	day, out, ok = lookup(shortDayNames, b)
+	tp.weekday = isoWeekday(day)
 	return
 }
 
+// isoWeekday returns the day of the week from 1 for Monday to 7 for Sunday,
+// of a day from 0 for Sunday.
+func isoWeekday(day int) int {
+	if day == 0 {
+		return 7
+	}
+	return day
+}
+
 type fmtMonthNameShort struct{}
 
 func (fmtMonthNameShort) format(dst []byte, t DateTime, prec uint8) []byte {
@@ -82,9 +118,12 @@ func (fmtMonthNameShort) format(dst []byte, t DateTime, prec uint8) []byte {
 
 func (fmtMonthNameShort) parse(tp *timeparts, b string) (out string, ok bool) {
 	tp.month, out, ok = lookup(shortMonthNames, b)
+	tp.month++
 	return
 }
 
+// The numeric specifiers pad to two digits when zero is set, but like MySQL
+// they parse one or two digits either way.
 type fmtMonth struct {
 	zero bool
 }
@@ -97,7 +136,7 @@ func (s fmtMonth) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (s fmtMonth) parse(tp *timeparts, b string) (out string, ok bool) {
-	tp.month, out, ok = getnum(b, s.zero)
+	tp.month, out, ok = getnum(b, false)
 	if ok && (tp.month < 0 || tp.month > 12) {
 		ok = false
 	}
@@ -129,8 +168,13 @@ func (fmtMonthDaySuffix) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (d fmtMonthDaySuffix) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	var ok bool
+	t.day, bytes, ok = getnum(bytes, false)
+	if !ok {
+		return "", false
+	}
+	// The suffix is skipped without being checked, like MySQL does.
+	return bytes[min(len(bytes), 2):], true
 }
 
 type fmtDay struct {
@@ -145,7 +189,7 @@ func (s fmtDay) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (s fmtDay) parse(tp *timeparts, b string) (out string, ok bool) {
-	tp.day, out, ok = getnum(b, s.zero)
+	tp.day, out, ok = getnum(b, false)
 	return
 }
 
@@ -170,8 +214,19 @@ func (fmtMicroseconds) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (f fmtMicroseconds) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	usec, n := 0, 0
+	for ; n < 6 && isDigit(bytes, n); n++ {
+		usec = usec*10 + int(bytes[n]-'0')
+	}
+	if n == 0 {
+		return "", false
+	}
+	for i := n; i < 6; i++ {
+		usec *= 10
+	}
+	t.nsec = usec * 1000
+	t.prec = DefaultPrecision
+	return bytes[n:], true
 }
 
 type fmtHour24 struct {
@@ -186,7 +241,7 @@ func (s fmtHour24) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (s fmtHour24) parse(tp *timeparts, b string) (out string, ok bool) {
-	tp.hour, out, ok = getnum(b, s.zero)
+	tp.hour, out, ok = getnum(b, false)
 	if tp.hour < 0 || 24 <= tp.hour {
 		ok = false
 	}
@@ -210,7 +265,8 @@ func (f fmtHour12) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (f fmtHour12) parse(tp *timeparts, b string) (out string, ok bool) {
-	tp.hour, out, ok = getnum(b, f.zero)
+	tp.hour, out, ok = getnum(b, false)
+	tp.hour12 = true
 	if tp.hour < 0 || 12 < tp.hour {
 		ok = false
 	}
@@ -237,7 +293,7 @@ func (s fmtMin) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (s fmtMin) parse(tp *timeparts, b string) (out string, ok bool) {
-	tp.min, out, ok = getnum(b, s.zero)
+	tp.min, out, ok = getnum(b, false)
 	if tp.min < 0 || 60 <= tp.min {
 		ok = false
 	}
@@ -254,8 +310,15 @@ func (fmtZeroYearDay) format(dst []byte, t DateTime, prec uint8) []byte {
 	return appendInt(dst, t.Date.Yearday(), 3)
 }
 func (j fmtZeroYearDay) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	yday, n := 0, 0
+	for ; n < 3 && isDigit(bytes, n); n++ {
+		yday = yday*10 + int(bytes[n]-'0')
+	}
+	if n == 0 {
+		return "", false
+	}
+	t.yday = yday
+	return bytes[n:], true
 }
 
 type fmtMonthName struct{}
@@ -265,8 +328,9 @@ func (fmtMonthName) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (m fmtMonthName) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	month, out, ok := lookup(monthNames, bytes)
+	t.month = month + 1
+	return out, ok
 }
 
 type fmtAMorPM struct{}
@@ -279,8 +343,9 @@ func (fmtAMorPM) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (p fmtAMorPM) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	i, out, ok := lookup([]string{"AM", "PM"}, bytes)
+	t.amset, t.pmset = i == 0, i == 1
+	return out, ok
 }
 
 type fmtFullTime12 struct{}
@@ -297,8 +362,7 @@ func (fmtFullTime12) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (r fmtFullTime12) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	return parseAll(t, bytes, fmtHour12{false}, &fmtVerbatim{s: ":"}, fmtMin{false}, &fmtVerbatim{s: ":"}, fmtSecond{false, false}, &fmtVerbatim{s: " "}, fmtAMorPM{})
 }
 
 type fmtSecond struct {
@@ -320,7 +384,7 @@ func (s fmtSecond) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (s fmtSecond) parse(tp *timeparts, b string) (out string, ok bool) {
-	tp.sec, out, ok = getnum(b, s.zero)
+	tp.sec, out, ok = getnum(b, false)
 	if tp.sec < 0 || 60 <= tp.sec {
 		return "", false
 	}
@@ -352,8 +416,7 @@ func (fmtFullTime24) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (t2 fmtFullTime24) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	return parseAll(t, bytes, fmtHour24{false}, &fmtVerbatim{s: ":"}, fmtMin{false}, &fmtVerbatim{s: ":"}, fmtSecond{false, false})
 }
 
 type fmtWeek0 struct{}
@@ -364,8 +427,7 @@ func (fmtWeek0) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (u fmtWeek0) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	return parseWeek(t, bytes, true, false)
 }
 
 type fmtWeek1 struct{}
@@ -376,8 +438,7 @@ func (fmtWeek1) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (u fmtWeek1) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	return parseWeek(t, bytes, false, false)
 }
 
 type fmtWeek2 struct{}
@@ -388,8 +449,7 @@ func (fmtWeek2) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (v fmtWeek2) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	return parseWeek(t, bytes, true, true)
 }
 
 type fmtWeek3 struct{}
@@ -400,8 +460,19 @@ func (fmtWeek3) format(dst []byte, t DateTime, prec uint8) []byte {
 }
 
 func (v fmtWeek3) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	return parseWeek(t, bytes, false, true)
+}
+
+// parseWeek parses a week of the year, which makes a date with a day of the
+// week. Weeks start on Sunday when sunday is set, and are counted from the
+// first week of the year of %X or %x when strict is set, which starts week 1.
+func parseWeek(t *timeparts, bytes string, sunday, strict bool) (string, bool) {
+	week, out, ok := getnum(bytes, false)
+	if !ok || week > 53 || strict && week == 0 {
+		return "", false
+	}
+	t.week, t.sundayWeek, t.strictWeek = week, sunday, strict
+	return out, true
 }
 
 type fmtWeekdayName struct{}
@@ -410,8 +481,9 @@ func (fmtWeekdayName) format(dst []byte, t DateTime, prec uint8) []byte {
 	return append(dst, t.Date.Weekday().String()...)
 }
 func (w fmtWeekdayName) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	day, out, ok := lookup(dayNames, bytes)
+	t.weekday = isoWeekday(day)
+	return out, ok
 }
 
 type fmtWeekday struct{}
@@ -420,8 +492,11 @@ func (fmtWeekday) format(dst []byte, t DateTime, prec uint8) []byte {
 	return appendInt(dst, int(t.Date.Weekday()), 0)
 }
 func (w fmtWeekday) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	if len(bytes) == 0 || bytes[0] < '0' || bytes[0] > '6' {
+		return "", false
+	}
+	t.weekday = isoWeekday(int(bytes[0] - '0'))
+	return bytes[1:], true
 }
 
 type fmtYearForWeek2 struct{}
@@ -431,8 +506,7 @@ func (fmtYearForWeek2) format(dst []byte, t DateTime, prec uint8) []byte {
 	return appendInt(dst, year, 4)
 }
 func (x fmtYearForWeek2) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	return parseWeekYear(t, bytes, true)
 }
 
 type fmtYearForWeek3 struct{}
@@ -442,8 +516,21 @@ func (fmtYearForWeek3) format(dst []byte, t DateTime, prec uint8) []byte {
 	return appendInt(dst, year, 4)
 }
 func (x fmtYearForWeek3) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	return parseWeekYear(t, bytes, false)
+}
+
+// parseWeekYear parses the year of up to four digits that %V or %v count
+// weeks from, whose weeks start on Sunday when sunday is set.
+func parseWeekYear(t *timeparts, bytes string, sunday bool) (string, bool) {
+	year, n := 0, 0
+	for ; n < 4 && isDigit(bytes, n); n++ {
+		year = year*10 + int(bytes[n]-'0')
+	}
+	if n == 0 {
+		return "", false
+	}
+	t.weekYear, t.sundayWeekYear = year, sunday
+	return bytes[n:], true
 }
 
 type fmtYearLong struct{}
@@ -498,14 +585,38 @@ type fmtVerbatim struct {
 }
 
 func (v *fmtVerbatim) parse(t *timeparts, bytes string) (string, bool) {
-	//TODO implement me
-	panic("implement me")
+	// Like MySQL, spaces in the input are skipped before every character of
+	// the pattern, which must match unless it is a space too.
+	for i := 0; i < len(v.s); i++ {
+		for len(bytes) > 0 && isSpace(bytes[0]) {
+			bytes = bytes[1:]
+		}
+		if isSpace(v.s[i]) {
+			continue
+		}
+		if len(bytes) == 0 || bytes[0] != v.s[i] {
+			return "", false
+		}
+		bytes = bytes[1:]
+	}
+	return bytes, true
 }
 
 func (v *fmtVerbatim) format(dst []byte, t DateTime, prec uint8) []byte {
 	return append(dst, v.s...)
 }
 
+// parseAll parses the parts of a pattern made of other patterns.
+func parseAll(tp *timeparts, b string, specs ...parser) (string, bool) {
+	var ok bool
+	for _, spec := range specs {
+		if b, ok = spec.parse(tp, b); !ok {
+			return "", false
+		}
+	}
+	return b, true
+}
+
 type fmtSeparator byte
 
 func (s fmtSeparator) format(dst []byte, t DateTime, prec uint8) []byte {
@@ -126,6 +126,8 @@ func (f *Strftime) parse(s string, prec int) (DateTime, string, int, bool) {
 	tp.month = -1
 	tp.day = -1
 	tp.yday = -1
+	tp.week = -1
+	tp.weekYear = -1
 
 	var ok bool
 	for _, w := range f.compiled {
@@ -142,3 +144,46 @@ func (f *Strftime) Parse(s string, prec int) (DateTime, int, bool) {
 	t, s, l, ok := f.parse(s, prec)
 	return t, l, ok && len(s) == 0
 }
+
+// StrToDate parses s like MySQL's STR_TO_DATE does, which is more lenient
+// than Parse: spaces in s are skipped before every part of the pattern, the
+// rest of the pattern is ignored once s ends, and the parts of the date and
+// the time the pattern does not set are zero. It returns the text left in s
+// after the pattern, which MySQL ignores with a warning unless it is spaces.
+func (f *Strftime) StrToDate(s string, prec int) (DateTime, int, string, bool) {
+	var tp timeparts
+	tp.week = -1
+	tp.weekYear = -1
+
+	// MySQL matches the pattern one character at a time, so s can end in
+	// the middle of its verbatim parts too.
+	var specs []parser
+	for _, w := range f.compiled {
+		if v, ok := w.(*fmtVerbatim); ok {
+			for i := range len(v.s) {
+				specs = append(specs, &fmtVerbatim{s: v.s[i : i+1]})
+			}
+			continue
+		}
+		specs = append(specs, w)
+	}
+
+	var ok bool
+	for _, spec := range specs {
+		if s = skipSpaces(s); len(s) == 0 {
+			break
+		}
+		if s, ok = spec.parse(&tp, s); !ok {
+			return DateTime{}, 0, "", false
+		}
+	}
+	t, l, ok := tp.toStrToDate(prec)
+	return t, l, s, ok
+}
+
+func skipSpaces(s string) string {
+	for len(s) > 0 && isSpace(s[0]) {
+		s = s[1:]
+	}
+	return s
+}
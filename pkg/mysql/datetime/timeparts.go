@@ -31,6 +31,21 @@ type timeparts struct {
 	pmset bool
 	amset bool
 
+	// hour12 is set by the specifiers of hours from 1 to 12.
+	hour12 bool
+
+	// week is the week of the year %U, %u, %V or %v sets, or -1, counted from
+	// weeks that start on Sunday when sundayWeek is set and from the first
+	// week of weekYear, the year %X or %x sets, when strictWeek is set.
+	// weekday is the day of the week %a, %W or %w sets, from 1 for Monday to
+	// 7 for Sunday, or 0.
+	week           int
+	weekday        int
+	weekYear       int
+	sundayWeek     bool
+	strictWeek     bool
+	sundayWeekYear bool
+
 	prec uint8
 }
 
@@ -45,20 +60,97 @@ func (tp *timeparts) toDateTime(prec int) (DateTime, int, bool) {
 	} else if tp.amset && tp.hour == 12 {
 		tp.hour = 0
 	}
-	if tp.yday > 0 {
+	if !tp.fromDayNumbers() {
+		return DateTime{}, 0, false
+	}
+	if tp.month < 1 {
+		tp.month = int(time.January)
+	}
+	if tp.day < 0 {
+		tp.day = 1
+	}
+	if tp.day < 1 || tp.day > daysIn(time.Month(tp.month), tp.year) {
 		return DateTime{}, 0, false
-	} else {
-		if tp.month < 1 {
-			tp.month = int(time.January)
+	}
+	dt := tp.dateTime(prec)
+	return dt, int(tp.prec), true
+}
+
+// toStrToDate returns the DATETIME STR_TO_DATE parses, where the parts that
+// are not set are zero, as the month and the day of a date can be.
+func (tp *timeparts) toStrToDate(prec int) (DateTime, int, bool) {
+	if tp.hour12 {
+		if tp.hour < 1 || tp.hour > 12 {
+			return DateTime{}, 0, false
 		}
-		if tp.day < 0 {
-			tp.day = 1
+		tp.hour %= 12
+		if tp.pmset {
+			tp.hour += 12
 		}
 	}
-	if tp.day < 1 || tp.day > daysIn(time.Month(tp.month), tp.year) {
+	if !tp.fromDayNumbers() {
+		return DateTime{}, 0, false
+	}
+	tp.month, tp.day = max(tp.month, 0), max(tp.day, 0)
+	if tp.month > 12 || tp.day > 31 || tp.hour > 23 || tp.min > 59 || tp.sec > 59 {
 		return DateTime{}, 0, false
 	}
+	if tp.month > 0 && tp.day > daysIn(time.Month(tp.month), tp.year) {
+		return DateTime{}, 0, false
+	}
+	dt := tp.dateTime(prec)
+	return dt, int(tp.prec), true
+}
+
+// fromDayNumbers sets the date from the day of the year, and then from the
+// week and the day of the week, like MySQL does, when they are set. The week
+// of %V and %v must come with the year of %X and %x that counts weeks alike,
+// and the week of %U and %u without one.
+func (tp *timeparts) fromDayNumbers() bool {
+	if tp.yday > 0 {
+		daynr := MysqlDayNumber(tp.year, 1, 1) + tp.yday - 1
+		if daynr <= 0 || daynr > maxDay {
+			return false
+		}
+		d := DateFromDayNumber(daynr)
+		tp.year, tp.month, tp.day = d.Year(), d.Month(), d.Day()
+	}
+	if tp.week >= 0 && tp.weekday > 0 {
+		if tp.strictWeek && (tp.weekYear < 0 || tp.sundayWeekYear != tp.sundayWeek) || !tp.strictWeek && tp.weekYear >= 0 {
+			return false
+		}
+		year := tp.year
+		if tp.strictWeek {
+			year = tp.weekYear
+		}
+		daynr := MysqlDayNumber(year, 1, 1)
+		// The day of the week of January 1st, from 0 for the first day of
+		// a week.
+		first := (daynr + 5) % 7
+		if tp.sundayWeek {
+			first = (daynr + 6) % 7
+			if first != 0 {
+				daynr += 7
+			}
+			daynr += (tp.week-1)*7 + tp.weekday%7 - first
+		} else {
+			if first > 3 {
+				daynr += 7
+			}
+			daynr += (tp.week-1)*7 + tp.weekday - 1 - first
+		}
+		if daynr <= 0 || daynr > maxDay {
+			return false
+		}
+		d := DateFromDayNumber(daynr)
+		tp.year, tp.month, tp.day = d.Year(), d.Month(), d.Day()
+	}
+	return true
+}
 
+// dateTime returns the DATETIME of the parts, rounded to prec fractional
+// digits unless prec is negative.
+func (tp *timeparts) dateTime(prec int) DateTime {
 	dt := DateTime{
 		Date: Date{
 			year:  uint16(tp.year),
@@ -73,14 +165,11 @@ func (tp *timeparts) toDateTime(prec int) (DateTime, int, bool) {
 		},
 	}
 
-	l := prec
-	if prec < 0 {
-		l = int(tp.prec)
-	} else {
+	if prec >= 0 {
+		tp.prec = uint8(prec)
 		dt = dt.Round(prec)
 	}
-
-	return dt, l, true
+	return dt
 }
 
 func (tp *timeparts) isZero() bool {
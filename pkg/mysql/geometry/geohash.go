@@ -0,0 +1,130 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geometry
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const geoHashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// MaxGeoHashLength is the longest GeoHash there is.
+const MaxGeoHashLength = 100
+
+// ErrInvalidGeoHash is returned for GeoHashes that are empty, too long or
+// have characters that are not in the GeoHash alphabet.
+var ErrInvalidGeoHash = errors.New("invalid geohash")
+
+// EncodeGeoHash returns the GeoHash of a position of length characters.
+// Like MySQL, it puts a coordinate at the midpoint of an interval into its
+// upper half.
+func EncodeGeoHash(longitude, latitude float64, length int) string {
+	lon := [2]float64{-180, 180}
+	lat := [2]float64{-90, 90}
+	hash := make([]byte, length)
+	bit := 0
+	for i := range hash {
+		var c byte
+		for j := 0; j < 5; j, bit = j+1, bit+1 {
+			interval, value := &lon, longitude
+			if bit%2 == 1 {
+				interval, value = &lat, latitude
+			}
+			mid := (interval[0] + interval[1]) / 2
+			c <<= 1
+			if value >= mid {
+				c |= 1
+				interval[0] = mid
+			} else {
+				interval[1] = mid
+			}
+		}
+		hash[i] = geoHashAlphabet[c]
+	}
+	return string(hash)
+}
+
+// GeoHashBox is the area a GeoHash stands for.
+type GeoHashBox struct {
+	MinLongitude, MaxLongitude float64
+	MinLatitude, MaxLatitude   float64
+}
+
+// DecodeGeoHash returns the area a GeoHash stands for. The GeoHash is case
+// insensitive.
+func DecodeGeoHash(hash string) (GeoHashBox, error) {
+	if len(hash) == 0 || len(hash) > MaxGeoHashLength {
+		return GeoHashBox{}, ErrInvalidGeoHash
+	}
+	box := GeoHashBox{-180, 180, -90, 90}
+	bit := 0
+	for i := 0; i < len(hash); i++ {
+		c := strings.IndexByte(geoHashAlphabet, lower(hash[i]))
+		if c < 0 {
+			return GeoHashBox{}, ErrInvalidGeoHash
+		}
+		for j := 4; j >= 0; j, bit = j-1, bit+1 {
+			min, max := &box.MinLongitude, &box.MaxLongitude
+			if bit%2 == 1 {
+				min, max = &box.MinLatitude, &box.MaxLatitude
+			}
+			mid := (*min + *max) / 2
+			if c>>j&1 == 1 {
+				*min = mid
+			} else {
+				*max = mid
+			}
+		}
+	}
+	return box, nil
+}
+
+func lower(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+// Longitude returns the longitude of the center of a box, rounded to as few
+// decimal digits as keep it in the box, like ST_LongFromGeoHash does.
+func (b GeoHashBox) Longitude() float64 {
+	return roundWithin(b.MinLongitude, b.MaxLongitude)
+}
+
+// Latitude returns the latitude of the center of a box, rounded to as few
+// decimal digits as keep it in the box, like ST_LatFromGeoHash does.
+func (b GeoHashBox) Latitude() float64 {
+	return roundWithin(b.MinLatitude, b.MaxLatitude)
+}
+
+func roundWithin(min, max float64) float64 {
+	center := (min + max) / 2
+	for digits := 0; digits < 17; digits++ {
+		f, _ := strconv.ParseFloat(strconv.FormatFloat(center, 'f', digits, 64), 64)
+		if f == 0 {
+			// Rounding keeps the sign of a negative center, which MySQL drops.
+			f = 0
+		}
+		if f >= min && f <= max {
+			return f
+		}
+	}
+	return center
+}
@@ -0,0 +1,421 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geometry
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/json"
+)
+
+// The options of GeoJSON, which ST_AsGeoJSON takes as a bitmask.
+const (
+	GeoJSONBoundingBox = 1 << iota
+	GeoJSONShortCRS
+	GeoJSONLongCRS
+)
+
+// The ways ParseGeoJSON handles positions with more than two coordinates,
+// which ST_GeomFromGeoJSON takes as its options.
+const (
+	DimensionsReject = 1 + iota
+	DimensionsStrip
+	DimensionsStripFor3
+	DimensionsStripFor4
+)
+
+// GeoJSON data errors. ErrInvalidGeoJSON is returned for data that is not
+// GeoJSON in a way a MemberError or a DimensionError does not describe.
+var ErrInvalidGeoJSON = errors.New("invalid GeoJSON data")
+
+// MemberError is returned for GeoJSON objects that miss a member they need,
+// or whose member is of the wrong type. Type is empty for a missing member.
+type MemberError struct {
+	Member string
+	Type   string
+}
+
+func (e *MemberError) Error() string {
+	if e.Type == "" {
+		return fmt.Sprintf("missing required member '%s'", e.Member)
+	}
+	return fmt.Sprintf("member '%s' must be of type '%s'", e.Member, e.Type)
+}
+
+// DimensionError is returned for GeoJSON positions with more than two
+// coordinates when they are rejected.
+type DimensionError struct {
+	Found int
+}
+
+func (e *DimensionError) Error() string {
+	return fmt.Sprintf("found %d coordinate dimensions, expected 2", e.Found)
+}
+
+var geoJSONTypes = map[string]Type{
+	"Point":              TypePoint,
+	"LineString":         TypeLineString,
+	"Polygon":            TypePolygon,
+	"MultiPoint":         TypeMultiPoint,
+	"MultiLineString":    TypeMultiLineString,
+	"MultiPolygon":       TypeMultiPolygon,
+	"GeometryCollection": TypeGeometryCollection,
+}
+
+func (t Type) geoJSONName() string {
+	for name, typ := range geoJSONTypes {
+		if typ == t {
+			return name
+		}
+	}
+	return ""
+}
+
+// ParseGeoJSON parses a geometry, a Feature or a FeatureCollection in GeoJSON.
+// A FeatureCollection is a geometry collection of the geometries of its
+// features, and a Feature without a geometry is nil. The geometry is in the
+// system the crs member of the document names, or in WGS 84 if there is none,
+// and its points are longitude first like GeoJSON has them.
+func ParseGeoJSON(doc *json.Value, dimensions int) (*Geometry, error) {
+	p := &geoJSONParser{dimensions: dimensions}
+	g, err := p.object(doc, 0)
+	if err != nil || g == nil {
+		return nil, err
+	}
+	srid := uint32(4326)
+	if crs, ok := doc.Object().Get("crs"); ok && crs.Type() != json.TypeNull {
+		if srid, err = parseCRS(crs); err != nil {
+			return nil, err
+		}
+	}
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+	g.SetSRID(srid)
+	return g, nil
+}
+
+// parseCRS returns the SRID of a named coordinate reference system.
+func parseCRS(crs *json.Value) (uint32, error) {
+	props, err := member(crs, "properties", json.TypeObject)
+	if err != nil {
+		return 0, err
+	}
+	name, err := member(props, "name", json.TypeString)
+	if err != nil {
+		return 0, err
+	}
+	text := name.Text()
+	if text == "urn:ogc:def:crs:OGC:1.3:CRS84" {
+		return 4326, nil
+	}
+	for _, prefix := range []string{"urn:ogc:def:crs:EPSG::", "EPSG:"} {
+		if digits, ok := strings.CutPrefix(text, prefix); ok {
+			srid, err := strconv.ParseUint(digits, 10, 32)
+			if err != nil {
+				return 0, ErrInvalidGeoJSON
+			}
+			return uint32(srid), nil
+		}
+	}
+	return 0, ErrInvalidGeoJSON
+}
+
+type geoJSONParser struct {
+	dimensions int
+}
+
+// member returns the member of an object, which has to be of a type.
+func member(v *json.Value, key string, typ json.Type) (*json.Value, error) {
+	if v.Type() != json.TypeObject {
+		return nil, ErrInvalidGeoJSON
+	}
+	m, ok := v.Object().Get(key)
+	if !ok {
+		return nil, &MemberError{Member: key}
+	}
+	if m.Type() != typ {
+		name := "string"
+		switch typ {
+		case json.TypeArray:
+			name = "array"
+		case json.TypeObject:
+			name = "object"
+		}
+		return nil, &MemberError{Member: key, Type: name}
+	}
+	return m, nil
+}
+
+func (p *geoJSONParser) object(v *json.Value, depth int) (*Geometry, error) {
+	if depth > maxDepth {
+		return nil, ErrInvalidGeoJSON
+	}
+	typ, err := member(v, "type", json.TypeString)
+	if err != nil {
+		return nil, err
+	}
+	switch typ.Text() {
+	case "Feature":
+		geom, ok := v.Object().Get("geometry")
+		if !ok {
+			return nil, &MemberError{Member: "geometry"}
+		}
+		if geom.Type() == json.TypeNull {
+			return nil, nil
+		}
+		if geom.Type() != json.TypeObject {
+			return nil, &MemberError{Member: "geometry", Type: "object"}
+		}
+		return p.object(geom, depth+1)
+	case "FeatureCollection":
+		features, err := member(v, "features", json.TypeArray)
+		if err != nil {
+			return nil, err
+		}
+		g := &Geometry{Type: TypeGeometryCollection}
+		for _, feature := range features.Array() {
+			geom, err := p.object(feature, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			if geom != nil {
+				g.Geoms = append(g.Geoms, geom)
+			}
+		}
+		return g, nil
+	case "GeometryCollection":
+		geoms, err := member(v, "geometries", json.TypeArray)
+		if err != nil {
+			return nil, err
+		}
+		g := &Geometry{Type: TypeGeometryCollection}
+		for _, geom := range geoms.Array() {
+			member, err := p.object(geom, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			if member == nil {
+				return nil, ErrInvalidGeoJSON
+			}
+			g.Geoms = append(g.Geoms, member)
+		}
+		return g, nil
+	}
+	t, ok := geoJSONTypes[typ.Text()]
+	if !ok {
+		return nil, ErrInvalidGeoJSON
+	}
+	coordinates, err := member(v, "coordinates", json.TypeArray)
+	if err != nil {
+		return nil, err
+	}
+	return p.geometry(t, coordinates)
+}
+
+// geometry returns the geometry of a type with coordinates.
+func (p *geoJSONParser) geometry(t Type, coordinates *json.Value) (*Geometry, error) {
+	g := &Geometry{Type: t}
+	var err error
+	switch t {
+	case TypePoint:
+		var point Point
+		point, err = p.position(coordinates)
+		g.Points = []Point{point}
+	case TypeLineString:
+		g.Points, err = p.positions(coordinates)
+	case TypePolygon:
+		g.Rings, err = p.rings(coordinates)
+	default:
+		var member Type
+		switch t {
+		case TypeMultiPoint:
+			member = TypePoint
+		case TypeMultiLineString:
+			member = TypeLineString
+		default:
+			member = TypePolygon
+		}
+		for _, c := range coordinates.Array() {
+			if c.Type() != json.TypeArray {
+				return nil, ErrInvalidGeoJSON
+			}
+			geom, err := p.geometry(member, c)
+			if err != nil {
+				return nil, err
+			}
+			g.Geoms = append(g.Geoms, geom)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (p *geoJSONParser) position(v *json.Value) (Point, error) {
+	coordinates := v.Array()
+	if v.Type() != json.TypeArray || len(coordinates) < 2 {
+		return Point{}, ErrInvalidGeoJSON
+	}
+	if len(coordinates) > 2 && p.dimensions == DimensionsReject {
+		return Point{}, &DimensionError{Found: len(coordinates)}
+	}
+	var xy [2]float64
+	for i, c := range coordinates {
+		f, ok := number(c)
+		if !ok {
+			return Point{}, ErrInvalidGeoJSON
+		}
+		if i < 2 {
+			xy[i] = f
+		}
+	}
+	return Point{X: xy[0], Y: xy[1]}, nil
+}
+
+func (p *geoJSONParser) positions(v *json.Value) ([]Point, error) {
+	if v.Type() != json.TypeArray {
+		return nil, ErrInvalidGeoJSON
+	}
+	var points []Point
+	for _, c := range v.Array() {
+		point, err := p.position(c)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, point)
+	}
+	return points, nil
+}
+
+func (p *geoJSONParser) rings(v *json.Value) ([][]Point, error) {
+	var rings [][]Point
+	for _, c := range v.Array() {
+		ring, err := p.positions(c)
+		if err != nil {
+			return nil, err
+		}
+		rings = append(rings, ring)
+	}
+	return rings, nil
+}
+
+// number returns the value of a JSON number.
+func number(v *json.Value) (float64, bool) {
+	switch v.Type() {
+	case json.TypeInteger:
+		return float64(v.Int64()), true
+	case json.TypeUnsigned:
+		return float64(v.Uint64()), true
+	case json.TypeDouble:
+		return v.Float64(), true
+	case json.TypeDecimal:
+		dec, _ := v.Decimal()
+		return dec.Float64()
+	}
+	return 0, false
+}
+
+// GeoJSON returns a geometry in GeoJSON, longitude first, with its
+// coordinates rounded to maxDigits decimal digits, and the members options
+// ask for: a bounding box, and the coordinate reference system of a geometry
+// with an SRID other than 0 as a short or, taking precedence, a long URN.
+func (g *Geometry) GeoJSON(maxDigits int, options int) *json.Value {
+	v := g.geoJSON(maxDigits)
+	if options&GeoJSONBoundingBox != 0 && !g.IsEmpty() {
+		min, max := g.Bounds()
+		v.Object().Set("bbox", json.NewArray(
+			coordinate(min.X, maxDigits), coordinate(min.Y, maxDigits),
+			coordinate(max.X, maxDigits), coordinate(max.Y, maxDigits)))
+	}
+	if options&(GeoJSONShortCRS|GeoJSONLongCRS) != 0 && g.SRID != 0 {
+		name := fmt.Sprintf("EPSG:%d", g.SRID)
+		if options&GeoJSONLongCRS != 0 {
+			name = fmt.Sprintf("urn:ogc:def:crs:EPSG::%d", g.SRID)
+		}
+		props := json.NewObject()
+		props.Object().Set("name", json.NewString(name))
+		crs := json.NewObject()
+		crs.Object().Set("type", json.NewString("name"))
+		crs.Object().Set("properties", props)
+		v.Object().Set("crs", crs)
+	}
+	return v
+}
+
+func (g *Geometry) geoJSON(maxDigits int) *json.Value {
+	v := json.NewObject()
+	v.Object().Set("type", json.NewString(g.Type.geoJSONName()))
+	if g.Type == TypeGeometryCollection {
+		geoms := make([]*json.Value, len(g.Geoms))
+		for i, geom := range g.Geoms {
+			geoms[i] = geom.geoJSON(maxDigits)
+		}
+		v.Object().Set("geometries", json.NewArray(geoms...))
+		return v
+	}
+	v.Object().Set("coordinates", g.coordinates(maxDigits))
+	return v
+}
+
+func (g *Geometry) coordinates(maxDigits int) *json.Value {
+	switch g.Type {
+	case TypePoint:
+		return position(g.Point(), maxDigits)
+	case TypeLineString:
+		return positions(g.Points, maxDigits)
+	case TypePolygon:
+		rings := make([]*json.Value, len(g.Rings))
+		for i, ring := range g.Rings {
+			rings[i] = positions(ring, maxDigits)
+		}
+		return json.NewArray(rings...)
+	}
+	geoms := make([]*json.Value, len(g.Geoms))
+	for i, geom := range g.Geoms {
+		geoms[i] = geom.coordinates(maxDigits)
+	}
+	return json.NewArray(geoms...)
+}
+
+func position(p Point, maxDigits int) *json.Value {
+	return json.NewArray(coordinate(p.X, maxDigits), coordinate(p.Y, maxDigits))
+}
+
+func positions(points []Point, maxDigits int) *json.Value {
+	values := make([]*json.Value, len(points))
+	for i, p := range points {
+		values[i] = position(p, maxDigits)
+	}
+	return json.NewArray(values...)
+}
+
+// coordinate returns a coordinate rounded to maxDigits decimal digits.
+func coordinate(f float64, maxDigits int) *json.Value {
+	if maxDigits < 17 {
+		f, _ = strconv.ParseFloat(strconv.FormatFloat(f, 'f', maxDigits, 64), 64)
+	}
+	if f == 0 {
+		f = math.Abs(f)
+	}
+	return json.NewFloat64(f)
+}
@@ -0,0 +1,297 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package geometry implements MySQL's spatial data types: geometries in a
+// spatial reference system, their internal format, which is the SRID of the
+// system followed by the geometry in WKB, the WKT, WKB and GeoJSON formats,
+// GeoHashes, and the properties and measures of geometries.
+//
+// Coordinates are X and Y, which in geographic spatial reference systems are
+// the longitude and the latitude, in degrees, like MySQL stores them. WKT and
+// WKB have them in the axis order of their system instead, which is latitude
+// first for the geographic systems of the EPSG, so callers swap the axes of
+// those with SwapAxes.
+package geometry
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Type is the type of a geometry, which is its WKB type code.
+type Type uint32
+
+const (
+	TypePoint Type = iota + 1
+	TypeLineString
+	TypePolygon
+	TypeMultiPoint
+	TypeMultiLineString
+	TypeMultiPolygon
+	TypeGeometryCollection
+)
+
+var typeNames = [...]string{
+	TypePoint:              "POINT",
+	TypeLineString:         "LINESTRING",
+	TypePolygon:            "POLYGON",
+	TypeMultiPoint:         "MULTIPOINT",
+	TypeMultiLineString:    "MULTILINESTRING",
+	TypeMultiPolygon:       "MULTIPOLYGON",
+	TypeGeometryCollection: "GEOMCOLLECTION",
+}
+
+// String returns the name MySQL gives the type, like ST_GeometryType does.
+func (t Type) String() string {
+	if t < TypePoint || t > TypeGeometryCollection {
+		return "GEOMETRY"
+	}
+	return typeNames[t]
+}
+
+// Point is the position of a point.
+type Point struct {
+	X, Y float64
+}
+
+// Geometry is a geometry in the spatial reference system SRID. Which of its
+// fields hold it depends on its type:
+//
+//   - a Point has its position as the only one of Points;
+//   - a LineString has its points in Points;
+//   - a Polygon has its rings in Rings, the exterior ring first, each with
+//     its first point again at its end;
+//   - a MultiPoint, a MultiLineString, a MultiPolygon and a
+//     GeometryCollection have the geometries they are made of in Geoms,
+//     which have the same SRID.
+type Geometry struct {
+	Type   Type
+	SRID   uint32
+	Points []Point
+	Rings  [][]Point
+	Geoms  []*Geometry
+}
+
+// NewPoint returns a point.
+func NewPoint(srid uint32, x, y float64) *Geometry {
+	return &Geometry{Type: TypePoint, SRID: srid, Points: []Point{{x, y}}}
+}
+
+// Point returns the position of a point.
+func (g *Geometry) Point() Point {
+	return g.Points[0]
+}
+
+// IsEmpty reports whether a geometry has no points, which only a geometry
+// collection can.
+func (g *Geometry) IsEmpty() bool {
+	if g.Type != TypeGeometryCollection {
+		return false
+	}
+	for _, geom := range g.Geoms {
+		if !geom.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// SetSRID sets the SRID of a geometry and of the geometries it is made of.
+func (g *Geometry) SetSRID(srid uint32) {
+	g.SRID = srid
+	for _, geom := range g.Geoms {
+		geom.SetSRID(srid)
+	}
+}
+
+// Each calls fn with each point of a geometry, which it can change.
+func (g *Geometry) Each(fn func(p *Point)) {
+	for i := range g.Points {
+		fn(&g.Points[i])
+	}
+	for _, ring := range g.Rings {
+		for i := range ring {
+			fn(&ring[i])
+		}
+	}
+	for _, geom := range g.Geoms {
+		geom.Each(fn)
+	}
+}
+
+// SwapAxes swaps the X and Y of every point of a geometry.
+func (g *Geometry) SwapAxes() {
+	g.Each(func(p *Point) {
+		p.X, p.Y = p.Y, p.X
+	})
+}
+
+// Clone returns a copy of a geometry that shares nothing with it.
+func (g *Geometry) Clone() *Geometry {
+	clone := &Geometry{Type: g.Type, SRID: g.SRID, Points: append([]Point(nil), g.Points...)}
+	for _, ring := range g.Rings {
+		clone.Rings = append(clone.Rings, append([]Point(nil), ring...))
+	}
+	for _, geom := range g.Geoms {
+		clone.Geoms = append(clone.Geoms, geom.Clone())
+	}
+	return clone
+}
+
+// ErrInvalidData is returned for geometries that are not well formed: text or
+// binary data that is not a geometry, coordinates that are not finite,
+// linestrings with fewer than two points, and polygons with rings that are
+// not closed or have fewer than four points.
+var ErrInvalidData = errors.New("invalid GIS data")
+
+// maxDepth is how deeply geometry collections can nest.
+const maxDepth = 100
+
+// Validate returns ErrInvalidData if a geometry is not well formed.
+func (g *Geometry) Validate() error {
+	return g.check(0)
+}
+
+// check returns ErrInvalidData if a geometry is not well formed.
+func (g *Geometry) check(depth int) error {
+	if depth > maxDepth {
+		return ErrInvalidData
+	}
+	var finite = true
+	for _, p := range g.Points {
+		finite = finite && isFinite(p)
+	}
+	switch g.Type {
+	case TypePoint:
+		if len(g.Points) != 1 {
+			return ErrInvalidData
+		}
+	case TypeLineString:
+		if len(g.Points) < 2 {
+			return ErrInvalidData
+		}
+	case TypePolygon:
+		if len(g.Rings) == 0 {
+			return ErrInvalidData
+		}
+		for _, ring := range g.Rings {
+			if len(ring) < 4 || ring[0] != ring[len(ring)-1] {
+				return ErrInvalidData
+			}
+			for _, p := range ring {
+				finite = finite && isFinite(p)
+			}
+		}
+	case TypeMultiPoint, TypeMultiLineString, TypeMultiPolygon:
+		if len(g.Geoms) == 0 {
+			return ErrInvalidData
+		}
+		fallthrough
+	case TypeGeometryCollection:
+		for _, geom := range g.Geoms {
+			if !g.Type.contains(geom.Type) {
+				return ErrInvalidData
+			}
+			if err := geom.check(depth + 1); err != nil {
+				return err
+			}
+		}
+	default:
+		return ErrInvalidData
+	}
+	if !finite {
+		return ErrInvalidData
+	}
+	return nil
+}
+
+// contains reports whether a geometry of a type can be made of geometries of
+// another type.
+func (t Type) contains(member Type) bool {
+	switch t {
+	case TypeMultiPoint:
+		return member == TypePoint
+	case TypeMultiLineString:
+		return member == TypeLineString
+	case TypeMultiPolygon:
+		return member == TypePolygon
+	}
+	return t == TypeGeometryCollection
+}
+
+func isFinite(p Point) bool {
+	return !math.IsInf(p.X, 0) && !math.IsNaN(p.X) && !math.IsInf(p.Y, 0) && !math.IsNaN(p.Y)
+}
+
+// SRS is a spatial reference system.
+type SRS struct {
+	ID   uint32
+	Name string
+	// Geographic is set for systems on an ellipsoid, whose coordinates are
+	// longitudes and latitudes.
+	Geographic bool
+	// LatitudeFirst is set for geographic systems whose axis order has the
+	// latitude first.
+	LatitudeFirst bool
+}
+
+// srss are the spatial reference systems there are: the Cartesian plane of
+// SRID 0, WGS 84 and its Pseudo-Mercator projection.
+var srss = map[uint32]*SRS{
+	0:    {ID: 0},
+	3857: {ID: 3857, Name: "WGS 84 / Pseudo-Mercator"},
+	4326: {ID: 4326, Name: "WGS 84", Geographic: true, LatitudeFirst: true},
+}
+
+// GetSRS returns the spatial reference system with an SRID.
+func GetSRS(srid uint32) (*SRS, bool) {
+	srs, ok := srss[srid]
+	return srs, ok
+}
+
+// RangeError is returned for coordinates of geographic geometries that are
+// out of the range of longitudes or latitudes.
+type RangeError struct {
+	Latitude bool
+	Value    float64
+}
+
+func (e *RangeError) Error() string {
+	if e.Latitude {
+		return fmt.Sprintf("latitude %f is out of range", e.Value)
+	}
+	return fmt.Sprintf("longitude %f is out of range", e.Value)
+}
+
+// CheckRange returns a RangeError if a geometry is in a geographic system
+// and has a longitude out of (-180, 180] or a latitude out of [-90, 90].
+func (g *Geometry) CheckRange(srs *SRS) error {
+	if !srs.Geographic {
+		return nil
+	}
+	var err error
+	g.Each(func(p *Point) {
+		switch {
+		case err != nil:
+		case p.X <= -180 || p.X > 180:
+			err = &RangeError{Value: p.X}
+		case p.Y < -90 || p.Y > 90:
+			err = &RangeError{Latitude: true, Value: p.Y}
+		}
+	})
+	return err
+}
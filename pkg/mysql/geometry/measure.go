@@ -0,0 +1,338 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geometry
+
+import (
+	"math"
+)
+
+// Bounds returns the lower left and upper right corners of the smallest
+// rectangle a geometry fits in, which is not defined for empty geometries.
+func (g *Geometry) Bounds() (min, max Point) {
+	min = Point{math.Inf(1), math.Inf(1)}
+	max = Point{math.Inf(-1), math.Inf(-1)}
+	g.Each(func(p *Point) {
+		min.X, min.Y = math.Min(min.X, p.X), math.Min(min.Y, p.Y)
+		max.X, max.Y = math.Max(max.X, p.X), math.Max(max.Y, p.Y)
+	})
+	return min, max
+}
+
+// Dimension returns the dimension of a geometry, like ST_Dimension: 0 for
+// points, 1 for linestrings, 2 for polygons, the largest dimension of the
+// geometries of a collection, and -1 for empty collections.
+func (g *Geometry) Dimension() int {
+	switch g.Type {
+	case TypePoint, TypeMultiPoint:
+		return 0
+	case TypeLineString, TypeMultiLineString:
+		return 1
+	case TypePolygon, TypeMultiPolygon:
+		return 2
+	}
+	dim := -1
+	for _, geom := range g.Geoms {
+		dim = max(dim, geom.Dimension())
+	}
+	return dim
+}
+
+// Envelope returns the smallest rectangle a geometry fits in, like
+// ST_Envelope: a polygon, or a point or a linestring if the rectangle has no
+// width or height. The envelope of an empty geometry is itself.
+func (g *Geometry) Envelope() *Geometry {
+	if g.IsEmpty() {
+		return &Geometry{Type: TypeGeometryCollection, SRID: g.SRID}
+	}
+	min, max := g.Bounds()
+	switch {
+	case min == max:
+		return NewPoint(g.SRID, min.X, min.Y)
+	case min.X == max.X || min.Y == max.Y:
+		return &Geometry{Type: TypeLineString, SRID: g.SRID, Points: []Point{min, max}}
+	}
+	return &Geometry{Type: TypePolygon, SRID: g.SRID, Rings: [][]Point{{
+		min, {max.X, min.Y}, max, {min.X, max.Y}, min,
+	}}}
+}
+
+// IsClosed reports whether a linestring, or every linestring of a
+// multilinestring, ends at its start.
+func (g *Geometry) IsClosed() bool {
+	if g.Type == TypeLineString {
+		return g.Points[0] == g.Points[len(g.Points)-1]
+	}
+	for _, geom := range g.Geoms {
+		if !geom.IsClosed() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSimple reports whether a geometry has no anomalous points, like
+// ST_IsSimple: a multipoint has no point twice, a linestring does not cross
+// or touch itself other than where a closed one ends at its start, and the
+// linestrings of a multilinestring only meet at their ends. Points and well
+// formed polygons are simple, and a collection is simple if all its
+// geometries are.
+func (g *Geometry) IsSimple() bool {
+	switch g.Type {
+	case TypeLineString:
+		return isSimpleLine(g.Points)
+	case TypeMultiPoint:
+		seen := make(map[Point]bool, len(g.Geoms))
+		for _, geom := range g.Geoms {
+			if seen[geom.Point()] {
+				return false
+			}
+			seen[geom.Point()] = true
+		}
+		return true
+	case TypeMultiLineString:
+		for i, a := range g.Geoms {
+			if !isSimpleLine(a.Points) {
+				return false
+			}
+			for _, b := range g.Geoms[:i] {
+				if !meetAtEnds(a.Points, b.Points) {
+					return false
+				}
+			}
+		}
+		return true
+	case TypeGeometryCollection:
+		for _, geom := range g.Geoms {
+			if !geom.IsSimple() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isSimpleLine(points []Point) bool {
+	closed := points[0] == points[len(points)-1]
+	n := len(points) - 1
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if !segmentsIntersect(points[i], points[i+1], points[j], points[j+1]) {
+				continue
+			}
+			// Consecutive segments share a point, and so do the first and
+			// the last of a closed line, which is fine as long as they do
+			// not overlap.
+			adjacent := j == i+1 || closed && i == 0 && j == n-1
+			if !adjacent || collinearOverlap(points[i], points[i+1], points[j], points[j+1]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// meetAtEnds reports whether two linestrings only have ends of both in
+// common.
+func meetAtEnds(a, b []Point) bool {
+	isEnd := func(p Point, line []Point) bool {
+		return p == line[0] || p == line[len(line)-1]
+	}
+	for i := 0; i+1 < len(a); i++ {
+		for j := 0; j+1 < len(b); j++ {
+			if !segmentsIntersect(a[i], a[i+1], b[j], b[j+1]) {
+				continue
+			}
+			p, ok := sharedPoint(a[i], a[i+1], b[j], b[j+1])
+			if !ok || !isEnd(p, a) || !isEnd(p, b) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// sharedPoint returns the point two segments that intersect have in common
+// if it is an end of both, which is how the ends of linestrings meet.
+func sharedPoint(a1, a2, b1, b2 Point) (Point, bool) {
+	if collinearOverlap(a1, a2, b1, b2) {
+		return Point{}, false
+	}
+	for _, p := range []Point{a1, a2} {
+		if p == b1 || p == b2 {
+			return p, true
+		}
+	}
+	return Point{}, false
+}
+
+func cross(o, a, b Point) float64 {
+	return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+}
+
+func onSegment(p, a, b Point) bool {
+	return math.Min(a.X, b.X) <= p.X && p.X <= math.Max(a.X, b.X) &&
+		math.Min(a.Y, b.Y) <= p.Y && p.Y <= math.Max(a.Y, b.Y)
+}
+
+func segmentsIntersect(a1, a2, b1, b2 Point) bool {
+	d1, d2 := cross(b1, b2, a1), cross(b1, b2, a2)
+	d3, d4 := cross(a1, a2, b1), cross(a1, a2, b2)
+	if (d1 > 0) != (d2 > 0) && d1 != 0 && d2 != 0 && (d3 > 0) != (d4 > 0) && d3 != 0 && d4 != 0 {
+		return true
+	}
+	return d1 == 0 && onSegment(a1, b1, b2) || d2 == 0 && onSegment(a2, b1, b2) ||
+		d3 == 0 && onSegment(b1, a1, a2) || d4 == 0 && onSegment(b2, a1, a2)
+}
+
+// collinearOverlap reports whether two segments on a line have more than a
+// point in common.
+func collinearOverlap(a1, a2, b1, b2 Point) bool {
+	if cross(a1, a2, b1) != 0 || cross(a1, a2, b2) != 0 {
+		return false
+	}
+	// Project on the axis the segment a extends the most along.
+	proj := func(p Point) float64 { return p.X }
+	if math.Abs(a2.X-a1.X) < math.Abs(a2.Y-a1.Y) {
+		proj = func(p Point) float64 { return p.Y }
+	}
+	lo := math.Max(math.Min(proj(a1), proj(a2)), math.Min(proj(b1), proj(b2)))
+	hi := math.Min(math.Max(proj(a1), proj(a2)), math.Max(proj(b1), proj(b2)))
+	return lo < hi
+}
+
+// Length returns the length of a linestring, or the sum of the lengths of the
+// linestrings of a multilinestring, in a Cartesian system.
+func (g *Geometry) Length() float64 {
+	if g.Type == TypeLineString {
+		var length float64
+		for i := 1; i < len(g.Points); i++ {
+			length += math.Hypot(g.Points[i].X-g.Points[i-1].X, g.Points[i].Y-g.Points[i-1].Y)
+		}
+		return length
+	}
+	var length float64
+	for _, geom := range g.Geoms {
+		length += geom.Length()
+	}
+	return length
+}
+
+// Area returns the area of a polygon, which is that of its exterior ring less
+// those of its interior rings, or the sum of the areas of the polygons of a
+// multipolygon, in a Cartesian system.
+func (g *Geometry) Area() float64 {
+	if g.Type == TypePolygon {
+		area := math.Abs(ringArea(g.Rings[0]))
+		for _, ring := range g.Rings[1:] {
+			area -= math.Abs(ringArea(ring))
+		}
+		return area
+	}
+	var area float64
+	for _, geom := range g.Geoms {
+		area += geom.Area()
+	}
+	return area
+}
+
+// ringArea returns the signed area of a closed ring by the shoelace formula.
+func ringArea(ring []Point) float64 {
+	var sum float64
+	for i := 1; i < len(ring); i++ {
+		sum += ring[i-1].X*ring[i].Y - ring[i].X*ring[i-1].Y
+	}
+	return sum / 2
+}
+
+// Centroid returns the center of mass of a geometry in a Cartesian system,
+// like ST_Centroid, which only weighs the parts of a collection of the
+// largest dimension: the mean of points, the middles of the segments of
+// linestrings weighed by their length, and polygons by area. It is nil for
+// empty geometries.
+func (g *Geometry) Centroid() *Geometry {
+	var c centroid
+	c.add(g, g.Dimension())
+	if c.weight == 0 {
+		// Degenerate lines and polygons have no length or area, so fall
+		// back to the mean of their points.
+		if c.dim < 0 {
+			return nil
+		}
+		c = centroid{}
+		c.add(g, 0)
+		if c.weight == 0 {
+			return nil
+		}
+	}
+	return NewPoint(g.SRID, c.x/c.weight, c.y/c.weight)
+}
+
+type centroid struct {
+	dim          int
+	x, y, weight float64
+}
+
+// add adds the parts of a geometry of a dimension to a centroid, or all of
+// its points for dimension 0.
+func (c *centroid) add(g *Geometry, dim int) {
+	c.dim = dim
+	if dim == 0 {
+		g.Each(func(p *Point) {
+			c.x, c.y, c.weight = c.x+p.X, c.y+p.Y, c.weight+1
+		})
+		return
+	}
+	switch {
+	case g.Type == TypeLineString && dim == 1:
+		for i := 1; i < len(g.Points); i++ {
+			a, b := g.Points[i-1], g.Points[i]
+			l := math.Hypot(b.X-a.X, b.Y-a.Y)
+			c.x += l * (a.X + b.X) / 2
+			c.y += l * (a.Y + b.Y) / 2
+			c.weight += l
+		}
+	case g.Type == TypePolygon && dim == 2:
+		for i, ring := range g.Rings {
+			a := ringArea(ring)
+			if a == 0 {
+				continue
+			}
+			var x, y float64
+			for j := 1; j < len(ring); j++ {
+				f := ring[j-1].X*ring[j].Y - ring[j].X*ring[j-1].Y
+				x += (ring[j-1].X + ring[j].X) * f
+				y += (ring[j-1].Y + ring[j].Y) * f
+			}
+			// The centroid of a ring is x/(6a), weighed by |a|, and interior
+			// rings take away from the exterior one.
+			sign := 1.0
+			if a < 0 {
+				sign = -1
+			}
+			if i > 0 {
+				sign = -sign
+			}
+			c.x += sign * x / 6
+			c.y += sign * y / 6
+			c.weight += sign * a
+		}
+	default:
+		for _, geom := range g.Geoms {
+			c.add(geom, dim)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geometry
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// Parse parses a geometry in MySQL's internal format: its SRID, as four
+// little endian bytes, followed by its WKB.
+func Parse(b []byte) (*Geometry, error) {
+	if len(b) < 4 {
+		return nil, ErrInvalidData
+	}
+	g, err := ParseWKB(b[4:])
+	if err != nil {
+		return nil, err
+	}
+	g.SetSRID(binary.LittleEndian.Uint32(b))
+	return g, nil
+}
+
+// Marshal returns a geometry in MySQL's internal format.
+func (g *Geometry) Marshal() []byte {
+	return g.AppendWKB(binary.LittleEndian.AppendUint32(nil, g.SRID))
+}
+
+// ParseWKB parses a well formed geometry in WKB, which has SRID 0. Its points
+// are as the WKB has them, in the axis order of its spatial reference system.
+func ParseWKB(b []byte) (*Geometry, error) {
+	r := &wkbReader{b: b}
+	g, err := r.geometry(0)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.b) > 0 {
+		return nil, ErrInvalidData
+	}
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// AppendWKB appends a geometry in little endian WKB.
+func (g *Geometry) AppendWKB(dst []byte) []byte {
+	dst = append(dst, 1)
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(g.Type))
+	switch g.Type {
+	case TypePoint:
+		return appendPoint(dst, g.Point())
+	case TypeLineString:
+		return appendPoints(dst, g.Points)
+	case TypePolygon:
+		dst = binary.LittleEndian.AppendUint32(dst, uint32(len(g.Rings)))
+		for _, ring := range g.Rings {
+			dst = appendPoints(dst, ring)
+		}
+		return dst
+	}
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(len(g.Geoms)))
+	for _, geom := range g.Geoms {
+		dst = geom.AppendWKB(dst)
+	}
+	return dst
+}
+
+func appendPoint(dst []byte, p Point) []byte {
+	dst = binary.LittleEndian.AppendUint64(dst, math.Float64bits(p.X))
+	return binary.LittleEndian.AppendUint64(dst, math.Float64bits(p.Y))
+}
+
+func appendPoints(dst []byte, points []Point) []byte {
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(len(points)))
+	for _, p := range points {
+		dst = appendPoint(dst, p)
+	}
+	return dst
+}
+
+type wkbReader struct {
+	b     []byte
+	order binary.ByteOrder
+	err   error
+}
+
+func (r *wkbReader) uint32() uint32 {
+	if len(r.b) < 4 {
+		r.err = ErrInvalidData
+		return 0
+	}
+	u := r.order.Uint32(r.b)
+	r.b = r.b[4:]
+	return u
+}
+
+func (r *wkbReader) point() Point {
+	if len(r.b) < 16 {
+		r.err = ErrInvalidData
+		return Point{}
+	}
+	p := Point{X: math.Float64frombits(r.order.Uint64(r.b)), Y: math.Float64frombits(r.order.Uint64(r.b[8:]))}
+	r.b = r.b[16:]
+	return p
+}
+
+// points reads a number of points and the points. The number is checked
+// against what is left to read before anything is allocated for them.
+func (r *wkbReader) points() []Point {
+	n := r.uint32()
+	if r.err != nil || uint64(n)*16 > uint64(len(r.b)) {
+		r.err = ErrInvalidData
+		return nil
+	}
+	points := make([]Point, n)
+	for i := range points {
+		points[i] = r.point()
+	}
+	return points
+}
+
+func (r *wkbReader) geometry(depth int) (*Geometry, error) {
+	if depth > maxDepth || len(r.b) == 0 {
+		return nil, ErrInvalidData
+	}
+	switch r.b[0] {
+	case 0:
+		r.order = binary.BigEndian
+	case 1:
+		r.order = binary.LittleEndian
+	default:
+		return nil, ErrInvalidData
+	}
+	r.b = r.b[1:]
+	g := &Geometry{Type: Type(r.uint32())}
+	switch g.Type {
+	case TypePoint:
+		g.Points = []Point{r.point()}
+	case TypeLineString:
+		g.Points = r.points()
+	case TypePolygon:
+		n := r.uint32()
+		// Each ring takes at least the four bytes of its number of points.
+		if r.err != nil || uint64(n)*4 > uint64(len(r.b)) {
+			return nil, ErrInvalidData
+		}
+		for i := uint32(0); i < n && r.err == nil; i++ {
+			g.Rings = append(g.Rings, r.points())
+		}
+	case TypeMultiPoint, TypeMultiLineString, TypeMultiPolygon, TypeGeometryCollection:
+		n := r.uint32()
+		// Each geometry takes at least the five bytes of its header.
+		if r.err != nil || uint64(n)*5 > uint64(len(r.b)) {
+			return nil, ErrInvalidData
+		}
+		for i := uint32(0); i < n; i++ {
+			geom, err := r.geometry(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			g.Geoms = append(g.Geoms, geom)
+		}
+	default:
+		return nil, ErrInvalidData
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return g, nil
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package geometry
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/format"
+)
+
+// ParseWKT parses a well formed geometry in WKT, which has SRID 0. Its points
+// are as the WKT has them, in the axis order of its spatial reference system.
+//
+// Like MySQL, it accepts the points of a MULTIPOINT with or without
+// parentheses around each, GEOMCOLLECTION for GEOMETRYCOLLECTION, and
+// GEOMETRYCOLLECTION EMPTY and GEOMETRYCOLLECTION() for empty collections.
+func ParseWKT(s string) (*Geometry, error) {
+	p := &wktParser{s: s}
+	g := p.geometry(0)
+	p.skipSpace()
+	if p.err != nil || p.pos < len(p.s) {
+		return nil, ErrInvalidData
+	}
+	if err := g.Validate(); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// WKT returns a geometry in WKT, the way ST_AsText writes it.
+func (g *Geometry) WKT() string {
+	var b strings.Builder
+	g.appendWKT(&b, true)
+	return b.String()
+}
+
+func (g *Geometry) appendWKT(b *strings.Builder, tagged bool) {
+	if tagged {
+		if g.Type == TypeGeometryCollection {
+			b.WriteString("GEOMETRYCOLLECTION")
+			if len(g.Geoms) == 0 {
+				b.WriteString(" EMPTY")
+				return
+			}
+		} else {
+			b.WriteString(g.Type.String())
+		}
+	}
+	b.WriteByte('(')
+	switch g.Type {
+	case TypePoint:
+		writeWKTPoint(b, g.Point())
+	case TypeLineString:
+		writeWKTPoints(b, g.Points)
+	case TypePolygon:
+		for i, ring := range g.Rings {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteByte('(')
+			writeWKTPoints(b, ring)
+			b.WriteByte(')')
+		}
+	default:
+		for i, geom := range g.Geoms {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			geom.appendWKT(b, g.Type == TypeGeometryCollection)
+		}
+	}
+	b.WriteByte(')')
+}
+
+func writeWKTPoint(b *strings.Builder, p Point) {
+	b.Write(format.FormatFloat(p.X))
+	b.WriteByte(' ')
+	b.Write(format.FormatFloat(p.Y))
+}
+
+func writeWKTPoints(b *strings.Builder, points []Point) {
+	for i, p := range points {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		writeWKTPoint(b, p)
+	}
+}
+
+type wktParser struct {
+	s   string
+	pos int
+	err error
+}
+
+func (p *wktParser) skipSpace() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\n\r", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// consume skips spaces and then c, if it is next.
+func (p *wktParser) consume(c byte) bool {
+	p.skipSpace()
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *wktParser) expect(c byte) {
+	if !p.consume(c) {
+		p.err = ErrInvalidData
+	}
+}
+
+func (p *wktParser) word() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && (p.s[p.pos] >= 'a' && p.s[p.pos] <= 'z' || p.s[p.pos] >= 'A' && p.s[p.pos] <= 'Z') {
+		p.pos++
+	}
+	return strings.ToUpper(p.s[start:p.pos])
+}
+
+func (p *wktParser) number() float64 {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte("0123456789+-.eE", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+	f, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+	if err != nil {
+		p.err = ErrInvalidData
+	}
+	return f
+}
+
+func (p *wktParser) point() Point {
+	return Point{X: p.number(), Y: p.number()}
+}
+
+// points parses points in parentheses, separated by commas.
+func (p *wktParser) points() []Point {
+	p.expect('(')
+	var points []Point
+	for p.err == nil {
+		points = append(points, p.point())
+		if !p.consume(',') {
+			break
+		}
+	}
+	p.expect(')')
+	return points
+}
+
+// list parses what item parses in parentheses, separated by commas.
+func (p *wktParser) list(item func()) {
+	p.expect('(')
+	for p.err == nil {
+		item()
+		if !p.consume(',') {
+			break
+		}
+	}
+	p.expect(')')
+}
+
+func (p *wktParser) geometry(depth int) *Geometry {
+	if depth > maxDepth {
+		p.err = ErrInvalidData
+		return nil
+	}
+	g := &Geometry{}
+	switch p.word() {
+	case "POINT":
+		g.Type = TypePoint
+		p.expect('(')
+		g.Points = []Point{p.point()}
+		p.expect(')')
+	case "LINESTRING":
+		g.Type = TypeLineString
+		g.Points = p.points()
+	case "POLYGON":
+		g.Type = TypePolygon
+		g.Rings = p.rings()
+	case "MULTIPOINT":
+		g.Type = TypeMultiPoint
+		p.list(func() {
+			parenthesized := p.consume('(')
+			g.Geoms = append(g.Geoms, &Geometry{Type: TypePoint, Points: []Point{p.point()}})
+			if parenthesized {
+				p.expect(')')
+			}
+		})
+	case "MULTILINESTRING":
+		g.Type = TypeMultiLineString
+		p.list(func() {
+			g.Geoms = append(g.Geoms, &Geometry{Type: TypeLineString, Points: p.points()})
+		})
+	case "MULTIPOLYGON":
+		g.Type = TypeMultiPolygon
+		p.list(func() {
+			g.Geoms = append(g.Geoms, &Geometry{Type: TypePolygon, Rings: p.rings()})
+		})
+	case "GEOMETRYCOLLECTION", "GEOMCOLLECTION":
+		g.Type = TypeGeometryCollection
+		if p.word() == "EMPTY" {
+			break
+		}
+		p.expect('(')
+		if p.consume(')') {
+			break
+		}
+		for p.err == nil {
+			g.Geoms = append(g.Geoms, p.geometry(depth+1))
+			if !p.consume(',') {
+				break
+			}
+		}
+		p.expect(')')
+	default:
+		p.err = ErrInvalidData
+	}
+	return g
+}
+
+func (p *wktParser) rings() [][]Point {
+	var rings [][]Point
+	p.list(func() {
+		rings = append(rings, p.points())
+	})
+	return rings
+}
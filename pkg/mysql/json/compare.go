@@ -0,0 +1,228 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package json
+
+import (
+	"cmp"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/decimal"
+)
+
+// precedence returns where the type of a value orders among the others. From
+// the lowest to the highest, MySQL orders NULL, numbers, strings, objects,
+// arrays, booleans, dates, times, datetimes, opaque values, bits and blobs.
+func (v *Value) precedence() int {
+	switch v.t {
+	case TypeNull:
+		return 0
+	case TypeInteger, TypeUnsigned, TypeDouble, TypeDecimal:
+		return 1
+	case TypeString:
+		return 2
+	case TypeObject:
+		return 3
+	case TypeArray:
+		return 4
+	case TypeBoolean:
+		return 5
+	case TypeDate:
+		return 6
+	case TypeTime:
+		return 7
+	case TypeDateTime:
+		return 8
+	}
+	switch v.opaque {
+	case OpaqueBit:
+		return 10
+	case OpaqueVarString, OpaqueBlob:
+		return 11
+	}
+	return 9
+}
+
+// Compare returns how two values order. Values of different types order by
+// the precedence of their types, except numbers, which all compare as
+// numbers. Strings compare by their bytes, arrays element by element with a
+// shorter array before a longer one it starts, and booleans with false first.
+// Objects are equal when they have the same members, and order by their
+// number of members and then by their members otherwise.
+func Compare(a, b *Value) int {
+	if n := cmp.Compare(a.precedence(), b.precedence()); n != 0 {
+		return n
+	}
+	switch a.t {
+	case TypeNull:
+		return 0
+	case TypeInteger, TypeUnsigned, TypeDouble, TypeDecimal:
+		return compareNumbers(a, b)
+	case TypeString, TypeOpaque:
+		return strings.Compare(a.s, b.s)
+	case TypeBoolean:
+		switch {
+		case a.b == b.b:
+			return 0
+		case a.b:
+			return 1
+		}
+		return -1
+	case TypeDate:
+		return a.dt.Date.Compare(b.dt.Date)
+	case TypeTime:
+		return a.dt.Time.Compare(b.dt.Time)
+	case TypeDateTime:
+		return a.dt.Compare(b.dt)
+	case TypeArray:
+		for i := range min(len(a.arr), len(b.arr)) {
+			if n := Compare(a.arr[i], b.arr[i]); n != 0 {
+				return n
+			}
+		}
+		return cmp.Compare(len(a.arr), len(b.arr))
+	}
+	if n := cmp.Compare(a.obj.Len(), b.obj.Len()); n != 0 {
+		return n
+	}
+	for i, key := range a.obj.keys {
+		if key != b.obj.keys[i] {
+			if keyLess(key, b.obj.keys[i]) {
+				return -1
+			}
+			return 1
+		}
+		if n := Compare(a.obj.values[i], b.obj.values[i]); n != 0 {
+			return n
+		}
+	}
+	return 0
+}
+
+func compareNumbers(a, b *Value) int {
+	switch {
+	case a.t == TypeInteger && b.t == TypeInteger:
+		return cmp.Compare(a.i, b.i)
+	case a.t == TypeUnsigned && b.t == TypeUnsigned:
+		return cmp.Compare(a.u, b.u)
+	case a.t == TypeDouble && b.t == TypeDouble:
+		return cmp.Compare(a.f, b.f)
+	case a.t == TypeInteger && b.t == TypeUnsigned:
+		if a.i < 0 {
+			return -1
+		}
+		return cmp.Compare(uint64(a.i), b.u)
+	case a.t == TypeUnsigned && b.t == TypeInteger:
+		return -compareNumbers(b, a)
+	}
+	return a.toDecimal().Cmp(b.toDecimal())
+}
+
+func (v *Value) toDecimal() decimal.Decimal {
+	switch v.t {
+	case TypeInteger:
+		return decimal.NewFromInt(v.i)
+	case TypeUnsigned:
+		return decimal.NewFromUint(v.u)
+	case TypeDouble:
+		return decimal.NewFromFloat(v.f)
+	}
+	return v.dec
+}
+
+// Contains reports whether a document contains another like JSON_CONTAINS
+// does:
+//
+//   - a scalar contains a scalar it is equal to;
+//   - an object contains an object whose keys it all has, with values that
+//     contain the other's;
+//   - an array contains the elements of an array, or a value that is not an
+//     array, when each of them is equal to one of its scalars if it is a
+//     scalar, and is contained in one of its arrays or objects otherwise.
+func Contains(doc, candidate *Value) bool {
+	switch doc.t {
+	case TypeObject:
+		if candidate.t != TypeObject {
+			return false
+		}
+		for i, key := range candidate.obj.keys {
+			value, ok := doc.obj.Get(key)
+			if !ok || !Contains(value, candidate.obj.values[i]) {
+				return false
+			}
+		}
+		return true
+	case TypeArray:
+		candidates := []*Value{candidate}
+		if candidate.t == TypeArray {
+			candidates = candidate.arr
+		}
+		for _, c := range candidates {
+			found := false
+			for _, elem := range doc.arr {
+				if c.IsScalar() {
+					found = elem.IsScalar() && Compare(elem, c) == 0
+				} else {
+					found = elem.t == c.t && Contains(elem, c)
+				}
+				if found {
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	return candidate.IsScalar() && Compare(doc, candidate) == 0
+}
+
+// Overlaps reports whether two documents have anything in common like
+// JSON_OVERLAPS does: an element of two arrays, a key and its value of two
+// objects, or equal values otherwise. A value that is not an array overlaps
+// with an array it is an element of.
+func Overlaps(a, b *Value) bool {
+	switch {
+	case a.t == TypeArray || b.t == TypeArray:
+		left, right := a.elements(), b.elements()
+		for _, l := range left {
+			for _, r := range right {
+				if Compare(l, r) == 0 {
+					return true
+				}
+			}
+		}
+		return false
+	case a.t == TypeObject && b.t == TypeObject:
+		for i, key := range a.obj.keys {
+			if value, ok := b.obj.Get(key); ok && Compare(a.obj.values[i], value) == 0 {
+				return true
+			}
+		}
+		return false
+	}
+	return Compare(a, b) == 0
+}
+
+// elements returns the elements of an array, and a value in an array of its
+// own otherwise.
+func (v *Value) elements() []*Value {
+	if v.t == TypeArray {
+		return v.arr
+	}
+	return []*Value{v}
+}
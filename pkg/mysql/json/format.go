@@ -0,0 +1,186 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package json
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/format"
+)
+
+// Marshal returns a value as JSON text the way MySQL prints it, with a space
+// after each comma and colon.
+func (v *Value) Marshal() []byte {
+	return v.marshal(nil, -1, 0)
+}
+
+// MarshalPretty returns a value as JSON text the way JSON_PRETTY prints it,
+// with each element and member on its own line, indented by two spaces for
+// each level.
+func (v *Value) MarshalPretty() []byte {
+	return v.marshal(nil, 0, 0)
+}
+
+func (v *Value) String() string {
+	return string(v.Marshal())
+}
+
+// marshal appends a value to buf. indent is -1 for the compact format, and
+// the level the value is at for the pretty one.
+func (v *Value) marshal(buf []byte, indent, level int) []byte {
+	newline := func(buf []byte, level int) []byte {
+		buf = append(buf, '\n')
+		return append(buf, bytes.Repeat([]byte("  "), level)...)
+	}
+	switch v.t {
+	case TypeNull:
+		return append(buf, "null"...)
+	case TypeBoolean:
+		return strconv.AppendBool(buf, v.b)
+	case TypeInteger:
+		return strconv.AppendInt(buf, v.i, 10)
+	case TypeUnsigned:
+		return strconv.AppendUint(buf, v.u, 10)
+	case TypeDouble:
+		return appendDouble(buf, v.f)
+	case TypeDecimal:
+		return append(buf, v.dec.FormatMySQL(v.scale)...)
+	case TypeString:
+		return appendQuoted(buf, v.s)
+	case TypeDate, TypeTime, TypeDateTime, TypeOpaque:
+		return appendQuoted(buf, v.scalarText())
+	case TypeArray:
+		if len(v.arr) == 0 {
+			return append(buf, "[]"...)
+		}
+		buf = append(buf, '[')
+		for i, value := range v.arr {
+			if i > 0 {
+				buf = append(buf, ',')
+				if indent < 0 {
+					buf = append(buf, ' ')
+				}
+			}
+			if indent >= 0 {
+				buf = newline(buf, level+1)
+			}
+			buf = value.marshal(buf, indent, level+1)
+		}
+		if indent >= 0 {
+			buf = newline(buf, level)
+		}
+		return append(buf, ']')
+	}
+	if v.obj.Len() == 0 {
+		return append(buf, "{}"...)
+	}
+	buf = append(buf, '{')
+	for i, key := range v.obj.keys {
+		if i > 0 {
+			buf = append(buf, ',')
+			if indent < 0 {
+				buf = append(buf, ' ')
+			}
+		}
+		if indent >= 0 {
+			buf = newline(buf, level+1)
+		}
+		buf = appendQuoted(buf, key)
+		buf = append(buf, ": "...)
+		buf = v.obj.values[i].marshal(buf, indent, level+1)
+	}
+	if indent >= 0 {
+		buf = newline(buf, level)
+	}
+	return append(buf, '}')
+}
+
+// Unquote returns a value as JSON_UNQUOTE does: the contents of strings, and
+// the JSON text of other values.
+func (v *Value) Unquote() []byte {
+	switch v.t {
+	case TypeString:
+		return []byte(v.s)
+	case TypeDate, TypeTime, TypeDateTime, TypeOpaque:
+		return []byte(v.scalarText())
+	}
+	return v.Marshal()
+}
+
+// scalarText returns the string temporal and opaque values are printed as.
+// Temporal values have all six digits of fractional seconds, and opaque values
+// are in base64 behind their column type.
+func (v *Value) scalarText() string {
+	switch v.t {
+	case TypeDate:
+		return string(v.dt.Date.Format())
+	case TypeTime:
+		return string(v.dt.Time.Format(6))
+	case TypeDateTime:
+		return string(v.dt.Format(6))
+	}
+	return "base64:type" + strconv.Itoa(int(v.opaque)) + ":" + base64.StdEncoding.EncodeToString([]byte(v.s))
+}
+
+// appendDouble appends a double the way MySQL prints it, which always has a
+// decimal point or an exponent.
+func appendDouble(buf []byte, f float64) []byte {
+	b := format.FormatFloat(f)
+	if bytes.IndexAny(b, ".e") < 0 {
+		b = append(b, ".0"...)
+	}
+	return append(buf, b...)
+}
+
+func appendQuoted(buf []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	buf = append(buf, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c >= utf8.RuneSelf {
+			_, size := utf8.DecodeRuneInString(s[i:])
+			buf = append(buf, s[i:i+size]...)
+			i += size
+			continue
+		}
+		switch c {
+		case '"', '\\':
+			buf = append(buf, '\\', c)
+		case '\b':
+			buf = append(buf, '\\', 'b')
+		case '\f':
+			buf = append(buf, '\\', 'f')
+		case '\n':
+			buf = append(buf, '\\', 'n')
+		case '\r':
+			buf = append(buf, '\\', 'r')
+		case '\t':
+			buf = append(buf, '\\', 't')
+		default:
+			if c < 0x20 {
+				buf = append(buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			} else {
+				buf = append(buf, c)
+			}
+		}
+		i++
+	}
+	return append(buf, '"')
+}
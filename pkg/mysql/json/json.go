@@ -0,0 +1,313 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package json implements MySQL's JSON data type: parsing JSON text into
+// values, printing them the way MySQL does, ordering them with MySQL's
+// comparison rules, and the JSON path language that searches and modifies
+// them.
+//
+// Besides the types of JSON text, a MySQL JSON value can be a DATE, a TIME, a
+// DATETIME, a DECIMAL, an unsigned integer or an opaque binary value, which
+// only come from SQL values converted to JSON.
+package json
+
+import (
+	"sort"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/datetime"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/decimal"
+)
+
+// Type is the type of a JSON value.
+type Type int8
+
+const (
+	TypeNull Type = iota
+	TypeBoolean
+	TypeInteger
+	TypeUnsigned
+	TypeDouble
+	TypeDecimal
+	TypeString
+	TypeObject
+	TypeArray
+	TypeDate
+	TypeTime
+	TypeDateTime
+	TypeOpaque
+)
+
+// The MySQL column types opaque values are tagged with.
+const (
+	OpaqueVarString = 15
+	OpaqueBit       = 16
+	OpaqueBlob      = 252
+)
+
+// Value is a JSON value. Values are modified in place by the functions that
+// modify documents, which callers clone first when they need the original.
+type Value struct {
+	t   Type
+	b   bool
+	i   int64
+	u   uint64
+	f   float64
+	dec decimal.Decimal
+	// scale is the number of digits of a decimal after its decimal point.
+	scale int32
+	// s is the contents of a string or of an opaque value.
+	s string
+	// opaque is the column type of an opaque value.
+	opaque byte
+	dt     datetime.DateTime
+	arr    []*Value
+	obj    *Object
+}
+
+// Object is a JSON object. Like MySQL, it keeps its members sorted by the
+// length of their keys and then by their bytes, and a key set twice keeps the
+// last value.
+type Object struct {
+	keys   []string
+	values []*Value
+}
+
+func NewNull() *Value             { return &Value{t: TypeNull} }
+func NewBool(b bool) *Value       { return &Value{t: TypeBoolean, b: b} }
+func NewInt64(i int64) *Value     { return &Value{t: TypeInteger, i: i} }
+func NewUint64(u uint64) *Value   { return &Value{t: TypeUnsigned, u: u} }
+func NewFloat64(f float64) *Value { return &Value{t: TypeDouble, f: f} }
+func NewString(s string) *Value   { return &Value{t: TypeString, s: s} }
+
+// NewDecimal returns a decimal with scale digits after its decimal point.
+func NewDecimal(dec decimal.Decimal, scale int32) *Value {
+	return &Value{t: TypeDecimal, dec: dec, scale: scale}
+}
+
+// NewOpaque returns a binary value of a MySQL column type, like
+// OpaqueVarString for binary strings.
+func NewOpaque(typ byte, b []byte) *Value {
+	return &Value{t: TypeOpaque, opaque: typ, s: string(b)}
+}
+
+func NewDate(d datetime.Date) *Value {
+	return &Value{t: TypeDate, dt: datetime.DateTime{Date: d}}
+}
+
+func NewTime(t datetime.Time) *Value {
+	return &Value{t: TypeTime, dt: datetime.DateTime{Time: t}}
+}
+
+func NewDateTime(dt datetime.DateTime) *Value {
+	return &Value{t: TypeDateTime, dt: dt}
+}
+
+func NewArray(values ...*Value) *Value {
+	return &Value{t: TypeArray, arr: values}
+}
+
+// NewObject returns an empty object.
+func NewObject() *Value {
+	return &Value{t: TypeObject, obj: &Object{}}
+}
+
+func (v *Value) Type() Type                  { return v.t }
+func (v *Value) Bool() bool                  { return v.b }
+func (v *Value) Int64() int64                { return v.i }
+func (v *Value) Uint64() uint64              { return v.u }
+func (v *Value) Float64() float64            { return v.f }
+func (v *Value) DateTime() datetime.DateTime { return v.dt }
+func (v *Value) Array() []*Value             { return v.arr }
+func (v *Value) Object() *Object             { return v.obj }
+
+// Decimal returns a decimal and the number of digits after its decimal point.
+func (v *Value) Decimal() (decimal.Decimal, int32) {
+	return v.dec, v.scale
+}
+
+// Text returns the contents of a string.
+func (v *Value) Text() string {
+	return v.s
+}
+
+// Opaque returns the column type and the bytes of an opaque value.
+func (v *Value) Opaque() (byte, []byte) {
+	return v.opaque, []byte(v.s)
+}
+
+// IsScalar reports whether a value is neither an array nor an object.
+func (v *Value) IsScalar() bool {
+	return v.t != TypeArray && v.t != TypeObject
+}
+
+// IsNumber reports whether a value is an integer, a double or a decimal.
+func (v *Value) IsNumber() bool {
+	switch v.t {
+	case TypeInteger, TypeUnsigned, TypeDouble, TypeDecimal:
+		return true
+	}
+	return false
+}
+
+// TypeName returns the type of a value as JSON_TYPE names it.
+func (v *Value) TypeName() string {
+	switch v.t {
+	case TypeNull:
+		return "NULL"
+	case TypeBoolean:
+		return "BOOLEAN"
+	case TypeInteger:
+		return "INTEGER"
+	case TypeUnsigned:
+		return "UNSIGNED INTEGER"
+	case TypeDouble:
+		return "DOUBLE"
+	case TypeDecimal:
+		return "DECIMAL"
+	case TypeString:
+		return "STRING"
+	case TypeObject:
+		return "OBJECT"
+	case TypeArray:
+		return "ARRAY"
+	case TypeDate:
+		return "DATE"
+	case TypeTime:
+		return "TIME"
+	case TypeDateTime:
+		return "DATETIME"
+	}
+	switch v.opaque {
+	case OpaqueBit:
+		return "BIT"
+	case OpaqueVarString, OpaqueBlob:
+		return "BLOB"
+	}
+	return "OPAQUE"
+}
+
+// Len returns the number of elements of an array or members of an object,
+// and 1 for scalars.
+func (v *Value) Len() int {
+	switch v.t {
+	case TypeArray:
+		return len(v.arr)
+	case TypeObject:
+		return v.obj.Len()
+	}
+	return 1
+}
+
+// Depth returns the depth of a value: 1 for scalars and empty arrays and
+// objects, and one more than the deepest of their values for others.
+func (v *Value) Depth() int {
+	depth := 0
+	for _, value := range v.children() {
+		depth = max(depth, value.Depth())
+	}
+	return depth + 1
+}
+
+// Clone returns a deep copy of a value.
+func (v *Value) Clone() *Value {
+	c := *v
+	switch v.t {
+	case TypeArray:
+		c.arr = make([]*Value, len(v.arr))
+		for i, value := range v.arr {
+			c.arr[i] = value.Clone()
+		}
+	case TypeObject:
+		c.obj = &Object{keys: append([]string(nil), v.obj.keys...), values: make([]*Value, len(v.obj.values))}
+		for i, value := range v.obj.values {
+			c.obj.values[i] = value.Clone()
+		}
+	}
+	return &c
+}
+
+// children returns the elements of an array or the values of an object, in
+// document order.
+func (v *Value) children() []*Value {
+	switch v.t {
+	case TypeArray:
+		return v.arr
+	case TypeObject:
+		return v.obj.values
+	}
+	return nil
+}
+
+func keyLess(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+func (o *Object) search(key string) (int, bool) {
+	i := sort.Search(len(o.keys), func(i int) bool { return !keyLess(o.keys[i], key) })
+	return i, i < len(o.keys) && o.keys[i] == key
+}
+
+// Len returns the number of members of an object.
+func (o *Object) Len() int {
+	return len(o.keys)
+}
+
+// Keys returns the keys of an object, in order.
+func (o *Object) Keys() []string {
+	return o.keys
+}
+
+// Get returns the value of a key.
+func (o *Object) Get(key string) (*Value, bool) {
+	if i, ok := o.search(key); ok {
+		return o.values[i], true
+	}
+	return nil, false
+}
+
+// Set sets the value of a key, adding it if it is not there.
+func (o *Object) Set(key string, v *Value) {
+	i, ok := o.search(key)
+	if ok {
+		o.values[i] = v
+		return
+	}
+	o.keys = append(o.keys, "")
+	o.values = append(o.values, nil)
+	copy(o.keys[i+1:], o.keys[i:])
+	copy(o.values[i+1:], o.values[i:])
+	o.keys[i], o.values[i] = key, v
+}
+
+// Delete removes a key, and reports whether it was there.
+func (o *Object) Delete(key string) bool {
+	i, ok := o.search(key)
+	if ok {
+		o.keys = append(o.keys[:i], o.keys[i+1:]...)
+		o.values = append(o.values[:i], o.values[i+1:]...)
+	}
+	return ok
+}
+
+// Each calls fn for each member of an object, in order.
+func (o *Object) Each(fn func(key string, v *Value)) {
+	for i, key := range o.keys {
+		fn(key, o.values[i])
+	}
+}
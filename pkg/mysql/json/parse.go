@@ -0,0 +1,378 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package json
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// MaxDepth is how deeply arrays and objects can nest in a document.
+const MaxDepth = 100
+
+// ErrTooDeep is returned for documents that nest deeper than MaxDepth.
+var ErrTooDeep = errors.New("The JSON document exceeds the maximum depth of 100.")
+
+// SyntaxError is an error in JSON text, with the reason MySQL gives for it and
+// the offset in the text it is at.
+type SyntaxError struct {
+	Reason string
+	Pos    int
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("\"%s\" at position %d", e.Reason, e.Pos)
+}
+
+const (
+	errDocumentEmpty       = "The document is empty."
+	errRootNotSingular     = "The document root must not be followed by other values."
+	errValueInvalid        = "Invalid value."
+	errObjectMissName      = "Missing a name for object member."
+	errObjectMissColon     = "Missing a colon after a name of object member."
+	errObjectMissComma     = "Missing a comma or '}' after an object member."
+	errArrayMissComma      = "Missing a comma or ']' after an array element."
+	errStringEscapeHex     = "Incorrect hex digit after \\u escape in string."
+	errStringSurrogate     = "The surrogate pair in string is invalid."
+	errStringEscape        = "Invalid escape in string."
+	errStringMissQuotation = "Missing a closing quotation mark in string."
+	errStringEncoding      = "Invalid encoding in string."
+	errNumberTooBig        = "Number too big to be stored in double."
+	errNumberMissFraction  = "Miss fraction part in number."
+	errNumberMissExponent  = "Miss exponent in number."
+)
+
+// Parse parses JSON text. Integers are signed when they fit in 64 bits,
+// unsigned when they only fit unsigned and doubles when they fit neither, and
+// all other numbers are doubles.
+func Parse(s string) (*Value, error) {
+	p := &parser{s: s}
+	p.skipSpace()
+	if p.pos == len(s) {
+		return nil, p.error(errDocumentEmpty)
+	}
+	v, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(s) {
+		return nil, p.error(errRootNotSingular)
+	}
+	return v, nil
+}
+
+type parser struct {
+	s     string
+	pos   int
+	depth int
+}
+
+func (p *parser) error(reason string) error {
+	return &SyntaxError{Reason: reason, Pos: p.pos}
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.s) {
+		switch p.s[p.pos] {
+		case ' ', '\t', '\n', '\r':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (p *parser) value() (*Value, error) {
+	if p.pos == len(p.s) {
+		return nil, p.error(errValueInvalid)
+	}
+	switch p.s[p.pos] {
+	case '{':
+		return p.object()
+	case '[':
+		return p.array()
+	case '"':
+		s, err := p.string()
+		if err != nil {
+			return nil, err
+		}
+		return NewString(s), nil
+	case 't':
+		return p.literal("true", NewBool(true))
+	case 'f':
+		return p.literal("false", NewBool(false))
+	case 'n':
+		return p.literal("null", NewNull())
+	}
+	return p.number()
+}
+
+func (p *parser) literal(text string, v *Value) (*Value, error) {
+	for i := range len(text) {
+		if p.pos == len(p.s) || p.s[p.pos] != text[i] {
+			return nil, p.error(errValueInvalid)
+		}
+		p.pos++
+	}
+	return v, nil
+}
+
+func (p *parser) nest() error {
+	p.depth++
+	if p.depth > MaxDepth {
+		return ErrTooDeep
+	}
+	return nil
+}
+
+func (p *parser) object() (*Value, error) {
+	if err := p.nest(); err != nil {
+		return nil, err
+	}
+	p.pos++
+	obj := NewObject()
+	p.skipSpace()
+	if p.pos < len(p.s) && p.s[p.pos] == '}' {
+		p.pos++
+		p.depth--
+		return obj, nil
+	}
+	for {
+		if p.pos == len(p.s) || p.s[p.pos] != '"' {
+			return nil, p.error(errObjectMissName)
+		}
+		key, err := p.string()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.pos == len(p.s) || p.s[p.pos] != ':' {
+			return nil, p.error(errObjectMissColon)
+		}
+		p.pos++
+		p.skipSpace()
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		obj.obj.Set(key, v)
+		p.skipSpace()
+		if p.pos < len(p.s) {
+			switch p.s[p.pos] {
+			case ',':
+				p.pos++
+				p.skipSpace()
+				continue
+			case '}':
+				p.pos++
+				p.depth--
+				return obj, nil
+			}
+		}
+		return nil, p.error(errObjectMissComma)
+	}
+}
+
+func (p *parser) array() (*Value, error) {
+	if err := p.nest(); err != nil {
+		return nil, err
+	}
+	p.pos++
+	arr := NewArray()
+	p.skipSpace()
+	if p.pos < len(p.s) && p.s[p.pos] == ']' {
+		p.pos++
+		p.depth--
+		return arr, nil
+	}
+	for {
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		arr.arr = append(arr.arr, v)
+		p.skipSpace()
+		if p.pos < len(p.s) {
+			switch p.s[p.pos] {
+			case ',':
+				p.pos++
+				p.skipSpace()
+				continue
+			case ']':
+				p.pos++
+				p.depth--
+				return arr, nil
+			}
+		}
+		return nil, p.error(errArrayMissComma)
+	}
+}
+
+func (p *parser) string() (string, error) {
+	p.pos++
+	var b strings.Builder
+	for {
+		start := p.pos
+		for p.pos < len(p.s) && p.s[p.pos] >= 0x20 && p.s[p.pos] != '"' && p.s[p.pos] != '\\' && p.s[p.pos] < utf8.RuneSelf {
+			p.pos++
+		}
+		b.WriteString(p.s[start:p.pos])
+		if p.pos == len(p.s) {
+			return "", p.error(errStringMissQuotation)
+		}
+		switch c := p.s[p.pos]; {
+		case c == '"':
+			p.pos++
+			return b.String(), nil
+		case c == '\\':
+			if err := p.escape(&b); err != nil {
+				return "", err
+			}
+		case c < 0x20:
+			return "", p.error(errStringEncoding)
+		default:
+			r, size := utf8.DecodeRuneInString(p.s[p.pos:])
+			if r == utf8.RuneError && size <= 1 {
+				return "", p.error(errStringEncoding)
+			}
+			b.WriteString(p.s[p.pos : p.pos+size])
+			p.pos += size
+		}
+	}
+}
+
+// escape decodes the escape sequence at the parser's position. Errors in it
+// are at the backslash it starts with.
+func (p *parser) escape(b *strings.Builder) error {
+	start := p.pos
+	fail := func(reason string) error {
+		return &SyntaxError{Reason: reason, Pos: start}
+	}
+	p.pos++
+	if p.pos == len(p.s) {
+		return fail(errStringEscape)
+	}
+	c := p.s[p.pos]
+	p.pos++
+	switch c {
+	case '"', '\\', '/':
+		b.WriteByte(c)
+	case 'b':
+		b.WriteByte('\b')
+	case 'f':
+		b.WriteByte('\f')
+	case 'n':
+		b.WriteByte('\n')
+	case 'r':
+		b.WriteByte('\r')
+	case 't':
+		b.WriteByte('\t')
+	case 'u':
+		r, ok := p.hex4()
+		if !ok {
+			return fail(errStringEscapeHex)
+		}
+		if utf16.IsSurrogate(r) {
+			if !strings.HasPrefix(p.s[p.pos:], "\\u") {
+				return fail(errStringSurrogate)
+			}
+			p.pos += 2
+			low, ok := p.hex4()
+			if !ok {
+				return fail(errStringEscapeHex)
+			}
+			if r = utf16.DecodeRune(r, low); r == utf8.RuneError {
+				return fail(errStringSurrogate)
+			}
+		}
+		b.WriteRune(r)
+	default:
+		return fail(errStringEscape)
+	}
+	return nil
+}
+
+func (p *parser) hex4() (rune, bool) {
+	if len(p.s)-p.pos < 4 {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(p.s[p.pos:p.pos+4], 16, 16)
+	if err != nil {
+		return 0, false
+	}
+	p.pos += 4
+	return rune(n), true
+}
+
+func (p *parser) number() (*Value, error) {
+	start := p.pos
+	digits := func() int {
+		n := 0
+		for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+			p.pos++
+			n++
+		}
+		return n
+	}
+	if p.s[p.pos] == '-' {
+		p.pos++
+	}
+	switch {
+	case p.pos < len(p.s) && p.s[p.pos] == '0':
+		p.pos++
+	case digits() == 0:
+		return nil, p.error(errValueInvalid)
+	}
+	integer := true
+	if p.pos < len(p.s) && p.s[p.pos] == '.' {
+		p.pos++
+		if digits() == 0 {
+			return nil, p.error(errNumberMissFraction)
+		}
+		integer = false
+	}
+	if p.pos < len(p.s) && (p.s[p.pos] == 'e' || p.s[p.pos] == 'E') {
+		p.pos++
+		if p.pos < len(p.s) && (p.s[p.pos] == '+' || p.s[p.pos] == '-') {
+			p.pos++
+		}
+		if digits() == 0 {
+			return nil, p.error(errNumberMissExponent)
+		}
+		integer = false
+	}
+	text := p.s[start:p.pos]
+	if integer {
+		if i, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return NewInt64(i), nil
+		}
+		if u, err := strconv.ParseUint(text, 10, 64); err == nil {
+			return NewUint64(u), nil
+		}
+	}
+	f, _ := strconv.ParseFloat(text, 64)
+	if math.IsInf(f, 0) {
+		return nil, &SyntaxError{Reason: errNumberTooBig, Pos: start}
+	}
+	return NewFloat64(f), nil
+}
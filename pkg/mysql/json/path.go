@@ -0,0 +1,464 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package json
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+// Path is a JSON path expression, which is $ followed by legs:
+//
+//   - .key or ."key" for the member of an object with a key, and .* for all
+//     its members;
+//   - [n] for the element of an array at an index, [last] for its last one
+//     and [last-n] for the one n before it, [m to n] for the elements from an
+//     index to another, and [*] for all its elements;
+//   - ** for the value the path is at and all the values in it, which must be
+//     followed by another leg.
+//
+// A value that is not an array is an array of its own in index legs, like it
+// is in MySQL, so $[0] and $[last] of a scalar are the scalar itself.
+type Path struct {
+	text string
+	legs []leg
+}
+
+type legKind uint8
+
+const (
+	legMember legKind = iota
+	legMemberWildcard
+	legIndex
+	legRange
+	legArrayWildcard
+	legEllipsis
+)
+
+type leg struct {
+	kind legKind
+	key  string
+	// from is the index of an index leg, and from and to are the bounds of a
+	// range leg.
+	from, to arrayIndex
+}
+
+// arrayIndex is an index from the start of an array, or from its end for
+// last.
+type arrayIndex struct {
+	n    int
+	last bool
+}
+
+// resolve returns the index in an array of a length.
+func (i arrayIndex) resolve(length int) int {
+	if i.last {
+		return length - 1 - i.n
+	}
+	return i.n
+}
+
+// PathError is an error in a path expression, at an offset in its text.
+type PathError struct {
+	Pos int
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("Invalid JSON path expression. The error is around character position %d.", e.Pos)
+}
+
+// ParsePath parses a path expression.
+func ParsePath(s string) (*Path, error) {
+	p := &pathParser{s: s}
+	legs, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	return &Path{text: s, legs: legs}, nil
+}
+
+type pathParser struct {
+	s   string
+	pos int
+}
+
+func (p *pathParser) error() error {
+	return &PathError{Pos: p.pos}
+}
+
+func (p *pathParser) skipSpace() {
+	for p.pos < len(p.s) && isSpace(p.s[p.pos]) {
+		p.pos++
+	}
+}
+
+func isSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\r', '\v', '\f':
+		return true
+	}
+	return false
+}
+
+func (p *pathParser) parse() ([]leg, error) {
+	p.skipSpace()
+	if p.pos == len(p.s) || p.s[p.pos] != '$' {
+		return nil, p.error()
+	}
+	p.pos++
+	var legs []leg
+	for {
+		p.skipSpace()
+		if p.pos == len(p.s) {
+			break
+		}
+		var l leg
+		var err error
+		switch p.s[p.pos] {
+		case '.':
+			l, err = p.member()
+		case '[':
+			l, err = p.cell()
+		case '*':
+			if p.pos+1 == len(p.s) || p.s[p.pos+1] != '*' || (len(legs) > 0 && legs[len(legs)-1].kind == legEllipsis) {
+				return nil, p.error()
+			}
+			p.pos += 2
+			l = leg{kind: legEllipsis}
+		default:
+			return nil, p.error()
+		}
+		if err != nil {
+			return nil, err
+		}
+		legs = append(legs, l)
+	}
+	if len(legs) > 0 && legs[len(legs)-1].kind == legEllipsis {
+		return nil, p.error()
+	}
+	return legs, nil
+}
+
+func (p *pathParser) member() (leg, error) {
+	p.pos++
+	p.skipSpace()
+	if p.pos == len(p.s) {
+		return leg{}, p.error()
+	}
+	switch p.s[p.pos] {
+	case '*':
+		p.pos++
+		return leg{kind: legMemberWildcard}, nil
+	case '"':
+		jp := &parser{s: p.s, pos: p.pos}
+		key, err := jp.string()
+		if err != nil {
+			return leg{}, p.error()
+		}
+		p.pos = jp.pos
+		return leg{kind: legMember, key: key}, nil
+	}
+	start := p.pos
+	for p.pos < len(p.s) && !isSpace(p.s[p.pos]) && p.s[p.pos] != '.' && p.s[p.pos] != '[' && p.s[p.pos] != '*' {
+		p.pos++
+	}
+	key := p.s[start:p.pos]
+	if !isIdentifier(key) {
+		p.pos = start
+		return leg{}, p.error()
+	}
+	return leg{kind: legMember, key: key}, nil
+}
+
+// isIdentifier reports whether a key is an ECMAScript identifier, which is
+// what keys can be without quotes.
+func isIdentifier(key string) bool {
+	if key == "" {
+		return false
+	}
+	for i, r := range key {
+		switch {
+		case r == '$' || r == '_' || unicode.IsLetter(r):
+		case i > 0 && (unicode.IsDigit(r) || unicode.In(r, unicode.Mn, unicode.Mc, unicode.Pc)):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (p *pathParser) cell() (leg, error) {
+	p.pos++
+	p.skipSpace()
+	if p.pos < len(p.s) && p.s[p.pos] == '*' {
+		p.pos++
+		p.skipSpace()
+		if p.pos == len(p.s) || p.s[p.pos] != ']' {
+			return leg{}, p.error()
+		}
+		p.pos++
+		return leg{kind: legArrayWildcard}, nil
+	}
+	from, err := p.index()
+	if err != nil {
+		return leg{}, err
+	}
+	l := leg{kind: legIndex, from: from}
+	p.skipSpace()
+	if p.keyword("to") {
+		p.skipSpace()
+		to, err := p.index()
+		if err != nil {
+			return leg{}, err
+		}
+		if !from.last && !to.last && from.n > to.n {
+			return leg{}, p.error()
+		}
+		l.kind, l.to = legRange, to
+		p.skipSpace()
+	}
+	if p.pos == len(p.s) || p.s[p.pos] != ']' {
+		return leg{}, p.error()
+	}
+	p.pos++
+	return l, nil
+}
+
+func (p *pathParser) keyword(word string) bool {
+	end := p.pos + len(word)
+	if end > len(p.s) || p.s[p.pos:end] != word {
+		return false
+	}
+	if end < len(p.s) && !isSpace(p.s[end]) && p.s[end] != ']' && p.s[end] != '-' {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+func (p *pathParser) index() (arrayIndex, error) {
+	if p.keyword("last") {
+		p.skipSpace()
+		if p.pos == len(p.s) || p.s[p.pos] != '-' {
+			return arrayIndex{last: true}, nil
+		}
+		p.pos++
+		p.skipSpace()
+		n, err := p.number()
+		return arrayIndex{n: n, last: true}, err
+	}
+	n, err := p.number()
+	return arrayIndex{n: n}, err
+}
+
+func (p *pathParser) number() (int, error) {
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		p.pos++
+	}
+	n, err := strconv.ParseUint(p.s[start:p.pos], 10, 32)
+	if err != nil {
+		p.pos = start
+		return 0, p.error()
+	}
+	return int(n), nil
+}
+
+func (p *Path) String() string {
+	return p.text
+}
+
+// IsRoot reports whether a path is $ alone.
+func (p *Path) IsRoot() bool {
+	return len(p.legs) == 0
+}
+
+// ContainsWildcard reports whether a path has *, ** or a range, which make it
+// possible for it to match more than one value.
+func (p *Path) ContainsWildcard() bool {
+	for _, l := range p.legs {
+		switch l.kind {
+		case legMemberWildcard, legRange, legArrayWildcard, legEllipsis:
+			return true
+		}
+	}
+	return false
+}
+
+// EndsWithIndex reports whether the last leg of a path is an array index.
+func (p *Path) EndsWithIndex() bool {
+	return len(p.legs) > 0 && p.legs[len(p.legs)-1].kind == legIndex
+}
+
+// Match returns the values a path matches in a document, in document order
+// and without duplicates.
+func (p *Path) Match(doc *Value) []*Value {
+	var matches []*Value
+	p.find(doc, func(_ []byte, v *Value) {
+		matches = append(matches, v)
+	})
+	return matches
+}
+
+// Exists reports whether a path matches any value in a document.
+func (p *Path) Exists(doc *Value) bool {
+	found := false
+	p.find(doc, func([]byte, *Value) {
+		found = true
+	})
+	return found
+}
+
+// find calls fn with the values a path matches in a document and the paths
+// without wildcards to them.
+func (p *Path) find(doc *Value, fn func(path []byte, v *Value)) {
+	f := &finder{fn: fn}
+	for _, l := range p.legs {
+		if l.kind == legEllipsis {
+			f.seen = map[*Value]bool{}
+		}
+	}
+	f.find(doc, p.legs, []byte{'$'})
+}
+
+type finder struct {
+	fn func(path []byte, v *Value)
+	// seen is the values found so far, for paths with ** that can match a
+	// value more than once.
+	seen map[*Value]bool
+}
+
+func (f *finder) find(v *Value, legs []leg, path []byte) {
+	if len(legs) == 0 {
+		if f.seen != nil {
+			if f.seen[v] {
+				return
+			}
+			f.seen[v] = true
+		}
+		f.fn(path, v)
+		return
+	}
+	l, rest := legs[0], legs[1:]
+	switch l.kind {
+	case legMember:
+		if v.t == TypeObject {
+			if value, ok := v.obj.Get(l.key); ok {
+				f.find(value, rest, appendMember(path, l.key))
+			}
+		}
+	case legMemberWildcard:
+		if v.t == TypeObject {
+			for i, key := range v.obj.keys {
+				f.find(v.obj.values[i], rest, appendMember(path, key))
+			}
+		}
+	case legIndex:
+		if v.t != TypeArray {
+			if l.from.resolve(1) == 0 {
+				f.find(v, rest, path)
+			}
+			return
+		}
+		if i := l.from.resolve(len(v.arr)); i >= 0 && i < len(v.arr) {
+			f.find(v.arr[i], rest, appendIndex(path, i))
+		}
+	case legRange:
+		if v.t != TypeArray {
+			if l.from.resolve(1) <= 0 && l.to.resolve(1) >= 0 {
+				f.find(v, rest, path)
+			}
+			return
+		}
+		from, to := max(l.from.resolve(len(v.arr)), 0), min(l.to.resolve(len(v.arr)), len(v.arr)-1)
+		for i := from; i <= to; i++ {
+			f.find(v.arr[i], rest, appendIndex(path, i))
+		}
+	case legArrayWildcard:
+		if v.t == TypeArray {
+			for i, value := range v.arr {
+				f.find(value, rest, appendIndex(path, i))
+			}
+		}
+	case legEllipsis:
+		f.find(v, rest, path)
+		switch v.t {
+		case TypeArray:
+			for i, value := range v.arr {
+				f.find(value, legs, appendIndex(path, i))
+			}
+		case TypeObject:
+			for i, key := range v.obj.keys {
+				f.find(v.obj.values[i], legs, appendMember(path, key))
+			}
+		}
+	}
+}
+
+// appendMember appends a member leg to a path, with its key quoted when it is
+// not an identifier. The path is copied so that siblings do not share it.
+func appendMember(path []byte, key string) []byte {
+	path = append(path[:len(path):len(path)], '.')
+	if isIdentifier(key) {
+		return append(path, key...)
+	}
+	return appendQuoted(path, key)
+}
+
+func appendIndex(path []byte, i int) []byte {
+	path = append(path[:len(path):len(path)], '[')
+	path = strconv.AppendInt(path, int64(i), 10)
+	return append(path, ']')
+}
+
+// Search returns the paths to the strings in a document that match reports
+// true for, in document order and without duplicates. With paths, it only
+// searches the values they match, and with one set, it stops at the first
+// string found.
+func Search(doc *Value, paths []*Path, match func(s string) bool, one bool) []string {
+	if len(paths) == 0 {
+		paths = []*Path{{text: "$"}}
+	}
+	var found []string
+	seen := map[*Value]bool{}
+	var walk func(path []byte, v *Value)
+	walk = func(path []byte, v *Value) {
+		if one && len(found) > 0 {
+			return
+		}
+		switch v.t {
+		case TypeString:
+			if !seen[v] && match(v.s) {
+				seen[v] = true
+				found = append(found, string(path))
+			}
+		case TypeArray:
+			for i, value := range v.arr {
+				walk(appendIndex(path, i), value)
+			}
+		case TypeObject:
+			for i, key := range v.obj.keys {
+				walk(appendMember(path, key), v.obj.values[i])
+			}
+		}
+	}
+	for _, p := range paths {
+		p.find(doc, walk)
+	}
+	return found
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package json
+
+// The functions that modify documents take paths without wildcards, modify
+// the documents in place and return them, or the values that replace them
+// when they are replaced as a whole.
+
+// SetMode is what Set does with a path.
+type SetMode uint8
+
+const (
+	// Insert adds a value at a path that does not exist.
+	Insert SetMode = 1 << iota
+	// Replace replaces the value at a path that exists.
+	Replace
+)
+
+// update replaces the value at a path with what fn returns for it, when the
+// path exists.
+func update(v *Value, legs []leg, fn func(*Value) *Value) *Value {
+	if len(legs) == 0 {
+		return fn(v)
+	}
+	l, rest := legs[0], legs[1:]
+	switch l.kind {
+	case legMember:
+		if v.t == TypeObject {
+			if value, ok := v.obj.Get(l.key); ok {
+				v.obj.Set(l.key, update(value, rest, fn))
+			}
+		}
+	case legIndex:
+		if v.t != TypeArray {
+			if l.from.resolve(1) == 0 {
+				return update(v, rest, fn)
+			}
+			break
+		}
+		if i := l.from.resolve(len(v.arr)); i >= 0 && i < len(v.arr) {
+			v.arr[i] = update(v.arr[i], rest, fn)
+		}
+	}
+	return v
+}
+
+// Set sets the value at a path like JSON_SET, JSON_INSERT and JSON_REPLACE
+// do, depending on the mode. A path that does not exist is only added when
+// the value it is in exists: as a member of an object, at the end of an array
+// when the index is past it, or in an array with a value that is not one when
+// the index is past 0.
+func Set(doc *Value, p *Path, v *Value, mode SetMode) *Value {
+	if len(p.legs) == 0 {
+		if mode&Replace != 0 {
+			return v
+		}
+		return doc
+	}
+	last := p.legs[len(p.legs)-1]
+	return update(doc, p.legs[:len(p.legs)-1], func(parent *Value) *Value {
+		switch last.kind {
+		case legMember:
+			if parent.t != TypeObject {
+				break
+			}
+			if _, ok := parent.obj.Get(last.key); (ok && mode&Replace != 0) || (!ok && mode&Insert != 0) {
+				parent.obj.Set(last.key, v)
+			}
+		case legIndex:
+			if parent.t != TypeArray {
+				i := last.from.resolve(1)
+				switch {
+				case i == 0 && mode&Replace != 0:
+					return v
+				case i > 0 && mode&Insert != 0:
+					return NewArray(parent, v)
+				}
+				break
+			}
+			i := last.from.resolve(len(parent.arr))
+			switch {
+			case i >= 0 && i < len(parent.arr) && mode&Replace != 0:
+				parent.arr[i] = v
+			case i >= len(parent.arr) && mode&Insert != 0:
+				parent.arr = append(parent.arr, v)
+			}
+		}
+		return parent
+	})
+}
+
+// ArrayAppend appends a value to the array at a path like JSON_ARRAY_APPEND,
+// making a value that is not an array the first element of one.
+func ArrayAppend(doc *Value, p *Path, v *Value) *Value {
+	return update(doc, p.legs, func(target *Value) *Value {
+		if target.t == TypeArray {
+			target.arr = append(target.arr, v)
+			return target
+		}
+		return NewArray(target, v)
+	})
+}
+
+// ArrayInsert inserts a value in an array like JSON_ARRAY_INSERT, at the index
+// a path ends with, or at its end when the index is past it. It does nothing
+// when the path is not in an array.
+func ArrayInsert(doc *Value, p *Path, v *Value) *Value {
+	last := p.legs[len(p.legs)-1]
+	return update(doc, p.legs[:len(p.legs)-1], func(parent *Value) *Value {
+		if parent.t != TypeArray {
+			return parent
+		}
+		i := last.from.resolve(len(parent.arr))
+		if i < 0 {
+			return parent
+		}
+		i = min(i, len(parent.arr))
+		parent.arr = append(parent.arr, nil)
+		copy(parent.arr[i+1:], parent.arr[i:])
+		parent.arr[i] = v
+		return parent
+	})
+}
+
+// Remove removes the value at a path like JSON_REMOVE, which must not be $.
+func Remove(doc *Value, p *Path) *Value {
+	last := p.legs[len(p.legs)-1]
+	return update(doc, p.legs[:len(p.legs)-1], func(parent *Value) *Value {
+		switch {
+		case last.kind == legMember && parent.t == TypeObject:
+			parent.obj.Delete(last.key)
+		case last.kind == legIndex && parent.t == TypeArray:
+			if i := last.from.resolve(len(parent.arr)); i >= 0 && i < len(parent.arr) {
+				parent.arr = append(parent.arr[:i], parent.arr[i+1:]...)
+			}
+		}
+		return parent
+	})
+}
+
+// MergePreserve merges two documents like JSON_MERGE_PRESERVE: two objects
+// into an object with the members of both, merging the values of the keys
+// they both have, and anything else into an array with the elements of both,
+// where values that are not arrays are arrays of their own.
+func MergePreserve(a, b *Value) *Value {
+	if a.t == TypeObject && b.t == TypeObject {
+		for i, key := range b.obj.keys {
+			value := b.obj.values[i]
+			if existing, ok := a.obj.Get(key); ok {
+				value = MergePreserve(existing, value)
+			}
+			a.obj.Set(key, value)
+		}
+		return a
+	}
+	return NewArray(append(append([]*Value{}, a.elements()...), b.elements()...)...)
+}
+
+// MergePatch merges two documents like JSON_MERGE_PATCH, following RFC 7396: a
+// patch that is an object sets the members of a document with the members it
+// has, merged with theirs, and removes the ones it has as null. Any other
+// patch replaces the document.
+func MergePatch(doc, patch *Value) *Value {
+	if patch.t != TypeObject {
+		return patch
+	}
+	if doc.t != TypeObject {
+		doc = NewObject()
+	}
+	for i, key := range patch.obj.keys {
+		value := patch.obj.values[i]
+		if value.t == TypeNull {
+			doc.obj.Delete(key)
+			continue
+		}
+		existing, ok := doc.obj.Get(key)
+		if !ok {
+			existing = NewNull()
+		}
+		doc.obj.Set(key, MergePatch(existing, value))
+	}
+	return doc
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package semantics
+
+import (
+	"sort"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// Operation is what a statement does to a table it accesses.
+type Operation int8
+
+const (
+	// OpSelect reads the rows of a table.
+	OpSelect Operation = iota
+	// OpInsert adds rows to a table.
+	OpInsert
+	// OpUpdate changes rows of a table.
+	OpUpdate
+	// OpDelete removes rows from a table.
+	OpDelete
+	// OpDDL creates, alters or drops a table, or reads its definition.
+	OpDDL
+	// OpLock locks a table, or the rows a locking read reads.
+	OpLock
+)
+
+// String returns the name of the operation.
+func (op Operation) String() string {
+	switch op {
+	case OpSelect:
+		return "select"
+	case OpInsert:
+		return "insert"
+	case OpUpdate:
+		return "update"
+	case OpDelete:
+		return "delete"
+	case OpDDL:
+		return "ddl"
+	case OpLock:
+		return "lock"
+	}
+	return "unknown"
+}
+
+// TableAccess is an access of a statement to a table.
+type TableAccess struct {
+	Operation Operation
+	Database  string
+	// Table is empty for statements on a whole database. For CREATE and DROP
+	// PROCEDURE it is the name of the procedure.
+	Table string
+	// Columns are the columns read or written, sorted. They are empty when the
+	// statement accesses whole rows or the table itself, like deletes, DDL and
+	// locks do, or when a query reads no column of the table.
+	Columns []string
+}
+
+// Routine is a stored procedure.
+type Routine struct {
+	Database string
+	Name     string
+}
+
+// AccessSet holds the tables and columns a statement reads and writes.
+type AccessSet struct {
+	Type sqlparser.StatementType
+	// Reads are the accesses that only read, with OpSelect, or with OpLock for
+	// shared locks and OpDDL for the definitions DDL refers to.
+	Reads []TableAccess
+	// Writes are the accesses that change data or definitions, or take
+	// exclusive locks.
+	Writes []TableAccess
+	// Calls are the procedures a CALL runs. What they access cannot be known
+	// from the statement.
+	Calls []Routine
+}
+
+// Access returns the tables and columns a statement reads and writes. Column
+// names are resolved against the schema like Bind does, and unqualified table
+// names refer to defaultDB.
+//
+// The target of an INSERT is written with OpInsert, and with OpUpdate for the
+// columns of ON DUPLICATE KEY UPDATE; REPLACE and LOAD DATA ... REPLACE also
+// delete from it. LOAD DATA writes the columns it reads fields into or assigns,
+// and its forms that are not parsed, like LOAD DATA FROM S3, are left out. A
+// multi-table UPDATE writes only the tables it assigns to,
+// and a multi-table DELETE only its targets; the other tables are read.
+// SELECT ... FOR UPDATE writes a lock on the tables its query reads, and FOR
+// SHARE reads one.
+func Access(stmt sqlparser.Statement, schema SchemaInformation, defaultDB string) (*AccessSet, error) {
+	b, err := bind(stmt, schema, defaultDB)
+	if err != nil {
+		return nil, err
+	}
+	a := &accessor{binder: b, reads: accesses{}, writes: accesses{}, assigned: map[*sqlparser.ColName]bool{}, targets: map[*TableSource]bool{}}
+	set := &AccessSet{Type: sqlparser.ASTToStatementType(stmt)}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Insert:
+		a.insert(stmt)
+	case *sqlparser.Update:
+		a.update(stmt)
+	case *sqlparser.Delete:
+		a.delete(stmt)
+	case *sqlparser.Load:
+		if err := a.load(stmt); err != nil {
+			return nil, err
+		}
+	case *sqlparser.CallProc:
+		db, err := b.qualify(stmt.Name)
+		if err != nil {
+			return nil, err
+		}
+		set.Calls = append(set.Calls, Routine{Database: db, Name: stmt.Name.Name.String()})
+	case *sqlparser.LockTables:
+		if err := a.lockTables(stmt); err != nil {
+			return nil, err
+		}
+	case sqlparser.DDLStatement:
+		if err := a.ddl(stmt); err != nil {
+			return nil, err
+		}
+	case sqlparser.DBDDLStatement:
+		a.writes.add(OpDDL, stmt.GetDatabaseName(), "")
+	}
+	a.queries()
+	set.Reads = a.reads.list()
+	set.Writes = a.writes.list()
+	return set, nil
+}
+
+// qualify returns the database of a table name.
+func (b *binder) qualify(name sqlparser.TableName) (string, error) {
+	if name.Qualifier.NotEmpty() {
+		return name.Qualifier.String(), nil
+	}
+	if b.defaultDB == "" {
+		return "", vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.NoDB, "No database selected")
+	}
+	return b.defaultDB, nil
+}
+
+type accessKey struct {
+	op        Operation
+	db, table string
+}
+
+// accesses maps the tables a statement accesses to the columns it accesses.
+type accesses map[accessKey]map[string]bool
+
+func (acc accesses) add(op Operation, db, table string, columns ...string) {
+	key := accessKey{op: op, db: db, table: table}
+	set := acc[key]
+	if set == nil {
+		set = map[string]bool{}
+		acc[key] = set
+	}
+	for _, col := range columns {
+		set[col] = true
+	}
+}
+
+func (acc accesses) list() []TableAccess {
+	var res []TableAccess
+	for key, set := range acc {
+		access := TableAccess{Operation: key.op, Database: key.db, Table: key.table}
+		for col := range set {
+			access.Columns = append(access.Columns, col)
+		}
+		sort.Strings(access.Columns)
+		res = append(res, access)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Operation != res[j].Operation {
+			return res[i].Operation < res[j].Operation
+		}
+		if res[i].Database != res[j].Database {
+			return res[i].Database < res[j].Database
+		}
+		return res[i].Table < res[j].Table
+	})
+	return res
+}
+
+type accessor struct {
+	*binder
+	reads, writes accesses
+	// assigned are the column names a statement assigns to, which it does not
+	// read.
+	assigned map[*sqlparser.ColName]bool
+	// targets are the tables an INSERT, UPDATE or DELETE writes, which it only
+	// reads through the columns it uses.
+	targets map[*TableSource]bool
+}
+
+func (a *accessor) insert(ins *sqlparser.Insert) {
+	s := a.scopes[ins]
+	if s == nil || len(s.sources) == 0 {
+		return
+	}
+	target := s.sources[0]
+	a.targets[target] = true
+	columns := target.Columns
+	if len(ins.Columns) > 0 {
+		columns = nil
+		for _, col := range ins.Columns {
+			name, _ := target.column(col.String())
+			columns = append(columns, name)
+		}
+	}
+	a.writes.add(OpInsert, target.Database, target.Table, columns...)
+	if ins.Action == sqlparser.ReplaceAct {
+		a.writes.add(OpDelete, target.Database, target.Table)
+	}
+	for _, expr := range ins.OnDup {
+		a.assigned[expr.Name] = true
+		if binding := a.bindings[expr.Name]; binding != nil && binding.Source == target {
+			a.writes.add(OpUpdate, target.Database, target.Table, binding.Column)
+		}
+	}
+	// VALUES(col) in ON DUPLICATE KEY UPDATE is the value the row would have
+	// been inserted with.
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if values, ok := node.(*sqlparser.ValuesFuncExpr); ok {
+			a.assigned[values.Name] = true
+		}
+		return true, nil
+	}, ins.OnDup)
+}
+
+// load writes the columns a LOAD DATA statement reads fields into or assigns,
+// or the whole table when it names none.
+func (a *accessor) load(load *sqlparser.Load) error {
+	if load.Table.IsEmpty() {
+		return nil
+	}
+	db, err := a.qualify(load.Table)
+	if err != nil {
+		return err
+	}
+	var columns []string
+	for _, expr := range load.Columns {
+		if col, ok := expr.(*sqlparser.ColName); ok {
+			a.assigned[col] = true
+			columns = append(columns, col.Name.String())
+		}
+	}
+	for _, expr := range load.SetExprs {
+		a.assigned[expr.Name] = true
+		columns = append(columns, expr.Name.Name.String())
+	}
+	table := load.Table.Name.String()
+	a.writes.add(OpInsert, db, table, columns...)
+	if load.Action == sqlparser.ReplaceAct {
+		a.writes.add(OpDelete, db, table)
+	}
+	return nil
+}
+
+func (a *accessor) update(upd *sqlparser.Update) {
+	for _, expr := range upd.Exprs {
+		a.assigned[expr.Name] = true
+		binding := a.bindings[expr.Name]
+		if binding == nil || binding.Source == nil || binding.Source.Kind != BaseTable {
+			continue
+		}
+		a.targets[binding.Source] = true
+		a.writes.add(OpUpdate, binding.Source.Database, binding.Source.Table, binding.Column)
+	}
+}
+
+func (a *accessor) delete(del *sqlparser.Delete) {
+	s := a.scopes[del]
+	if s == nil {
+		return
+	}
+	for _, source := range s.sources {
+		if source.Kind != BaseTable {
+			continue
+		}
+		target := len(del.Targets) == 0
+		for _, name := range del.Targets {
+			target = target || source.matches(name)
+		}
+		if target {
+			a.targets[source] = true
+			a.writes.add(OpDelete, source.Database, source.Table)
+		}
+	}
+}
+
+func (a *accessor) lockTables(lock *sqlparser.LockTables) error {
+	for _, table := range lock.Tables {
+		expr, ok := table.Table.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			continue
+		}
+		name, ok := expr.Expr.(sqlparser.TableName)
+		if !ok {
+			continue
+		}
+		db, err := a.qualify(name)
+		if err != nil {
+			return err
+		}
+		switch table.Lock {
+		case sqlparser.Read, sqlparser.ReadLocal:
+			a.reads.add(OpLock, db, name.Name.String())
+		default:
+			a.writes.add(OpLock, db, name.Name.String())
+		}
+	}
+	return nil
+}
+
+// ddl adds the tables a DDL statement changes, and the ones whose definitions
+// it refers to: the table of CREATE TABLE ... LIKE and the tables foreign keys
+// reference. The queries of views are added like any other query.
+func (a *accessor) ddl(ddl sqlparser.DDLStatement) error {
+	for _, name := range ddl.AffectedTables() {
+		db, err := a.qualify(name)
+		if err != nil {
+			return err
+		}
+		a.writes.add(OpDDL, db, name.Name.String())
+	}
+	var refs []sqlparser.TableName
+	if create, ok := ddl.(*sqlparser.CreateTable); ok && create.OptLike != nil {
+		refs = append(refs, create.OptLike.LikeTable)
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if ref, ok := node.(*sqlparser.ReferenceDefinition); ok {
+			refs = append(refs, ref.ReferencedTable)
+		}
+		return true, nil
+	}, ddl)
+	for _, name := range refs {
+		db, err := a.qualify(name)
+		if err != nil {
+			return err
+		}
+		a.reads.add(OpDDL, db, name.Name.String())
+	}
+	return nil
+}
+
+// queries adds what the queries of a statement read: the tables of their FROM
+// clauses, the columns they use, and the locks of locking reads.
+func (a *accessor) queries() {
+	for node, s := range a.scopes {
+		var lock sqlparser.Lock
+		if sel, ok := node.(*sqlparser.Select); ok {
+			lock = sel.Lock
+		}
+		for _, source := range s.sources {
+			if source.Kind != BaseTable || a.targets[source] {
+				continue
+			}
+			a.reads.add(OpSelect, source.Database, source.Table)
+			switch lock {
+			case sqlparser.NoLock:
+			case sqlparser.ForUpdateLock, sqlparser.ForUpdateLockNoWait, sqlparser.ForUpdateLockSkipLocked:
+				a.writes.add(OpLock, source.Database, source.Table)
+			default:
+				a.reads.add(OpLock, source.Database, source.Table)
+			}
+		}
+	}
+	for col, binding := range a.bindings {
+		if !a.assigned[col] {
+			a.column(binding)
+		}
+	}
+	for _, bindings := range a.stars {
+		for _, binding := range bindings {
+			a.column(binding)
+		}
+	}
+}
+
+func (a *accessor) column(binding *Binding) {
+	if binding.Source != nil && binding.Source.Kind == BaseTable {
+		a.reads.add(OpSelect, binding.Source.Database, binding.Source.Table, binding.Column)
+	}
+}
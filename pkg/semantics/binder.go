@@ -0,0 +1,870 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package semantics resolves the names used in statements against a schema, and
+// infers the types of their expressions.
+package semantics
+
+import (
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// SchemaInformation gives the columns of the tables and views a statement uses.
+type SchemaInformation interface {
+	// TableColumns returns the names of the columns of a table or a view, in
+	// order, or false if there is no such table.
+	TableColumns(db, table string) ([]string, bool)
+}
+
+// SourceKind is the kind of a TableSource.
+type SourceKind int8
+
+const (
+	// BaseTable is a table or a view of the schema.
+	BaseTable SourceKind = iota
+	// DerivedTable is a subquery in a FROM clause.
+	DerivedTable
+	// CommonTable is a common table expression of a WITH clause.
+	CommonTable
+	// JSONTable is a JSON_TABLE expression.
+	JSONTable
+)
+
+// TableSource is a table a query reads from or writes to.
+type TableSource struct {
+	Kind SourceKind
+	// Name is what the query calls the table: its alias, or its name.
+	Name string
+	// Database is the database of a base table.
+	Database string
+	// Table is the name of a base table or of a common table expression.
+	Table string
+	// Columns are the names of the columns of the table, in order.
+	Columns []string
+	// Node is the table expression the table comes from, an *AliasedTableExpr
+	// or a *JSONTableExpr.
+	Node sqlparser.TableExpr
+	// Select is the query of a derived table or a common table expression.
+	Select sqlparser.TableStatement
+
+	// merged are the columns of the table merged into the other side of a
+	// NATURAL join or USING, which a star leaves out.
+	merged map[string]bool
+}
+
+// column returns the name of a column of the table as it was declared, or false.
+func (ts *TableSource) column(name string) (string, bool) {
+	for _, col := range ts.Columns {
+		if strings.EqualFold(col, name) {
+			return col, true
+		}
+	}
+	return "", false
+}
+
+// Binding is what a column name refers to.
+type Binding struct {
+	// Source and Column are the table and the column the name refers to.
+	Source *TableSource
+	Column string
+	// Alias is set instead of Source when the name refers to an expression of
+	// the select list, in ORDER BY, GROUP BY or HAVING.
+	Alias *sqlparser.AliasedExpr
+	// Outer is the number of queries between the one the name is used in and
+	// the one it is bound in. It is more than 0 for correlated subqueries.
+	Outer int
+}
+
+// Bindings holds the binding of every column name of a statement.
+type Bindings map[*sqlparser.ColName]*Binding
+
+// Bind resolves every column name in a SELECT, UNION, INSERT, UPDATE, DELETE,
+// CREATE VIEW, ALTER VIEW or CALL statement. Unqualified table names refer to
+// defaultDB. The errors returned carry the state MySQL would give them, like
+// BadFieldError for unknown columns and NonUniqError for ambiguous ones.
+func Bind(stmt sqlparser.Statement, schema SchemaInformation, defaultDB string) (Bindings, error) {
+	b, err := bind(stmt, schema, defaultDB)
+	if err != nil {
+		return nil, err
+	}
+	return b.bindings, nil
+}
+
+func bind(stmt sqlparser.Statement, schema SchemaInformation, defaultDB string) (*binder, error) {
+	b := &binder{
+		schema:    schema,
+		defaultDB: defaultDB,
+		bindings:  Bindings{},
+		stars:     map[*sqlparser.StarExpr][]*Binding{},
+		scopes:    map[sqlparser.SQLNode]*scope{},
+	}
+	if err := b.bindStatement(stmt); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+type binder struct {
+	schema    SchemaInformation
+	defaultDB string
+	bindings  Bindings
+	// stars holds the columns each star of a select list stands for.
+	stars map[*sqlparser.StarExpr][]*Binding
+	// scopes holds the scope of every SELECT, INSERT, UPDATE and DELETE.
+	scopes map[sqlparser.SQLNode]*scope
+}
+
+// scope holds the names visible in a part of a statement. Scopes of queries hold
+// the tables of their FROM clause; the scopes in between them hold the common
+// table expressions of WITH clauses.
+type scope struct {
+	parent *scope
+	query  bool
+
+	sources []*TableSource
+	// using maps the lowered names of the columns merged by NATURAL joins and
+	// USING to the table the unqualified names refer to.
+	using map[string]*TableSource
+	// selectExprs is the select list, whose aliases ORDER BY, GROUP BY and
+	// HAVING can refer to.
+	selectExprs []sqlparser.SelectExpr
+
+	ctes map[string]*TableSource
+}
+
+func newQueryScope(parent *scope) *scope {
+	return &scope{parent: parent, query: true, using: map[string]*TableSource{}}
+}
+
+func (s *scope) cte(name string) *TableSource {
+	for ; s != nil; s = s.parent {
+		if cte := s.ctes[name]; cte != nil {
+			return cte
+		}
+	}
+	return nil
+}
+
+// aliasMode says whether a clause can use the aliases of the select list, and
+// whether they come before the columns of the tables.
+type aliasMode int8
+
+const (
+	noAliases aliasMode = iota
+	aliasesFirst
+	aliasesLast
+)
+
+// Names of the clauses as MySQL reports them in errors.
+const (
+	fieldList   = "field list"
+	whereClause = "where clause"
+	onClause    = "on clause"
+	fromClause  = "from clause"
+	groupClause = "group statement"
+	havingCl    = "having clause"
+	orderClause = "order clause"
+	windowCl    = "window clause"
+)
+
+func (b *binder) bindStatement(stmt sqlparser.Statement) error {
+	switch stmt := stmt.(type) {
+	case sqlparser.TableStatement:
+		_, err := b.bindTableStatement(stmt, nil)
+		return err
+	case *sqlparser.Update:
+		return b.bindUpdate(stmt)
+	case *sqlparser.Delete:
+		return b.bindDelete(stmt)
+	case *sqlparser.Insert:
+		return b.bindInsert(stmt)
+	case *sqlparser.CreateView:
+		_, err := b.bindTableStatement(stmt.Select, nil)
+		return err
+	case *sqlparser.AlterView:
+		_, err := b.bindTableStatement(stmt.Select, nil)
+		return err
+	case *sqlparser.CallProc:
+		// The parameters cannot refer to columns, but can hold subqueries.
+		s := newQueryScope(nil)
+		for _, param := range stmt.Params {
+			if err := b.bindExpr(param, s, fieldList, noAliases); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// columnNames returns the names of the columns a query produces, which are the
+// ones of its first SELECT.
+func (b *binder) columnNames(stmt sqlparser.TableStatement) []string {
+	first, err := sqlparser.GetFirstSelect(stmt)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, expr := range first.GetColumns() {
+		switch expr := expr.(type) {
+		case *sqlparser.AliasedExpr:
+			names = append(names, expr.ColumnName())
+		case *sqlparser.StarExpr:
+			for _, col := range b.stars[expr] {
+				names = append(names, col.Column)
+			}
+		}
+	}
+	return names
+}
+
+// bindTableStatement binds a SELECT or a UNION and returns the names of the
+// columns it produces.
+func (b *binder) bindTableStatement(stmt sqlparser.TableStatement, parent *scope) ([]string, error) {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		return b.bindSelect(stmt, parent)
+	case *sqlparser.Union:
+		with, err := b.bindWith(stmt.With, parent)
+		if err != nil {
+			return nil, err
+		}
+		columns, err := b.bindTableStatement(stmt.Left, with)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := b.bindTableStatement(stmt.Right, with); err != nil {
+			return nil, err
+		}
+		// The ORDER BY of a union can only use the columns it produces, which
+		// are named after the select list of its first query.
+		s := newQueryScope(with)
+		first, err := sqlparser.GetFirstSelect(stmt)
+		if err != nil {
+			return nil, err
+		}
+		s.selectExprs = first.GetColumns()
+		if err := b.bindOrderBy(stmt.OrderBy, s); err != nil {
+			return nil, err
+		}
+		return columns, b.bindLimit(stmt.Limit, s)
+	}
+	return nil, nil
+}
+
+func (b *binder) bindSelect(sel *sqlparser.Select, parent *scope) ([]string, error) {
+	with, err := b.bindWith(sel.With, parent)
+	if err != nil {
+		return nil, err
+	}
+	s := newQueryScope(with)
+	b.scopes[sel] = s
+	for _, expr := range sel.From {
+		if err := b.addTableExpr(expr, s); err != nil {
+			return nil, err
+		}
+	}
+	if sel.Where != nil {
+		if err := b.bindExpr(sel.Where.Expr, s, whereClause, noAliases); err != nil {
+			return nil, err
+		}
+	}
+
+	var columns []string
+	for _, expr := range sel.GetColumns() {
+		switch expr := expr.(type) {
+		case *sqlparser.AliasedExpr:
+			if err := b.bindExpr(expr.Expr, s, fieldList, noAliases); err != nil {
+				return nil, err
+			}
+			columns = append(columns, expr.ColumnName())
+		case *sqlparser.StarExpr:
+			starColumns, err := s.expandStar(expr)
+			if err != nil {
+				return nil, err
+			}
+			b.stars[expr] = starColumns
+			for _, col := range starColumns {
+				columns = append(columns, col.Column)
+			}
+		}
+	}
+	s.selectExprs = sel.GetColumns()
+
+	if sel.GroupBy != nil {
+		for _, expr := range sel.GroupBy.Exprs {
+			if err := b.bindExpr(expr, s, groupClause, aliasesLast); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if sel.Having != nil {
+		if err := b.bindExpr(sel.Having.Expr, s, havingCl, aliasesFirst); err != nil {
+			return nil, err
+		}
+	}
+	for _, named := range sel.Windows {
+		for _, def := range named.Windows {
+			if err := b.bindNode(def.WindowSpec, s, windowCl, noAliases); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := b.bindOrderBy(sel.OrderBy, s); err != nil {
+		return nil, err
+	}
+	return columns, b.bindLimit(sel.Limit, s)
+}
+
+// bindWith binds the common table expressions of a WITH clause, and returns the
+// scope they are visible in.
+func (b *binder) bindWith(with *sqlparser.With, parent *scope) (*scope, error) {
+	if with == nil {
+		return parent, nil
+	}
+	s := &scope{parent: parent, ctes: map[string]*TableSource{}}
+	for _, cte := range with.CTEs {
+		name := cte.ID.String()
+		source := &TableSource{Kind: CommonTable, Name: name, Table: name, Select: cte.Subquery}
+		for _, col := range cte.Columns {
+			source.Columns = append(source.Columns, col.String())
+		}
+		if with.Recursive {
+			// A recursive common table expression can refer to itself, with
+			// the columns of its first query when they are not listed.
+			if source.Columns == nil {
+				first, err := sqlparser.GetFirstSelect(cte.Subquery)
+				if err != nil {
+					return nil, err
+				}
+				if source.Columns, err = b.bindTableStatement(first, s); err != nil {
+					return nil, err
+				}
+			}
+			s.ctes[name] = source
+		}
+		columns, err := b.bindTableStatement(cte.Subquery, s)
+		if err != nil {
+			return nil, err
+		}
+		if source.Columns == nil {
+			source.Columns = columns
+		} else if len(source.Columns) != len(columns) {
+			return nil, vterrors.VT03033()
+		}
+		s.ctes[name] = source
+	}
+	return s, nil
+}
+
+// addTableExpr adds the tables of a FROM clause item to the scope of a query.
+func (b *binder) addTableExpr(expr sqlparser.TableExpr, s *scope) error {
+	switch expr := expr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		source, err := b.aliasedTableSource(expr, s)
+		if err != nil || source == nil {
+			return err
+		}
+		return s.addSource(source)
+	case *sqlparser.JoinTableExpr:
+		left := len(s.sources)
+		if err := b.addTableExpr(expr.LeftExpr, s); err != nil {
+			return err
+		}
+		right := len(s.sources)
+		if err := b.addTableExpr(expr.RightExpr, s); err != nil {
+			return err
+		}
+		switch expr.Join {
+		case sqlparser.NaturalJoinType, sqlparser.NaturalLeftJoinType, sqlparser.NaturalRightJoinType:
+			return s.mergeColumns(s.sources[left:right], s.sources[right:], commonColumns(s.sources[left:right], s.sources[right:]), expr.Join == sqlparser.NaturalRightJoinType)
+		}
+		if expr.Condition == nil {
+			return nil
+		}
+		if len(expr.Condition.Using) > 0 {
+			var columns []string
+			for _, col := range expr.Condition.Using {
+				columns = append(columns, col.String())
+			}
+			return s.mergeColumns(s.sources[left:right], s.sources[right:], columns, expr.Join == sqlparser.RightJoinType)
+		}
+		if expr.Condition.On != nil {
+			return b.bindExpr(expr.Condition.On, s, onClause, noAliases)
+		}
+	case *sqlparser.ParenTableExpr:
+		for _, expr := range expr.Exprs {
+			if err := b.addTableExpr(expr, s); err != nil {
+				return err
+			}
+		}
+	case *sqlparser.JSONTableExpr:
+		if err := b.bindExpr(expr.Expr, s, fromClause, noAliases); err != nil {
+			return err
+		}
+		source := &TableSource{Kind: JSONTable, Name: expr.Alias.String(), Node: expr}
+		source.Columns = jsonTableColumns(expr.Columns, nil)
+		return s.addSource(source)
+	}
+	return nil
+}
+
+func (b *binder) aliasedTableSource(expr *sqlparser.AliasedTableExpr, s *scope) (*TableSource, error) {
+	switch table := expr.Expr.(type) {
+	case sqlparser.TableName:
+		name := table.Name.String()
+		if table.Qualifier.IsEmpty() && strings.EqualFold(name, "dual") {
+			// DUAL has no columns, and is where a query without FROM reads from.
+			return nil, nil
+		}
+		source := &TableSource{Name: name, Node: expr}
+		if cte := s.cte(name); cte != nil && table.Qualifier.IsEmpty() {
+			source.Kind = CommonTable
+			source.Table = cte.Table
+			source.Columns = cte.Columns
+			source.Select = cte.Select
+		} else {
+			db := b.defaultDB
+			if table.Qualifier.NotEmpty() {
+				db = table.Qualifier.String()
+			}
+			if db == "" {
+				return nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.NoDB, "No database selected")
+			}
+			columns, ok := b.schema.TableColumns(db, name)
+			if !ok {
+				return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.NoSuchTable, "Table '%s.%s' doesn't exist", db, name)
+			}
+			source.Kind = BaseTable
+			source.Database = db
+			source.Table = name
+			source.Columns = columns
+		}
+		if expr.As.NotEmpty() {
+			source.Name = expr.As.String()
+		}
+		return source, nil
+	case *sqlparser.DerivedTable:
+		// Derived tables cannot see the tables next to them, unless they are LATERAL.
+		parent := s.parent
+		if table.Lateral {
+			parent = s
+		}
+		columns, err := b.bindTableStatement(table.Select, parent)
+		if err != nil {
+			return nil, err
+		}
+		if len(expr.Columns) > 0 {
+			if len(expr.Columns) != len(columns) {
+				return nil, vterrors.VT03033()
+			}
+			columns = nil
+			for _, col := range expr.Columns {
+				columns = append(columns, col.String())
+			}
+		}
+		return &TableSource{Kind: DerivedTable, Name: expr.As.String(), Columns: columns, Node: expr, Select: table.Select}, nil
+	}
+	return nil, nil
+}
+
+func jsonTableColumns(defs []*sqlparser.JtColumnDefinition, columns []string) []string {
+	for _, def := range defs {
+		switch {
+		case def.JtOrdinal != nil:
+			columns = append(columns, def.JtOrdinal.Name.String())
+		case def.JtPath != nil:
+			columns = append(columns, def.JtPath.Name.String())
+		case def.JtNestedPath != nil:
+			columns = jsonTableColumns(def.JtNestedPath.Columns, columns)
+		}
+	}
+	return columns
+}
+
+func (s *scope) addSource(source *TableSource) error {
+	for _, other := range s.sources {
+		if other.Name == source.Name {
+			return vterrors.VT03013(source.Name)
+		}
+	}
+	s.sources = append(s.sources, source)
+	return nil
+}
+
+// commonColumns returns the columns a NATURAL join merges: the ones both sides have.
+func commonColumns(left, right []*TableSource) []string {
+	var columns []string
+	for _, l := range left {
+		for _, col := range l.Columns {
+			if l.merged[strings.ToLower(col)] {
+				continue
+			}
+			for _, r := range right {
+				if _, ok := r.column(col); ok {
+					columns = append(columns, col)
+					break
+				}
+			}
+		}
+	}
+	return columns
+}
+
+// mergeColumns merges the columns of the two sides of a join, so an
+// unqualified name refers to the left one, or to the right one for a RIGHT join.
+func (s *scope) mergeColumns(left, right []*TableSource, columns []string, rightJoin bool) error {
+	for _, col := range columns {
+		l, err := findSource(left, col)
+		if err != nil {
+			return err
+		}
+		r, err := findSource(right, col)
+		if err != nil {
+			return err
+		}
+		kept, dropped := l, r
+		if rightJoin {
+			kept, dropped = r, l
+		}
+		if dropped.merged == nil {
+			dropped.merged = map[string]bool{}
+		}
+		dropped.merged[strings.ToLower(col)] = true
+		s.using[strings.ToLower(col)] = kept
+	}
+	return nil
+}
+
+func findSource(sources []*TableSource, col string) (*TableSource, error) {
+	var found *TableSource
+	for _, source := range sources {
+		if _, ok := source.column(col); !ok {
+			continue
+		}
+		if found != nil {
+			return nil, ambiguousColumn(col, fromClause)
+		}
+		found = source
+	}
+	if found == nil {
+		return nil, unknownColumn(col, fromClause)
+	}
+	return found, nil
+}
+
+// expandStar returns the columns a star stands for.
+func (s *scope) expandStar(star *sqlparser.StarExpr) ([]*Binding, error) {
+	var columns []*Binding
+	if star.TableName.IsEmpty() {
+		// The merged columns of joins come first, once.
+		for _, source := range s.sources {
+			for _, col := range source.Columns {
+				if s.using[strings.ToLower(col)] == source {
+					columns = append(columns, &Binding{Source: source, Column: col})
+				}
+			}
+		}
+		for _, source := range s.sources {
+			for _, col := range source.Columns {
+				lowered := strings.ToLower(col)
+				if s.using[lowered] != source && !source.merged[lowered] {
+					columns = append(columns, &Binding{Source: source, Column: col})
+				}
+			}
+		}
+		return columns, nil
+	}
+	for _, source := range s.sources {
+		if source.matches(star.TableName) {
+			for _, col := range source.Columns {
+				columns = append(columns, &Binding{Source: source, Column: col})
+			}
+			return columns, nil
+		}
+	}
+	return nil, vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadTableError, "Unknown table '%s'", sqlparser.String(star.TableName))
+}
+
+// matches reports whether a table name used in a query refers to the source.
+func (ts *TableSource) matches(name sqlparser.TableName) bool {
+	if name.Name.String() != ts.Name {
+		return false
+	}
+	return name.Qualifier.IsEmpty() || (ts.Kind == BaseTable && name.Qualifier.String() == ts.Database && ts.Name == ts.Table)
+}
+
+func (b *binder) bindOrderBy(orderBy sqlparser.OrderBy, s *scope) error {
+	for _, order := range orderBy {
+		if err := b.bindExpr(order.Expr, s, orderClause, aliasesFirst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *binder) bindLimit(limit *sqlparser.Limit, s *scope) error {
+	if limit == nil {
+		return nil
+	}
+	return b.bindNode(limit, s, fieldList, noAliases)
+}
+
+func (b *binder) bindExpr(expr sqlparser.Expr, s *scope, clause string, mode aliasMode) error {
+	if expr == nil {
+		return nil
+	}
+	return b.bindNode(expr, s, clause, mode)
+}
+
+// bindNode binds the column names below node, and the queries of its subqueries.
+func (b *binder) bindNode(node sqlparser.SQLNode, s *scope, clause string, mode aliasMode) error {
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			return false, b.resolve(node, s, clause, mode)
+		case *sqlparser.Subquery:
+			_, err := b.bindTableStatement(node.Select, s)
+			return false, err
+		}
+		return true, nil
+	}, node)
+}
+
+// resolve binds a column name, looking in the query it is used in first, and
+// then in the queries around it.
+func (b *binder) resolve(col *sqlparser.ColName, s *scope, clause string, mode aliasMode) error {
+	if mode == aliasesFirst {
+		if found, err := b.resolveAlias(col, s, clause); found || err != nil {
+			return err
+		}
+	}
+	outer := 0
+	for sc := s; sc != nil; sc = sc.parent {
+		if !sc.query {
+			continue
+		}
+		binding, err := sc.find(col, clause)
+		if err != nil {
+			return err
+		}
+		if binding != nil {
+			binding.Outer = outer
+			b.bindings[col] = binding
+			return nil
+		}
+		if sc == s && mode == aliasesLast {
+			if found, err := b.resolveAlias(col, s, clause); found || err != nil {
+				return err
+			}
+		}
+		outer++
+	}
+	return unknownColumn(sqlparser.String(col), clause)
+}
+
+// find returns the binding of a column name among the tables of the scope, or
+// nil if none of them has the column.
+func (s *scope) find(col *sqlparser.ColName, clause string) (*Binding, error) {
+	name := col.Name.String()
+	if !col.Qualifier.IsEmpty() {
+		for _, source := range s.sources {
+			if !source.matches(col.Qualifier) {
+				continue
+			}
+			if column, ok := source.column(name); ok {
+				return &Binding{Source: source, Column: column}, nil
+			}
+		}
+		return nil, nil
+	}
+	if source := s.using[col.Name.Lowered()]; source != nil {
+		column, _ := source.column(name)
+		return &Binding{Source: source, Column: column}, nil
+	}
+	var binding *Binding
+	for _, source := range s.sources {
+		column, ok := source.column(name)
+		if !ok {
+			continue
+		}
+		if binding != nil {
+			return nil, ambiguousColumn(name, clause)
+		}
+		binding = &Binding{Source: source, Column: column}
+	}
+	return binding, nil
+}
+
+// resolveAlias binds an unqualified column name to the select list expression
+// with that alias, if there is one.
+func (b *binder) resolveAlias(col *sqlparser.ColName, s *scope, clause string) (bool, error) {
+	if !col.Qualifier.IsEmpty() {
+		return false, nil
+	}
+	var found *sqlparser.AliasedExpr
+	for _, expr := range s.selectExprs {
+		ae, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok || !strings.EqualFold(ae.ColumnName(), col.Name.String()) {
+			continue
+		}
+		if _, isCol := ae.Expr.(*sqlparser.ColName); isCol && ae.As.IsEmpty() && len(s.sources) > 0 {
+			// A plain column of the select list is found among the tables, but
+			// the ORDER BY of a union has none.
+			continue
+		}
+		if found != nil {
+			return false, ambiguousColumn(col.Name.String(), clause)
+		}
+		found = ae
+	}
+	if found == nil {
+		return false, nil
+	}
+	b.bindings[col] = &Binding{Alias: found}
+	return true, nil
+}
+
+func (b *binder) bindUpdate(upd *sqlparser.Update) error {
+	with, err := b.bindWith(upd.With, nil)
+	if err != nil {
+		return err
+	}
+	s := newQueryScope(with)
+	b.scopes[upd] = s
+	for _, expr := range upd.TableExprs {
+		if err := b.addTableExpr(expr, s); err != nil {
+			return err
+		}
+	}
+	for _, expr := range upd.Exprs {
+		if err := b.resolve(expr.Name, s, fieldList, noAliases); err != nil {
+			return err
+		}
+		if err := b.bindExpr(expr.Expr, s, fieldList, noAliases); err != nil {
+			return err
+		}
+	}
+	if upd.Where != nil {
+		if err := b.bindExpr(upd.Where.Expr, s, whereClause, noAliases); err != nil {
+			return err
+		}
+	}
+	if err := b.bindOrderBy(upd.OrderBy, s); err != nil {
+		return err
+	}
+	return b.bindLimit(upd.Limit, s)
+}
+
+func (b *binder) bindDelete(del *sqlparser.Delete) error {
+	with, err := b.bindWith(del.With, nil)
+	if err != nil {
+		return err
+	}
+	s := newQueryScope(with)
+	b.scopes[del] = s
+	for _, expr := range del.TableExprs {
+		if err := b.addTableExpr(expr, s); err != nil {
+			return err
+		}
+	}
+	for _, target := range del.Targets {
+		found := false
+		for _, source := range s.sources {
+			found = found || source.matches(target)
+		}
+		if !found {
+			return vterrors.VT03003(sqlparser.String(target))
+		}
+	}
+	if del.Where != nil {
+		if err := b.bindExpr(del.Where.Expr, s, whereClause, noAliases); err != nil {
+			return err
+		}
+	}
+	if err := b.bindOrderBy(del.OrderBy, s); err != nil {
+		return err
+	}
+	return b.bindLimit(del.Limit, s)
+}
+
+func (b *binder) bindInsert(ins *sqlparser.Insert) error {
+	s := newQueryScope(nil)
+	b.scopes[ins] = s
+	target, err := b.aliasedTableSource(ins.Table, s)
+	if err != nil || target == nil {
+		return err
+	}
+	if err := s.addSource(target); err != nil {
+		return err
+	}
+	columns := target.Columns
+	if len(ins.Columns) > 0 {
+		columns = nil
+		for _, col := range ins.Columns {
+			name, ok := target.column(col.String())
+			if !ok {
+				return unknownColumn(col.String(), fieldList)
+			}
+			columns = append(columns, name)
+		}
+	}
+
+	switch rows := ins.Rows.(type) {
+	case sqlparser.TableStatement:
+		if _, err := b.bindTableStatement(rows, nil); err != nil {
+			return err
+		}
+	case sqlparser.Values:
+		if err := b.bindNode(rows, s, fieldList, noAliases); err != nil {
+			return err
+		}
+	}
+
+	// ON DUPLICATE KEY UPDATE can refer to the new row by its alias.
+	if ins.RowAlias != nil {
+		alias := &TableSource{Kind: DerivedTable, Name: ins.RowAlias.TableName.String(), Columns: columns}
+		if len(ins.RowAlias.Columns) > 0 {
+			alias.Columns = nil
+			for _, col := range ins.RowAlias.Columns {
+				alias.Columns = append(alias.Columns, col.String())
+			}
+		}
+		if err := s.addSource(alias); err != nil {
+			return err
+		}
+	}
+	for _, expr := range ins.OnDup {
+		if err := b.resolve(expr.Name, s, fieldList, noAliases); err != nil {
+			return err
+		}
+		if err := b.bindExpr(expr.Expr, s, fieldList, noAliases); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unknownColumn(name, clause string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.BadFieldError, "Unknown column '%s' in '%s'", name, clause)
+}
+
+func ambiguousColumn(name, clause string) error {
+	return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUniqError, "Column '%s' in %s is ambiguous", name, clause)
+}
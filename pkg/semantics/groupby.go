@@ -0,0 +1,434 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package semantics
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// KeySchema gives the unique keys of the tables a statement uses.
+type KeySchema interface {
+	SchemaInformation
+	// UniqueKeys returns the columns of the primary key and of the unique keys
+	// of a table, leaving out the keys that do not identify rows because they
+	// have nullable columns or expression parts.
+	UniqueKeys(db, table string) [][]string
+}
+
+// Names of the clauses as MySQL reports them in ONLY_FULL_GROUP_BY errors.
+const (
+	selectList    = "SELECT list"
+	havingClause  = "HAVING clause"
+	orderByClause = "ORDER BY clause"
+)
+
+// ValidateGroupBy checks that the queries of a statement are valid under
+// ONLY_FULL_GROUP_BY. Every column a grouped query uses outside of aggregates
+// and ANY_VALUE, in its select list, HAVING or ORDER BY, must be part of an
+// expression of GROUP BY, or be functionally dependent on them: through a
+// primary or unique key of the table that has all its columns grouped, or an
+// equality of WHERE or of an inner join.
+//
+// The error of the first invalid query is returned, with the state and message
+// of MySQL's error 1055 (ER_WRONG_FIELD_WITH_GROUP), or 1140
+// (ER_MIX_OF_GROUP_FUNC_AND_FIELDS) for queries that aggregate without GROUP
+// BY. When source is not nil, the message also gives the position of the
+// column at fault, counted in bytes from 1.
+func ValidateGroupBy(stmt sqlparser.Statement, schema KeySchema, defaultDB string, source *sqlparser.SourceTree) error {
+	b, err := bind(stmt, schema, defaultDB)
+	if err != nil {
+		return err
+	}
+	v := &groupByValidator{binder: b, schema: schema, source: source}
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if sel, ok := node.(*sqlparser.Select); ok {
+			if err := v.query(sel); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}, stmt)
+}
+
+type groupByValidator struct {
+	*binder
+	schema KeySchema
+	source *sqlparser.SourceTree
+}
+
+// grouping is what a grouped query can use outside of aggregates.
+type grouping struct {
+	*groupByValidator
+	scope *scope
+	// exprs are the expressions of GROUP BY, and determined the columns that
+	// depend on them.
+	exprs      []sqlparser.Expr
+	determined map[*TableSource]map[string]bool
+	// explicit is false for queries that aggregate without GROUP BY.
+	explicit bool
+	cmp      *sqlparser.Comparator
+}
+
+func (v *groupByValidator) query(sel *sqlparser.Select) error {
+	s := v.scopes[sel]
+	if s == nil {
+		return nil
+	}
+	g := &grouping{
+		groupByValidator: v,
+		scope:            s,
+		determined:       map[*TableSource]map[string]bool{},
+		explicit:         sel.GroupBy != nil && len(sel.GroupBy.Exprs) > 0,
+	}
+	g.cmp = &sqlparser.Comparator{RefOfColName_: g.sameColumn}
+	if !g.explicit && !aggregates(sel) {
+		return nil
+	}
+	if g.explicit {
+		if err := g.groupBy(sel); err != nil {
+			return err
+		}
+		g.dependencies(sel)
+	}
+
+	n := 0
+	for _, expr := range sel.GetColumns() {
+		switch expr := expr.(type) {
+		case *sqlparser.AliasedExpr:
+			n++
+			if err := g.check(expr.Expr, selectList, n); err != nil {
+				return err
+			}
+		case *sqlparser.StarExpr:
+			for _, binding := range v.stars[expr] {
+				n++
+				if binding.Source != nil && !g.dependent(binding.Source, binding.Column) {
+					return g.notGrouped(expr, selectList, n, binding)
+				}
+			}
+		}
+	}
+	if sel.Having != nil {
+		if err := g.check(sel.Having.Expr, havingClause, 1); err != nil {
+			return err
+		}
+	}
+	for i, order := range sel.OrderBy {
+		if err := g.check(order.Expr, orderByClause, i+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// aggregates reports whether a query aggregates its rows: whether its select
+// list, HAVING or ORDER BY has an aggregate that is not a window function.
+// Aggregates in subqueries belong to the subqueries.
+func aggregates(sel *sqlparser.Select) bool {
+	found := false
+	visit := func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery:
+			return false, nil
+		case sqlparser.AggrFunc:
+			if !windowed(node) {
+				found = true
+				return false, nil
+			}
+		}
+		return !found, nil
+	}
+	_ = sqlparser.Walk(visit, sel.SelectExprs, sel.OrderBy)
+	if sel.Having != nil {
+		_ = sqlparser.Walk(visit, sel.Having)
+	}
+	return found
+}
+
+// aggregated reports whether an expression has an aggregate that is not a
+// window function, outside of subqueries.
+func aggregated(expr sqlparser.Expr) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery:
+			return false, nil
+		case sqlparser.AggrFunc:
+			if !windowed(node) {
+				found = true
+			}
+		}
+		return !found, nil
+	}, expr)
+	return found
+}
+
+// windowed reports whether an aggregate is used as a window function.
+func windowed(aggr sqlparser.AggrFunc) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if _, ok := node.(*sqlparser.OverClause); ok {
+			found = true
+		}
+		return !found, nil
+	}, aggr)
+	return found
+}
+
+// groupBy collects the expressions of GROUP BY. Aliases and positions stand for
+// the expressions of the select list they refer to, which cannot be aggregates:
+// grouping on them is MySQL's error 1056 (ER_WRONG_GROUP_FIELD).
+func (g *grouping) groupBy(sel *sqlparser.Select) error {
+	columns := sel.GetColumns()
+	for _, expr := range sel.GroupBy.Exprs {
+		var ref *sqlparser.AliasedExpr
+		switch e := expr.(type) {
+		case *sqlparser.ColName:
+			if binding := g.bindings[e]; binding != nil && binding.Alias != nil {
+				ref = binding.Alias
+			}
+		case *sqlparser.Literal:
+			if e.Type == sqlparser.IntVal {
+				if i, err := strconv.Atoi(e.Val); err == nil && i >= 1 && i <= len(columns) {
+					ref, _ = columns[i-1].(*sqlparser.AliasedExpr)
+				}
+			}
+		}
+		if ref != nil {
+			if aggregated(ref.Expr) {
+				name := ref.As.String()
+				if ref.As.IsEmpty() {
+					name = sqlparser.String(ref.Expr)
+				}
+				return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongGroupField, "Can't group on '%s'", name)
+			}
+			expr = ref.Expr
+		}
+		g.exprs = append(g.exprs, expr)
+		if col, ok := expr.(*sqlparser.ColName); ok {
+			if binding := g.bindings[col]; binding != nil && binding.Source != nil {
+				g.determine(binding.Source, binding.Column)
+			}
+		}
+	}
+	return nil
+}
+
+func (g *grouping) determine(source *TableSource, column string) bool {
+	columns := g.determined[source]
+	if columns == nil {
+		columns = map[string]bool{}
+		g.determined[source] = columns
+	}
+	key := strings.ToLower(column)
+	if columns[key] {
+		return false
+	}
+	columns[key] = true
+	return true
+}
+
+func (g *grouping) dependent(source *TableSource, column string) bool {
+	if !g.local(source) {
+		return true
+	}
+	return g.determined[source][strings.ToLower(column)]
+}
+
+// local reports whether a table is one of the FROM clause of the query. The
+// columns of the other tables are constant for the query.
+func (g *grouping) local(source *TableSource) bool {
+	for _, s := range g.scope.sources {
+		if s == source {
+			return true
+		}
+	}
+	return false
+}
+
+// dependencies adds the columns that depend on the grouped ones, until there
+// are no more to add.
+func (g *grouping) dependencies(sel *sqlparser.Select) {
+	var equalities []sqlparser.Expr
+	if sel.Where != nil {
+		equalities = sqlparser.SplitAndExpression(equalities, sel.Where.Expr)
+	}
+	for _, expr := range sel.From {
+		equalities = innerJoinConditions(equalities, expr)
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, expr := range equalities {
+			cmp, ok := expr.(*sqlparser.ComparisonExpr)
+			if !ok || cmp.Operator != sqlparser.EqualOp {
+				continue
+			}
+			changed = g.equality(cmp.Left, cmp.Right) || changed
+			changed = g.equality(cmp.Right, cmp.Left) || changed
+		}
+		for _, source := range g.scope.sources {
+			if source.Kind != BaseTable {
+				continue
+			}
+			for _, key := range g.schema.UniqueKeys(source.Database, source.Table) {
+				if !g.all(source, key) {
+					continue
+				}
+				for _, col := range source.Columns {
+					changed = g.determine(source, col) || changed
+				}
+			}
+		}
+	}
+}
+
+// equality adds the column of one side of an equality when the other side
+// only uses grouped columns and constants.
+func (g *grouping) equality(column, other sqlparser.Expr) bool {
+	col, ok := column.(*sqlparser.ColName)
+	if !ok {
+		return false
+	}
+	binding := g.bindings[col]
+	if binding == nil || binding.Source == nil || !g.local(binding.Source) {
+		return false
+	}
+	if g.find(other) != nil {
+		return false
+	}
+	return g.determine(binding.Source, binding.Column)
+}
+
+func (g *grouping) all(source *TableSource, columns []string) bool {
+	for _, col := range columns {
+		if !g.determined[source][strings.ToLower(col)] {
+			return false
+		}
+	}
+	return true
+}
+
+// innerJoinConditions adds the conjuncts of the ON conditions of the inner
+// joins of a FROM clause item.
+func innerJoinConditions(conds []sqlparser.Expr, expr sqlparser.TableExpr) []sqlparser.Expr {
+	switch expr := expr.(type) {
+	case *sqlparser.JoinTableExpr:
+		conds = innerJoinConditions(conds, expr.LeftExpr)
+		conds = innerJoinConditions(conds, expr.RightExpr)
+		switch expr.Join {
+		case sqlparser.NormalJoinType, sqlparser.StraightJoinType, sqlparser.HashJoinType,
+			sqlparser.ParallelNormalJoinType, sqlparser.ParallelHashJoinType:
+			if expr.Condition != nil {
+				conds = sqlparser.SplitAndExpression(conds, expr.Condition.On)
+			}
+		}
+	case *sqlparser.ParenTableExpr:
+		for _, expr := range expr.Exprs {
+			conds = innerJoinConditions(conds, expr)
+		}
+	}
+	return conds
+}
+
+// sameColumn compares column names by what they are bound to, so that GROUP BY
+// a matches t.a.
+func (g *grouping) sameColumn(a, b *sqlparser.ColName) bool {
+	ba, bb := g.bindings[a], g.bindings[b]
+	if ba == nil || bb == nil {
+		return sqlparser.Equals.IdentifierCI(a.Name, b.Name) && sqlparser.Equals.TableName(a.Qualifier, b.Qualifier)
+	}
+	return ba.Source == bb.Source && ba.Alias == bb.Alias && strings.EqualFold(ba.Column, bb.Column)
+}
+
+func (g *grouping) grouped(expr sqlparser.Expr) bool {
+	for _, e := range g.exprs {
+		if g.cmp.Expr(expr, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// check returns an error if an expression of a clause uses a column that does
+// not depend on the grouped ones.
+func (g *grouping) check(expr sqlparser.Expr, clause string, n int) error {
+	col := g.find(expr)
+	if col == nil {
+		return nil
+	}
+	return g.notGrouped(col, clause, n, g.bindings[col])
+}
+
+// find returns the first column of an expression that does not depend on the
+// grouped ones, or nil.
+func (g *grouping) find(expr sqlparser.Expr) *sqlparser.ColName {
+	var found *sqlparser.ColName
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if found != nil {
+			return false, nil
+		}
+		switch node := node.(type) {
+		case sqlparser.AggrFunc:
+			// ANY_VALUE is an aggregate too.
+			if !windowed(node) {
+				return false, nil
+			}
+		case *sqlparser.ColName:
+			binding := g.bindings[node]
+			// Aliases of HAVING and ORDER BY refer to the select list, which is
+			// checked on its own.
+			if binding != nil && binding.Source != nil && !g.dependent(binding.Source, binding.Column) {
+				found = node
+			}
+			return false, nil
+		}
+		if e, ok := node.(sqlparser.Expr); ok && g.grouped(e) {
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	return found
+}
+
+func (g *grouping) notGrouped(node sqlparser.SQLNode, clause string, n int, binding *Binding) error {
+	name := binding.Source.Name + "." + binding.Column
+	if binding.Source.Kind == BaseTable {
+		name = binding.Source.Database + "." + binding.Source.Table + "." + binding.Column
+	}
+	var msg string
+	if g.explicit {
+		msg = fmt.Sprintf("Expression #%d of %s is not in GROUP BY clause and contains nonaggregated column '%s' which is not functionally dependent on columns in GROUP BY clause; this is incompatible with sql_mode=only_full_group_by", n, clause, name)
+	} else {
+		msg = fmt.Sprintf("In aggregated query without GROUP BY, expression #%d of %s contains nonaggregated column '%s'; this is incompatible with sql_mode=only_full_group_by", n, clause, name)
+	}
+	if g.source != nil {
+		if offset, ok := g.source.Offset(node); ok {
+			msg += fmt.Sprintf(" at position %d", offset+1)
+		}
+	}
+	state := vterrors.WrongFieldWithGroup
+	if !g.explicit {
+		state = vterrors.MixOfGroupFuncAndFields
+	}
+	return vterrors.NewError(vtrpcpb.Code_INVALID_ARGUMENT, state, msg)
+}
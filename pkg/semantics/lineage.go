@@ -0,0 +1,429 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package semantics
+
+import (
+	"sort"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+)
+
+// LineageKind says how a source column feeds a column.
+type LineageKind int8
+
+const (
+	// Direct means the values of the column are copied from the source column.
+	Direct LineageKind = iota
+	// Transformation means the values of the column are computed from the
+	// source column.
+	Transformation
+	// Filter means the source column only decides which rows there are, from
+	// a WHERE, ON, GROUP BY or HAVING clause.
+	Filter
+)
+
+// String returns the name of the kind.
+func (k LineageKind) String() string {
+	switch k {
+	case Direct:
+		return "direct"
+	case Transformation:
+		return "transformation"
+	}
+	return "filter"
+}
+
+// ColumnRef is a column of a table. The columns a query produces belong to no
+// table, and have only their name.
+type ColumnRef struct {
+	Database, Table, Column string
+}
+
+// String returns the column as db.table.column.
+func (c ColumnRef) String() string {
+	if c.Table == "" {
+		return c.Column
+	}
+	return c.Database + "." + c.Table + "." + c.Column
+}
+
+// ColumnLineage gives the source columns that feed a column.
+type ColumnLineage struct {
+	Column ColumnRef
+	// Sources maps the columns of the tables of the schema that feed the
+	// column to how they do it. When a source feeds it in several ways, the
+	// most direct one is kept.
+	Sources map[ColumnRef]LineageKind
+}
+
+// SortedSources returns the sources of the column, sorted.
+func (cl *ColumnLineage) SortedSources() []ColumnRef {
+	sources := make([]ColumnRef, 0, len(cl.Sources))
+	for col := range cl.Sources {
+		sources = append(sources, col)
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i].String() < sources[j].String() })
+	return sources
+}
+
+// Lineage returns the lineage of the columns a statement produces or writes:
+// the columns of the result of a SELECT or a UNION, the columns an INSERT or
+// an UPDATE writes, and the columns of a view created or altered. Common table
+// expressions, derived tables, the queries of unions and the subqueries are
+// traced down to the tables of the schema they read from.
+func Lineage(stmt sqlparser.Statement, schema SchemaInformation, defaultDB string) ([]ColumnLineage, error) {
+	b, err := bind(stmt, schema, defaultDB)
+	if err != nil {
+		return nil, err
+	}
+	tr := &tracer{
+		binder:     b,
+		queries:    map[sqlparser.TableStatement]*queryLineage{},
+		inProgress: map[sqlparser.TableStatement]bool{},
+	}
+	switch stmt := stmt.(type) {
+	case sqlparser.TableStatement:
+		return tr.outputs(stmt, ColumnRef{}, nil), nil
+	case *sqlparser.CreateView:
+		return tr.outputs(stmt.Select, tr.qualify(stmt.ViewName), stmt.Columns), nil
+	case *sqlparser.AlterView:
+		return tr.outputs(stmt.Select, tr.qualify(stmt.ViewName), stmt.Columns), nil
+	case *sqlparser.Insert:
+		return tr.insert(stmt), nil
+	case *sqlparser.Update:
+		return tr.update(stmt), nil
+	}
+	return nil, nil
+}
+
+// lineage maps source columns to how they feed a column.
+type lineage map[ColumnRef]LineageKind
+
+func (l lineage) add(col ColumnRef, kind LineageKind) {
+	if prev, ok := l[col]; !ok || kind < prev {
+		l[col] = kind
+	}
+}
+
+// merge adds the sources of other, as they feed a column through an expression
+// of the given kind.
+func (l lineage) merge(other lineage, kind LineageKind) {
+	for col, k := range other {
+		switch {
+		case k == Filter || kind == Filter:
+			l.add(col, Filter)
+		case kind == Direct:
+			l.add(col, k)
+		default:
+			l.add(col, Transformation)
+		}
+	}
+}
+
+// queryLineage is the lineage of the columns of a query, and of the columns
+// deciding which rows it has.
+type queryLineage struct {
+	columns []lineage
+	filters lineage
+}
+
+type tracer struct {
+	binder     *binder
+	queries    map[sqlparser.TableStatement]*queryLineage
+	inProgress map[sqlparser.TableStatement]bool
+}
+
+func (tr *tracer) qualify(name sqlparser.TableName) ColumnRef {
+	ref := ColumnRef{Database: tr.binder.defaultDB, Table: name.Name.String()}
+	if name.Qualifier.NotEmpty() {
+		ref.Database = name.Qualifier.String()
+	}
+	return ref
+}
+
+// outputs returns the lineage of the columns of a query, which belong to the
+// given table. Columns, when given, rename them.
+func (tr *tracer) outputs(stmt sqlparser.TableStatement, table ColumnRef, columns sqlparser.Columns) []ColumnLineage {
+	q := tr.query(stmt)
+	names := tr.binder.columnNames(stmt)
+	res := make([]ColumnLineage, 0, len(q.columns))
+	for i, col := range q.columns {
+		ref := table
+		ref.Column = names[i]
+		if i < len(columns) {
+			ref.Column = columns[i].String()
+		}
+		sources := lineage{}
+		sources.merge(col, Direct)
+		sources.merge(q.filters, Filter)
+		res = append(res, ColumnLineage{Column: ref, Sources: sources})
+	}
+	return res
+}
+
+// query returns the lineage of a SELECT or a UNION.
+func (tr *tracer) query(stmt sqlparser.TableStatement) *queryLineage {
+	if q, ok := tr.queries[stmt]; ok {
+		return q
+	}
+	if tr.inProgress[stmt] {
+		// A recursive common table expression refers to itself from its
+		// second query; its first query is where its rows come from.
+		first, err := sqlparser.GetFirstSelect(stmt)
+		if err != nil || first == stmt {
+			return &queryLineage{filters: lineage{}}
+		}
+		return tr.query(first)
+	}
+	tr.inProgress[stmt] = true
+	defer delete(tr.inProgress, stmt)
+
+	q := &queryLineage{filters: lineage{}}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		for _, expr := range stmt.GetColumns() {
+			switch expr := expr.(type) {
+			case *sqlparser.AliasedExpr:
+				q.columns = append(q.columns, tr.expr(expr.Expr))
+			case *sqlparser.StarExpr:
+				for _, col := range tr.binder.stars[expr] {
+					q.columns = append(q.columns, tr.column(col))
+				}
+			}
+		}
+		tr.fromFilters(stmt.From, tr.binder.scopes[stmt], q.filters)
+		if stmt.Where != nil {
+			q.filters.merge(tr.expr(stmt.Where.Expr), Filter)
+		}
+		if stmt.GroupBy != nil {
+			for _, expr := range stmt.GroupBy.Exprs {
+				q.filters.merge(tr.expr(expr), Filter)
+			}
+		}
+		if stmt.Having != nil {
+			q.filters.merge(tr.expr(stmt.Having.Expr), Filter)
+		}
+		tr.limitFilters(stmt.OrderBy, stmt.Limit, q.filters)
+	case *sqlparser.Union:
+		left, right := tr.query(stmt.Left), tr.query(stmt.Right)
+		for i, col := range left.columns {
+			merged := lineage{}
+			merged.merge(col, Direct)
+			if i < len(right.columns) {
+				merged.merge(right.columns[i], Direct)
+			}
+			q.columns = append(q.columns, merged)
+		}
+		q.filters.merge(left.filters, Filter)
+		q.filters.merge(right.filters, Filter)
+		tr.limitFilters(stmt.OrderBy, stmt.Limit, q.filters)
+	}
+	tr.queries[stmt] = q
+	return q
+}
+
+// fromFilters adds to filters the columns of the join conditions of a FROM
+// clause, and the filters of the derived tables and common table expressions
+// it reads from.
+func (tr *tracer) fromFilters(from []sqlparser.TableExpr, s *scope, filters lineage) {
+	var joins func(expr sqlparser.TableExpr)
+	joins = func(expr sqlparser.TableExpr) {
+		switch expr := expr.(type) {
+		case *sqlparser.JoinTableExpr:
+			joins(expr.LeftExpr)
+			joins(expr.RightExpr)
+			if expr.Condition != nil && expr.Condition.On != nil {
+				filters.merge(tr.expr(expr.Condition.On), Filter)
+			}
+		case *sqlparser.ParenTableExpr:
+			for _, expr := range expr.Exprs {
+				joins(expr)
+			}
+		}
+	}
+	for _, expr := range from {
+		joins(expr)
+	}
+	if s == nil {
+		return
+	}
+	// The columns merged by NATURAL joins and USING are compared on both sides.
+	for name, source := range s.using {
+		col, _ := source.column(name)
+		filters.merge(tr.column(&Binding{Source: source, Column: col}), Filter)
+	}
+	for _, source := range s.sources {
+		for name := range source.merged {
+			col, _ := source.column(name)
+			filters.merge(tr.column(&Binding{Source: source, Column: col}), Filter)
+		}
+		if source.Select != nil {
+			filters.merge(tr.query(source.Select).filters, Filter)
+		}
+	}
+}
+
+// limitFilters adds to filters the columns of an ORDER BY, which decide the
+// rows a LIMIT keeps.
+func (tr *tracer) limitFilters(orderBy sqlparser.OrderBy, limit *sqlparser.Limit, filters lineage) {
+	if limit == nil {
+		return
+	}
+	for _, order := range orderBy {
+		filters.merge(tr.expr(order.Expr), Filter)
+	}
+}
+
+// expr returns the lineage of the value of an expression. A column alone is
+// copied; anything else transforms the columns it uses.
+func (tr *tracer) expr(expr sqlparser.Expr) lineage {
+	if col, ok := expr.(*sqlparser.ColName); ok {
+		return tr.column(tr.binder.bindings[col])
+	}
+	res := lineage{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			res.merge(tr.column(tr.binder.bindings[node]), Transformation)
+		case *sqlparser.Subquery:
+			q := tr.query(node.Select)
+			for _, col := range q.columns {
+				res.merge(col, Transformation)
+			}
+			res.merge(q.filters, Filter)
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+	return res
+}
+
+// column returns the lineage of the column a name is bound to.
+func (tr *tracer) column(binding *Binding) lineage {
+	if binding == nil {
+		return lineage{}
+	}
+	if binding.Alias != nil {
+		return tr.expr(binding.Alias.Expr)
+	}
+	source := binding.Source
+	switch source.Kind {
+	case BaseTable:
+		return lineage{ColumnRef{Database: source.Database, Table: source.Table, Column: binding.Column}: Direct}
+	case DerivedTable, CommonTable:
+		if source.Select == nil {
+			break
+		}
+		q := tr.query(source.Select)
+		for i, col := range source.Columns {
+			if col == binding.Column && i < len(q.columns) {
+				return q.columns[i]
+			}
+		}
+	case JSONTable:
+		// The columns of a JSON_TABLE are extracted from its document.
+		if jt, ok := source.Node.(*sqlparser.JSONTableExpr); ok {
+			res := lineage{}
+			res.merge(tr.expr(jt.Expr), Transformation)
+			return res
+		}
+	}
+	return lineage{}
+}
+
+func (tr *tracer) insert(ins *sqlparser.Insert) []ColumnLineage {
+	table, ok := ins.Table.Expr.(sqlparser.TableName)
+	if !ok {
+		return nil
+	}
+	target := tr.qualify(table)
+	var names []string
+	for _, col := range ins.Columns {
+		names = append(names, col.String())
+	}
+	if names == nil {
+		names, _ = tr.binder.schema.TableColumns(target.Database, target.Table)
+	}
+
+	written := map[string]lineage{}
+	var order []string
+	write := func(name string, sources lineage, kind LineageKind) {
+		if written[name] == nil {
+			written[name] = lineage{}
+			order = append(order, name)
+		}
+		written[name].merge(sources, kind)
+	}
+	switch rows := ins.Rows.(type) {
+	case sqlparser.TableStatement:
+		q := tr.query(rows)
+		for i, col := range q.columns {
+			if i < len(names) {
+				write(names[i], col, Direct)
+				write(names[i], q.filters, Filter)
+			}
+		}
+	case sqlparser.Values:
+		for i, name := range names {
+			write(name, lineage{}, Direct)
+			for _, row := range rows {
+				if i < len(row) {
+					write(name, tr.expr(row[i]), Direct)
+				}
+			}
+		}
+	}
+	for _, upd := range ins.OnDup {
+		write(upd.Name.Name.String(), tr.expr(upd.Expr), Direct)
+	}
+
+	res := make([]ColumnLineage, 0, len(order))
+	for _, name := range order {
+		ref := target
+		ref.Column = name
+		res = append(res, ColumnLineage{Column: ref, Sources: written[name]})
+	}
+	return res
+}
+
+func (tr *tracer) update(upd *sqlparser.Update) []ColumnLineage {
+	filters := lineage{}
+	tr.fromFilters(upd.TableExprs, tr.binder.scopes[upd], filters)
+	if upd.Where != nil {
+		filters.merge(tr.expr(upd.Where.Expr), Filter)
+	}
+	tr.limitFilters(upd.OrderBy, upd.Limit, filters)
+
+	var res []ColumnLineage
+	index := map[ColumnRef]int{}
+	for _, expr := range upd.Exprs {
+		binding := tr.binder.bindings[expr.Name]
+		if binding == nil || binding.Source == nil || binding.Source.Kind != BaseTable {
+			continue
+		}
+		ref := ColumnRef{Database: binding.Source.Database, Table: binding.Source.Table, Column: binding.Column}
+		i, ok := index[ref]
+		if !ok {
+			i = len(res)
+			index[ref] = i
+			res = append(res, ColumnLineage{Column: ref, Sources: lineage{}})
+			lineage(res[i].Sources).merge(filters, Filter)
+		}
+		lineage(res[i].Sources).merge(tr.expr(expr.Expr), Direct)
+	}
+	return res
+}
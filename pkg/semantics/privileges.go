@@ -0,0 +1,517 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package semantics
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+	vtrpcpb "github.com/vedadiyan/sqlparser/pkg/vtrpc"
+)
+
+// Privilege is a MySQL privilege, named the way GRANT names it.
+type Privilege string
+
+// The privileges statements need.
+const (
+	PrivAll                   Privilege = "all"
+	PrivSelect                Privilege = "select"
+	PrivInsert                Privilege = "insert"
+	PrivUpdate                Privilege = "update"
+	PrivDelete                Privilege = "delete"
+	PrivCreate                Privilege = "create"
+	PrivAlter                 Privilege = "alter"
+	PrivDrop                  Privilege = "drop"
+	PrivIndex                 Privilege = "index"
+	PrivReferences            Privilege = "references"
+	PrivCreateView            Privilege = "create view"
+	PrivCreateRoutine         Privilege = "create routine"
+	PrivAlterRoutine          Privilege = "alter routine"
+	PrivExecute               Privilege = "execute"
+	PrivCreateTemporaryTables Privilege = "create temporary tables"
+	PrivLockTables            Privilege = "lock tables"
+	PrivFile                  Privilege = "file"
+	PrivGrantOption           Privilege = "grant option"
+)
+
+// Requirement is a privilege a statement needs on an object.
+type Requirement struct {
+	Privilege Privilege
+	// Or are other privileges that meet the requirement as well.
+	Or []Privilege
+	// Database and Table name the object. Database is empty for global
+	// privileges, and Table for database privileges.
+	Database string
+	Table    string
+	// Routine is set when Table is the name of a stored procedure.
+	Routine bool
+	// Columns are the columns of the table the privilege is needed on. When
+	// there are none, the privilege is needed on the table, or for SELECT on
+	// any of its columns.
+	Columns []string
+}
+
+// Privileges returns the privileges a statement needs, in the order of the
+// objects it uses. SELECT, INSERT and UPDATE are needed on the columns the
+// statement reads and writes. Column names are resolved against the schema
+// like Bind does, and unqualified names refer to defaultDB.
+func Privileges(stmt sqlparser.Statement, schema SchemaInformation, defaultDB string) ([]Requirement, error) {
+	set, err := Access(stmt, schema, defaultDB)
+	if err != nil {
+		return nil, err
+	}
+	p := &privileges{binder: &binder{defaultDB: defaultDB}}
+	switch stmt := stmt.(type) {
+	case *sqlparser.LockTables:
+		for _, access := range append(set.Reads, set.Writes...) {
+			p.add(PrivSelect, access.Database, access.Table)
+			p.add(PrivLockTables, access.Database, access.Table)
+		}
+		return p.reqs, nil
+	case *sqlparser.Load:
+		if !stmt.Local {
+			p.add(PrivFile, "", "")
+		}
+	case *sqlparser.CallProc:
+		for _, routine := range set.Calls {
+			p.reqs = append(p.reqs, Requirement{Privilege: PrivExecute, Database: routine.Database, Table: routine.Name, Routine: true})
+		}
+	case sqlparser.DDLStatement:
+		if err := p.ddl(stmt); err != nil {
+			return nil, err
+		}
+	case sqlparser.DBDDLStatement:
+		switch stmt.(type) {
+		case *sqlparser.CreateDatabase:
+			p.add(PrivCreate, stmt.GetDatabaseName(), "")
+		case *sqlparser.AlterDatabase:
+			p.add(PrivAlter, stmt.GetDatabaseName(), "")
+		case *sqlparser.DropDatabase:
+			p.add(PrivDrop, stmt.GetDatabaseName(), "")
+		}
+	case *sqlparser.Grant:
+		if err := p.grant(stmt); err != nil {
+			return nil, err
+		}
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if sel, ok := node.(*sqlparser.Select); ok && sel.Into != nil && sel.Into.Type != sqlparser.IntoVariables {
+			p.add(PrivFile, "", "")
+		}
+		return true, nil
+	}, stmt)
+
+	for _, access := range set.Reads {
+		switch access.Operation {
+		case OpSelect:
+			p.add(PrivSelect, access.Database, access.Table, access.Columns...)
+		case OpLock:
+			p.locking(access)
+		}
+	}
+	for _, access := range set.Writes {
+		switch access.Operation {
+		case OpInsert:
+			p.add(PrivInsert, access.Database, access.Table, access.Columns...)
+		case OpUpdate:
+			p.add(PrivUpdate, access.Database, access.Table, access.Columns...)
+		case OpDelete:
+			p.add(PrivDelete, access.Database, access.Table)
+		case OpLock:
+			p.locking(access)
+		}
+	}
+	return p.reqs, nil
+}
+
+type privileges struct {
+	*binder
+	reqs []Requirement
+}
+
+// add adds a requirement, merging the columns of the ones on the same object.
+func (p *privileges) add(priv Privilege, db, table string, columns ...string) {
+	for i := range p.reqs {
+		req := &p.reqs[i]
+		if req.Privilege != priv || req.Database != db || req.Table != table || req.Routine || req.Or != nil {
+			continue
+		}
+		if len(req.Columns) == 0 || len(columns) == 0 {
+			// The privilege is needed on the table.
+			req.Columns = nil
+			return
+		}
+		for _, col := range columns {
+			if !containsFold(req.Columns, col) {
+				req.Columns = append(req.Columns, col)
+			}
+		}
+		sort.Strings(req.Columns)
+		return
+	}
+	p.reqs = append(p.reqs, Requirement{Privilege: priv, Database: db, Table: table, Columns: columns})
+}
+
+// locking adds what a locking read needs besides SELECT.
+func (p *privileges) locking(access TableAccess) {
+	p.reqs = append(p.reqs, Requirement{
+		Privilege: PrivUpdate,
+		Or:        []Privilege{PrivDelete, PrivLockTables},
+		Database:  access.Database,
+		Table:     access.Table,
+	})
+}
+
+func (p *privileges) table(priv Privilege, name sqlparser.TableName) error {
+	db, err := p.qualify(name)
+	if err != nil {
+		return err
+	}
+	p.add(priv, db, name.Name.String())
+	return nil
+}
+
+func (p *privileges) tables(name sqlparser.TableName, privs ...Privilege) error {
+	for _, priv := range privs {
+		if err := p.table(priv, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *privileges) ddl(ddl sqlparser.DDLStatement) error {
+	switch ddl := ddl.(type) {
+	case *sqlparser.CreateTable:
+		if ddl.Temp {
+			db, err := p.qualify(ddl.Table)
+			if err != nil {
+				return err
+			}
+			p.add(PrivCreateTemporaryTables, db, "")
+		} else if err := p.table(PrivCreate, ddl.Table); err != nil {
+			return err
+		}
+		if ddl.OptLike != nil {
+			if err := p.table(PrivSelect, ddl.OptLike.LikeTable); err != nil {
+				return err
+			}
+		}
+	case *sqlparser.AlterTable:
+		if indexOnly(ddl.AlterOptions) {
+			if err := p.table(PrivIndex, ddl.Table); err != nil {
+				return err
+			}
+			break
+		}
+		if to := ddl.GetToTables(); len(to) > 0 {
+			if err := p.tables(ddl.Table, PrivAlter, PrivDrop); err != nil {
+				return err
+			}
+			return p.tables(to[0], PrivCreate, PrivInsert)
+		}
+		if err := p.tables(ddl.Table, PrivAlter, PrivCreate, PrivInsert); err != nil {
+			return err
+		}
+	case *sqlparser.RenameTable:
+		for _, pair := range ddl.TablePairs {
+			if err := p.tables(pair.FromTable, PrivAlter, PrivDrop); err != nil {
+				return err
+			}
+			if err := p.tables(pair.ToTable, PrivCreate, PrivInsert); err != nil {
+				return err
+			}
+		}
+	case *sqlparser.DropTable, *sqlparser.DropView, *sqlparser.TruncateTable:
+		for _, name := range ddl.AffectedTables() {
+			if err := p.table(PrivDrop, name); err != nil {
+				return err
+			}
+		}
+	case *sqlparser.CreateView:
+		if err := p.table(PrivCreateView, ddl.ViewName); err != nil {
+			return err
+		}
+		if ddl.IsReplace {
+			return p.table(PrivDrop, ddl.ViewName)
+		}
+	case *sqlparser.AlterView:
+		return p.tables(ddl.ViewName, PrivCreateView, PrivDrop)
+	case *sqlparser.CreateProcedure:
+		db, err := p.qualify(ddl.GetTable())
+		if err != nil {
+			return err
+		}
+		p.add(PrivCreateRoutine, db, "")
+	case *sqlparser.DropProcedure:
+		name := ddl.GetTable()
+		db, err := p.qualify(name)
+		if err != nil {
+			return err
+		}
+		p.reqs = append(p.reqs, Requirement{Privilege: PrivAlterRoutine, Database: db, Table: name.Name.String(), Routine: true})
+	}
+	// Foreign keys need REFERENCES on the tables they reference.
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if ref, ok := node.(*sqlparser.ReferenceDefinition); ok {
+			return false, p.table(PrivReferences, ref.ReferencedTable)
+		}
+		return true, nil
+	}, ddl)
+}
+
+// indexOnly reports whether an ALTER TABLE only adds and drops indexes, which
+// the INDEX privilege allows.
+func indexOnly(options []sqlparser.AlterOption) bool {
+	for _, opt := range options {
+		switch opt := opt.(type) {
+		case *sqlparser.AddIndexDefinition:
+		case *sqlparser.DropKey:
+			if opt.Type != sqlparser.NormalKeyType {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return len(options) > 0
+}
+
+// grant adds what a GRANT needs: GRANT OPTION and the privileges it grants, on
+// the object it grants them on.
+func (p *privileges) grant(stmt *sqlparser.Grant) error {
+	level, err := grantLevel(stmt, p.defaultDB)
+	if err != nil {
+		return err
+	}
+	db, table := level.db, level.table
+	if db == "*" {
+		db, table = "", ""
+	} else if table == "*" {
+		table = ""
+	}
+	add := func(priv Privilege, columns ...string) {
+		if level.routine {
+			p.reqs = append(p.reqs, Requirement{Privilege: priv, Database: db, Table: table, Routine: true})
+			return
+		}
+		p.add(priv, db, table, columns...)
+	}
+	add(PrivGrantOption)
+	for _, priv := range stmt.Privileges {
+		var columns []string
+		for _, col := range priv.Columns {
+			columns = append(columns, col.String())
+		}
+		add(privilegeName(priv.Name), columns...)
+	}
+	return nil
+}
+
+// privilegeName returns the privilege a GRANT names.
+func privilegeName(name string) Privilege {
+	if name == "all privileges" {
+		return PrivAll
+	}
+	return Privilege(name)
+}
+
+// Grants are the privileges of an account, as GRANT statements give them.
+type Grants struct {
+	User, Host string
+	grants     []grant
+}
+
+// grant is a privilege given on an object. The db and table of global and
+// database grants are *.
+type grant struct {
+	priv      Privilege
+	db, table string
+	routine   bool
+	columns   []string
+}
+
+// NewGrants returns the grants of an account, which has none yet.
+func NewGrants(user, host string) *Grants {
+	return &Grants{User: user, Host: host}
+}
+
+// Grant gives a privilege on a table, or on some of its columns. db and table
+// are * for every database and every table.
+func (g *Grants) Grant(priv Privilege, db, table string, columns ...string) {
+	g.grants = append(g.grants, grant{priv: priv, db: db, table: table, columns: columns})
+}
+
+// GrantRoutine gives a privilege on a stored procedure.
+func (g *Grants) GrantRoutine(priv Privilege, db, name string) {
+	g.grants = append(g.grants, grant{priv: priv, db: db, table: name, routine: true})
+}
+
+// Apply adds the privileges a GRANT statement gives, if it gives them to the
+// account. Unqualified names refer to defaultDB.
+func (g *Grants) Apply(stmt *sqlparser.Grant, defaultDB string) error {
+	found := false
+	for _, user := range stmt.Users {
+		host := unquote(user.Address)
+		if host == "" {
+			host = "%"
+		}
+		found = found || unquote(user.Name) == g.User && host == g.Host
+	}
+	if !found {
+		return nil
+	}
+	level, err := grantLevel(stmt, defaultDB)
+	if err != nil {
+		return err
+	}
+	for _, priv := range stmt.Privileges {
+		gr := level
+		gr.priv = privilegeName(priv.Name)
+		for _, col := range priv.Columns {
+			gr.columns = append(gr.columns, col.String())
+		}
+		g.grants = append(g.grants, gr)
+	}
+	if stmt.WithGrantOption {
+		gr := level
+		gr.priv = PrivGrantOption
+		g.grants = append(g.grants, gr)
+	}
+	return nil
+}
+
+// grantLevel returns the object a GRANT statement gives privileges on.
+func grantLevel(stmt *sqlparser.Grant, defaultDB string) (grant, error) {
+	level := grant{
+		db:      stmt.Level.Qualifier.String(),
+		table:   stmt.Level.Name.String(),
+		routine: stmt.ObjectType == "procedure" || stmt.ObjectType == "function",
+	}
+	if level.db == "" {
+		if defaultDB == "" {
+			return grant{}, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.NoDB, "No database selected")
+		}
+		level.db = defaultDB
+	}
+	return level, nil
+}
+
+// unquote removes the quotes around a user or a host name.
+func unquote(name string) string {
+	if len(name) >= 2 && (name[0] == '\'' || name[0] == '"' || name[0] == '`') && name[len(name)-1] == name[0] {
+		return name[1 : len(name)-1]
+	}
+	return name
+}
+
+// Check returns an error for the first requirement the grants do not meet, with
+// the message MySQL gives for it.
+func (g *Grants) Check(reqs []Requirement) error {
+	for _, req := range reqs {
+		if err := g.check(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Grants) check(req Requirement) error {
+	var missing []string
+	for _, priv := range append([]Privilege{req.Privilege}, req.Or...) {
+		columns := g.missingColumns(priv, req)
+		if columns == nil {
+			return nil
+		}
+		missing = columns
+	}
+	priv := strings.ToUpper(string(req.Privilege))
+	if req.Privilege == PrivGrantOption {
+		// MySQL names the command rather than the privilege.
+		priv = "GRANT"
+	}
+	switch {
+	case req.Database == "":
+		names := []string{priv}
+		for _, or := range req.Or {
+			names = append(names, strings.ToUpper(string(or)))
+		}
+		return vterrors.NewErrorf(vtrpcpb.Code_PERMISSION_DENIED, vterrors.AccessDeniedError, "Access denied; you need (at least one of) the %s privilege(s) for this operation", strings.Join(names, ", "))
+	case req.Table == "":
+		return vterrors.NewErrorf(vtrpcpb.Code_PERMISSION_DENIED, vterrors.AccessDeniedError, "Access denied for user '%s'@'%s' to database '%s'", g.User, g.Host, req.Database)
+	case req.Routine:
+		return vterrors.NewErrorf(vtrpcpb.Code_PERMISSION_DENIED, vterrors.AccessDeniedError, "%s command denied to user '%s'@'%s' for routine '%s.%s'", strings.ToLower(priv), g.User, g.Host, req.Database, req.Table)
+	case len(missing) > 0:
+		return vterrors.NewErrorf(vtrpcpb.Code_PERMISSION_DENIED, vterrors.AccessDeniedError, "%s command denied to user '%s'@'%s' for column '%s' in table '%s'", priv, g.User, g.Host, missing[0], req.Table)
+	}
+	return vterrors.NewErrorf(vtrpcpb.Code_PERMISSION_DENIED, vterrors.AccessDeniedError, "%s command denied to user '%s'@'%s' for table '%s'", priv, g.User, g.Host, req.Table)
+}
+
+// missingColumns returns nil when the grants give a privilege on the object of a
+// requirement. Otherwise it returns the columns the privilege is missing on,
+// which are none when it is missing on the object itself.
+func (g *Grants) missingColumns(priv Privilege, req Requirement) []string {
+	covered := map[string]bool{}
+	anyColumn := false
+	for _, gr := range g.grants {
+		if gr.priv != priv && (gr.priv != PrivAll || priv == PrivGrantOption) {
+			continue
+		}
+		switch {
+		case gr.db == "*":
+		case req.Database == "" || gr.db != req.Database:
+			continue
+		case gr.table == "*":
+		case req.Table == "" || gr.table != req.Table || gr.routine != req.Routine:
+			continue
+		case len(gr.columns) > 0:
+			anyColumn = true
+			for _, col := range gr.columns {
+				covered[strings.ToLower(col)] = true
+			}
+			continue
+		}
+		return nil
+	}
+	if len(req.Columns) == 0 {
+		if anyColumn && req.Privilege == PrivSelect {
+			return nil
+		}
+		return []string{}
+	}
+	var missing []string
+	for _, col := range req.Columns {
+		if !covered[strings.ToLower(col)] {
+			missing = append(missing, col)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return missing
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
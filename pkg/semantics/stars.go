@@ -0,0 +1,80 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package semantics
+
+import (
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+)
+
+// ExpandStars returns a copy of a statement whose stars, * and t.*, are
+// replaced by the columns they stand for, each qualified with its table, so
+// that the columns a query returns no longer change with the schema. The
+// statement itself is left as it is.
+//
+// The columns come in the order a star returns them: the tables in the order
+// of the FROM clause and their columns in the order they were defined. For an
+// unqualified star, the columns merged by NATURAL joins and USING come first,
+// once, qualified with the table of the left side, or of the right side for a
+// RIGHT join. The columns of derived tables and common table expressions are
+// the ones their queries return.
+//
+// Column names are resolved against the schema like Bind does, and unqualified
+// table names refer to defaultDB.
+func ExpandStars(stmt sqlparser.Statement, schema SchemaInformation, defaultDB string) (sqlparser.Statement, error) {
+	b, err := bind(stmt, schema, defaultDB)
+	if err != nil {
+		return nil, err
+	}
+	if len(b.stars) == 0 {
+		return stmt, nil
+	}
+	return sqlparser.CopyOnRewrite(stmt, nil, func(cursor *sqlparser.CopyOnWriteCursor) {
+		exprs, ok := cursor.Node().(*sqlparser.SelectExprs)
+		if !ok {
+			return
+		}
+		expanded := &sqlparser.SelectExprs{}
+		changed := false
+		for _, expr := range exprs.Exprs {
+			star, ok := expr.(*sqlparser.StarExpr)
+			if !ok {
+				expanded.Exprs = append(expanded.Exprs, expr)
+				continue
+			}
+			changed = true
+			for _, binding := range b.stars[star] {
+				col := sqlparser.NewColNameWithQualifier(binding.Column, qualifier(binding.Source))
+				expanded.Exprs = append(expanded.Exprs, &sqlparser.AliasedExpr{Expr: col})
+			}
+		}
+		if changed {
+			cursor.Replace(expanded)
+		}
+	}, nil).(sqlparser.Statement), nil
+}
+
+// qualifier returns the name a column of a table is qualified with: the alias
+// of the table, or its name, qualified with its database when the query
+// qualifies it.
+func qualifier(source *TableSource) sqlparser.TableName {
+	if expr, ok := source.Node.(*sqlparser.AliasedTableExpr); ok && expr.As.IsEmpty() {
+		if name, ok := expr.Expr.(sqlparser.TableName); ok && name.Qualifier.NotEmpty() {
+			return sqlparser.TableName{Qualifier: name.Qualifier, Name: name.Name}
+		}
+	}
+	return sqlparser.TableName{Name: sqlparser.NewIdentifierCS(source.Name)}
+}
@@ -0,0 +1,1388 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package semantics
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+// TypeSchema gives the columns of the tables a statement uses, and their types.
+type TypeSchema interface {
+	SchemaInformation
+	// ColumnType returns the declared type of a column of a table, or nil if it
+	// is not known, as for the columns of views. When its options do not say
+	// whether the column is nullable, it is taken to be.
+	ColumnType(db, table, column string) *sqlparser.ColumnType
+}
+
+// Type is the type of the values of an expression.
+type Type struct {
+	Type     querypb.Type
+	Nullable bool
+	// Collation is the collation of text values, "binary" for binary strings
+	// and empty for the other types.
+	Collation string
+	// Size is the length of strings in characters, the display width of
+	// integers and floats, and the precision of decimals.
+	Size uint32
+	// Scale is the number of digits after the point of decimals, and the
+	// fractional seconds precision of temporal types.
+	Scale uint32
+}
+
+// Field is a column of the result of a query.
+type Field struct {
+	Name string
+	Type Type
+}
+
+// TypeInfo holds the types of the expressions of a statement.
+type TypeInfo struct {
+	Bindings Bindings
+	// Fields are the columns of the result of a SELECT or a UNION.
+	Fields []Field
+
+	types map[sqlparser.Expr]Type
+}
+
+// TypeOf returns the type of an expression of the statement, or false if the
+// expression is not part of it.
+func (ti *TypeInfo) TypeOf(expr sqlparser.Expr) (Type, bool) {
+	if !hashable(expr) {
+		return Type{}, false
+	}
+	typ, ok := ti.types[expr]
+	return typ, ok
+}
+
+// defaultCollation is the collation of string literals, which is the one of
+// the connection.
+const defaultCollation = "utf8mb4_0900_ai_ci"
+
+// divPrecisionIncrement is the number of digits the division adds to the scale
+// of its dividend, the default of div_precision_increment.
+const divPrecisionIncrement = 4
+
+// InferTypes binds the column names of a statement like Bind does, and then
+// gives every expression of the statement its type under the rules of MySQL.
+// Columns take the type they are declared with; expressions combining them
+// follow the promotion rules of arithmetic, the unification of CASE, COALESCE
+// and UNION, and the result types of the functions.
+func InferTypes(stmt sqlparser.Statement, schema TypeSchema, defaultDB string) (*TypeInfo, error) {
+	b, err := bind(stmt, schema, defaultDB)
+	if err != nil {
+		return nil, err
+	}
+	t := &typer{
+		schema:     schema,
+		binder:     b,
+		types:      map[sqlparser.Expr]Type{},
+		results:    map[sqlparser.TableStatement][]Type{},
+		inProgress: map[sqlparser.TableStatement]bool{},
+		outer:      map[sqlparser.TableExpr]bool{},
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if join, ok := node.(*sqlparser.JoinTableExpr); ok {
+			switch join.Join {
+			case sqlparser.LeftJoinType, sqlparser.NaturalLeftJoinType, sqlparser.LeftHashJoinType,
+				sqlparser.ParallelLeftJoinType, sqlparser.ParallelLeftHashJoinType:
+				t.markOuter(join.RightExpr)
+			case sqlparser.RightJoinType, sqlparser.NaturalRightJoinType, sqlparser.RightHashJoinType,
+				sqlparser.ParallelRightJoinType, sqlparser.ParallelRightHashJoinType:
+				t.markOuter(join.LeftExpr)
+			}
+		}
+		return true, nil
+	}, stmt)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if expr, ok := node.(sqlparser.Expr); ok {
+			t.typeOf(expr)
+		}
+		return true, nil
+	}, stmt)
+
+	info := &TypeInfo{Bindings: b.bindings, types: t.types}
+	if ts, ok := stmt.(sqlparser.TableStatement); ok {
+		names := t.binder.columnNames(ts)
+		for i, typ := range t.result(ts) {
+			info.Fields = append(info.Fields, Field{Name: names[i], Type: typ})
+		}
+	}
+	return info, nil
+}
+
+type typer struct {
+	schema TypeSchema
+	binder *binder
+	types  map[sqlparser.Expr]Type
+	// results holds the types of the columns of the queries of the statement.
+	results    map[sqlparser.TableStatement][]Type
+	inProgress map[sqlparser.TableStatement]bool
+	// outer holds the tables on the inner side of outer joins, whose columns
+	// are NULL for the rows that do not match.
+	outer map[sqlparser.TableExpr]bool
+}
+
+func (t *typer) markOuter(expr sqlparser.TableExpr) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.AliasedTableExpr:
+			t.outer[node] = true
+			return false, nil
+		case *sqlparser.JSONTableExpr:
+			t.outer[node] = true
+			return false, nil
+		}
+		return true, nil
+	}, expr)
+}
+
+// result returns the types of the columns of a query.
+func (t *typer) result(stmt sqlparser.TableStatement) []Type {
+	if types, ok := t.results[stmt]; ok {
+		return types
+	}
+	if t.inProgress[stmt] {
+		// A recursive common table expression refers to itself from its
+		// second query, and its columns have the types of its first one.
+		first, err := sqlparser.GetFirstSelect(stmt)
+		if err != nil || first == stmt {
+			return nil
+		}
+		return t.result(first)
+	}
+	t.inProgress[stmt] = true
+	defer delete(t.inProgress, stmt)
+
+	var types []Type
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		for _, expr := range stmt.GetColumns() {
+			switch expr := expr.(type) {
+			case *sqlparser.AliasedExpr:
+				types = append(types, t.typeOf(expr.Expr))
+			case *sqlparser.StarExpr:
+				for _, col := range t.binder.stars[expr] {
+					types = append(types, t.columnType(col))
+				}
+			}
+		}
+	case *sqlparser.Union:
+		left, right := t.result(stmt.Left), t.result(stmt.Right)
+		for i, typ := range left {
+			if i < len(right) {
+				typ = unify(typ, right[i])
+			}
+			types = append(types, typ)
+		}
+	}
+	t.results[stmt] = types
+	return types
+}
+
+// columnType returns the type of the column a name is bound to.
+func (t *typer) columnType(binding *Binding) Type {
+	if binding == nil {
+		return unknownType()
+	}
+	if binding.Alias != nil {
+		return t.typeOf(binding.Alias.Expr)
+	}
+	source := binding.Source
+	typ := unknownType()
+	switch source.Kind {
+	case BaseTable:
+		if ct := t.schema.ColumnType(source.Database, source.Table, binding.Column); ct != nil {
+			typ = declaredType(ct)
+		}
+	case DerivedTable, CommonTable:
+		if source.Select != nil {
+			types := t.result(source.Select)
+			for i, col := range source.Columns {
+				if col == binding.Column && i < len(types) {
+					typ = types[i]
+				}
+			}
+		}
+	case JSONTable:
+		if jt, ok := source.Node.(*sqlparser.JSONTableExpr); ok {
+			typ = jsonTableColumnType(jt.Columns, binding.Column)
+		}
+	}
+	if source.Node != nil && t.outer[source.Node] {
+		typ.Nullable = true
+	}
+	return typ
+}
+
+func jsonTableColumnType(defs []*sqlparser.JtColumnDefinition, name string) Type {
+	for _, def := range defs {
+		switch {
+		case def.JtOrdinal != nil && def.JtOrdinal.Name.EqualString(name):
+			return Type{Type: sqltypes.Uint32, Size: 10}
+		case def.JtPath != nil && def.JtPath.Name.EqualString(name):
+			typ := declaredType(def.JtPath.Type)
+			typ.Nullable = true
+			if def.JtPath.JtColExists {
+				typ.Nullable = false
+			}
+			return typ
+		case def.JtNestedPath != nil:
+			if typ := jsonTableColumnType(def.JtNestedPath.Columns, name); typ.Type != sqltypes.Unknown {
+				return typ
+			}
+		}
+	}
+	return unknownType()
+}
+
+// declaredType returns the type of a column declared with the given type.
+func declaredType(ct *sqlparser.ColumnType) Type {
+	typ := Type{Type: ct.SQLType(), Nullable: true}
+	if typ.Type == sqltypes.Null {
+		return unknownType()
+	}
+	if ct.Options != nil && ct.Options.Null != nil {
+		typ.Nullable = *ct.Options.Null
+	}
+	if ct.Length != nil {
+		typ.Size = uint32(*ct.Length)
+	}
+	if ct.Scale != nil {
+		typ.Scale = uint32(*ct.Scale)
+	}
+	switch {
+	case sqltypes.IsIntegral(typ.Type) && ct.Length == nil:
+		typ.Size = intWidth(typ.Type)
+	case typ.Type == sqltypes.Decimal && ct.Length == nil:
+		typ.Size = 10
+	case sqltypes.IsFloat(typ.Type) && ct.Length == nil:
+		typ.Size = 22
+		if typ.Type == sqltypes.Float32 {
+			typ.Size = 12
+		}
+	case sqltypes.IsDateOrTime(typ.Type):
+		// The length of temporal types is their fractional seconds precision.
+		typ.Scale, typ.Size = typ.Size, temporalWidth(typ.Type, typ.Size)
+	case typ.Type == sqltypes.Year:
+		typ.Size = 4
+	case typ.Type == sqltypes.Text || typ.Type == sqltypes.Blob:
+		typ.Size = lobSize(ct.Type)
+	case typ.Type == sqltypes.Char || typ.Type == sqltypes.Binary:
+		if ct.Length == nil {
+			typ.Size = 1
+		}
+	case typ.Type == sqltypes.Enum || typ.Type == sqltypes.Set:
+		for _, v := range ct.EnumValues {
+			if n := uint32(utf8.RuneCountInString(v)); typ.Type == sqltypes.Set {
+				typ.Size += n + 1
+			} else if n > typ.Size {
+				typ.Size = n
+			}
+		}
+	}
+	switch {
+	case sqltypes.IsText(typ.Type) || typ.Type == sqltypes.Enum || typ.Type == sqltypes.Set:
+		var collate string
+		if ct.Options != nil {
+			collate = ct.Options.Collate
+		}
+		typ.Collation = collationOf(ct.Charset.Name, collate, ct.Charset.Binary)
+	case sqltypes.IsBinary(typ.Type) || typ.Type == sqltypes.Geometry:
+		typ.Collation = "binary"
+	case typ.Type == sqltypes.TypeJSON:
+		typ.Collation = "utf8mb4_bin"
+	}
+	return typ
+}
+
+func lobSize(typeName string) uint32 {
+	switch strings.ToLower(typeName) {
+	case "tinytext", "tinyblob":
+		return 255
+	case "mediumtext", "mediumblob":
+		return 16777215
+	case "longtext", "longblob":
+		return 4294967295
+	}
+	return 65535
+}
+
+// charsetCollations are the default collations of the character sets.
+var charsetCollations = map[string]string{
+	"utf8mb4": "utf8mb4_0900_ai_ci",
+	"utf8mb3": "utf8mb3_general_ci",
+	"utf8":    "utf8mb3_general_ci",
+	"latin1":  "latin1_swedish_ci",
+	"ascii":   "ascii_general_ci",
+	"binary":  "binary",
+	"ucs2":    "ucs2_general_ci",
+	"utf16":   "utf16_general_ci",
+	"utf32":   "utf32_general_ci",
+}
+
+// collationOf returns the collation of a string of the given character set and
+// collation, either of which can be empty. The BINARY attribute of a character
+// set stands for its binary collation.
+func collationOf(charset, collation string, binary bool) string {
+	if collation != "" {
+		return strings.ToLower(collation)
+	}
+	charset = strings.ToLower(charset)
+	switch charset {
+	case "":
+		if !binary {
+			return defaultCollation
+		}
+		charset = "utf8mb4"
+	case "utf8":
+		charset = "utf8mb3"
+	}
+	if binary && charset != "binary" {
+		return charset + "_bin"
+	}
+	if collation, ok := charsetCollations[charset]; ok {
+		return collation
+	}
+	return charset + "_general_ci"
+}
+
+func hashable(expr sqlparser.Expr) bool {
+	_, tuple := expr.(sqlparser.ValTuple)
+	return expr != nil && !tuple
+}
+
+// typeOf returns the type of an expression, and records it.
+func (t *typer) typeOf(expr sqlparser.Expr) Type {
+	if expr == nil {
+		return nullType()
+	}
+	if hashable(expr) {
+		if typ, ok := t.types[expr]; ok {
+			return typ
+		}
+	}
+	typ := t.infer(expr)
+	if hashable(expr) {
+		t.types[expr] = typ
+	}
+	return typ
+}
+
+// anyNullable reports whether any of the expressions can be NULL.
+func (t *typer) anyNullable(exprs ...sqlparser.Expr) bool {
+	for _, expr := range exprs {
+		if expr != nil && t.typeOf(expr).Nullable {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *typer) infer(expr sqlparser.Expr) Type {
+	switch expr := expr.(type) {
+	case *sqlparser.Literal:
+		return literalType(expr)
+	case *sqlparser.NullVal:
+		return nullType()
+	case sqlparser.BoolVal:
+		return boolType(false)
+	case *sqlparser.Argument:
+		if expr.Type == sqltypes.Null {
+			return unknownType()
+		}
+		typ := Type{Type: expr.Type, Nullable: true}
+		if expr.Size > 0 {
+			typ.Size = uint32(expr.Size)
+		}
+		if expr.Scale > 0 {
+			typ.Scale = uint32(expr.Scale)
+		}
+		return typ
+	case sqlparser.ListArg:
+		return Type{Type: sqltypes.Tuple, Nullable: true}
+	case sqlparser.ValTuple:
+		return Type{Type: sqltypes.Tuple, Nullable: t.anyNullable(expr...)}
+	case *sqlparser.ColName:
+		return t.columnType(t.binder.bindings[expr])
+	case *sqlparser.Subquery:
+		types := t.result(expr.Select)
+		if len(types) != 1 {
+			return Type{Type: sqltypes.Tuple, Nullable: true}
+		}
+		// A subquery without rows is NULL.
+		typ := types[0]
+		typ.Nullable = true
+		return typ
+	case *sqlparser.Offset, *sqlparser.Default, *sqlparser.Variable, *sqlparser.NamedWindow:
+		return unknownType()
+	case *sqlparser.AssignmentExpr:
+		return t.typeOf(expr.Right)
+	case *sqlparser.ValuesFuncExpr:
+		return t.typeOf(expr.Name)
+
+	case *sqlparser.AndExpr:
+		return boolType(t.anyNullable(expr.Left, expr.Right))
+	case *sqlparser.OrExpr:
+		return boolType(t.anyNullable(expr.Left, expr.Right))
+	case *sqlparser.XorExpr:
+		return boolType(t.anyNullable(expr.Left, expr.Right))
+	case *sqlparser.NotExpr:
+		return boolType(t.anyNullable(expr.Expr))
+	case *sqlparser.ComparisonExpr:
+		if expr.Operator == sqlparser.NullSafeEqualOp {
+			return boolType(false)
+		}
+		return boolType(t.anyNullable(expr.Left, expr.Right, expr.Escape))
+	case *sqlparser.BetweenExpr:
+		return boolType(t.anyNullable(expr.Left, expr.From, expr.To))
+	case *sqlparser.IsExpr, *sqlparser.ExistsExpr:
+		return boolType(false)
+	case *sqlparser.MemberOfExpr, *sqlparser.JSONContainsExpr, *sqlparser.JSONContainsPathExpr,
+		*sqlparser.JSONOverlapsExpr, *sqlparser.JSONSchemaValidFuncExpr, *sqlparser.RegexpLikeExpr:
+		return boolType(true)
+	case *sqlparser.MatchExpr:
+		return floatType(false)
+
+	case *sqlparser.BinaryExpr:
+		return arithmetic(expr.Operator, t.typeOf(expr.Left), t.typeOf(expr.Right))
+	case *sqlparser.UnaryExpr:
+		return t.unary(expr)
+	case *sqlparser.CaseExpr:
+		var vals []Type
+		for _, when := range expr.Whens {
+			vals = append(vals, t.typeOf(when.Val))
+		}
+		if expr.Else == nil {
+			vals = append(vals, nullType())
+		} else {
+			vals = append(vals, t.typeOf(expr.Else))
+		}
+		return unify(vals...)
+	case *sqlparser.FuncExpr:
+		return t.function(expr)
+
+	case *sqlparser.CastExpr:
+		if expr.Array {
+			return jsonType(true)
+		}
+		return castType(t.typeOf(expr.Expr), expr.Type)
+	case *sqlparser.ConvertExpr:
+		return castType(t.typeOf(expr.Expr), expr.Type)
+	case *sqlparser.ConvertUsingExpr:
+		arg := t.typeOf(expr.Expr)
+		return textType(stringLength(arg), collationOf(expr.Type, "", false), arg.Nullable)
+	case *sqlparser.CollateExpr:
+		typ := t.typeOf(expr.Expr)
+		typ.Collation = strings.ToLower(expr.Collation)
+		return typ
+	case *sqlparser.IntroducerExpr:
+		arg := t.typeOf(expr.Expr)
+		charset := strings.TrimPrefix(strings.ToLower(expr.CharacterSet), "_")
+		return textType(stringLength(arg), collationOf(charset, "", false), arg.Nullable)
+	case *sqlparser.SubstrExpr:
+		arg := t.typeOf(expr.Name)
+		return textType(stringLength(arg), textCollation(arg), t.anyNullable(expr.Name, expr.From, expr.To))
+	case *sqlparser.TrimFuncExpr:
+		arg := t.typeOf(expr.StringArg)
+		return textType(stringLength(arg), textCollation(arg), t.anyNullable(expr.StringArg, expr.TrimArg))
+	case *sqlparser.InsertExpr:
+		str, newStr := t.typeOf(expr.Str), t.typeOf(expr.NewStr)
+		return textType(stringLength(str)+stringLength(newStr), textCollation(str), t.anyNullable(expr.Str, expr.Pos, expr.Len, expr.NewStr))
+	case *sqlparser.CharExpr:
+		if expr.Charset == "" {
+			return textType(uint32(len(expr.Exprs))*4, "binary", false)
+		}
+		return textType(uint32(len(expr.Exprs)), collationOf(expr.Charset, "", false), false)
+	case *sqlparser.WeightStringFuncExpr:
+		return textType(stringLength(t.typeOf(expr.Expr)), "binary", t.anyNullable(expr.Expr))
+	case *sqlparser.LocateExpr:
+		return intType(sqltypes.Int64, t.anyNullable(expr.SubStr, expr.Str, expr.Pos))
+	case *sqlparser.IntervalFuncExpr:
+		return intType(sqltypes.Int64, false)
+	case *sqlparser.RegexpInstrExpr:
+		return intType(sqltypes.Int64, true)
+	case *sqlparser.RegexpReplaceExpr:
+		arg := t.typeOf(expr.Expr)
+		return textType(stringLength(arg), textCollation(arg), true)
+	case *sqlparser.RegexpSubstrExpr:
+		arg := t.typeOf(expr.Expr)
+		return textType(stringLength(arg), textCollation(arg), true)
+
+	case *sqlparser.CurTimeFuncExpr:
+		switch expr.Name.Lowered() {
+		case "curdate", "current_date", "utc_date":
+			return temporalType(sqltypes.Date, 0, false)
+		case "curtime", "current_time", "utc_time":
+			return temporalType(sqltypes.Time, uint32(expr.Fsp), false)
+		}
+		return temporalType(sqltypes.Datetime, uint32(expr.Fsp), false)
+	case *sqlparser.IntervalDateExpr:
+		return intervalType(t.typeOf(expr.Date), expr.Unit)
+	case *sqlparser.TimestampDiffExpr, *sqlparser.ExtractFuncExpr:
+		return intType(sqltypes.Int64, true)
+
+	case *sqlparser.JSONArrayExpr, *sqlparser.JSONObjectExpr:
+		return jsonType(false)
+	case *sqlparser.JSONExtractExpr, *sqlparser.JSONKeysExpr, *sqlparser.JSONSearchExpr,
+		*sqlparser.JSONArrayAgg, *sqlparser.JSONObjectAgg, *sqlparser.JSONSchemaValidationReportFuncExpr:
+		return jsonType(true)
+	case *sqlparser.JSONValueModifierExpr:
+		return jsonType(t.anyNullable(expr.JSONDoc))
+	case *sqlparser.JSONValueMergeExpr:
+		return jsonType(t.anyNullable(append([]sqlparser.Expr{expr.JSONDoc}, expr.JSONDocList...)...))
+	case *sqlparser.JSONRemoveExpr:
+		return jsonType(true)
+	case *sqlparser.JSONUnquoteExpr:
+		return Type{Type: sqltypes.Text, Nullable: t.anyNullable(expr.JSONValue), Collation: "utf8mb4_bin", Size: 4294967295}
+	case *sqlparser.JSONQuoteExpr:
+		arg := t.typeOf(expr.StringArg)
+		return textType(stringLength(arg)*6+2, "utf8mb4_bin", arg.Nullable)
+	case *sqlparser.JSONPrettyExpr:
+		return Type{Type: sqltypes.Text, Nullable: t.anyNullable(expr.JSONVal), Collation: "utf8mb4_bin", Size: 4294967295}
+	case *sqlparser.JSONStorageFreeExpr, *sqlparser.JSONStorageSizeExpr:
+		return intType(sqltypes.Int64, true)
+	case *sqlparser.JSONAttributesExpr:
+		nullable := expr.Path != nil || t.anyNullable(expr.JSONDoc)
+		if expr.Type == sqlparser.TypeAttributeType {
+			return textType(16, "utf8mb4_bin", nullable)
+		}
+		return intType(sqltypes.Int64, nullable)
+	case *sqlparser.JSONValueExpr:
+		if expr.ReturningType != nil {
+			typ := castType(textType(512, "utf8mb4_bin", true), expr.ReturningType)
+			typ.Nullable = true
+			return typ
+		}
+		return textType(512, "utf8mb4_bin", true)
+
+	case *sqlparser.CountStar, *sqlparser.Count:
+		return intType(sqltypes.Int64, false)
+	case *sqlparser.Sum:
+		arg := asNumber(t.typeOf(expr.Arg))
+		if sqltypes.IsFloat(arg.Type) {
+			return floatType(true)
+		}
+		return decimalType(intDigits(arg)+22+arg.Scale, arg.Scale, true)
+	case *sqlparser.Avg:
+		arg := asNumber(t.typeOf(expr.Arg))
+		if sqltypes.IsFloat(arg.Type) {
+			return floatType(true)
+		}
+		return decimalType(intDigits(arg)+arg.Scale+divPrecisionIncrement, arg.Scale+divPrecisionIncrement, true)
+	case *sqlparser.Min:
+		typ := t.typeOf(expr.Arg)
+		typ.Nullable = true
+		return typ
+	case *sqlparser.Max:
+		typ := t.typeOf(expr.Arg)
+		typ.Nullable = true
+		return typ
+	case *sqlparser.AnyValue:
+		return t.typeOf(expr.Arg)
+	case *sqlparser.GroupConcatExpr:
+		var args []Type
+		for _, arg := range expr.Exprs {
+			args = append(args, t.typeOf(arg))
+		}
+		typ := textType(1024, collationOfArgs(args), true)
+		// group_concat_max_len is more than 512, which makes the result a LOB.
+		if typ.Type == sqltypes.VarBinary {
+			typ.Type = sqltypes.Blob
+		} else {
+			typ.Type = sqltypes.Text
+		}
+		return typ
+	case *sqlparser.BitAnd, *sqlparser.BitOr, *sqlparser.BitXor:
+		return intType(sqltypes.Uint64, false)
+	case *sqlparser.Std, *sqlparser.StdDev, *sqlparser.StdPop, *sqlparser.StdSamp,
+		*sqlparser.VarPop, *sqlparser.VarSamp, *sqlparser.Variance:
+		return floatType(true)
+
+	case *sqlparser.ArgumentLessWindowExpr:
+		if expr.Type == sqlparser.CumeDistExprType || expr.Type == sqlparser.PercentRankExprType {
+			return floatType(false)
+		}
+		return intType(sqltypes.Uint64, false)
+	case *sqlparser.NtileExpr:
+		return intType(sqltypes.Uint64, false)
+	case *sqlparser.LagLeadExpr:
+		typ := t.typeOf(expr.Expr)
+		if expr.Default != nil {
+			typ = unify(typ, t.typeOf(expr.Default))
+		}
+		typ.Nullable = true
+		return typ
+	case *sqlparser.FirstOrLastValueExpr:
+		typ := t.typeOf(expr.Expr)
+		typ.Nullable = true
+		return typ
+	case *sqlparser.NTHValueExpr:
+		typ := t.typeOf(expr.Expr)
+		typ.Nullable = true
+		return typ
+
+	case *sqlparser.PointExpr, *sqlparser.LineStringExpr, *sqlparser.PolygonExpr, *sqlparser.MultiPointExpr,
+		*sqlparser.MultiLinestringExpr, *sqlparser.MultiPolygonExpr, *sqlparser.GeomFromTextExpr,
+		*sqlparser.GeomFromWKBExpr, *sqlparser.GeomFromGeoJSONExpr:
+		return geometryType()
+	case *sqlparser.GeomFromGeoHashExpr:
+		if expr.GeomType == sqlparser.PointFromHash {
+			return geometryType()
+		}
+		return floatType(true)
+	case *sqlparser.GeomFormatExpr:
+		if expr.FormatType == sqlparser.BinaryFormat {
+			return Type{Type: sqltypes.Blob, Nullable: true, Collation: "binary", Size: 4294967295}
+		}
+		return Type{Type: sqltypes.Text, Nullable: true, Collation: defaultCollation, Size: 4294967295}
+	case *sqlparser.GeoJSONFromGeomExpr:
+		return jsonType(true)
+	case *sqlparser.GeoHashFromLatLongExpr, *sqlparser.GeoHashFromPointExpr:
+		return textType(100, defaultCollation, true)
+	case *sqlparser.GeomPropertyFuncExpr:
+		switch expr.Property {
+		case sqlparser.GeometryType:
+			return textType(20, defaultCollation, true)
+		case sqlparser.Envelope:
+			return geometryType()
+		}
+		return intType(sqltypes.Int64, true)
+	case *sqlparser.PointPropertyFuncExpr:
+		if expr.ValueToSet != nil {
+			return geometryType()
+		}
+		return floatType(true)
+	case *sqlparser.LinestrPropertyFuncExpr:
+		switch expr.Property {
+		case sqlparser.IsClosed, sqlparser.NumPoints:
+			return intType(sqltypes.Int64, true)
+		case sqlparser.Length:
+			return floatType(true)
+		}
+		return geometryType()
+	case *sqlparser.PolygonPropertyFuncExpr:
+		switch expr.Property {
+		case sqlparser.Area:
+			return floatType(true)
+		case sqlparser.NumInteriorRings:
+			return intType(sqltypes.Int64, true)
+		}
+		return geometryType()
+	case *sqlparser.GeomCollPropertyFuncExpr:
+		if expr.Property == sqlparser.NumGeometries {
+			return intType(sqltypes.Int64, true)
+		}
+		return geometryType()
+
+	case *sqlparser.ExtractValueExpr, *sqlparser.UpdateXMLExpr:
+		return Type{Type: sqltypes.Text, Nullable: true, Collation: defaultCollation, Size: 4294967295}
+	case *sqlparser.LockingFunc:
+		return intType(sqltypes.Int64, true)
+	case *sqlparser.PerformanceSchemaFuncExpr:
+		return textType(20, defaultCollation, true)
+	case *sqlparser.GTIDFuncExpr:
+		if expr.Type == sqlparser.GTIDSubtractType {
+			return Type{Type: sqltypes.Text, Nullable: true, Collation: defaultCollation, Size: 4294967295}
+		}
+		return intType(sqltypes.Int64, true)
+	}
+	return unknownType()
+}
+
+func (t *typer) unary(expr *sqlparser.UnaryExpr) Type {
+	arg := t.typeOf(expr.Expr)
+	switch expr.Operator {
+	case sqlparser.UMinusOp:
+		typ := asNumber(arg)
+		if sqltypes.IsUnsigned(typ.Type) {
+			return intType(sqltypes.Int64, arg.Nullable)
+		}
+		return typ
+	case sqlparser.TildaOp:
+		return intType(sqltypes.Uint64, arg.Nullable)
+	case sqlparser.BangOp:
+		return boolType(arg.Nullable)
+	case sqlparser.NStringOp:
+		return textType(stringLength(arg), "utf8mb3_general_ci", arg.Nullable)
+	}
+	return arg
+}
+
+// function returns the type of the functions that have no node of their own.
+func (t *typer) function(fn *sqlparser.FuncExpr) Type {
+	args := make([]Type, len(fn.Exprs))
+	nullable := false
+	for i, expr := range fn.Exprs {
+		args[i] = t.typeOf(expr)
+		nullable = nullable || args[i].Nullable
+	}
+	arg := func(i int) Type {
+		if i < len(args) {
+			return args[i]
+		}
+		return nullType()
+	}
+
+	switch name := fn.Name.Lowered(); name {
+	case "coalesce":
+		typ := unify(args...)
+		typ.Nullable = true
+		for _, arg := range args {
+			typ.Nullable = typ.Nullable && arg.Nullable
+		}
+		return typ
+	case "ifnull", "nvl":
+		typ := unify(arg(0), arg(1))
+		typ.Nullable = arg(0).Nullable && arg(1).Nullable
+		return typ
+	case "if":
+		return unify(arg(1), arg(2))
+	case "nullif":
+		typ := arg(0)
+		typ.Nullable = true
+		return typ
+	case "greatest", "least":
+		return unify(args...)
+
+	case "concat":
+		var size uint32
+		for _, arg := range args {
+			size += stringLength(arg)
+		}
+		return textType(size, collationOfArgs(args), nullable)
+	case "concat_ws":
+		var size uint32
+		for _, arg := range args {
+			size += stringLength(arg)
+		}
+		return textType(size, collationOfArgs(args), arg(0).Nullable)
+	case "upper", "lower", "ucase", "lcase", "ltrim", "rtrim", "reverse", "soundex",
+		"replace", "left", "right", "substring_index", "mid", "lpad", "rpad", "repeat", "elt":
+		first := arg(0)
+		if name == "elt" {
+			first = unify(args[min(1, len(args)):]...)
+		}
+		return textType(stringLength(first), textCollation(first), nullable || name == "lpad" || name == "rpad" || name == "repeat")
+	case "quote":
+		return textType(stringLength(arg(0))*2+2, textCollation(arg(0)), false)
+	case "hex", "to_base64", "bin", "oct", "conv", "format", "space", "char", "export_set", "make_set",
+		"inet_ntoa", "inet6_ntoa", "bin_to_uuid":
+		return textType(stringLength(arg(0))*2, defaultCollation, true)
+	case "md5":
+		return textType(32, defaultCollation, nullable)
+	case "sha", "sha1":
+		return textType(40, defaultCollation, nullable)
+	case "sha2":
+		return textType(128, defaultCollation, true)
+	case "uuid":
+		return textType(36, defaultCollation, false)
+	case "date_format", "time_format":
+		return textType(stringLength(arg(1))*10, defaultCollation, true)
+	case "monthname", "dayname":
+		return textType(9, defaultCollation, true)
+	case "database", "schema":
+		return textType(64, "utf8mb3_general_ci", true)
+	case "user", "current_user", "session_user", "system_user":
+		return textType(288, "utf8mb3_general_ci", false)
+	case "version":
+		return textType(64, "utf8mb3_general_ci", false)
+	case "unhex", "from_base64", "aes_encrypt", "aes_decrypt", "compress", "uncompress", "inet6_aton", "uuid_to_bin":
+		return textType(stringLength(arg(0)), "binary", true)
+	case "random_bytes":
+		return textType(1024, "binary", nullable)
+
+	case "length", "octet_length", "char_length", "character_length", "bit_length", "ascii", "ord",
+		"instr", "position", "field", "find_in_set", "strcmp", "sign", "bit_count", "is_ipv4", "is_ipv6",
+		"is_uuid", "row_count", "found_rows":
+		return intType(sqltypes.Int64, nullable)
+	case "crc32":
+		return intType(sqltypes.Uint32, nullable)
+	case "uuid_short", "connection_id", "last_insert_id":
+		return intType(sqltypes.Uint64, false)
+	case "inet_aton":
+		return intType(sqltypes.Uint64, true)
+	case "year", "month", "day", "dayofmonth", "dayofweek", "dayofyear", "hour", "minute", "second",
+		"microsecond", "quarter", "week", "weekday", "weekofyear", "yearweek", "to_days", "to_seconds",
+		"datediff", "period_add", "period_diff", "time_to_sec":
+		return intType(sqltypes.Int64, true)
+	case "unix_timestamp":
+		if len(args) == 0 {
+			return intType(sqltypes.Int64, false)
+		}
+		if scale := arg(0).Scale; scale > 0 && sqltypes.IsDateOrTime(arg(0).Type) {
+			return decimalType(17+scale, scale, true)
+		}
+		return intType(sqltypes.Int64, true)
+
+	case "pi", "rand":
+		return floatType(false)
+	case "sqrt", "ln", "log", "log2", "log10", "acos", "asin", "cot":
+		return floatType(true)
+	case "exp", "pow", "power", "sin", "cos", "tan", "atan", "atan2", "degrees", "radians":
+		return floatType(nullable)
+	case "abs":
+		typ := asNumber(arg(0))
+		typ.Nullable = nullable
+		return typ
+	case "ceil", "ceiling", "floor":
+		typ := asNumber(arg(0))
+		if typ.Type == sqltypes.Decimal {
+			if digits := intDigits(typ); digits < 19 {
+				return intType(sqltypes.Int64, nullable)
+			}
+			return decimalType(intDigits(typ)+1, 0, nullable)
+		}
+		typ.Nullable = nullable
+		return typ
+	case "round", "truncate":
+		typ := asNumber(arg(0))
+		if typ.Type != sqltypes.Decimal {
+			typ.Nullable = nullable
+			return typ
+		}
+		scale := typ.Scale
+		if len(fn.Exprs) == 1 {
+			scale = 0
+		} else if lit, ok := fn.Exprs[1].(*sqlparser.Literal); ok && lit.Type == sqlparser.IntVal {
+			if d, err := strconv.Atoi(lit.Val); err == nil {
+				scale = uint32(min(max(d, 0), 30))
+			}
+		}
+		return decimalType(intDigits(typ)+1+scale, scale, nullable)
+
+	case "now", "current_timestamp", "localtime", "localtimestamp", "sysdate", "utc_timestamp":
+		return temporalType(sqltypes.Datetime, literalFsp(fn.Exprs), false)
+	case "curdate", "current_date", "utc_date":
+		return temporalType(sqltypes.Date, 0, false)
+	case "curtime", "current_time", "utc_time":
+		return temporalType(sqltypes.Time, literalFsp(fn.Exprs), false)
+	case "date", "from_days", "makedate", "last_day":
+		return temporalType(sqltypes.Date, 0, true)
+	case "time", "timediff":
+		return temporalType(sqltypes.Time, arg(0).Scale, true)
+	case "maketime", "sec_to_time":
+		return temporalType(sqltypes.Time, arg(len(args)-1).Scale, true)
+	case "timestamp", "convert_tz":
+		return temporalType(sqltypes.Datetime, arg(0).Scale, true)
+	case "from_unixtime":
+		if len(args) > 1 {
+			return textType(stringLength(arg(1))*10, defaultCollation, true)
+		}
+		return temporalType(sqltypes.Datetime, min(asNumber(arg(0)).Scale, 6), true)
+	case "str_to_date":
+		return temporalType(sqltypes.Datetime, 6, true)
+	case "addtime", "subtime":
+		typ := arg(0)
+		if !sqltypes.IsDateOrTime(typ.Type) {
+			return textType(29, defaultCollation, true)
+		}
+		return temporalType(typ.Type, max(typ.Scale, arg(1).Scale), true)
+	}
+	return unknownType()
+}
+
+// literalFsp returns the fractional seconds precision given to a function like
+// NOW(), or 0.
+func literalFsp(exprs []sqlparser.Expr) uint32 {
+	if len(exprs) == 0 {
+		return 0
+	}
+	if lit, ok := exprs[0].(*sqlparser.Literal); ok && lit.Type == sqlparser.IntVal {
+		if fsp, err := strconv.Atoi(lit.Val); err == nil && fsp >= 0 && fsp <= 6 {
+			return uint32(fsp)
+		}
+	}
+	return 0
+}
+
+func literalType(lit *sqlparser.Literal) Type {
+	switch lit.Type {
+	case sqlparser.StrVal:
+		return textType(uint32(utf8.RuneCountInString(lit.Val)), defaultCollation, false)
+	case sqlparser.IntVal:
+		if _, err := strconv.ParseInt(lit.Val, 10, 64); err == nil {
+			return Type{Type: sqltypes.Int64, Size: uint32(len(lit.Val))}
+		}
+		if _, err := strconv.ParseUint(lit.Val, 10, 64); err == nil {
+			return Type{Type: sqltypes.Uint64, Size: uint32(len(lit.Val))}
+		}
+		return decimalType(uint32(len(lit.Val)), 0, false)
+	case sqlparser.DecimalVal:
+		whole, frac, _ := strings.Cut(strings.TrimLeft(lit.Val, "+-"), ".")
+		whole = strings.TrimLeft(whole, "0")
+		return decimalType(uint32(max(len(whole), 1)+len(frac)), uint32(len(frac)), false)
+	case sqlparser.FloatVal:
+		return floatType(false)
+	case sqlparser.HexNum:
+		return textType(uint32(len(lit.Val)-1)/2, "binary", false)
+	case sqlparser.HexVal:
+		return textType(uint32(len(lit.Val)+1)/2, "binary", false)
+	case sqlparser.BitNum:
+		return textType(uint32(len(lit.Val)+5)/8, "binary", false)
+	case sqlparser.DateVal:
+		return temporalType(sqltypes.Date, 0, false)
+	case sqlparser.TimeVal:
+		return temporalType(sqltypes.Time, fracDigits(lit.Val), false)
+	case sqlparser.TimestampVal:
+		return temporalType(sqltypes.Datetime, fracDigits(lit.Val), false)
+	}
+	return unknownType()
+}
+
+func fracDigits(val string) uint32 {
+	if _, frac, ok := strings.Cut(val, "."); ok {
+		return uint32(min(len(frac), 6))
+	}
+	return 0
+}
+
+// arithmetic returns the type of a binary operation. The operands are taken as
+// numbers: integers stay integers unless the operation makes a fraction, exact
+// values give decimals with as many digits as the result can have, and
+// anything with a float, or a string, gives a float.
+func arithmetic(op sqlparser.BinaryExprOperator, l, r Type) Type {
+	nullable := l.Nullable || r.Nullable
+	l, r = asNumber(l), asNumber(r)
+	switch {
+	case l.Type == sqltypes.Null && r.Type == sqltypes.Null:
+		return nullType()
+	case l.Type == sqltypes.Null:
+		l = r
+	case r.Type == sqltypes.Null:
+		r = l
+	}
+	float := sqltypes.IsFloat(l.Type) || sqltypes.IsFloat(r.Type)
+	decimal := l.Type == sqltypes.Decimal || r.Type == sqltypes.Decimal
+	unsigned := sqltypes.IsUnsigned(l.Type) || sqltypes.IsUnsigned(r.Type)
+
+	switch op {
+	case sqlparser.BitAndOp, sqlparser.BitOrOp, sqlparser.BitXorOp, sqlparser.ShiftLeftOp, sqlparser.ShiftRightOp:
+		return intType(sqltypes.Uint64, nullable)
+	case sqlparser.IntDivOp:
+		// Dividing by zero gives NULL.
+		if unsigned {
+			return intType(sqltypes.Uint64, true)
+		}
+		return intType(sqltypes.Int64, true)
+	case sqlparser.DivOp:
+		if float {
+			return floatType(true)
+		}
+		scale := l.Scale + divPrecisionIncrement
+		return decimalType(intDigits(l)+r.Scale+scale, scale, true)
+	case sqlparser.ModOp:
+		switch {
+		case float:
+			return floatType(true)
+		case decimal:
+			scale := max(l.Scale, r.Scale)
+			return decimalType(max(intDigits(l), intDigits(r))+scale, scale, true)
+		case sqltypes.IsUnsigned(l.Type):
+			return intType(sqltypes.Uint64, true)
+		}
+		return intType(sqltypes.Int64, true)
+	}
+
+	switch {
+	case float:
+		return floatType(nullable)
+	case decimal && op == sqlparser.MultOp:
+		return decimalType(l.Size+r.Size, l.Scale+r.Scale, nullable)
+	case decimal:
+		scale := max(l.Scale, r.Scale)
+		return decimalType(max(intDigits(l), intDigits(r))+scale+1, scale, nullable)
+	case unsigned:
+		return intType(sqltypes.Uint64, nullable)
+	}
+	return intType(sqltypes.Int64, nullable)
+}
+
+// asNumber returns the type a value takes when it is used as a number. Temporal
+// values become integers, or decimals when they have fractional seconds, and
+// strings become floats.
+func asNumber(typ Type) Type {
+	var num Type
+	switch {
+	case sqltypes.IsIntegral(typ.Type) || typ.Type == sqltypes.Decimal || typ.Type == sqltypes.Null:
+		num = typ
+		if sqltypes.IsIntegral(typ.Type) {
+			num.Size = intDigits(typ)
+		}
+	case sqltypes.IsFloat(typ.Type):
+		num = floatType(false)
+	case typ.Type == sqltypes.Bit:
+		num = Type{Type: sqltypes.Uint64, Size: 20}
+	case typ.Type == sqltypes.Year:
+		num = Type{Type: sqltypes.Int64, Size: 4}
+	case sqltypes.IsDateOrTime(typ.Type):
+		digits := uint32(14)
+		switch typ.Type {
+		case sqltypes.Date:
+			digits = 8
+		case sqltypes.Time:
+			digits = 7
+		}
+		if typ.Scale > 0 {
+			num = decimalType(digits+typ.Scale, typ.Scale, false)
+		} else {
+			num = Type{Type: sqltypes.Int64, Size: digits}
+		}
+	default:
+		num = floatType(false)
+	}
+	num.Nullable = typ.Nullable
+	num.Collation = ""
+	return num
+}
+
+// intDigits returns the number of digits of a number before its point.
+func intDigits(typ Type) uint32 {
+	switch typ.Type {
+	case sqltypes.Int8, sqltypes.Uint8:
+		return 3
+	case sqltypes.Int16, sqltypes.Uint16:
+		return 5
+	case sqltypes.Int24:
+		return 7
+	case sqltypes.Uint24:
+		return 8
+	case sqltypes.Int32, sqltypes.Uint32:
+		return 10
+	case sqltypes.Int64:
+		if typ.Size > 0 && typ.Size < 19 {
+			return typ.Size
+		}
+		return 19
+	case sqltypes.Uint64:
+		return 20
+	case sqltypes.Decimal:
+		if typ.Size > typ.Scale {
+			return typ.Size - typ.Scale
+		}
+		return 1
+	}
+	return 0
+}
+
+// intWidth returns the display width of an integer type.
+func intWidth(typ querypb.Type) uint32 {
+	switch typ {
+	case sqltypes.Int8:
+		return 4
+	case sqltypes.Uint8:
+		return 3
+	case sqltypes.Int16:
+		return 6
+	case sqltypes.Uint16:
+		return 5
+	case sqltypes.Int24:
+		return 9
+	case sqltypes.Uint24:
+		return 8
+	case sqltypes.Int32:
+		return 11
+	case sqltypes.Uint32:
+		return 10
+	case sqltypes.Int64, sqltypes.Uint64:
+		return 20
+	}
+	return 0
+}
+
+// intRank orders the integer types by the range of their values.
+func intRank(typ querypb.Type) int {
+	switch typ {
+	case sqltypes.Int8, sqltypes.Uint8:
+		return 1
+	case sqltypes.Int16, sqltypes.Uint16, sqltypes.Year:
+		return 2
+	case sqltypes.Int24, sqltypes.Uint24:
+		return 3
+	case sqltypes.Int32, sqltypes.Uint32:
+		return 4
+	}
+	return 5
+}
+
+var (
+	signedTypes   = []querypb.Type{sqltypes.Int8, sqltypes.Int8, sqltypes.Int16, sqltypes.Int24, sqltypes.Int32, sqltypes.Int64}
+	unsignedTypes = []querypb.Type{sqltypes.Uint8, sqltypes.Uint8, sqltypes.Uint16, sqltypes.Uint24, sqltypes.Uint32, sqltypes.Uint64}
+)
+
+// unify returns the type of an expression whose value is one of the values of
+// the given types, as for CASE, COALESCE and the columns of a UNION. Numbers
+// unify to the smallest type that can hold them all, temporal values to
+// DATETIME unless they all are of the same type, and anything else to a string.
+func unify(types ...Type) Type {
+	var res Type
+	found, nullable := false, false
+	for _, typ := range types {
+		nullable = nullable || typ.Nullable
+		if typ.Type == sqltypes.Null {
+			continue
+		}
+		if !found {
+			res, found = typ, true
+			continue
+		}
+		res = unifyPair(res, typ)
+	}
+	if !found {
+		return nullType()
+	}
+	res.Nullable = nullable
+	return res
+}
+
+func isNumeric(typ querypb.Type) bool {
+	return sqltypes.IsNumber(typ) || typ == sqltypes.Bit || typ == sqltypes.Year
+}
+
+func unifyPair(a, b Type) Type {
+	switch {
+	case a.Type == b.Type && a.Type != sqltypes.Decimal:
+		res := a
+		res.Size = max(a.Size, b.Size)
+		res.Scale = max(a.Scale, b.Scale)
+		return res
+	case isNumeric(a.Type) && isNumeric(b.Type):
+		a, b = asNumber(a), asNumber(b)
+		switch {
+		case sqltypes.IsFloat(a.Type) || sqltypes.IsFloat(b.Type):
+			return floatType(false)
+		case a.Type == sqltypes.Decimal || b.Type == sqltypes.Decimal:
+			scale := max(a.Scale, b.Scale)
+			return decimalType(max(intDigits(a), intDigits(b))+scale, scale, false)
+		case sqltypes.IsUnsigned(a.Type) == sqltypes.IsUnsigned(b.Type):
+			if intRank(a.Type) >= intRank(b.Type) {
+				return intType(a.Type, false)
+			}
+			return intType(b.Type, false)
+		}
+		signed, unsigned := a, b
+		if sqltypes.IsUnsigned(a.Type) {
+			signed, unsigned = b, a
+		}
+		rank := max(intRank(signed.Type), intRank(unsigned.Type)+1)
+		if rank > 5 {
+			return decimalType(20, 0, false)
+		}
+		return intType(signedTypes[rank], false)
+	case sqltypes.IsDateOrTime(a.Type) && sqltypes.IsDateOrTime(b.Type):
+		return temporalType(sqltypes.Datetime, max(a.Scale, b.Scale), false)
+	case a.Type == sqltypes.TypeJSON && b.Type == sqltypes.TypeJSON:
+		return a
+	}
+	res := textType(max(stringLength(a), stringLength(b)), collationOfArgs([]Type{a, b}), false)
+	if isLob(a.Type) || isLob(b.Type) {
+		if res.Type == sqltypes.VarBinary {
+			res.Type = sqltypes.Blob
+		} else {
+			res.Type = sqltypes.Text
+		}
+	}
+	return res
+}
+
+func isLob(typ querypb.Type) bool {
+	return typ == sqltypes.Text || typ == sqltypes.Blob || typ == sqltypes.TypeJSON || typ == sqltypes.Geometry
+}
+
+// stringLength returns the number of characters of the values of a type when
+// they are turned into strings.
+func stringLength(typ Type) uint32 {
+	switch {
+	case sqltypes.IsIntegral(typ.Type):
+		return intWidth(typ.Type)
+	case typ.Type == sqltypes.Decimal:
+		return typ.Size + 2
+	case sqltypes.IsFloat(typ.Type):
+		return 22
+	case sqltypes.IsDateOrTime(typ.Type):
+		return temporalWidth(typ.Type, typ.Scale)
+	case typ.Type == sqltypes.Year:
+		return 4
+	}
+	return typ.Size
+}
+
+func temporalWidth(typ querypb.Type, fsp uint32) uint32 {
+	width := uint32(19)
+	switch typ {
+	case sqltypes.Date:
+		return 10
+	case sqltypes.Time:
+		width = 10
+	}
+	if fsp > 0 {
+		width += fsp + 1
+	}
+	return width
+}
+
+// collationOfArgs returns the collation of a string made of values of the given
+// types: binary if any of them is binary, or else the one of the first text.
+func collationOfArgs(args []Type) string {
+	collation := ""
+	for _, arg := range args {
+		switch {
+		case sqltypes.IsBinary(arg.Type) || arg.Type == sqltypes.Geometry || arg.Collation == "binary":
+			return "binary"
+		case collation == "" && arg.Collation != "":
+			collation = arg.Collation
+		}
+	}
+	if collation == "" {
+		return defaultCollation
+	}
+	return collation
+}
+
+// textCollation returns the collation of a value of the given type used as a string.
+func textCollation(typ Type) string {
+	return collationOfArgs([]Type{typ})
+}
+
+// castType returns the type of CAST(expr AS type) for an expr of type arg.
+func castType(arg Type, ct *sqlparser.ConvertType) Type {
+	length := func(def uint32) uint32 {
+		if ct.Length != nil {
+			return uint32(*ct.Length)
+		}
+		return def
+	}
+	switch strings.ToLower(ct.Type) {
+	case "signed":
+		return intType(sqltypes.Int64, arg.Nullable)
+	case "unsigned":
+		return intType(sqltypes.Uint64, arg.Nullable)
+	case "char":
+		return textType(length(stringLength(arg)), collationOf(ct.Charset.Name, "", ct.Charset.Binary), arg.Nullable)
+	case "nchar":
+		return textType(length(stringLength(arg)), "utf8mb3_general_ci", arg.Nullable)
+	case "binary":
+		return textType(length(stringLength(arg)), "binary", arg.Nullable)
+	case "decimal":
+		scale := uint32(0)
+		if ct.Scale != nil {
+			scale = uint32(*ct.Scale)
+		}
+		return decimalType(length(10), scale, arg.Nullable)
+	case "date":
+		return temporalType(sqltypes.Date, 0, true)
+	case "datetime":
+		return temporalType(sqltypes.Datetime, length(0), true)
+	case "time":
+		return temporalType(sqltypes.Time, length(0), true)
+	case "year":
+		return Type{Type: sqltypes.Year, Nullable: true, Size: 4}
+	case "json":
+		return jsonType(arg.Nullable)
+	case "float":
+		if length(0) > 24 {
+			return floatType(arg.Nullable)
+		}
+		return Type{Type: sqltypes.Float32, Nullable: arg.Nullable, Size: 12}
+	case "double", "real":
+		return floatType(arg.Nullable)
+	}
+	return unknownType()
+}
+
+// intervalType returns the type of adding an interval with the given unit to a
+// value of type date, as DATE_ADD does. Dates stay dates unless the interval
+// has a time part, times stay times unless it has a date part, and strings
+// stay strings.
+func intervalType(date Type, unit sqlparser.IntervalType) Type {
+	fsp := date.Scale
+	if unit.NeedsPrecision() {
+		fsp = 6
+	}
+	switch date.Type {
+	case sqltypes.Date:
+		if unit.HasTimeParts() {
+			return temporalType(sqltypes.Datetime, fsp, true)
+		}
+		return temporalType(sqltypes.Date, 0, true)
+	case sqltypes.Datetime, sqltypes.Timestamp:
+		return temporalType(sqltypes.Datetime, fsp, true)
+	case sqltypes.Time:
+		if unit.HasDateParts() {
+			return temporalType(sqltypes.Datetime, fsp, true)
+		}
+		return temporalType(sqltypes.Time, fsp, true)
+	}
+	return textType(29, defaultCollation, true)
+}
+
+func unknownType() Type {
+	return Type{Type: sqltypes.Unknown, Nullable: true}
+}
+
+func nullType() Type {
+	return Type{Type: sqltypes.Null, Nullable: true}
+}
+
+// boolType is the type of predicates, which MySQL gives as integers.
+func boolType(nullable bool) Type {
+	return Type{Type: sqltypes.Int64, Nullable: nullable, Size: 1}
+}
+
+func intType(typ querypb.Type, nullable bool) Type {
+	return Type{Type: typ, Nullable: nullable, Size: intWidth(typ)}
+}
+
+func floatType(nullable bool) Type {
+	return Type{Type: sqltypes.Float64, Nullable: nullable, Size: 22}
+}
+
+// decimalType returns a DECIMAL type, within the 65 digits and the scale of
+// 30 MySQL allows.
+func decimalType(precision, scale uint32, nullable bool) Type {
+	scale = min(scale, 30)
+	precision = min(max(precision, scale, 1), 65)
+	return Type{Type: sqltypes.Decimal, Nullable: nullable, Size: precision, Scale: scale}
+}
+
+func temporalType(typ querypb.Type, fsp uint32, nullable bool) Type {
+	fsp = min(fsp, 6)
+	return Type{Type: typ, Nullable: nullable, Size: temporalWidth(typ, fsp), Scale: fsp}
+}
+
+// textType returns a VARCHAR type, or a VARBINARY one for the binary collation.
+func textType(size uint32, collation string, nullable bool) Type {
+	typ := sqltypes.VarChar
+	if collation == "binary" {
+		typ = sqltypes.VarBinary
+	}
+	return Type{Type: typ, Nullable: nullable, Collation: collation, Size: size}
+}
+
+func jsonType(nullable bool) Type {
+	return Type{Type: sqltypes.TypeJSON, Nullable: nullable, Collation: "utf8mb4_bin", Size: 4294967295}
+}
+
+func geometryType() Type {
+	return Type{Type: sqltypes.Geometry, Nullable: true, Collation: "binary", Size: 4294967295}
+}
@@ -617,8 +617,46 @@ type (
 	// DDLAction is an enum for DDL.Action
 	DDLAction int8
 
-	// Load represents a LOAD statement
+	// Load represents a LOAD DATA statement. ExportOption holds the field and
+	// line formats like SelectInto does, and Columns the columns and user
+	// variables the fields are read into. The forms that load from elsewhere
+	// than a file, like LOAD DATA FROM S3, are not parsed: Source keeps the
+	// text that follows FROM and the other fields are empty.
 	Load struct {
+		Local        bool
+		FileName     string
+		Action       InsertAction
+		Ignore       Ignore
+		Table        TableName
+		Priority     LoadPriority
+		Partitions   Partitions
+		Charset      ColumnCharset
+		ExportOption string
+		IgnoreLines  string
+		Columns      []Expr
+		SetExprs     UpdateExprs
+		Source       string
+	}
+
+	// LoadPriority is an enum for the priority of a LOAD DATA statement
+	LoadPriority int8
+
+	// Grant represents a GRANT statement. Level is the object the privileges
+	// are granted on, where a * name or qualifier stands for every table or
+	// every database.
+	Grant struct {
+		Privileges      []*GrantPrivilege
+		ObjectType      string
+		Level           TableName
+		Users           []*Definer
+		WithGrantOption bool
+	}
+
+	// GrantPrivilege is a privilege of a GRANT statement, with the columns it
+	// is limited to.
+	GrantPrivilege struct {
+		Name    string
+		Columns Columns
 	}
 
 	// PurgeBinaryLogs represents a PURGE BINARY LOGS statement
@@ -920,6 +958,7 @@ func (*ValuesStatement) iSelectStatement() {}
 func (*Union) iSelectStatement()           {}
 func (*Union) iTableStatement()            {}
 func (*Load) iStatement()                  {}
+func (*Grant) iStatement()                 {}
 func (*CreateDatabase) iStatement()        {}
 func (*AlterDatabase) iStatement()         {}
 func (*CreateTable) iStatement()           {}
@@ -1,5 +1,5 @@
 /*
-Copyright 2025 The Vitess Authors.
+Copyright 2025 Pouya Vedadiyan.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -221,6 +221,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfGeomFromWKBExpr(in)
 	case *GeomPropertyFuncExpr:
 		return CloneRefOfGeomPropertyFuncExpr(in)
+	case *Grant:
+		return CloneRefOfGrant(in)
 	case *GroupBy:
 		return CloneRefOfGroupBy(in)
 	case *GroupConcatExpr:
@@ -1678,6 +1680,18 @@ func CloneRefOfGeomPropertyFuncExpr(n *GeomPropertyFuncExpr) *GeomPropertyFuncEx
 	return &out
 }
 
+// CloneRefOfGrant creates a deep clone of the input.
+func CloneRefOfGrant(n *Grant) *Grant {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Privileges = CloneSliceOfRefOfGrantPrivilege(n.Privileges)
+	out.Level = CloneTableName(n.Level)
+	out.Users = CloneSliceOfRefOfDefiner(n.Users)
+	return &out
+}
+
 // CloneRefOfGroupBy creates a deep clone of the input.
 func CloneRefOfGroupBy(n *GroupBy) *GroupBy {
 	if n == nil {
@@ -2287,6 +2301,11 @@ func CloneRefOfLoad(n *Load) *Load {
 		return nil
 	}
 	out := *n
+	out.Table = CloneTableName(n.Table)
+	out.Partitions = ClonePartitions(n.Partitions)
+	out.Charset = CloneColumnCharset(n.Charset)
+	out.Columns = CloneSliceOfExpr(n.Columns)
+	out.SetExprs = CloneUpdateExprs(n.SetExprs)
 	return &out
 }
 
@@ -4544,6 +4563,8 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfExplainTab(in)
 	case *Flush:
 		return CloneRefOfFlush(in)
+	case *Grant:
+		return CloneRefOfGrant(in)
 	case *Insert:
 		return CloneRefOfInsert(in)
 	case *Kill:
@@ -4838,6 +4859,30 @@ func CloneSliceOfRefOfVariable(n []*Variable) []*Variable {
 	return res
 }
 
+// CloneSliceOfRefOfGrantPrivilege creates a deep clone of the input.
+func CloneSliceOfRefOfGrantPrivilege(n []*GrantPrivilege) []*GrantPrivilege {
+	if n == nil {
+		return nil
+	}
+	res := make([]*GrantPrivilege, len(n))
+	for i, x := range n {
+		res[i] = CloneRefOfGrantPrivilege(x)
+	}
+	return res
+}
+
+// CloneSliceOfRefOfDefiner creates a deep clone of the input.
+func CloneSliceOfRefOfDefiner(n []*Definer) []*Definer {
+	if n == nil {
+		return nil
+	}
+	res := make([]*Definer, len(n))
+	for i, x := range n {
+		res[i] = CloneRefOfDefiner(x)
+	}
+	return res
+}
+
 // CloneRefOfIdentifierCI creates a deep clone of the input.
 func CloneRefOfIdentifierCI(n *IdentifierCI) *IdentifierCI {
 	if n == nil {
@@ -5139,6 +5184,16 @@ func CloneRefOfColumnCharset(n *ColumnCharset) *ColumnCharset {
 	return &out
 }
 
+// CloneRefOfGrantPrivilege creates a deep clone of the input.
+func CloneRefOfGrantPrivilege(n *GrantPrivilege) *GrantPrivilege {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Columns = CloneColumns(n.Columns)
+	return &out
+}
+
 // CloneRefOfIndexColumn creates a deep clone of the input.
 func CloneRefOfIndexColumn(n *IndexColumn) *IndexColumn {
 	if n == nil {
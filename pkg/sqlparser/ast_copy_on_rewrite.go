@@ -1,5 +1,5 @@
 /*
-Copyright 2025 The Vitess Authors.
+Copyright 2025 Pouya Vedadiyan.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -220,6 +220,8 @@ func (c *cow) copyOnRewriteSQLNode(n SQLNode, parent SQLNode) (out SQLNode, chan
 		return c.copyOnRewriteRefOfGeomFromWKBExpr(n, parent)
 	case *GeomPropertyFuncExpr:
 		return c.copyOnRewriteRefOfGeomPropertyFuncExpr(n, parent)
+	case *Grant:
+		return c.copyOnRewriteRefOfGrant(n, parent)
 	case *GroupBy:
 		return c.copyOnRewriteRefOfGroupBy(n, parent)
 	case *GroupConcatExpr:
@@ -2954,6 +2956,38 @@ func (c *cow) copyOnRewriteRefOfGeomPropertyFuncExpr(n *GeomPropertyFuncExpr, pa
 	}
 	return
 }
+func (c *cow) copyOnRewriteRefOfGrant(n *Grant, parent SQLNode) (out SQLNode, changed bool) {
+	if n == nil || c.cursor.stop {
+		return n, false
+	}
+	out = n
+	if c.pre == nil || c.pre(n, parent) {
+		_Level, changedLevel := c.copyOnRewriteTableName(n.Level, n)
+		var changedUsers bool
+		_Users := make([]*Definer, len(n.Users))
+		for x, el := range n.Users {
+			this, changed := c.copyOnRewriteRefOfDefiner(el, n)
+			_Users[x] = this.(*Definer)
+			if changed {
+				changedUsers = true
+			}
+		}
+		if changedLevel || changedUsers {
+			res := *n
+			res.Level, _ = _Level.(TableName)
+			res.Users = _Users
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
+	}
+	if c.post != nil {
+		out, changed = c.postVisit(out, parent, changed)
+	}
+	return
+}
 func (c *cow) copyOnRewriteRefOfGroupBy(n *GroupBy, parent SQLNode) (out SQLNode, changed bool) {
 	if n == nil || c.cursor.stop {
 		return n, false
@@ -4323,6 +4357,30 @@ func (c *cow) copyOnRewriteRefOfLoad(n *Load, parent SQLNode) (out SQLNode, chan
 	}
 	out = n
 	if c.pre == nil || c.pre(n, parent) {
+		_Table, changedTable := c.copyOnRewriteTableName(n.Table, n)
+		_Partitions, changedPartitions := c.copyOnRewritePartitions(n.Partitions, n)
+		var changedColumns bool
+		_Columns := make([]Expr, len(n.Columns))
+		for x, el := range n.Columns {
+			this, changed := c.copyOnRewriteExpr(el, n)
+			_Columns[x] = this.(Expr)
+			if changed {
+				changedColumns = true
+			}
+		}
+		_SetExprs, changedSetExprs := c.copyOnRewriteUpdateExprs(n.SetExprs, n)
+		if changedTable || changedPartitions || changedColumns || changedSetExprs {
+			res := *n
+			res.Table, _ = _Table.(TableName)
+			res.Partitions, _ = _Partitions.(Partitions)
+			res.Columns = _Columns
+			res.SetExprs, _ = _SetExprs.(UpdateExprs)
+			out = &res
+			if c.cloned != nil {
+				c.cloned(n, out)
+			}
+			changed = true
+		}
 	}
 	if c.post != nil {
 		out, changed = c.postVisit(out, parent, changed)
@@ -8250,6 +8308,8 @@ func (c *cow) copyOnRewriteStatement(n Statement, parent SQLNode) (out SQLNode,
 		return c.copyOnRewriteRefOfExplainTab(n, parent)
 	case *Flush:
 		return c.copyOnRewriteRefOfFlush(n, parent)
+	case *Grant:
+		return c.copyOnRewriteRefOfGrant(n, parent)
 	case *Insert:
 		return c.copyOnRewriteRefOfInsert(n, parent)
 	case *Kill:
@@ -1,5 +1,5 @@
 /*
-Copyright 2025 The Vitess Authors.
+Copyright 2025 Pouya Vedadiyan.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -620,6 +620,12 @@ func (cmp *Comparator) SQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return cmp.RefOfGeomPropertyFuncExpr(a, b)
+	case *Grant:
+		b, ok := inB.(*Grant)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfGrant(a, b)
 	case *GroupBy:
 		b, ok := inB.(*GroupBy)
 		if !ok {
@@ -3031,6 +3037,21 @@ func (cmp *Comparator) RefOfGeomPropertyFuncExpr(a, b *GeomPropertyFuncExpr) boo
 		cmp.Expr(a.Geom, b.Geom)
 }
 
+// RefOfGrant does deep equals between the two objects.
+func (cmp *Comparator) RefOfGrant(a, b *Grant) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.ObjectType == b.ObjectType &&
+		a.WithGrantOption == b.WithGrantOption &&
+		cmp.SliceOfRefOfGrantPrivilege(a.Privileges, b.Privileges) &&
+		cmp.TableName(a.Level, b.Level) &&
+		cmp.SliceOfRefOfDefiner(a.Users, b.Users)
+}
+
 // RefOfGroupBy does deep equals between the two objects.
 func (cmp *Comparator) RefOfGroupBy(a, b *GroupBy) bool {
 	if a == b {
@@ -3725,7 +3746,19 @@ func (cmp *Comparator) RefOfLoad(a, b *Load) bool {
 	if a == nil || b == nil {
 		return false
 	}
-	return true
+	return a.Local == b.Local &&
+		a.FileName == b.FileName &&
+		a.ExportOption == b.ExportOption &&
+		a.IgnoreLines == b.IgnoreLines &&
+		a.Source == b.Source &&
+		a.Action == b.Action &&
+		a.Ignore == b.Ignore &&
+		cmp.TableName(a.Table, b.Table) &&
+		a.Priority == b.Priority &&
+		cmp.Partitions(a.Partitions, b.Partitions) &&
+		cmp.ColumnCharset(a.Charset, b.Charset) &&
+		cmp.SliceOfExpr(a.Columns, b.Columns) &&
+		cmp.UpdateExprs(a.SetExprs, b.SetExprs)
 }
 
 // RefOfLocateExpr does deep equals between the two objects.
@@ -7477,6 +7510,12 @@ func (cmp *Comparator) Statement(inA, inB Statement) bool {
 			return false
 		}
 		return cmp.RefOfFlush(a, b)
+	case *Grant:
+		b, ok := inB.(*Grant)
+		if !ok {
+			return false
+		}
+		return cmp.RefOfGrant(a, b)
 	case *Insert:
 		b, ok := inB.(*Insert)
 		if !ok {
@@ -7946,6 +7985,32 @@ func (cmp *Comparator) SliceOfRefOfVariable(a, b []*Variable) bool {
 	return true
 }
 
+// SliceOfRefOfGrantPrivilege does deep equals between the two objects.
+func (cmp *Comparator) SliceOfRefOfGrantPrivilege(a, b []*GrantPrivilege) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.RefOfGrantPrivilege(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// SliceOfRefOfDefiner does deep equals between the two objects.
+func (cmp *Comparator) SliceOfRefOfDefiner(a, b []*Definer) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := 0; i < len(a); i++ {
+		if !cmp.RefOfDefiner(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // RefOfIdentifierCI does deep equals between the two objects.
 func (cmp *Comparator) RefOfIdentifierCI(a, b *IdentifierCI) bool {
 	if a == b {
@@ -8285,6 +8350,18 @@ func (cmp *Comparator) RefOfColumnCharset(a, b *ColumnCharset) bool {
 		a.Binary == b.Binary
 }
 
+// RefOfGrantPrivilege does deep equals between the two objects.
+func (cmp *Comparator) RefOfGrantPrivilege(a, b *GrantPrivilege) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Name == b.Name &&
+		cmp.Columns(a.Columns, b.Columns)
+}
+
 // RefOfIndexColumn does deep equals between the two objects.
 func (cmp *Comparator) RefOfIndexColumn(a, b *IndexColumn) bool {
 	if a == b {
@@ -2314,7 +2314,33 @@ func (node IdentifierCS) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Load) Format(buf *TrackedBuffer) {
-	buf.literal("AST node missing for Load type")
+	if node.Source != "" {
+		buf.astPrintf(node, "load data from %#s", node.Source)
+		return
+	}
+	buf.astPrintf(node, "load data %s", node.Priority.ToString())
+	if node.Local {
+		buf.literal("local ")
+	}
+	buf.astPrintf(node, "infile %#s ", encodeSQLString(node.FileName))
+	if node.Action == ReplaceAct {
+		buf.literal("replace ")
+	}
+	buf.astPrintf(node, "%sinto table %v", node.Ignore.ToString(), node.Table)
+	buf.astPrintf(node, "%v", node.Partitions)
+	if node.Charset.Name != "" {
+		buf.astPrintf(node, " character set %#s", node.Charset.Name)
+	}
+	buf.astPrintf(node, "%#s", node.ExportOption)
+	if node.IgnoreLines != "" {
+		buf.astPrintf(node, " ignore %#s lines", node.IgnoreLines)
+	}
+	if node.Columns != nil {
+		buf.astPrintf(node, " (%n)", node.Columns)
+	}
+	if len(node.SetExprs) > 0 {
+		buf.astPrintf(node, " set %v", node.SetExprs)
+	}
 }
 
 // Format formats the node.
@@ -2466,6 +2492,44 @@ func (node *LockTables) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node.
+func (node *Grant) Format(buf *TrackedBuffer) {
+	buf.literal("grant ")
+	for i, priv := range node.Privileges {
+		if i > 0 {
+			buf.literal(", ")
+		}
+		buf.literal(priv.Name)
+		if len(priv.Columns) > 0 {
+			buf.astPrintf(node, " %v", priv.Columns)
+		}
+	}
+	buf.literal(" on ")
+	if node.ObjectType != "" {
+		buf.astPrintf(node, "%s ", node.ObjectType)
+	}
+	if node.Level.Qualifier.String() == "*" {
+		buf.literal("*.")
+	} else if node.Level.Qualifier.NotEmpty() {
+		buf.astPrintf(node, "%v.", node.Level.Qualifier)
+	}
+	if node.Level.Name.String() == "*" {
+		buf.literal("*")
+	} else {
+		buf.astPrintf(node, "%v", node.Level.Name)
+	}
+	buf.literal(" to ")
+	for i, user := range node.Users {
+		if i > 0 {
+			buf.literal(", ")
+		}
+		buf.astPrintf(node, "%v", user)
+	}
+	if node.WithGrantOption {
+		buf.literal(" with grant option")
+	}
+}
+
 // Format formats the UnlockTables node.
 func (node *UnlockTables) Format(buf *TrackedBuffer) {
 	buf.literal("unlock tables")
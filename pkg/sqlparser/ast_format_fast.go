@@ -1458,7 +1458,7 @@ func (c *ConstraintDefinition) FormatFast(buf *TrackedBuffer) {
 		c.Name.FormatFast(buf)
 		buf.WriteByte(' ')
 	}
-	c.Details.Format(buf)
+	c.Details.FormatFast(buf)
 }
 
 // FormatFast formats the node.
@@ -3039,7 +3039,45 @@ func (node IdentifierCS) FormatFast(buf *TrackedBuffer) {
 
 // FormatFast formats the node.
 func (node *Load) FormatFast(buf *TrackedBuffer) {
-	buf.WriteString("AST node missing for Load type")
+	if node.Source != "" {
+		buf.WriteString("load data from ")
+		buf.WriteString(node.Source)
+		return
+	}
+	buf.WriteString("load data ")
+	buf.WriteString(node.Priority.ToString())
+	if node.Local {
+		buf.WriteString("local ")
+	}
+	buf.WriteString("infile ")
+	buf.WriteString(encodeSQLString(node.FileName))
+	buf.WriteByte(' ')
+	if node.Action == ReplaceAct {
+		buf.WriteString("replace ")
+	}
+	buf.WriteString(node.Ignore.ToString())
+	buf.WriteString("into table ")
+	node.Table.FormatFast(buf)
+	node.Partitions.FormatFast(buf)
+	if node.Charset.Name != "" {
+		buf.WriteString(" character set ")
+		buf.WriteString(node.Charset.Name)
+	}
+	buf.WriteString(node.ExportOption)
+	if node.IgnoreLines != "" {
+		buf.WriteString(" ignore ")
+		buf.WriteString(node.IgnoreLines)
+		buf.WriteString(" lines")
+	}
+	if node.Columns != nil {
+		buf.WriteString(" (")
+		buf.formatExprs(node.Columns)
+		buf.WriteByte(')')
+	}
+	if len(node.SetExprs) > 0 {
+		buf.WriteString(" set ")
+		node.SetExprs.FormatFast(buf)
+	}
 }
 
 // FormatFast formats the node.
@@ -3232,6 +3270,47 @@ func (node *LockTables) FormatFast(buf *TrackedBuffer) {
 	}
 }
 
+// FormatFast formats the node.
+func (node *Grant) FormatFast(buf *TrackedBuffer) {
+	buf.WriteString("grant ")
+	for i, priv := range node.Privileges {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(priv.Name)
+		if len(priv.Columns) > 0 {
+			buf.WriteByte(' ')
+			priv.Columns.FormatFast(buf)
+		}
+	}
+	buf.WriteString(" on ")
+	if node.ObjectType != "" {
+		buf.WriteString(node.ObjectType)
+		buf.WriteByte(' ')
+	}
+	if node.Level.Qualifier.String() == "*" {
+		buf.WriteString("*.")
+	} else if node.Level.Qualifier.NotEmpty() {
+		node.Level.Qualifier.FormatFast(buf)
+		buf.WriteByte('.')
+	}
+	if node.Level.Name.String() == "*" {
+		buf.WriteString("*")
+	} else {
+		node.Level.Name.FormatFast(buf)
+	}
+	buf.WriteString(" to ")
+	for i, user := range node.Users {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		user.FormatFast(buf)
+	}
+	if node.WithGrantOption {
+		buf.WriteString(" with grant option")
+	}
+}
+
 // FormatFast formats the UnlockTables node.
 func (node *UnlockTables) FormatFast(buf *TrackedBuffer) {
 	buf.WriteString("unlock tables")
@@ -1929,6 +1929,8 @@ func (ty FrameUnitType) ToString() string {
 		return FrameRowsStr
 	case FrameRangeType:
 		return FrameRangeStr
+	case FrameGroupsType:
+		return FrameGroupsStr
 	default:
 		return "Unknown FrameUnitType"
 	}
@@ -2216,6 +2218,18 @@ func (ty LockType) ToString() string {
 	}
 }
 
+// ToString returns the priority as a string
+func (ty LoadPriority) ToString() string {
+	switch ty {
+	case LowPriorityLoad:
+		return LowPriorityLoadStr
+	case ConcurrentLoad:
+		return ConcurrentLoadStr
+	default:
+		return ""
+	}
+}
+
 // ToString returns ShowCommandType as a string
 func (ty ShowCommandType) ToString() string {
 	switch ty {
@@ -2410,13 +2424,15 @@ func formatIdentifier(id string) string {
 	return buf.String()
 }
 
+// formatAddress formats the host of an account, which is quoted as a string
+// like MySQL does when it is not a plain identifier, as in 'user'@'%'.
 func formatAddress(address string) string {
-	if len(address) > 0 && address[0] == '\'' {
-		return address
-	}
 	buf := NewTrackedBuffer(nil)
 	formatID(buf, address, NoAt)
-	return buf.String()
+	if id := buf.String(); id == address {
+		return id
+	}
+	return encodeSQLString(address)
 }
 
 // ContainsAggregation returns true if the expression contains aggregation
@@ -0,0 +1,164 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Every node can be turned into JSON and back. Structs are encoded as objects keyed
+// by their field names, with an extra "type" key holding the name of the struct, e.g.
+//
+//	{"type":"ColName","Name":"id","Qualifier":{"type":"TableName","Name":"t","Qualifier":""}}
+//
+// Nodes that are not structs, such as ValTuple or BoolVal, are wrapped in an object
+// when they are stored in an interface, so their type is not lost:
+//
+//	{"type":"BoolVal","value":true}
+//
+// The code doing the encoding is generated in ast_json.go.
+
+// MarshalJSONNode encodes node into JSON, along with its type.
+func MarshalJSONNode(node SQLNode) ([]byte, error) {
+	return marshalJSONSQLNode(node)
+}
+
+// UnmarshalJSONNode decodes a node encoded with MarshalJSONNode. The result is
+// equal to the node that was encoded.
+func UnmarshalJSONNode(data []byte) (SQLNode, error) {
+	return unmarshalJSONSQLNode(data)
+}
+
+// UnmarshalJSONStatement decodes a statement encoded with MarshalJSONNode.
+func UnmarshalJSONStatement(data []byte) (Statement, error) {
+	return unmarshalJSONNode[Statement](data)
+}
+
+// jsonNode encodes a node stored in an interface of type T.
+type jsonNode[T SQLNode] struct {
+	node T
+}
+
+func (n jsonNode[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSONSQLNode(n.node)
+}
+
+func (n *jsonNode[T]) UnmarshalJSON(data []byte) error {
+	node, err := unmarshalJSONNode[T](data)
+	if err != nil {
+		return err
+	}
+	n.node = node
+	return nil
+}
+
+// jsonNodes encodes a slice of nodes stored in interfaces of type T.
+type jsonNodes[T SQLNode] []T
+
+func (n jsonNodes[T]) MarshalJSON() ([]byte, error) {
+	if n == nil {
+		return []byte("null"), nil
+	}
+	nodes := make([]jsonNode[T], len(n))
+	for i, node := range n {
+		nodes[i].node = node
+	}
+	return json.Marshal(nodes)
+}
+
+func (n *jsonNodes[T]) UnmarshalJSON(data []byte) error {
+	var nodes []jsonNode[T]
+	if err := json.Unmarshal(data, &nodes); err != nil {
+		return err
+	}
+	if nodes == nil {
+		*n = nil
+		return nil
+	}
+	*n = make(jsonNodes[T], len(nodes))
+	for i, node := range nodes {
+		(*n)[i] = node.node
+	}
+	return nil
+}
+
+func unmarshalJSONNode[T SQLNode](data []byte) (T, error) {
+	var zero T
+	node, err := unmarshalJSONSQLNode(data)
+	if err != nil || node == nil {
+		return zero, err
+	}
+	t, ok := node.(T)
+	if !ok {
+		return zero, fmt.Errorf("%T is not a %s", node, reflect.TypeOf(&zero).Elem().Name())
+	}
+	return t, nil
+}
+
+// jsonHead is the part of an encoded node needed to know how to decode it.
+type jsonHead struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+
+	// JSON keys are matched case-insensitively against field names, so keys in a
+	// different case need fields of their own to be kept away from the ones above.
+	TypeField  json.RawMessage `json:"Type,omitempty"`
+	ValueField json.RawMessage `json:"Value,omitempty"`
+}
+
+func unmarshalJSONHead(data []byte) (*jsonHead, error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil, nil
+	}
+	var head jsonHead
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, err
+	}
+	return &head, nil
+}
+
+// marshalJSONValue encodes a node that is not a struct, wrapping it in an object along
+// with the name of its type.
+func marshalJSONValue(typ string, value any) ([]byte, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jsonHead{Type: typ, Value: encoded})
+}
+
+// MarshalJSON encodes the comments of node into JSON. Directives are parsed out of
+// the comments on demand, so they are not part of it.
+func (node *ParsedComments) MarshalJSON() ([]byte, error) {
+	if node == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(node.comments)
+}
+
+// UnmarshalJSON decodes ParsedComments from JSON.
+func (node *ParsedComments) UnmarshalJSON(data []byte) error {
+	var comments Comments
+	if err := json.Unmarshal(data, &comments); err != nil {
+		return err
+	}
+	*node = ParsedComments{comments: comments}
+	return nil
+}
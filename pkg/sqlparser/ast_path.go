@@ -1,5 +1,5 @@
 /*
-Copyright 2025 The Vitess Authors.
+Copyright 2025 Pouya Vedadiyan.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -206,6 +206,8 @@ const (
 	RefOfGeomFromWKBExprSrid
 	RefOfGeomFromWKBExprAxisOrderOpt
 	RefOfGeomPropertyFuncExprGeom
+	RefOfGrantLevel
+	RefOfGrantUsersOffset
 	RefOfGroupByExprsOffset
 	RefOfGroupConcatExprExprsOffset
 	RefOfGroupConcatExprOrderBy
@@ -306,6 +308,10 @@ const (
 	RefOfLineStringExprPointParamsOffset
 	RefOfLinestrPropertyFuncExprLinestring
 	RefOfLinestrPropertyFuncExprPropertyDefArg
+	RefOfLoadTable
+	RefOfLoadPartitions
+	RefOfLoadColumnsOffset
+	RefOfLoadSetExprs
 	RefOfLocateExprSubStr
 	RefOfLocateExprStr
 	RefOfLocateExprPos
@@ -565,6 +571,7 @@ const (
 	SliceOfHandlerConditionOffset
 	SliceOfTableExprOffset
 	SliceOfRefOfVariableOffset
+	SliceOfRefOfDefinerOffset
 	SliceOfRefOfElseIfBlockOffset
 	SliceOfRefOfJSONObjectParamOffset
 	SliceOfRefOfJtColumnDefinitionOffset
@@ -590,6 +597,7 @@ const (
 	RefOfVindexParamKey
 	SliceOfVindexParamOffset
 	SliceOfRefOfCommonTableExprOffset
+	RefOfGrantPrivilegeColumns
 	RefOfIndexColumnColumn
 	RefOfIndexColumnExpression
 	RefOfIndexOptionValue
@@ -973,6 +981,10 @@ func (s ASTStep) DebugString() string {
 		return "(*GeomFromWKBExpr).AxisOrderOpt"
 	case RefOfGeomPropertyFuncExprGeom:
 		return "(*GeomPropertyFuncExpr).Geom"
+	case RefOfGrantLevel:
+		return "(*Grant).Level"
+	case RefOfGrantUsersOffset:
+		return "(*Grant).UsersOffset"
 	case RefOfGroupByExprsOffset:
 		return "(*GroupBy).ExprsOffset"
 	case RefOfGroupConcatExprExprsOffset:
@@ -1173,6 +1185,14 @@ func (s ASTStep) DebugString() string {
 		return "(*LinestrPropertyFuncExpr).Linestring"
 	case RefOfLinestrPropertyFuncExprPropertyDefArg:
 		return "(*LinestrPropertyFuncExpr).PropertyDefArg"
+	case RefOfLoadTable:
+		return "(*Load).Table"
+	case RefOfLoadPartitions:
+		return "(*Load).Partitions"
+	case RefOfLoadColumnsOffset:
+		return "(*Load).ColumnsOffset"
+	case RefOfLoadSetExprs:
+		return "(*Load).SetExprs"
 	case RefOfLocateExprSubStr:
 		return "(*LocateExpr).SubStr"
 	case RefOfLocateExprStr:
@@ -1691,6 +1711,8 @@ func (s ASTStep) DebugString() string {
 		return "([]TableExpr)[]Offset"
 	case SliceOfRefOfVariableOffset:
 		return "([]*Variable)[]Offset"
+	case SliceOfRefOfDefinerOffset:
+		return "([]*Definer)[]Offset"
 	case SliceOfRefOfElseIfBlockOffset:
 		return "([]*ElseIfBlock)[]Offset"
 	case SliceOfRefOfJSONObjectParamOffset:
@@ -1741,6 +1763,8 @@ func (s ASTStep) DebugString() string {
 		return "([]VindexParam)[]Offset"
 	case SliceOfRefOfCommonTableExprOffset:
 		return "([]*CommonTableExpr)[]Offset"
+	case RefOfGrantPrivilegeColumns:
+		return "(*GrantPrivilege).Columns"
 	case RefOfIndexColumnColumn:
 		return "(*IndexColumn).Column"
 	case RefOfIndexColumnExpression:
@@ -2167,6 +2191,12 @@ func GetNodeFromPath(node SQLNode, path ASTPath) SQLNode {
 			node = node.(*GeomFromWKBExpr).AxisOrderOpt
 		case RefOfGeomPropertyFuncExprGeom:
 			node = node.(*GeomPropertyFuncExpr).Geom
+		case RefOfGrantLevel:
+			node = node.(*Grant).Level
+		case RefOfGrantUsersOffset:
+			idx, bytesRead := path.nextPathOffset()
+			path = path[bytesRead:]
+			node = node.(*Grant).Users[idx]
 		case RefOfGroupByExprsOffset:
 			idx, bytesRead := path.nextPathOffset()
 			path = path[bytesRead:]
@@ -2401,6 +2431,16 @@ func GetNodeFromPath(node SQLNode, path ASTPath) SQLNode {
 			node = node.(*LinestrPropertyFuncExpr).Linestring
 		case RefOfLinestrPropertyFuncExprPropertyDefArg:
 			node = node.(*LinestrPropertyFuncExpr).PropertyDefArg
+		case RefOfLoadTable:
+			node = node.(*Load).Table
+		case RefOfLoadPartitions:
+			node = node.(*Load).Partitions
+		case RefOfLoadColumnsOffset:
+			idx, bytesRead := path.nextPathOffset()
+			path = path[bytesRead:]
+			node = node.(*Load).Columns[idx]
+		case RefOfLoadSetExprs:
+			node = node.(*Load).SetExprs
 		case RefOfLocateExprSubStr:
 			node = node.(*LocateExpr).SubStr
 		case RefOfLocateExprStr:
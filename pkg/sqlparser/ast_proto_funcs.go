@@ -0,0 +1,98 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"fmt"
+	"reflect"
+
+	astpb "github.com/vedadiyan/sqlparser/pkg/ast"
+)
+
+// Every node has a protobuf representation, described in proto/ast.proto and
+// compiled with vtprotobuf. The conversions between the two are generated in
+// ast_proto.go.
+
+// ToProto converts node to its protobuf representation.
+func ToProto(node SQLNode) *astpb.Node {
+	return toProtoNode(node)
+}
+
+// FromProto converts the protobuf representation of a node back to the node. The
+// result is equal to the node that was converted.
+func FromProto(node *astpb.Node) (SQLNode, error) {
+	return fromProtoNode(node)
+}
+
+// MarshalProto encodes node in the protobuf wire format.
+func MarshalProto(node SQLNode) ([]byte, error) {
+	return toProtoNode(node).MarshalVT()
+}
+
+// UnmarshalProto decodes a node encoded with MarshalProto.
+func UnmarshalProto(data []byte) (SQLNode, error) {
+	var node astpb.Node
+	if err := node.UnmarshalVT(data); err != nil {
+		return nil, err
+	}
+	return fromProtoNode(&node)
+}
+
+// UnmarshalProtoStatement decodes a statement encoded with MarshalProto.
+func UnmarshalProtoStatement(data []byte) (Statement, error) {
+	var node astpb.Node
+	if err := node.UnmarshalVT(data); err != nil {
+		return nil, err
+	}
+	return fromProtoNodeAs[Statement](&node)
+}
+
+func fromProtoNodeAs[T SQLNode](in *astpb.Node) (T, error) {
+	var zero T
+	node, err := fromProtoNode(in)
+	if err != nil || node == nil {
+		return zero, err
+	}
+	t, ok := node.(T)
+	if !ok {
+		return zero, fmt.Errorf("%T is not a %s", node, reflect.TypeOf(&zero).Elem().Name())
+	}
+	return t, nil
+}
+
+// derefFromProto is used to convert back nodes that are stored as values.
+func derefFromProto[T any](node *T, err error) (T, error) {
+	var zero T
+	if err != nil || node == nil {
+		return zero, err
+	}
+	return *node, nil
+}
+
+func toProtoParsedComments(in *ParsedComments) *astpb.ParsedComments {
+	if in == nil {
+		return nil
+	}
+	return &astpb.ParsedComments{Comments: in.comments}
+}
+
+func fromProtoParsedComments(in *astpb.ParsedComments) (*ParsedComments, error) {
+	if in == nil {
+		return nil, nil
+	}
+	return &ParsedComments{comments: in.Comments}, nil
+}
@@ -1,5 +1,5 @@
 /*
-Copyright 2025 The Vitess Authors.
+Copyright 2025 Pouya Vedadiyan.
 
 Licensed under the Apache License, Version 2.0 (the "License");
 you may not use this file except in compliance with the License.
@@ -220,6 +220,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfGeomFromWKBExpr(in, f)
 	case *GeomPropertyFuncExpr:
 		return VisitRefOfGeomPropertyFuncExpr(in, f)
+	case *Grant:
+		return VisitRefOfGrant(in, f)
 	case *GroupBy:
 		return VisitRefOfGroupBy(in, f)
 	case *GroupConcatExpr:
@@ -2072,6 +2074,23 @@ func VisitRefOfGeomPropertyFuncExpr(in *GeomPropertyFuncExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfGrant(in *Grant, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.Level, f); err != nil {
+		return err
+	}
+	for _, el := range in.Users {
+		if err := VisitRefOfDefiner(el, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 func VisitRefOfGroupBy(in *GroupBy, f Visit) error {
 	if in == nil {
 		return nil
@@ -2911,6 +2930,20 @@ func VisitRefOfLoad(in *Load, f Visit) error {
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
+	if err := VisitTableName(in.Table, f); err != nil {
+		return err
+	}
+	if err := VisitPartitions(in.Partitions, f); err != nil {
+		return err
+	}
+	for _, el := range in.Columns {
+		if err := VisitExpr(el, f); err != nil {
+			return err
+		}
+	}
+	if err := VisitUpdateExprs(in.SetExprs, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitRefOfLocateExpr(in *LocateExpr, f Visit) error {
@@ -5676,6 +5709,8 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfExplainTab(in, f)
 	case *Flush:
 		return VisitRefOfFlush(in, f)
+	case *Grant:
+		return VisitRefOfGrant(in, f)
 	case *Insert:
 		return VisitRefOfInsert(in, f)
 	case *Kill:
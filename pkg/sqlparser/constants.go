@@ -307,6 +307,10 @@ const (
 	WriteStr            = "write"
 	LowPriorityWriteStr = "low_priority write"
 
+	// Load priorities
+	LowPriorityLoadStr = "low_priority "
+	ConcurrentLoadStr  = "concurrent "
+
 	// ShowCommand Types
 	CharsetStr                 = " charset"
 	CollationStr               = " collation"
@@ -361,8 +365,9 @@ const (
 	TrailingTrimStr = "trailing"
 
 	// FrameUnitType strings
-	FrameRowsStr  = "rows"
-	FrameRangeStr = "range"
+	FrameRowsStr   = "rows"
+	FrameRangeStr  = "range"
+	FrameGroupsStr = "groups"
 
 	// FramePointType strings
 	CurrentRowStr         = "current row"
@@ -589,6 +594,7 @@ const (
 const (
 	FrameRowsType FrameUnitType = iota
 	FrameRangeType
+	FrameGroupsType
 )
 
 // Constants for Enum Type - FramePointType
@@ -902,6 +908,13 @@ const (
 	LowPriorityWrite
 )
 
+// LoadPriority constants
+const (
+	NoLoadPriority LoadPriority = iota
+	LowPriorityLoad
+	ConcurrentLoad
+)
+
 // ShowCommandType constants
 const (
 	UnknownCommandType ShowCommandType = iota
@@ -0,0 +1,340 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/vedadiyan/sqlparser/pkg/vthash"
+)
+
+// Canonicalize returns a copy of a statement or an expression in a canonical
+// form, which two nodes that only differ in ways that cannot change their
+// result share:
+//
+//   - the operands of AND, OR, XOR, +, *, &, |, ^, =, != and <=> are sorted, and
+//     chains of them are flattened, so that neither order nor grouping matter;
+//   - the operands of <, <=, > and >= are sorted too, flipping the operator;
+//   - the values of IN lists are sorted and deduplicated;
+//   - table aliases are renamed t1, t2, ... in the order they are declared;
+//   - column and function names are lowercased;
+//   - BETWEEN becomes a pair of range comparisons;
+//   - NOT is pushed down with RewritePredicate and into the comparisons under
+//     it, and RewritePredicate simplifies filters.
+//
+// Redundant parentheses do not survive parsing, except around a single table
+// in a FROM clause, which are removed.
+func Canonicalize(node SQLNode) SQLNode {
+	return CanonicalizeWith(node, nil)
+}
+
+// CanonicalizeWith is Canonicalize, folding constant expressions in filters
+// with folder as RewritePredicateWith does.
+func CanonicalizeWith(node SQLNode, folder ConstantFolder) SQLNode {
+	// Clones share column names, which are renamed below.
+	node = Rewrite(CloneSQLNode(node), nil, func(cursor *Cursor) bool {
+		if col, ok := cursor.Node().(*ColName); ok {
+			copied := *col
+			cursor.Replace(&copied)
+		}
+		return true
+	})
+	renameAliases(node)
+	node = Rewrite(node, nil, func(cursor *Cursor) bool {
+		switch n := cursor.Node().(type) {
+		case *ColName:
+			n.Name = NewIdentifierCI(n.Name.Lowered())
+		case *FuncExpr:
+			n.Name = NewIdentifierCI(n.Name.Lowered())
+		case *ParenTableExpr:
+			if len(n.Exprs) == 1 {
+				cursor.Replace(n.Exprs[0])
+			}
+		case *BetweenExpr:
+			cursor.Replace(rangePair(n))
+		}
+		return true
+	})
+	node = RewritePredicateWith(node, folder)
+	return Rewrite(node, nil, func(cursor *Cursor) bool {
+		switch n := cursor.Node().(type) {
+		case *NotExpr:
+			if expr, ok := negate(n.Expr); ok {
+				cursor.Replace(canonicalExpr(expr))
+			}
+		case Expr:
+			cursor.Replace(canonicalExpr(n))
+		}
+		return true
+	})
+}
+
+// CanonicalHash returns a hash of the canonical form of a node. Nodes that are
+// Equivalent have the same hash.
+func CanonicalHash(node SQLNode) vthash.Hash {
+	return CanonicalHashWith(node, nil)
+}
+
+// CanonicalHashWith returns a hash of the canonical form CanonicalizeWith
+// gives a node with folder.
+func CanonicalHashWith(node SQLNode, folder ConstantFolder) vthash.Hash {
+	h := vthash.New()
+	_, _ = h.WriteString(String(CanonicalizeWith(node, folder)))
+	return h.Sum128()
+}
+
+// Equivalent reports whether two nodes have the same canonical form. Unlike
+// Equals, it does not tell apart a = 1 and b = 2 from b = 2 and a = 1.
+func Equivalent(a, b SQLNode) bool {
+	return EquivalentWith(a, b, nil)
+}
+
+// EquivalentWith reports whether two nodes have the same canonical form with
+// folder, so that a = 1 + 1 and a = 2 are too.
+func EquivalentWith(a, b SQLNode, folder ConstantFolder) bool {
+	return String(CanonicalizeWith(a, folder)) == String(CanonicalizeWith(b, folder))
+}
+
+// rangePair returns the range comparisons a BETWEEN is the same as.
+func rangePair(between *BetweenExpr) Expr {
+	if between.IsBetween {
+		return &AndExpr{
+			Left:  &ComparisonExpr{Operator: GreaterEqualOp, Left: between.Left, Right: between.From},
+			Right: &ComparisonExpr{Operator: LessEqualOp, Left: CloneExpr(between.Left), Right: between.To},
+		}
+	}
+	return &OrExpr{
+		Left:  &ComparisonExpr{Operator: LessThanOp, Left: between.Left, Right: between.From},
+		Right: &ComparisonExpr{Operator: GreaterThanOp, Left: CloneExpr(between.Left), Right: between.To},
+	}
+}
+
+// negate returns the expression NOT expr is the same as, if it has one without
+// NOT. NOT a <=> b has none, since a <=> b is never NULL.
+func negate(expr Expr) (Expr, bool) {
+	switch expr := expr.(type) {
+	case *ComparisonExpr:
+		if expr.Modifier != Missing || expr.Operator == NullSafeEqualOp {
+			return nil, false
+		}
+		return &ComparisonExpr{Operator: expr.Operator.Inverse(), Left: expr.Left, Right: expr.Right, Escape: expr.Escape}, true
+	case *IsExpr:
+		var op IsExprOperator
+		switch expr.Right {
+		case IsNullOp:
+			op = IsNotNullOp
+		case IsNotNullOp:
+			op = IsNullOp
+		case IsTrueOp:
+			op = IsNotTrueOp
+		case IsNotTrueOp:
+			op = IsTrueOp
+		case IsFalseOp:
+			op = IsNotFalseOp
+		case IsNotFalseOp:
+			op = IsFalseOp
+		}
+		return &IsExpr{Left: expr.Left, Right: op}, true
+	}
+	return nil, false
+}
+
+// canonicalExpr sorts the operands of an expression whose operands are already
+// canonical.
+func canonicalExpr(expr Expr) Expr {
+	switch expr := expr.(type) {
+	case *AndExpr:
+		return AndExpressions(sortedOperands(expr, func(e Expr) (Expr, Expr, bool) {
+			if and, ok := e.(*AndExpr); ok {
+				return and.Left, and.Right, true
+			}
+			return nil, nil, false
+		})...)
+	case *OrExpr:
+		operands := sortedOperands(expr, func(e Expr) (Expr, Expr, bool) {
+			if or, ok := e.(*OrExpr); ok {
+				return or.Left, or.Right, true
+			}
+			return nil, nil, false
+		})
+		result := operands[0]
+		for _, operand := range operands[1:] {
+			result = &OrExpr{Left: result, Right: operand}
+		}
+		return result
+	case *XorExpr:
+		if String(expr.Right) < String(expr.Left) {
+			return &XorExpr{Left: expr.Right, Right: expr.Left}
+		}
+	case *BinaryExpr:
+		switch expr.Operator {
+		case PlusOp, MultOp, BitAndOp, BitOrOp, BitXorOp:
+		default:
+			return expr
+		}
+		// Duplicates are kept, since a + a is not a.
+		var operands []Expr
+		var flatten func(e Expr)
+		flatten = func(e Expr) {
+			if bin, ok := e.(*BinaryExpr); ok && bin.Operator == expr.Operator {
+				flatten(bin.Left)
+				flatten(bin.Right)
+				return
+			}
+			operands = append(operands, e)
+		}
+		flatten(expr)
+		sortExprs(operands)
+		result := operands[0]
+		for _, operand := range operands[1:] {
+			result = &BinaryExpr{Operator: expr.Operator, Left: result, Right: operand}
+		}
+		return result
+	case *ComparisonExpr:
+		if expr.Modifier != Missing {
+			return expr
+		}
+		switch expr.Operator {
+		case InOp, NotInOp:
+			tuple, ok := expr.Right.(ValTuple)
+			if !ok {
+				return expr
+			}
+			values := slices.Clone(tuple)
+			sortExprs(values)
+			values = slices.CompactFunc(values, func(a, b Expr) bool { return String(a) == String(b) })
+			return &ComparisonExpr{Operator: expr.Operator, Left: expr.Left, Right: ValTuple(values)}
+		}
+		op, ok := expr.Operator.SwitchSides()
+		if ok && String(expr.Right) < String(expr.Left) {
+			return &ComparisonExpr{Operator: op, Left: expr.Right, Right: expr.Left}
+		}
+	}
+	return expr
+}
+
+// sortedOperands returns the operands of a chain of an associative,
+// commutative and idempotent operator, sorted and without duplicates.
+func sortedOperands(expr Expr, split func(Expr) (Expr, Expr, bool)) []Expr {
+	var operands []Expr
+	var flatten func(e Expr)
+	flatten = func(e Expr) {
+		if left, right, ok := split(e); ok {
+			flatten(left)
+			flatten(right)
+			return
+		}
+		operands = append(operands, e)
+	}
+	flatten(expr)
+	sortExprs(operands)
+	return slices.CompactFunc(operands, func(a, b Expr) bool { return String(a) == String(b) })
+}
+
+func sortExprs(exprs []Expr) {
+	slices.SortStableFunc(exprs, func(a, b Expr) int {
+		sa, sb := String(a), String(b)
+		switch {
+		case sa < sb:
+			return -1
+		case sa > sb:
+			return 1
+		}
+		return 0
+	})
+}
+
+// renameAliases renames the table aliases of the queries under node t1, t2,
+// ... in the order they are declared, skipping the names of tables, and
+// renames the columns and stars qualified with them.
+func renameAliases(node SQLNode) {
+	r := &aliasRenamer{taken: map[string]bool{}}
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if name, ok := node.(TableName); ok {
+			r.taken[name.Name.String()] = true
+		}
+		return true, nil
+	}, node)
+	_ = Walk(func(node SQLNode) (bool, error) {
+		if sel, ok := node.(*Select); ok {
+			r.scope(sel, nil)
+			return false, nil
+		}
+		return true, nil
+	}, node)
+}
+
+type aliasRenamer struct {
+	taken map[string]bool
+	next  int
+}
+
+func (r *aliasRenamer) name() string {
+	for {
+		r.next++
+		name := "t" + strconv.Itoa(r.next)
+		if !r.taken[name] {
+			return name
+		}
+	}
+}
+
+// scope renames the aliases a SELECT declares, and the references to them and
+// to the aliases of the queries it is in.
+func (r *aliasRenamer) scope(sel *Select, outer map[string]string) {
+	aliases := map[string]string{}
+	for alias, name := range outer {
+		aliases[alias] = name
+	}
+	for _, expr := range sel.From {
+		_ = Walk(func(node SQLNode) (bool, error) {
+			switch node := node.(type) {
+			case *AliasedTableExpr:
+				if node.As.NotEmpty() {
+					name := r.name()
+					aliases[node.As.String()] = name
+					node.As = NewIdentifierCS(name)
+				}
+			case *Subquery, *DerivedTable:
+				return false, nil
+			}
+			return true, nil
+		}, expr)
+	}
+	rename := func(table *TableName) {
+		if table.Qualifier.IsEmpty() {
+			if name, ok := aliases[table.Name.String()]; ok {
+				table.Name = NewIdentifierCS(name)
+			}
+		}
+	}
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *Select:
+			if node != sel {
+				r.scope(node, aliases)
+				return false, nil
+			}
+		case *ColName:
+			rename(&node.Qualifier)
+		case *StarExpr:
+			rename(&node.TableName)
+		}
+		return true, nil
+	}, sel)
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+// The parser is generated from the grammar in sql.y.
+//go:generate go run ../tools/goyacc -v "" -o sql.go sql.y
+
+// The AST helpers are generated from the declarations in ast.go, and the fast
+// formatting code from the Format methods in ast_format.go. Running the generator
+// with -verify checks that the committed files are up to date.
+//go:generate go run ../tools/asthelpergen/main -in . -iface SQLNode -equals-custom *ColName -clone-exclude *ColName
+
+// The protobuf schema asthelpergen writes to ../proto/ast.proto is compiled with
+// protoc 25.1 and the plugins at the versions go.mod requires: protoc-gen-go v1.33.0
+// and protoc-gen-go-vtproto v0.6.0. The plugins are built into ../../bin, so that
+// protoc does not pick up other versions from the PATH.
+//go:generate sh -c "protoc --version | grep -qx 'libprotoc 25.1' || { echo 'protoc 25.1 is required' >&2; exit 1; }"
+//go:generate go build -o ../../bin/protoc-gen-go google.golang.org/protobuf/cmd/protoc-gen-go
+//go:generate go build -o ../../bin/protoc-gen-go-vtproto github.com/planetscale/vtprotobuf/cmd/protoc-gen-go-vtproto
+//go:generate protoc --plugin=../../bin/protoc-gen-go --plugin=../../bin/protoc-gen-go-vtproto -I ../proto --go_out=../.. --go_opt=module=github.com/vedadiyan/sqlparser --go-vtproto_out=../.. --go-vtproto_opt=module=github.com/vedadiyan/sqlparser,features=marshal+unmarshal+size+clone ast.proto
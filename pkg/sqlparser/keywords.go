@@ -184,6 +184,7 @@ var keywords = []keyword{
 	{"complete", COMPLETE},
 	{"compressed", COMPRESSED},
 	{"compression", COMPRESSION},
+	{"concurrent", CONCURRENT},
 	{"condition", CONDITION},
 	{"connection", CONNECTION},
 	{"consistent", CONSISTENT},
@@ -317,10 +318,10 @@ var keywords = []keyword{
 	{"gtid_executed", GTID_EXECUTED},
 	{"gtid_subset", GTID_SUBSET},
 	{"gtid_subtract", GTID_SUBTRACT},
-	{"grant", UNUSED},
+	{"grant", GRANT},
 	{"group", GROUP},
 	{"grouping", UNUSED},
-	{"groups", UNUSED},
+	{"groups", GROUPS},
 	{"group_concat", GROUP_CONCAT},
 	{"handler", HANDLER},
 	{"hash", HASH},
@@ -338,7 +339,7 @@ var keywords = []keyword{
 	{"in", IN},
 	{"index", INDEX},
 	{"indexes", INDEXES},
-	{"infile", UNUSED},
+	{"infile", INFILE},
 	{"inout", INOUT},
 	{"inner", INNER},
 	{"inplace", INPLACE},
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
 )
 
 var (
@@ -48,3 +50,40 @@ func LikeToRegexp(likeExpr string) *regexp.Regexp {
 	keyPattern = fmt.Sprintf("^%s$", keyPattern)
 	return regexp.MustCompile(keyPattern) // Can never fail
 }
+
+// LikeToRegexpCollation converts a like sql expression to a regular expression
+// that matches the strings it matches in a collation: each character matches
+// the characters that are equal to it in the collation, % and _ match any
+// sequence of characters and any character, and the escape character makes
+// the character after it match itself. An escape of -1 means there is none.
+func LikeToRegexpCollation(likeExpr string, escape rune, col *collations.Collation) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("(?s)^")
+	pattern := []rune(likeExpr)
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == escape && i+1 < len(pattern):
+			i++
+			c = pattern[i]
+		case c == '%':
+			b.WriteString(".*")
+			continue
+		case c == '_':
+			b.WriteString(".")
+			continue
+		}
+		equivalents := col.Equivalents(c)
+		if len(equivalents) == 1 {
+			b.WriteString(regexp.QuoteMeta(string(c)))
+			continue
+		}
+		b.WriteByte('[')
+		for _, e := range equivalents {
+			fmt.Fprintf(&b, `\x{%x}`, e)
+		}
+		b.WriteByte(']')
+	}
+	b.WriteByte('$')
+	return regexp.MustCompile(b.String()) // Can never fail
+}
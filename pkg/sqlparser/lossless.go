@@ -0,0 +1,266 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import "strings"
+
+// Token is a token of the original query text.
+type Token struct {
+	// Type is the token type as known by the parser, e.g. SELECT or ID.
+	Type int
+	// Text is the token exactly as it was written.
+	Text string
+	// Trivia holds the whitespace and comments written before the token.
+	Trivia string
+	// Offset is the byte offset of the token in the query.
+	Offset int
+}
+
+// SourceTree remembers the original text of every node of a statement parsed with
+// ParseLossless. It is the concrete syntax counterpart of the AST: the AST can be
+// changed freely, through Rewrite or by hand, and String will print the untouched
+// parts of the statement exactly as they were written.
+type SourceTree struct {
+	sm    *sourceMap
+	nodes map[SQLNode]*sourceNode
+}
+
+// sourceNode is what a SourceTree knows about a node as it was parsed.
+type sourceNode struct {
+	span nodeSpan
+
+	// shallow is the formatted text of the node with its children left out, and
+	// children the nodes that were left out, in the order they were printed.
+	shallow  string
+	children []SQLNode
+
+	// spliceable is set when the children appear in the source in the same order
+	// they are printed in, so the text between them can be kept around.
+	spliceable bool
+}
+
+// ParseLossless behaves like Parse, but it also returns a SourceTree that keeps the
+// tokens, whitespace and comments that make up every node of the statement.
+func (p *Parser) ParseLossless(sql string) (Statement, *SourceTree, error) {
+	stmt, err := p.Parse(sql)
+	if err != nil {
+		return nil, nil, err
+	}
+	sm, err := p.newSourceMap(sql, stmt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	st := &SourceTree{
+		sm:    sm,
+		nodes: make(map[SQLNode]*sourceNode, len(sm.spans)),
+	}
+	for _, span := range sm.spans {
+		sn := &sourceNode{span: span}
+		sn.shallow, sn.children = shallowText(span.node)
+		sn.spliceable = true
+		prevEnd := span.start - 1
+		for _, child := range sn.children {
+			cs, ok := sm.span(child)
+			if !ok || cs.start <= prevEnd || cs.end > span.end {
+				sn.spliceable = false
+				break
+			}
+			prevEnd = cs.end
+		}
+		st.nodes[span.node] = sn
+	}
+	return stmt, st, nil
+}
+
+// Source returns the text node was parsed from. It returns false for nodes that
+// were not part of the parsed statement.
+func (st *SourceTree) Source(node SQLNode) (string, bool) {
+	sn, ok := st.lookup(node)
+	if !ok {
+		return "", false
+	}
+	return st.text(sn.span.start, sn.span.end), true
+}
+
+// Offset returns the byte offset in the query of the first token of node. It
+// returns false for nodes that were not part of the parsed statement.
+func (st *SourceTree) Offset(node SQLNode) (int, bool) {
+	sn, ok := st.lookup(node)
+	if !ok {
+		return 0, false
+	}
+	return st.sm.tokens[sn.span.start].start, true
+}
+
+// Tokens returns the tokens of the original query that node was parsed from.
+// Comments that are not part of the AST are returned as trivia of the token
+// that follows them.
+func (st *SourceTree) Tokens(node SQLNode) []Token {
+	sn, ok := st.lookup(node)
+	if !ok {
+		return nil
+	}
+	var tokens []Token
+	triviaStart := 0
+	if sn.span.start > 0 {
+		triviaStart = st.sm.tokens[sn.span.start-1].end
+	}
+	for i := sn.span.start; i <= sn.span.end; i++ {
+		tok := st.sm.tokens[i]
+		if st.isTrivia(i) {
+			continue
+		}
+		tokens = append(tokens, Token{
+			Type:   tok.typ,
+			Text:   st.sm.sql[tok.start:tok.end],
+			Trivia: st.sm.sql[triviaStart:tok.start],
+			Offset: tok.start,
+		})
+		triviaStart = tok.end
+	}
+	return tokens
+}
+
+// String prints node, reproducing the original text for every part of it that was
+// not changed since it was parsed. Changed nodes are printed with the regular
+// formatter, while the text around their unchanged children is kept.
+func (st *SourceTree) String(node SQLNode) string {
+	if node == nil {
+		return "<nil>"
+	}
+	buf := NewTrackedBuffer(st.format)
+	st.format(buf, node)
+	return buf.String()
+}
+
+func (st *SourceTree) format(buf *TrackedBuffer, node SQLNode) {
+	sn, ok := st.lookup(node)
+	if !ok {
+		node.Format(buf)
+		return
+	}
+	if !st.changed(node, sn) {
+		buf.WriteString(st.text(sn.span.start, sn.span.end))
+		return
+	}
+	if sn.spliceable {
+		shallow, children := shallowText(node)
+		if shallow == sn.shallow {
+			st.splice(buf, node, sn, children)
+			return
+		}
+	}
+	node.Format(buf)
+}
+
+// splice prints node using its original text, with the text of each of its original
+// children replaced by the current child at the same position.
+func (st *SourceTree) splice(buf *TrackedBuffer, node SQLNode, sn *sourceNode, children []SQLNode) {
+	pos := st.sm.tokens[sn.span.start].start
+	for i, child := range children {
+		cs, _ := st.sm.span(sn.children[i])
+		buf.WriteString(st.sm.sql[pos:st.sm.tokens[cs.start].start])
+
+		parens := false
+		if op, isExpr := node.(Expr); isExpr {
+			if val, isExpr := child.(Expr); isExpr && st.changedAt(child, cs) {
+				parens = needParens(op, val, false) && !st.parenthesized(cs)
+			}
+		}
+		if parens {
+			buf.WriteByte('(')
+		}
+		st.format(buf, child)
+		if parens {
+			buf.WriteByte(')')
+		}
+		pos = st.sm.tokens[cs.end].end
+	}
+	buf.WriteString(st.sm.sql[pos:st.sm.tokens[sn.span.end].end])
+}
+
+func (st *SourceTree) lookup(node SQLNode) (*sourceNode, bool) {
+	if !isRefNode(node) {
+		return nil, false
+	}
+	sn, ok := st.nodes[node]
+	return sn, ok
+}
+
+// changed returns whether node no longer formats the way it did when it was parsed.
+func (st *SourceTree) changed(node SQLNode, sn *sourceNode) bool {
+	return st.changedAt(node, sn.span)
+}
+
+// changedAt returns whether node would not print the way the node at span was printed.
+func (st *SourceTree) changedAt(node SQLNode, span nodeSpan) bool {
+	return canonicalText(node) != st.sm.formattedText(span)
+}
+
+// parenthesized returns whether the tokens right around span are a pair of parens.
+func (st *SourceTree) parenthesized(span nodeSpan) bool {
+	before, after := span.start-1, span.end+1
+	for before >= 0 && st.isTrivia(before) {
+		before--
+	}
+	for after < len(st.sm.tokens) && st.isTrivia(after) {
+		after++
+	}
+	return before >= 0 && after < len(st.sm.tokens) &&
+		st.sm.tokens[before].typ == '(' && st.sm.tokens[after].typ == ')'
+}
+
+// isTrivia returns whether the token at idx is a comment that is not part of the AST.
+func (st *SourceTree) isTrivia(idx int) bool {
+	return st.sm.tokens[idx].isComment() && !st.sm.aligned[idx]
+}
+
+func (st *SourceTree) text(start, end int) string {
+	return st.sm.sql[st.sm.tokens[start].start:st.sm.tokens[end].end]
+}
+
+// formatSlow formats node through Format rather than FormatFast, so that every
+// child goes through the buffer's node formatter.
+func formatSlow(buf *TrackedBuffer, node SQLNode) {
+	node.Format(buf)
+}
+
+// canonicalText returns the text of node as printed by the formatter.
+func canonicalText(node SQLNode) string {
+	buf := NewTrackedBuffer(formatSlow)
+	formatSlow(buf, node)
+	return buf.String()
+}
+
+// shallowText formats node with a placeholder in place of every child that is a
+// pointer node, and returns those children in the order they were printed. The
+// parens added around children depend on the children themselves, so they are
+// left out as well.
+func shallowText(node SQLNode) (string, []SQLNode) {
+	var children []SQLNode
+	buf := NewTrackedBuffer(func(buf *TrackedBuffer, child SQLNode) {
+		if isRefNode(child) {
+			children = append(children, child)
+			buf.WriteByte(0)
+			return
+		}
+		child.Format(buf)
+	})
+	node.Format(buf)
+	return strings.ReplaceAll(buf.String(), "(\x00)", "\x00"), children
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"strings"
+)
+
+// NodeComments holds the free-form comments of a query, keyed by the AST node they
+// were attached to. Comments that the grammar already keeps in ParsedComments are
+// not part of it.
+type NodeComments struct {
+	leading  map[SQLNode][]string
+	trailing map[SQLNode][]string
+}
+
+// Leading returns the comments printed right before node.
+func (nc *NodeComments) Leading(node SQLNode) []string {
+	if nc == nil || !isRefNode(node) {
+		return nil
+	}
+	return nc.leading[node]
+}
+
+// Trailing returns the comments printed right after node.
+func (nc *NodeComments) Trailing(node SQLNode) []string {
+	if nc == nil || !isRefNode(node) {
+		return nil
+	}
+	return nc.trailing[node]
+}
+
+// Len returns the number of comments attached to nodes.
+func (nc *NodeComments) Len() int {
+	if nc == nil {
+		return 0
+	}
+	count := 0
+	for _, c := range nc.leading {
+		count += len(c)
+	}
+	for _, c := range nc.trailing {
+		count += len(c)
+	}
+	return count
+}
+
+// AddLeading attaches a comment before node. It can be used by rewriters to keep
+// comments around when they replace a node.
+func (nc *NodeComments) AddLeading(node SQLNode, comment string) {
+	if !isRefNode(node) {
+		return
+	}
+	nc.leading[node] = append(nc.leading[node], comment)
+}
+
+// AddTrailing attaches a comment after node.
+func (nc *NodeComments) AddTrailing(node SQLNode, comment string) {
+	if !isRefNode(node) {
+		return
+	}
+	nc.trailing[node] = append(nc.trailing[node], comment)
+}
+
+// writeLeading formats node with its comments. Nodes that print the space separating
+// them from what comes before, like " where ...", keep it ahead of the comments.
+func (nc *NodeComments) writeLeading(buf *TrackedBuffer, node SQLNode) {
+	outer, locations := buf.Builder, len(buf.bindLocations)
+	buf.Builder = new(strings.Builder)
+	buf.formatNode(node)
+	out := buf.String()
+	buf.Builder = outer
+
+	skip := 0
+	if strings.HasPrefix(out, " ") {
+		buf.WriteByte(' ')
+		skip = 1
+	}
+	for _, c := range nc.Leading(node) {
+		buf.WriteString(c)
+		if isLineComment(c) {
+			if !strings.HasSuffix(c, "\n") {
+				buf.WriteByte('\n')
+			}
+		} else {
+			buf.WriteByte(' ')
+		}
+	}
+	for i := locations; i < len(buf.bindLocations); i++ {
+		buf.bindLocations[i].Offset += buf.Len() - skip
+	}
+	buf.WriteString(out[skip:])
+	nc.writeTrailing(buf, node)
+}
+
+// writeTrailing writes the comments after node, each one preceded by a single space.
+func (nc *NodeComments) writeTrailing(buf *TrackedBuffer, node SQLNode) {
+	for _, c := range nc.Trailing(node) {
+		if buf.Len() > 0 && !strings.HasSuffix(buf.String(), " ") && !strings.HasSuffix(buf.String(), "\n") {
+			buf.WriteByte(' ')
+		}
+		buf.WriteString(c)
+		if isLineComment(c) && !strings.HasSuffix(c, "\n") {
+			buf.WriteByte('\n')
+		}
+	}
+}
+
+func isLineComment(comment string) bool {
+	return !strings.HasPrefix(comment, "/*")
+}
+
+// isFreeFormComment returns whether the comment carries no meaning for the server.
+// MySQL specific comments (/*! ... */) are executable and are parsed as part of the query.
+func isFreeFormComment(comment string) bool {
+	return !strings.HasPrefix(comment, "/*!")
+}
+
+// ParseWithComments behaves like Parse, but it also returns the comments found inside
+// the query that the AST has no place for, each attached to the node nearest to it.
+// A comment on the same line as the token before it is attached after the node that
+// ends with that token; any other comment is attached before the node that starts with
+// the token following it. Block comments sharing a line with both tokens go after the
+// node ending with the token before them, if there is one, and before the following
+// node otherwise. Use StringWithComments to format the statement back.
+func (p *Parser) ParseWithComments(sql string) (Statement, *NodeComments, error) {
+	stmt, err := p.Parse(sql)
+	if err != nil {
+		return nil, nil, err
+	}
+	sm, err := p.newSourceMap(sql, stmt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stmt, sm.attachComments(stmt), nil
+}
+
+// attachComments distributes the comments of the source that did not make it into
+// the formatted query among the nodes of the tree.
+func (sm *sourceMap) attachComments(root SQLNode) *NodeComments {
+	nc := &NodeComments{
+		leading:  make(map[SQLNode][]string),
+		trailing: make(map[SQLNode][]string),
+	}
+
+	// innermost node starting and ending at every token; spans are ordered innermost first
+	startsAt := make(map[int]SQLNode)
+	endsAt := make(map[int]SQLNode)
+	for _, s := range sm.spans {
+		if _, ok := startsAt[s.start]; !ok {
+			startsAt[s.start] = s.node
+		}
+		if _, ok := endsAt[s.end]; !ok {
+			endsAt[s.end] = s.node
+		}
+	}
+
+	before := func(idx int) SQLNode {
+		for i := idx - 1; i >= 0; i-- {
+			if node, ok := endsAt[i]; ok {
+				return node
+			}
+		}
+		return nil
+	}
+	after := func(idx int) SQLNode {
+		for i := idx + 1; i < len(sm.tokens); i++ {
+			if node, ok := startsAt[i]; ok {
+				return node
+			}
+		}
+		return nil
+	}
+
+	for i, tok := range sm.tokens {
+		if !tok.isComment() || sm.aligned[i] || !isFreeFormComment(tok.val) {
+			continue
+		}
+		prev := i - 1
+		for prev >= 0 && sm.tokens[prev].isComment() {
+			prev--
+		}
+		next := i + 1
+		for next < len(sm.tokens) && sm.tokens[next].isComment() {
+			next++
+		}
+		// a comment trails the previous node when it shares its line; a block comment
+		// followed by more of the line only does when the node ends right before it, so
+		// that "a /* c */ + b" keeps the comment next to a while "where /* c */ a" does
+		// not move it in front of the keyword
+		sameLine := prev >= 0 && !strings.Contains(sm.sql[sm.tokens[prev].end:tok.start], "\n")
+		if sameLine && !isLineComment(tok.val) && next < len(sm.tokens) &&
+			!strings.Contains(sm.sql[tok.end:sm.tokens[next].start], "\n") {
+			if node, ok := endsAt[prev]; ok {
+				nc.AddTrailing(node, tok.val)
+				continue
+			}
+			sameLine = false
+		}
+
+		if sameLine {
+			if node := before(i); node != nil {
+				nc.AddTrailing(node, tok.val)
+				continue
+			}
+		}
+		if node := after(i); node != nil {
+			nc.AddLeading(node, tok.val)
+			continue
+		}
+		if node := before(i); node != nil {
+			nc.AddTrailing(node, tok.val)
+			continue
+		}
+		nc.AddTrailing(root, tok.val)
+	}
+	return nc
+}
@@ -0,0 +1,488 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"io"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/mysql/decimal"
+)
+
+// ConstantFolder evaluates an expression whose operands are all literals and
+// returns its value as a literal. It returns false when the expression cannot
+// be evaluated, or not without raising an error or a warning.
+//
+// Evaluating expressions is left to the evalengine package, whose FoldConstant
+// is one.
+type ConstantFolder func(expr Expr) (Expr, bool)
+
+// nondeterministicFunctions are the functions whose result is not decided by
+// their arguments alone, because it is random or depends on the time, the
+// session or the server.
+var nondeterministicFunctions = map[string]bool{
+	"rand":              true,
+	"random_bytes":      true,
+	"uuid":              true,
+	"uuid_short":        true,
+	"now":               true,
+	"sysdate":           true,
+	"current_timestamp": true,
+	"localtime":         true,
+	"localtimestamp":    true,
+	"curdate":           true,
+	"current_date":      true,
+	"curtime":           true,
+	"current_time":      true,
+	"utc_date":          true,
+	"utc_time":          true,
+	"utc_timestamp":     true,
+	"unix_timestamp":    true,
+	"from_unixtime":     true,
+	"connection_id":     true,
+	"current_user":      true,
+	"session_user":      true,
+	"system_user":       true,
+	"user":              true,
+	"database":          true,
+	"schema":            true,
+	"last_insert_id":    true,
+	"found_rows":        true,
+	"row_count":         true,
+	"sleep":             true,
+	"benchmark":         true,
+	"get_lock":          true,
+	"release_lock":      true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+	"release_all_locks": true,
+}
+
+// isConstant reports whether an expression can be folded: whether it is not
+// already a literal, all its operands are literals and it calls no function
+// whose result can change from one evaluation to the next. Expressions that
+// give a string a collation or a character set are not folded either, since a
+// literal has neither: 'x' COLLATE utf8mb4_bin would compare as 'x' does.
+func isConstant(expr Expr) bool {
+	switch expr.(type) {
+	case *Literal, BoolVal, *NullVal, ValTuple:
+		return false
+	}
+	constant := true
+	_ = Walk(func(node SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *ColName, *Argument, ListArg, *Subquery, *Variable, *Offset, *Default, *ValuesFuncExpr, AggrFunc, *CurTimeFuncExpr:
+			constant = false
+		case *FuncExpr:
+			constant = !nondeterministicFunctions[node.Name.Lowered()]
+		case *CollateExpr, *ConvertUsingExpr:
+			constant = false
+		case *IntroducerExpr:
+			constant = strings.EqualFold(node.CharacterSet, "_binary")
+		case *CastExpr:
+			constant = !hasCharset(node.Type)
+		case *ConvertExpr:
+			constant = !hasCharset(node.Type)
+		}
+		if !constant {
+			return false, io.EOF
+		}
+		return true, nil
+	}, expr)
+	return constant
+}
+
+// hasCharset reports whether a CAST or a CONVERT type names a character set or
+// a binary collation.
+func hasCharset(typ *ConvertType) bool {
+	return typ != nil && (typ.Charset.Name != "" || typ.Charset.Binary)
+}
+
+// foldConstants replaces the expressions that only have literals as operands
+// with their values in the predicates that filter rows, in WHERE, HAVING and ON
+// clauses, or in the node itself when it is an expression. The select list and
+// the values of INSERT and SET are left as they are written, since folding
+// changes the names of their columns and what the statement looks like.
+func foldConstants(node SQLNode, folder ConstantFolder) SQLNode {
+	if folder == nil {
+		return node
+	}
+	if expr, ok := node.(Expr); ok {
+		return foldExpr(expr, folder)
+	}
+	return SafeRewrite(node, nil, func(cursor *Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *Where:
+			node.Expr = foldExpr(node.Expr, folder)
+		case *JoinCondition:
+			if node.On != nil {
+				node.On = foldExpr(node.On, folder)
+			}
+		}
+		return true
+	})
+}
+
+// foldExpr folds the constant expressions of expr. The predicates of the
+// subqueries in it are folded on their own, and expressions of ORDER BY and
+// GROUP BY are left alone, where an integer would refer to a column by
+// position.
+func foldExpr(expr Expr, folder ConstantFolder) Expr {
+	foldable := func(node, parent SQLNode) bool {
+		switch parent.(type) {
+		case *Order, *GroupBy:
+			return false
+		}
+		expr, ok := node.(Expr)
+		return ok && isConstant(expr)
+	}
+	return SafeRewrite(expr, func(node, parent SQLNode) bool {
+		if _, ok := node.(*Subquery); ok {
+			return false
+		}
+		return !foldable(node, parent)
+	}, func(cursor *Cursor) bool {
+		if !foldable(cursor.Node(), cursor.Parent()) {
+			return true
+		}
+		if folded, ok := folder(cursor.Node().(Expr)); ok {
+			cursor.Replace(folded)
+		}
+		return true
+	}).(Expr)
+}
+
+// isBoolean reports whether an expression is always TRUE, FALSE or NULL, so
+// that x AND TRUE is x.
+func isBoolean(expr Expr) bool {
+	switch expr.(type) {
+	case *ComparisonExpr, *AndExpr, *OrExpr, *XorExpr, *NotExpr, *IsExpr, *BetweenExpr, *ExistsExpr, BoolVal:
+		return true
+	}
+	return false
+}
+
+// simplifyFilters simplifies the predicates that filter rows, in WHERE,
+// HAVING and ON clauses. An expression on its own is left alone, since it may
+// be a value, which is NULL where a filter would be FALSE.
+func simplifyFilters(node SQLNode) SQLNode {
+	if _, ok := node.(Expr); ok {
+		return node
+	}
+	return SafeRewrite(node, nil, func(cursor *Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *Where:
+			node.Expr = simplifyFilter(node.Expr)
+		case *JoinCondition:
+			if node.On != nil {
+				node.On = simplifyFilter(node.On)
+			}
+		}
+		return true
+	})
+}
+
+// simplifyFilter simplifies a predicate that filters rows, which keeps the
+// rows it is TRUE for and makes no difference between FALSE and NULL. Through
+// AND and OR, neither do its operands, so that:
+//
+//   - TRUE operands of AND and FALSE or NULL operands of OR are dropped, and
+//     AND is FALSE with an operand that is FALSE or NULL, and OR is TRUE with
+//     an operand that is TRUE;
+//   - AND is FALSE when the ranges its operands compare a column to do not
+//     overlap, as in x > 5 AND x < 3;
+//   - the operands of OR that compare a column to ranges covering every number
+//     become x IS NOT NULL, as x > 5 OR x <= 5 does;
+//   - a comparison on its own is FALSE or x IS NOT NULL in the same cases, as
+//     x BETWEEN 5 AND 3 and x NOT IN (1, 2) OR x IN (1, 2) are.
+func simplifyFilter(expr Expr) Expr {
+	switch expr := expr.(type) {
+	case *AndExpr:
+		var operands []Expr
+		for _, operand := range SplitAndExpression(nil, expr) {
+			operand = simplifyFilter(operand)
+			switch {
+			case operand == BoolVal(true):
+				continue
+			case operand == BoolVal(false), IsNull(operand):
+				return BoolVal(false)
+			}
+			operands = append(operands, operand)
+		}
+		if len(operands) == 0 {
+			return BoolVal(true)
+		}
+		for _, ranges := range columnRanges(operands, true) {
+			if ranges.set.isEmpty() {
+				return BoolVal(false)
+			}
+		}
+		return AndExpressions(operands...)
+	case *OrExpr:
+		var operands []Expr
+		for _, operand := range orOperands(expr) {
+			operand = simplifyFilter(operand)
+			switch {
+			case operand == BoolVal(false), IsNull(operand):
+				continue
+			case operand == BoolVal(true):
+				return BoolVal(true)
+			}
+			operands = append(operands, operand)
+		}
+		if len(operands) == 0 {
+			return BoolVal(false)
+		}
+		for _, ranges := range columnRanges(operands, false) {
+			if !ranges.set.complement().isEmpty() {
+				continue
+			}
+			notNull := &IsExpr{Left: ranges.col, Right: IsNotNullOp}
+			kept := operands[:0]
+			for i, operand := range operands {
+				switch {
+				case i == ranges.operands[0]:
+					kept = append(kept, notNull)
+				case !slices.Contains(ranges.operands, i):
+					kept = append(kept, operand)
+				}
+			}
+			operands = kept
+			break
+		}
+		result := operands[0]
+		for _, operand := range operands[1:] {
+			result = &OrExpr{Left: result, Right: operand}
+		}
+		return result
+	}
+	if col, set, ok := comparisonRange(expr); ok {
+		switch {
+		case set.isEmpty():
+			return BoolVal(false)
+		case set.complement().isEmpty():
+			return &IsExpr{Left: col, Right: IsNotNullOp}
+		}
+	}
+	return expr
+}
+
+func orOperands(expr Expr) []Expr {
+	if or, ok := expr.(*OrExpr); ok {
+		return append(orOperands(or.Left), orOperands(or.Right)...)
+	}
+	return []Expr{expr}
+}
+
+// columnRange is the set of numbers some operands of AND or OR compare a
+// column to be in.
+type columnRange struct {
+	col      *ColName
+	set      rangeSet
+	operands []int
+}
+
+// columnRanges returns, for each column that operands of AND or OR compare to
+// numbers, the set of numbers they compare it to be in: the intersection of
+// the sets of the operands for AND and their union for OR.
+func columnRanges(operands []Expr, and bool) []*columnRange {
+	var ranges []*columnRange
+outer:
+	for i, operand := range operands {
+		col, set, ok := comparisonRange(operand)
+		if !ok {
+			continue
+		}
+		for _, r := range ranges {
+			if !Equals.Expr(r.col, col) {
+				continue
+			}
+			if and {
+				r.set = r.set.intersect(set)
+			} else {
+				r.set = r.set.complement().intersect(set.complement()).complement()
+			}
+			r.operands = append(r.operands, i)
+			continue outer
+		}
+		ranges = append(ranges, &columnRange{col: col, set: set, operands: []int{i}})
+	}
+	return ranges
+}
+
+// comparisonRange returns the column a predicate compares to numbers and the
+// set of numbers the predicate is TRUE for.
+func comparisonRange(expr Expr) (*ColName, rangeSet, bool) {
+	switch expr := expr.(type) {
+	case *ComparisonExpr:
+		if expr.Modifier != Missing {
+			return nil, nil, false
+		}
+		col, value, op := expr.Left, expr.Right, expr.Operator
+		if _, ok := col.(*ColName); !ok {
+			switched, ok := op.SwitchSides()
+			if !ok {
+				return nil, nil, false
+			}
+			col, value, op = value, col, switched
+		}
+		column, ok := col.(*ColName)
+		if !ok {
+			return nil, nil, false
+		}
+		switch op {
+		case InOp, NotInOp:
+			tuple, ok := value.(ValTuple)
+			if !ok {
+				return nil, nil, false
+			}
+			var set rangeSet
+			for _, e := range tuple {
+				n, ok := rangeNumber(e)
+				if !ok {
+					return nil, nil, false
+				}
+				set = set.complement().intersect(rangeSet{{lo: n, hi: n}}.complement()).complement()
+			}
+			if op == NotInOp {
+				set = set.complement()
+			}
+			return column, set, true
+		}
+		n, ok := rangeNumber(value)
+		if !ok {
+			return nil, nil, false
+		}
+		inf := math.Inf(1)
+		switch op {
+		case EqualOp, NullSafeEqualOp:
+			return column, rangeSet{{lo: n, hi: n}}, true
+		case NotEqualOp:
+			return column, rangeSet{{lo: n, hi: n}}.complement(), true
+		case LessThanOp:
+			return column, rangeSet{{lo: -inf, hi: n, loOpen: true, hiOpen: true}}, true
+		case LessEqualOp:
+			return column, rangeSet{{lo: -inf, hi: n, loOpen: true}}, true
+		case GreaterThanOp:
+			return column, rangeSet{{lo: n, hi: inf, loOpen: true, hiOpen: true}}, true
+		case GreaterEqualOp:
+			return column, rangeSet{{lo: n, hi: inf, hiOpen: true}}, true
+		}
+	case *BetweenExpr:
+		column, ok := expr.Left.(*ColName)
+		if !ok {
+			return nil, nil, false
+		}
+		from, ok := rangeNumber(expr.From)
+		if !ok {
+			return nil, nil, false
+		}
+		to, ok := rangeNumber(expr.To)
+		if !ok {
+			return nil, nil, false
+		}
+		set := rangeSet{{lo: from, hi: to}}
+		if set[0].isEmpty() {
+			set = nil
+		}
+		if !expr.IsBetween {
+			set = set.complement()
+		}
+		return column, set, true
+	}
+	return nil, nil, false
+}
+
+// rangeNumber returns the number a literal is, when it is a number a double
+// holds exactly. Comparisons of columns to numbers are exact, or in doubles
+// for columns that are strings, and both agree on such numbers.
+func rangeNumber(expr Expr) (float64, bool) {
+	lit, ok := expr.(*Literal)
+	if !ok {
+		return 0, false
+	}
+	switch lit.Type {
+	case IntVal, DecimalVal, FloatVal:
+	default:
+		return 0, false
+	}
+	dec, err := decimal.NewFromString(lit.Val)
+	if err != nil {
+		return 0, false
+	}
+	f, ok := dec.Float64()
+	if !ok || decimal.NewFromFloat(f).Cmp(dec) != 0 {
+		return 0, false
+	}
+	return f, true
+}
+
+// numberRange is an interval of numbers, from lo to hi, which it includes
+// unless loOpen or hiOpen is set. Infinite ends are open.
+type numberRange struct {
+	lo, hi         float64
+	loOpen, hiOpen bool
+}
+
+func (r numberRange) isEmpty() bool {
+	return r.lo > r.hi || r.lo == r.hi && (r.loOpen || r.hiOpen)
+}
+
+// rangeSet is a set of numbers made of disjoint intervals in ascending order.
+type rangeSet []numberRange
+
+func (s rangeSet) isEmpty() bool {
+	return len(s) == 0
+}
+
+// complement returns the numbers that are not in the set.
+func (s rangeSet) complement() rangeSet {
+	var result rangeSet
+	lo, loOpen := math.Inf(-1), true
+	for _, r := range s {
+		if gap := (numberRange{lo: lo, hi: r.lo, loOpen: loOpen, hiOpen: !r.loOpen}); !gap.isEmpty() {
+			result = append(result, gap)
+		}
+		lo, loOpen = r.hi, !r.hiOpen
+	}
+	if gap := (numberRange{lo: lo, hi: math.Inf(1), loOpen: loOpen, hiOpen: true}); !gap.isEmpty() {
+		result = append(result, gap)
+	}
+	return result
+}
+
+// intersect returns the numbers that are in both sets.
+func (s rangeSet) intersect(other rangeSet) rangeSet {
+	var result rangeSet
+	for _, a := range s {
+		for _, b := range other {
+			r := a
+			if b.lo > r.lo || b.lo == r.lo && b.loOpen {
+				r.lo, r.loOpen = b.lo, b.loOpen
+			}
+			if b.hi < r.hi || b.hi == r.hi && b.hiOpen {
+				r.hi, r.hiOpen = b.hi, b.hiOpen
+			}
+			if !r.isEmpty() {
+				result = append(result, r)
+			}
+		}
+	}
+	return result
+}
@@ -20,8 +20,22 @@ import "slices"
 
 // RewritePredicate walks the input AST and rewrites any boolean logic into a simpler form
 // This simpler form is CNF plus logic for extracting predicates from OR, plus logic for turning ORs into IN
+//
+// The predicates that filter rows, in WHERE, HAVING and ON clauses, also lose the operands
+// that make no difference to them: see simplifyFilter.
+//
+// It does not fold the expressions with only literals as operands, which takes evaluating
+// them: RewritePredicateWith does, and evalengine.RewritePredicate folds them with
+// evalengine.FoldConstant.
 func RewritePredicate(ast SQLNode) SQLNode {
+	return RewritePredicateWith(ast, nil)
+}
+
+// RewritePredicateWith is RewritePredicate, folding the expressions with only literals as
+// operands into their values first with folder. A nil folder leaves them as they are written.
+func RewritePredicateWith(ast SQLNode, folder ConstantFolder) SQLNode {
 	original := CloneSQLNode(ast)
+	ast = simplifyFilters(foldConstants(ast, folder))
 
 	// Beware: converting to CNF in this loop might cause exponential formula growth.
 	// We bail out early to prevent going overboard.
@@ -45,7 +59,7 @@ func RewritePredicate(ast SQLNode) SQLNode {
 		})
 
 		if !exprChanged {
-			return ast
+			return simplifyFilters(ast)
 		}
 	}
 
@@ -68,6 +82,8 @@ func simplifyExpression(expr Expr) (Expr, bool) {
 
 func simplifyNot(expr *NotExpr) (Expr, bool) {
 	switch child := expr.Expr.(type) {
+	case BoolVal:
+		return !child, true
 	case *NotExpr:
 		return child.Expr, true
 	case *OrExpr:
@@ -81,6 +97,16 @@ func simplifyNot(expr *NotExpr) (Expr, bool) {
 }
 
 func simplifyOr(expr *OrExpr) (Expr, bool) {
+	// or(true, a) => true, or(false, a) => a   where a is TRUE, FALSE or NULL
+	switch {
+	case expr.Left == BoolVal(true), expr.Right == BoolVal(true):
+		return BoolVal(true), true
+	case expr.Left == BoolVal(false) && isBoolean(expr.Right):
+		return expr.Right, true
+	case expr.Right == BoolVal(false) && isBoolean(expr.Left):
+		return expr.Left, true
+	}
+
 	res, rewritten := distinctOr(expr)
 	if rewritten {
 		return res, true
@@ -178,6 +204,16 @@ func simplifyXor(expr *XorExpr) (Expr, bool) {
 }
 
 func simplifyAnd(expr *AndExpr) (Expr, bool) {
+	// and(false, a) => false, and(true, a) => a   where a is TRUE, FALSE or NULL
+	switch {
+	case expr.Left == BoolVal(false), expr.Right == BoolVal(false):
+		return BoolVal(false), true
+	case expr.Left == BoolVal(true) && isBoolean(expr.Right):
+		return expr.Right, true
+	case expr.Right == BoolVal(true) && isBoolean(expr.Left):
+		return expr.Left, true
+	}
+
 	res, rewritten := distinctAnd(expr)
 	if rewritten {
 		return res, true
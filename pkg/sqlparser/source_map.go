@@ -0,0 +1,298 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// The parser does not track positions, so we recover where every node came from
+// after the fact: the AST is formatted while recording the output range of every
+// node, and the tokens of that output are then matched against the tokens of the
+// original query. Formatting only normalizes a query, so the two token streams
+// differ in a handful of places (keyword case, optional keywords, parenthesis)
+// and a diff between them is enough to map every node back to the source.
+
+// sourceToken is a single token of a query together with its byte offsets.
+type sourceToken struct {
+	typ        int
+	val        string
+	start, end int
+}
+
+func (t sourceToken) isComment() bool {
+	return t.typ == COMMENT
+}
+
+// key returns the value used to compare tokens coming from different texts.
+// Keywords and identifiers are compared case-insensitively, since the formatter
+// lowercases keywords, while comments have to match exactly.
+func (t sourceToken) key() string {
+	if t.isComment() {
+		return t.val
+	}
+	typ := t.typ
+	if t.isKeyword() {
+		// the formatter escapes identifiers that collide with keywords
+		typ = ID
+	}
+	var b strings.Builder
+	b.Grow(len(t.val) + 4)
+	b.WriteByte(byte(typ >> 24))
+	b.WriteByte(byte(typ >> 16))
+	b.WriteByte(byte(typ >> 8))
+	b.WriteByte(byte(typ))
+	b.WriteString(strings.ToLower(t.val))
+	return b.String()
+}
+
+func (t sourceToken) isKeyword() bool {
+	id, found := keywordLookupTable.LookupString(t.val)
+	return found && id == t.typ
+}
+
+// scanSourceTokens splits sql into tokens, comments included. MySQL specific
+// comments (/*! ... */) are returned as a single comment token.
+func (p *Parser) scanSourceTokens(sql string) ([]sourceToken, error) {
+	tkn := p.NewStringTokenizer(sql)
+	tkn.SkipSpecialComments = true
+
+	var tokens []sourceToken
+	for {
+		tkn.skipBlank()
+		start := tkn.Pos
+		typ, val := tkn.Scan()
+		switch typ {
+		case 0, eofChar:
+			return tokens, nil
+		case LEX_ERROR:
+			return nil, PositionedErr{Err: "syntax error", Pos: start + 1, Near: val}
+		}
+		tokens = append(tokens, sourceToken{typ: typ, val: val, start: start, end: tkn.Pos})
+	}
+}
+
+// isRefNode returns whether node is a non-nil pointer. Pointer nodes are the only
+// nodes that have an identity, and thus the only ones we can keep information for.
+func isRefNode(node SQLNode) bool {
+	if node == nil {
+		return false
+	}
+	v := reflect.ValueOf(node)
+	return v.Kind() == reflect.Pointer && !v.IsNil()
+}
+
+// nodeSpan is a node along with the range of tokens it covers, and the range of
+// bytes it takes in the formatted query.
+type nodeSpan struct {
+	node             SQLNode
+	start, end       int
+	fmtStart, fmtEnd int
+}
+
+// sourceMap maps the nodes of a parsed query back to the tokens of the original text.
+type sourceMap struct {
+	sql    string
+	tokens []sourceToken
+
+	// formatted is the query as printed by the formatter, which the spans were recovered from.
+	formatted string
+
+	// aligned holds, for every token in tokens, whether it also appears in the formatted query.
+	aligned []bool
+
+	// spans holds the source token range of every pointer node, innermost nodes first.
+	spans []nodeSpan
+	index map[SQLNode]int
+}
+
+// newSourceMap computes the source token range of every pointer node in root, which
+// must have been parsed from sql.
+func (p *Parser) newSourceMap(sql string, root SQLNode) (*sourceMap, error) {
+	tokens, err := p.scanSourceTokens(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	// Format the tree, remembering where each node ended up in the output. Children
+	// finish formatting before their parents, so the list is ordered innermost first.
+	var printed []nodeSpan
+	record := func(buf *TrackedBuffer, node SQLNode) {
+		start := buf.Len()
+		node.Format(buf)
+		if isRefNode(node) {
+			printed = append(printed, nodeSpan{node: node, fmtStart: start, fmtEnd: buf.Len()})
+		}
+	}
+	buf := NewTrackedBuffer(record)
+	record(buf, root)
+	formatted := buf.String()
+
+	outTokens, err := p.scanSourceTokens(formatted)
+	if err != nil {
+		return nil, err
+	}
+	match := alignTokens(tokens, outTokens)
+
+	sm := &sourceMap{
+		sql:       sql,
+		tokens:    tokens,
+		formatted: formatted,
+		aligned:   make([]bool, len(tokens)),
+		index:     make(map[SQLNode]int, len(printed)),
+	}
+	back := make([]int, len(outTokens))
+	for i := range back {
+		back[i] = -1
+	}
+	for i, j := range match {
+		if j >= 0 {
+			sm.aligned[i] = true
+			back[j] = i
+		}
+	}
+
+	for _, ps := range printed {
+		if _, seen := sm.index[ps.node]; seen {
+			continue
+		}
+		// find the output tokens fully contained in the node's output range
+		first := sort.Search(len(outTokens), func(i int) bool { return outTokens[i].start >= ps.fmtStart })
+		last := sort.Search(len(outTokens), func(i int) bool { return outTokens[i].end > ps.fmtEnd }) - 1
+
+		// and map the outermost ones that also exist in the source back to it
+		for first <= last && back[first] < 0 {
+			first++
+		}
+		for last >= first && back[last] < 0 {
+			last--
+		}
+		if first > last {
+			continue
+		}
+		sm.index[ps.node] = len(sm.spans)
+		ps.start, ps.end = back[first], back[last]
+		sm.spans = append(sm.spans, ps)
+	}
+	return sm, nil
+}
+
+// span returns the source token range of node.
+func (sm *sourceMap) span(node SQLNode) (nodeSpan, bool) {
+	if !isRefNode(node) {
+		return nodeSpan{}, false
+	}
+	idx, ok := sm.index[node]
+	if !ok {
+		return nodeSpan{}, false
+	}
+	return sm.spans[idx], true
+}
+
+// formattedText returns the text of a node as printed by the formatter when the map was built.
+func (sm *sourceMap) formattedText(s nodeSpan) string {
+	return sm.formatted[s.fmtStart:s.fmtEnd]
+}
+
+// alignTokens matches the tokens of a against the tokens of b using Myers' diff
+// algorithm, and returns for each token in a the index of its match in b, or -1.
+func alignTokens(a, b []sourceToken) []int {
+	ak := make([]string, len(a))
+	for i, t := range a {
+		ak[i] = t.key()
+	}
+	bk := make([]string, len(b))
+	for i, t := range b {
+		bk[i] = t.key()
+	}
+
+	match := make([]int, len(a))
+	for i := range match {
+		match[i] = -1
+	}
+
+	n, m := len(ak), len(bk)
+	maxD := n + m
+	if maxD == 0 {
+		return match
+	}
+
+	// v[offset+k] holds the furthest x reached on diagonal k. Before every round
+	// we keep a copy of the diagonals the round may read from, which is all we
+	// need to walk the edit path back.
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+	type snapshot struct {
+		lo   int
+		vals []int
+	}
+	var trace []snapshot
+
+	finalD := -1
+	for d := 0; d <= maxD && finalD < 0; d++ {
+		lo := offset - d - 1
+		trace = append(trace, snapshot{lo: lo, vals: append([]int(nil), v[lo:offset+d+2]...)})
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && ak[x] == bk[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				finalD = d
+				break
+			}
+		}
+	}
+
+	x, y := n, m
+	for d := finalD; d >= 0; d-- {
+		snap := trace[d]
+		at := func(k int) int { return snap.vals[offset+k-snap.lo] }
+
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := 0
+		if d > 0 {
+			prevX = at(prevK)
+		}
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			match[x] = y
+		}
+		if d > 0 {
+			x, y = prevX, prevY
+		}
+	}
+	return match
+}
@@ -17,7 +17,11 @@ limitations under the License.
 %{
 package sqlparser
 
-import "github.com/vedadiyan/sqlparser/pkg/ptr"
+import (
+  "strings"
+
+  "github.com/vedadiyan/sqlparser/pkg/ptr"
+)
 
 func setParseTrees(yylex yyLexer, stmts []Statement) {
   if len(stmts) > 1 && stmts[len(stmts)-1] == nil {
@@ -45,6 +49,24 @@ func skipToEnd(yylex yyLexer) {
   yylex.(*Tokenizer).SkipToEnd = true
 }
 
+// skipToEndText behaves like skipToEnd and returns the text it skips. It must
+// be called before the parser reads the token following the current one.
+func skipToEndText(yylex yyLexer) string {
+  tkn := yylex.(*Tokenizer)
+  start, end := tkn.Pos, tkn.Pos
+  for {
+    typ, _ := tkn.Scan()
+    if typ == 0 || typ == ';' || typ == LEX_ERROR {
+      break
+    }
+    end = tkn.Pos
+  }
+  // the lexer scans the end of the statement again when it skips to it
+  tkn.Pos = end
+  tkn.SkipToEnd = true
+  return strings.TrimSpace(tkn.buf[start:end])
+}
+
 func markBindVariable(yylex yyLexer, bvar string) {
   yylex.(*Tokenizer).BindVars[bvar] = struct{}{}
 }
@@ -201,6 +223,7 @@ func markBindVariable(yylex yyLexer, bvar string) {
   vexplainType 	  VExplainType
   intervalType	  IntervalType
   lockType LockType
+  loadPriority LoadPriority
   referenceDefinition *ReferenceDefinition
   txAccessModes []TxAccessMode
   txAccessMode TxAccessMode
@@ -216,6 +239,9 @@ func markBindVariable(yylex yyLexer, bvar string) {
   subPartition  *SubPartition
   partitionByType PartitionByType
   definer 	*Definer
+  definers	[]*Definer
+  grantPrivilege *GrantPrivilege
+  grantPrivileges []*GrantPrivilege
   integer 	int
   intPtr *int
 
@@ -276,7 +302,8 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %token <str> DISTINCT AS EXISTS ASC DESC INTO DUPLICATE DEFAULT SET LOCK UNLOCK KEYS DO CALL
 %left <str> ALL ANY SOME
 %token <str> DISTINCTROW PARSER GENERATED ALWAYS
-%token <str> OUTFILE S3 DATA LOAD LINES TERMINATED ESCAPED ENCLOSED
+%token <str> OUTFILE INFILE S3 DATA LOAD LINES TERMINATED ESCAPED ENCLOSED
+%token <str> GRANT
 %token <str> DUMPFILE CSV HEADER MANIFEST OVERWRITE STARTING OPTIONALLY
 %token <str> VALUES LAST_INSERT_ID
 %token <str> NEXT VALUE SHARE MODE
@@ -438,7 +465,7 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %token <str> FORMAT TREE VITESS TRADITIONAL VTEXPLAIN VEXPLAIN PLAN
 
 // Lock type tokens
-%token <str> LOCAL LOW_PRIORITY
+%token <str> LOCAL LOW_PRIORITY CONCURRENT
 
 // Flush tokens
 %token <str> NO_WRITE_TO_BINLOG LOGS ERROR GENERAL HOSTS OPTIMIZER_COSTS USER_RESOURCES SLOW CHANNEL RELAY EXPORT
@@ -490,6 +517,13 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %type <elseIfs> elseif_list elseif_list_opt
 %type <statement> analyze_statement show_statement use_statement purge_statement other_statement
 %type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement load_statement
+%type <statement> grant_statement
+%type <grantPrivileges> grant_privilege_list
+%type <grantPrivilege> grant_privilege
+%type <str> grant_privilege_name grant_privilege_word grant_object_type
+%type <tableName> grant_level
+%type <definers> user_list
+%type <boolean> grant_option_opt
 %type <statement> lock_statement unlock_statement call_statement
 %type <statement> revert_statement
 %type <strs> comment_opt comment_list
@@ -525,7 +559,7 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %type <intervalType> interval timestampadd_interval
 %type <str> cache_opt separator_opt flush_option for_channel_opt maxvalue
 %type <matchExprOption> match_option
-%type <boolean> distinct_opt union_op replace local_opt
+%type <boolean> distinct_opt union_op replace local_opt load_local_opt
 %type <selectExprs> select_expression_list
 %type <selectExpr> select_expression
 %type <strs> select_options select_options_opt flush_option_list
@@ -601,6 +635,11 @@ func markBindVariable(yylex yyLexer, bvar string) {
 %type <rowAlias> row_alias_opt
 %type <empty> as_opt work_opt savepoint_opt
 %type <empty> skip_to_end ddl_skip_to_end
+%type <loadPriority> load_priority_opt
+%type <str> load_ignore_lines_opt load_source
+%type <exprs> load_columns_opt load_column_list
+%type <expr> load_column
+%type <updateExprs> load_set_opt
 %type <str> charset
 %type <scope> set_session_or_global
 %type <convertType> convert_type returning_type_opt convert_type_weight_string
@@ -741,6 +780,7 @@ command:
 | flush_statement
 | do_statement
 | load_statement
+| grant_statement
 | lock_statement
 | unlock_statement
 | call_statement
@@ -1035,9 +1075,243 @@ do_statement:
   }
 
 load_statement:
-  LOAD DATA skip_to_end
+  LOAD DATA load_priority_opt load_local_opt INFILE STRING ignore_opt INTO TABLE table_name opt_partition_clause charset_opt export_options load_ignore_lines_opt load_columns_opt load_set_opt
+  {
+    $$ = &Load{Priority: $3, Local: $4, FileName: $6, Action: InsertAct, Ignore: $7, Table: $10, Partitions: $11, Charset: $12, ExportOption: $13, IgnoreLines: $14, Columns: $15, SetExprs: $16}
+  }
+| LOAD DATA load_priority_opt load_local_opt INFILE STRING REPLACE INTO TABLE table_name opt_partition_clause charset_opt export_options load_ignore_lines_opt load_columns_opt load_set_opt
+  {
+    $$ = &Load{Priority: $3, Local: $4, FileName: $6, Action: ReplaceAct, Table: $10, Partitions: $11, Charset: $12, ExportOption: $13, IgnoreLines: $14, Columns: $15, SetExprs: $16}
+  }
+| LOAD DATA FROM load_source
+  {
+    $$ = &Load{Source: $4}
+  }
+
+load_priority_opt:
+  { $$ = NoLoadPriority }
+| LOW_PRIORITY
+  { $$ = LowPriorityLoad }
+| CONCURRENT
+  { $$ = ConcurrentLoad }
+
+load_local_opt:
+  { $$ = false }
+| LOCAL
+  { $$ = true }
+
+load_ignore_lines_opt:
+  {
+    $$ = ""
+  }
+| IGNORE INTEGRAL LINES
+  {
+    $$ = $2
+  }
+| IGNORE INTEGRAL ROWS
+  {
+    $$ = $2
+  }
+
+load_columns_opt:
+  {
+    $$ = nil
+  }
+| openb closeb
+  {
+    $$ = []Expr{}
+  }
+| openb load_column_list closeb
+  {
+    $$ = $2
+  }
+
+load_column_list:
+  load_column
+  {
+    $$ = []Expr{$1}
+  }
+| load_column_list ',' load_column
+  {
+    $$ = append($1, $3)
+  }
+
+load_column:
+  column_name
+  {
+    $$ = $1
+  }
+| user_defined_variable
+  {
+    $$ = $1
+  }
+
+load_set_opt:
+  {
+    $$ = nil
+  }
+| SET update_list
+  {
+    $$ = $2
+  }
+
+// The forms that load from elsewhere than a file, like LOAD DATA FROM S3, are
+// kept as they are written.
+load_source:
+  {
+    $$ = skipToEndText(yylex)
+  }
+
+grant_statement:
+  GRANT grant_privilege_list ON grant_level TO user_list grant_option_opt
+  {
+    $$ = &Grant{Privileges: $2, Level: $4, Users: $6, WithGrantOption: $7}
+  }
+| GRANT grant_privilege_list ON grant_object_type grant_level TO user_list grant_option_opt
+  {
+    $$ = &Grant{Privileges: $2, ObjectType: $4, Level: $5, Users: $7, WithGrantOption: $8}
+  }
+
+grant_privilege_list:
+  grant_privilege
+  {
+    $$ = []*GrantPrivilege{$1}
+  }
+| grant_privilege_list ',' grant_privilege
+  {
+    $$ = append($1, $3)
+  }
+
+grant_privilege:
+  grant_privilege_name
+  {
+    $$ = &GrantPrivilege{Name: $1}
+  }
+| grant_privilege_name '(' column_list ')'
+  {
+    $$ = &GrantPrivilege{Name: $1, Columns: $3}
+  }
+
+grant_privilege_name:
+  grant_privilege_word
+  {
+    $$ = $1
+  }
+| grant_privilege_name grant_privilege_word
+  {
+    $$ = $1 + " " + $2
+  }
+
+// Privileges are named by one or more words, some of which are reserved.
+grant_privilege_word:
+  sql_id
+  {
+    $$ = $1.Lowered()
+  }
+| ALL
+  {
+    $$ = "all"
+  }
+| ALTER
+  {
+    $$ = "alter"
+  }
+| CREATE
+  {
+    $$ = "create"
+  }
+| DATABASES
+  {
+    $$ = "databases"
+  }
+| DELETE
+  {
+    $$ = "delete"
+  }
+| DROP
+  {
+    $$ = "drop"
+  }
+| GRANT
+  {
+    $$ = "grant"
+  }
+| INDEX
+  {
+    $$ = "index"
+  }
+| INSERT
+  {
+    $$ = "insert"
+  }
+| LOCK
+  {
+    $$ = "lock"
+  }
+| SELECT
+  {
+    $$ = "select"
+  }
+| SHOW
+  {
+    $$ = "show"
+  }
+| UPDATE
   {
-    $$ = &Load{}
+    $$ = "update"
+  }
+
+grant_object_type:
+  TABLE
+  {
+    $$ = "table"
+  }
+| FUNCTION
+  {
+    $$ = "function"
+  }
+| PROCEDURE
+  {
+    $$ = "procedure"
+  }
+
+// The level of a grant is a table name where * stands for every database or
+// every table.
+grant_level:
+  '*'
+  {
+    $$ = TableName{Name: NewIdentifierCS("*")}
+  }
+| '*' '.' '*'
+  {
+    $$ = TableName{Qualifier: NewIdentifierCS("*"), Name: NewIdentifierCS("*")}
+  }
+| table_id '.' '*'
+  {
+    $$ = TableName{Qualifier: $1, Name: NewIdentifierCS("*")}
+  }
+| table_name
+  {
+    $$ = $1
+  }
+
+user_list:
+  user
+  {
+    $$ = []*Definer{$1}
+  }
+| user_list ',' user
+  {
+    $$ = append($1, $3)
+  }
+
+grant_option_opt:
+  {
+    $$ = false
+  }
+| WITH GRANT OPTION
+  {
+    $$ = true
   }
 
 with_clause:
@@ -4673,11 +4947,11 @@ full_opt:
 columns_or_fields:
   COLUMNS
   {
-    $$ = string($1)
+    $$ = "columns"
   }
 | FIELDS
   {
-    $$ = string($1)
+    $$ = "fields"
   }
 
 from_database_opt:
@@ -6111,6 +6385,10 @@ frame_units:
   {
     $$ = FrameRangeType
   }
+| GROUPS
+  {
+    $$ = FrameGroupsType
+  }
 
 
 argument_less_window_expr_type:
@@ -8717,6 +8995,7 @@ reserved_keyword:
 | FROM
 | FULLTEXT
 | GENERATED
+| GRANT
 | GROUP
 | GROUPING
 | GROUPS
@@ -8726,6 +9005,7 @@ reserved_keyword:
 | IGNORE
 | IN
 | INDEX
+| INFILE
 | INNER
 | INOUT
 | INSERT
@@ -8888,6 +9168,7 @@ non_reserved_keyword:
 | COMPONENT
 | COMPRESSED
 | COMPRESSION
+| CONCURRENT
 | CONNECTION
 | CONSISTENT
 | CONSTRAINT_CATALOG
@@ -147,6 +147,11 @@ func (tkn *Tokenizer) Scan() (int, string) {
 		if tkn.cur() == '`' {
 			tkn.skip(1)
 			tID, tBytes = tkn.scanLiteralIdentifier()
+		} else if quote := tkn.cur(); quote == '\'' || quote == '"' {
+			// quoted names can hold any character, like the % of 'user'@'%', and
+			// name the same variable as the backquoted ones
+			tkn.skip(1)
+			tID, tBytes = tkn.scanString(quote, STRING)
 		} else if tkn.cur() == eofChar {
 			return LEX_ERROR, ""
 		} else {
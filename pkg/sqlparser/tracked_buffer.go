@@ -42,6 +42,7 @@ type TrackedBuffer struct {
 	nodeFormatter NodeFormatter
 	literal       func(string) (int, error)
 	fast          bool
+	comments      *NodeComments
 
 	escape escapeType
 }
@@ -60,11 +61,17 @@ func NewTrackedBuffer(nodeFormatter NodeFormatter) *TrackedBuffer {
 		Builder:       new(strings.Builder),
 		nodeFormatter: nodeFormatter,
 	}
-	buf.literal = buf.WriteString
+	buf.literal = buf.writeString
 	buf.fast = nodeFormatter == nil
 	return buf
 }
 
+// writeString writes to the builder the buffer has at the time of the call, which
+// can be swapped while a node is formatted on its own.
+func (buf *TrackedBuffer) writeString(lit string) (int, error) {
+	return buf.WriteString(lit)
+}
+
 func (buf *TrackedBuffer) writeStringUpperCase(lit string) (int, error) {
 	// Upcasing is performed for ASCII only, following MySQL's behavior
 	buf.Grow(len(lit))
@@ -86,7 +93,7 @@ func (buf *TrackedBuffer) SetUpperCase(enable bool) {
 	if enable {
 		buf.literal = buf.writeStringUpperCase
 	} else {
-		buf.literal = buf.WriteString
+		buf.literal = buf.writeString
 	}
 }
 
@@ -111,6 +118,14 @@ func (buf *TrackedBuffer) SetEscapeNoIdentifier() {
 	buf.escape = escapeNoIdentifiers
 }
 
+// SetComments sets the free-form comments that will be printed around the nodes they
+// are attached to, as returned by Parser.ParseWithComments.
+// Enabling this option will prevent the optimized fastFormat routines from running.
+func (buf *TrackedBuffer) SetComments(comments *NodeComments) {
+	buf.fast = false
+	buf.comments = comments
+}
+
 // WriteNode function, initiates the writing of a single SQLNode tree by passing
 // through to Myprintf with a default format string
 func (buf *TrackedBuffer) WriteNode(node SQLNode) *TrackedBuffer {
@@ -300,6 +315,17 @@ func getExpressionForParensEval(checkParens bool, value any) Expr {
 }
 
 func (buf *TrackedBuffer) formatter(node SQLNode) {
+	if buf.comments != nil {
+		if len(buf.comments.Leading(node)) > 0 {
+			buf.comments.writeLeading(buf, node)
+			return
+		}
+		defer buf.comments.writeTrailing(buf, node)
+	}
+	buf.formatNode(node)
+}
+
+func (buf *TrackedBuffer) formatNode(node SQLNode) {
 	switch {
 	case buf.fast:
 		node.FormatFast(buf)
@@ -430,6 +456,19 @@ func CanonicalString(node SQLNode) string {
 	return buf.String()
 }
 
+// StringWithComments returns a string representation of an SQLNode that includes the
+// free-form comments attached to its nodes.
+func StringWithComments(node SQLNode, comments *NodeComments) string {
+	if node == nil {
+		return "<nil>"
+	}
+
+	buf := NewTrackedBuffer(nil)
+	buf.SetComments(comments)
+	buf.formatter(node)
+	return buf.String()
+}
+
 func SliceString[T SQLNode](valueExprs []T) string {
 	return SliceStringWithSep(valueExprs, ", ")
 }
@@ -0,0 +1,548 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package asthelpergen generates the helper code of the sqlparser AST, such as its
+// JSON serialization, from the type definitions in ast.go.
+package asthelpergen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const licenseFileHeader = `Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.`
+
+const generatedHeader = "// Code generated by ASTHelperGen. DO NOT EDIT."
+
+// Options configures a run of the generator.
+type Options struct {
+	// Package is the directory of the package holding the AST.
+	Package string
+	// RootInterface is the name of the interface every AST node implements.
+	RootInterface string
+	// ProtoFile is the path of the protobuf schema of the AST, relative to Package.
+	ProtoFile string
+	// ProtoGoPackage is the import path of the Go code compiled from ProtoFile.
+	ProtoGoPackage string
+	// EqualsCustom lists the types whose comparison can be overridden on the
+	// Comparator, written as in the AST package, e.g. *ColName.
+	EqualsCustom []string
+	// CloneExclude lists the pointer types that are not deep cloned, written as in
+	// the AST package.
+	CloneExclude []string
+}
+
+// generator produces a single file of helper code.
+type generator interface {
+	// fileName is the path of the generated file, relative to the package.
+	fileName() string
+	// generate returns the contents of the file.
+	generate(ast *astInfo) ([]byte, error)
+}
+
+// typeGenerator is a generator producing a function for each type reachable from the
+// root interface. The types are walked once for all of them, and each generator gets
+// to see every type as it is taken off the work list, through the method matching the
+// kind of the type.
+type typeGenerator interface {
+	generator
+
+	interfaceMethod(t types.Type, iface *types.Interface, w *typeWalker) error
+	structMethod(t types.Type, strct *types.Struct, w *typeWalker) error
+	ptrToStructMethod(t types.Type, strct *types.Struct, w *typeWalker) error
+	ptrToBasicMethod(t types.Type, basic *types.Basic, w *typeWalker) error
+	sliceMethod(t types.Type, slice *types.Slice, w *typeWalker) error
+	basicMethod(t types.Type, basic *types.Basic, w *typeWalker) error
+}
+
+// typeWalker goes through the types reachable from the root interface. Generators
+// add the types they refer to with addType, and the walker hands them back to every
+// generator once it gets to them.
+type typeWalker struct {
+	ast  *astInfo
+	todo []types.Type
+}
+
+// addType queues t to have functions generated for it.
+func (w *typeWalker) addType(t types.Type) {
+	w.todo = append(w.todo, t)
+}
+
+// walk feeds every type reachable from the root interface to gens, starting with the
+// root interface itself.
+func (w *typeWalker) walk(gens []typeGenerator) error {
+	done := map[string]bool{}
+	w.todo = append(w.todo, w.ast.root)
+	for len(w.todo) > 0 {
+		t := w.todo[0]
+		w.todo = w.todo[1:]
+		name := printableTypeName(t)
+		if done[name] {
+			continue
+		}
+		done[name] = true
+		for _, gen := range gens {
+			if err := w.visit(gen, t); err != nil {
+				return fmt.Errorf("%s: %s: %w", gen.fileName(), name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (w *typeWalker) visit(gen typeGenerator, t types.Type) error {
+	switch underlying := t.Underlying().(type) {
+	case *types.Interface:
+		return gen.interfaceMethod(t, underlying, w)
+	case *types.Struct:
+		return gen.structMethod(t, underlying, w)
+	case *types.Slice:
+		return gen.sliceMethod(t, underlying, w)
+	case *types.Basic:
+		return gen.basicMethod(t, underlying, w)
+	case *types.Pointer:
+		switch elem := underlying.Elem().Underlying().(type) {
+		case *types.Struct:
+			return gen.ptrToStructMethod(t, elem, w)
+		case *types.Basic:
+			return gen.ptrToBasicMethod(t, elem, w)
+		}
+	}
+	return fmt.Errorf("don't know how to handle %s", t)
+}
+
+// implementations calls fn with every type implementing iface, in the order of their
+// names. Interfaces extending iface are included, apart from the extension interface
+// used by nodes living outside of the package.
+func (w *typeWalker) implementations(iface *types.Interface, fn func(t types.Type)) {
+	scope := w.ast.pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || tn.IsAlias() || name == visitableName {
+			continue
+		}
+		switch t := tn.Type(); {
+		case types.Implements(t, iface):
+			fn(t)
+		case types.Implements(types.NewPointer(t), iface):
+			fn(types.NewPointer(t))
+		}
+	}
+}
+
+// visitableName is the interface nodes defined outside of the package implement to
+// take part in visits and rewrites.
+const visitableName = "Visitable"
+
+// printableTypeName returns the name used for t in the names of generated functions,
+// e.g. SliceOfRefOfColName for []*ColName.
+func printableTypeName(t types.Type) string {
+	switch t := types.Unalias(t).(type) {
+	case *types.Pointer:
+		return "RefOf" + printableTypeName(t.Elem())
+	case *types.Slice:
+		return "SliceOf" + printableTypeName(t.Elem())
+	case *types.Named:
+		return t.Obj().Name()
+	case *types.Basic:
+		return strings.ToUpper(t.Name()[:1]) + t.Name()[1:]
+	}
+	panic(fmt.Sprintf("unexpected type %s", t))
+}
+
+// isBasic returns whether the underlying type of t is a basic type.
+func isBasic(t types.Type) bool {
+	_, ok := t.Underlying().(*types.Basic)
+	return ok
+}
+
+// isNamed returns whether t is a named type.
+func isNamed(t types.Type) bool {
+	_, ok := types.Unalias(t).(*types.Named)
+	return ok
+}
+
+// skipField returns whether generated helpers leave the field alone. Fields starting
+// with an underscore hold caches derived from the other fields.
+func skipField(field *types.Var) bool {
+	return strings.HasPrefix(field.Name(), "_")
+}
+
+// astInfo describes the AST that helpers are generated for.
+type astInfo struct {
+	options Options
+
+	fset  *token.FileSet
+	files []*ast.File
+	info  *types.Info
+	pkg   *types.Package
+	iface *types.Interface
+	root  *types.Named
+
+	// implementations holds every type implementing the root interface, sorted by
+	// name. A named type is listed as a value if its value implements the interface,
+	// and as a pointer otherwise.
+	implementations []types.Type
+}
+
+// typeName returns the name of t as written inside the AST package.
+func (a *astInfo) typeName(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p == a.pkg {
+			return ""
+		}
+		return p.Name()
+	})
+}
+
+// lookupType returns the type written as typeString in the AST package. Only names
+// of the package, possibly behind pointers and slices, are supported.
+func (a *astInfo) lookupType(typeString string) (types.Type, error) {
+	switch {
+	case strings.HasPrefix(typeString, "*"):
+		elem, err := a.lookupType(typeString[1:])
+		if err != nil {
+			return nil, err
+		}
+		return types.NewPointer(elem), nil
+	case strings.HasPrefix(typeString, "[]"):
+		elem, err := a.lookupType(typeString[2:])
+		if err != nil {
+			return nil, err
+		}
+		return types.NewSlice(elem), nil
+	}
+	tn, ok := a.pkg.Scope().Lookup(typeString).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("type %s not found in %s", typeString, a.pkg.Path())
+	}
+	return tn.Type(), nil
+}
+
+// isNode returns whether t implements the root interface.
+func (a *astInfo) isNode(t types.Type) bool {
+	return types.Implements(t, a.iface)
+}
+
+// isNodeInterface returns whether t is an interface type that extends the root interface.
+func (a *astInfo) isNodeInterface(t types.Type) bool {
+	return types.IsInterface(t) && a.isNode(t)
+}
+
+// namedOf returns the named type of t, looking through a pointer.
+func namedOf(t types.Type) (*types.Named, bool) {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	return named, ok
+}
+
+// Generate regenerates every helper file of the package described by options.
+func Generate(options Options) error {
+	files, err := generateFiles(options)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := os.WriteFile(filepath.Join(options.Package, file.name), file.src, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Verify checks that the helper files of the package described by options are the
+// ones Generate would write, and returns an error naming the ones that are not.
+func Verify(options Options) error {
+	files, err := generateFiles(options)
+	if err != nil {
+		return err
+	}
+	var stale []string
+	for _, file := range files {
+		current, err := os.ReadFile(filepath.Join(options.Package, file.name))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if !bytes.Equal(current, file.src) {
+			stale = append(stale, file.name)
+		}
+	}
+	if len(stale) > 0 {
+		return fmt.Errorf("%s: out of date, run go generate", strings.Join(stale, ", "))
+	}
+	return nil
+}
+
+type generatedFile struct {
+	name string
+	src  []byte
+}
+
+func generateFiles(options Options) ([]generatedFile, error) {
+	typeGens := []typeGenerator{
+		&equalsGen{custom: options.EqualsCustom},
+		&cloneGen{exclude: options.CloneExclude},
+		&visitGen{},
+		&rewriteGen{},
+		&cowGen{},
+		&pathGen{},
+	}
+	var gens []generator
+	for _, gen := range typeGens {
+		gens = append(gens, gen)
+	}
+	gens = append(gens, &jsonGen{}, &protoSchemaGen{file: options.ProtoFile}, &protoGen{})
+
+	skip := make(map[string]bool, len(gens))
+	for _, gen := range gens {
+		skip[gen.fileName()] = true
+	}
+
+	// The nodes only implement the root interface along with their FormatFast method,
+	// so the fast formatting code is generated first. The package is loaded again if
+	// it changed, for the other helpers to see every node.
+	fastGen := &formatFastGen{}
+	info, err := load(options, skip, nil)
+	if err != nil {
+		return nil, err
+	}
+	fast, err := fastGen.generate(info)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fastGen.fileName(), err)
+	}
+	current, err := os.ReadFile(filepath.Join(options.Package, fastGen.fileName()))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if !bytes.Equal(current, fast) {
+		info, err = load(options, skip, map[string][]byte{fastGen.fileName(): fast})
+		if err != nil {
+			return nil, err
+		}
+	}
+	files := []generatedFile{{name: fastGen.fileName(), src: fast}}
+
+	walker := &typeWalker{ast: info}
+	if err := walker.walk(typeGens); err != nil {
+		return nil, err
+	}
+	for _, gen := range gens {
+		src, err := gen.generate(info)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", gen.fileName(), err)
+		}
+		files = append(files, generatedFile{name: gen.fileName(), src: src})
+	}
+	return files, nil
+}
+
+// renderGo puts together a Go file of the AST package and formats it. Imports are
+// given by path, or by name and path separated by a space.
+func renderGo(pkgName string, imports []string, body string) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "/*\n%s\n*/\n%s\n\npackage %s\n\n", licenseFileHeader, generatedHeader, pkgName)
+	if len(imports) > 0 {
+		// standard library imports go first, in a group of their own
+		sort.Slice(imports, func(i, j int) bool {
+			si, sj := isStdlib(imports[i]), isStdlib(imports[j])
+			if si != sj {
+				return si
+			}
+			return imports[i] < imports[j]
+		})
+		buf.WriteString("import (\n")
+		for i, imp := range imports {
+			if i > 0 && isStdlib(imports[i-1]) && !isStdlib(imp) {
+				buf.WriteString("\n")
+			}
+			if alias, path, ok := strings.Cut(imp, " "); ok {
+				fmt.Fprintf(&buf, "\t%s %q\n", alias, path)
+			} else {
+				fmt.Fprintf(&buf, "\t%q\n", imp)
+			}
+		}
+		buf.WriteString(")\n\n")
+	}
+	buf.WriteString(body)
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+func isStdlib(importPath string) bool {
+	return !strings.Contains(strings.Split(importPath, "/")[0], ".")
+}
+
+// load type checks the package in options. Files listed in skip, which are the ones
+// about to be regenerated, are left out so that stale helpers cannot get in the way.
+// The files that use the helpers will then fail to type check, which is expected:
+// the declarations the generator needs are checked all the same. The contents of the
+// files in overlay replace the ones on disk.
+func load(options Options, skip map[string]bool, overlay map[string][]byte) (*astInfo, error) {
+	entries, err := os.ReadDir(options.Package)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || skip[name] {
+			continue
+		}
+		var src any
+		if content, ok := overlay[name]; ok {
+			src = content
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(options.Package, name), src, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Go files in %s", options.Package)
+	}
+	pkgName := files[0].Name.Name
+
+	exports, importPath, err := exportData(options.Package)
+	if err != nil {
+		return nil, err
+	}
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+			file, ok := exports[path]
+			if !ok {
+				return nil, fmt.Errorf("no export data for %s", path)
+			}
+			return os.Open(file)
+		}),
+		Error: func(error) {},
+	}
+	typesInfo := &types.Info{Types: make(map[ast.Expr]types.TypeAndValue)}
+	pkg, _ := conf.Check(importPath, fset, files, typesInfo)
+	if pkg.Name() != pkgName {
+		return nil, fmt.Errorf("could not type check %s", options.Package)
+	}
+
+	obj := pkg.Scope().Lookup(options.RootInterface)
+	if obj == nil {
+		return nil, fmt.Errorf("interface %s not found in %s", options.RootInterface, importPath)
+	}
+	root, ok := obj.Type().(*types.Named)
+	if !ok || !types.IsInterface(root) {
+		return nil, fmt.Errorf("%s is not an interface", options.RootInterface)
+	}
+	info := &astInfo{
+		options: options,
+		fset:    fset,
+		files:   files,
+		info:    typesInfo,
+		pkg:     pkg,
+		iface:   root.Underlying().(*types.Interface),
+		root:    root,
+	}
+
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || tn.IsAlias() || types.IsInterface(tn.Type()) {
+			continue
+		}
+		switch {
+		case info.isNode(tn.Type()):
+			info.implementations = append(info.implementations, tn.Type())
+		case info.isNode(types.NewPointer(tn.Type())):
+			info.implementations = append(info.implementations, types.NewPointer(tn.Type()))
+		}
+	}
+	return info, nil
+}
+
+// exportData compiles the dependencies of the package in dir and returns the location
+// of their export data, keyed by import path, along with the import path of the package.
+func exportData(dir string) (map[string]string, string, error) {
+	out, err := goList(dir, "-f", "{{.ImportPath}}\n{{join .Imports \"\\n\"}}", ".")
+	if err != nil {
+		return nil, "", err
+	}
+	lines := strings.Fields(string(out))
+	importPath, imports := lines[0], lines[1:]
+	if len(imports) == 0 {
+		return map[string]string{}, importPath, nil
+	}
+
+	out, err = goList(dir, append([]string{"-e", "-export", "-deps", "-json=ImportPath,Export"}, imports...)...)
+	if err != nil {
+		return nil, "", err
+	}
+	exports := make(map[string]string)
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for dec.More() {
+		var p struct {
+			ImportPath string
+			Export     string
+		}
+		if err := dec.Decode(&p); err != nil {
+			return nil, "", err
+		}
+		if p.Export != "" {
+			exports[p.ImportPath] = p.Export
+		}
+	}
+	return exports, importPath, nil
+}
+
+func goList(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("go", append([]string{"list"}, args...)...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %w: %s", err, stderr.String())
+	}
+	return out, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asthelpergen
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+const cloneName = "Clone"
+
+// cloneGen generates ast_clone.go, a function for every type making a deep copy of it.
+// Pointers to the types listed in exclude are shared by the copies instead.
+type cloneGen struct {
+	exclude []string
+	buf     bytes.Buffer
+}
+
+func (c *cloneGen) fileName() string {
+	return "ast_clone.go"
+}
+
+func (c *cloneGen) generate(ast *astInfo) ([]byte, error) {
+	return renderGo(ast.pkg.Name(), nil, c.buf.String())
+}
+
+func (c *cloneGen) addFunc(t types.Type, param, body string, w *typeWalker) {
+	name := cloneName + printableTypeName(t)
+	typeString := w.ast.typeName(t)
+	fmt.Fprintf(&c.buf, "// %s creates a deep clone of the input.\n", name)
+	fmt.Fprintf(&c.buf, "func %s(%s %s) %s {\n%s}\n\n", name, param, typeString, typeString, body)
+}
+
+func (c *cloneGen) interfaceMethod(t types.Type, iface *types.Interface, w *typeWalker) error {
+	var b strings.Builder
+	b.WriteString("if in == nil {\nreturn nil\n}\n")
+	b.WriteString("switch in := in.(type) {\n")
+	w.implementations(iface, func(impl types.Type) {
+		clone := c.readValueOfType(impl, "in", w)
+		if types.IsInterface(impl) {
+			return
+		}
+		fmt.Fprintf(&b, "case %s:\nreturn %s\n", w.ast.typeName(impl), clone)
+	})
+	b.WriteString("default:\n// this should never happen\nreturn nil\n}\n")
+	c.addFunc(t, "in", b.String(), w)
+	return nil
+}
+
+func (c *cloneGen) structMethod(t types.Type, _ *types.Struct, w *typeWalker) error {
+	ptr := types.NewPointer(t)
+	w.addType(ptr)
+	c.addFunc(t, "n", fmt.Sprintf("return *%s%s(&n)\n", cloneName, printableTypeName(ptr)), w)
+	return nil
+}
+
+func (c *cloneGen) ptrToStructMethod(t types.Type, strct *types.Struct, w *typeWalker) error {
+	for _, excluded := range c.exclude {
+		if excluded == w.ast.typeName(t) {
+			c.addFunc(t, "n", "return n\n", w)
+			return nil
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("if n == nil {\nreturn nil\n}\n")
+	b.WriteString("out := *n\n")
+	for i := 0; i < strct.NumFields(); i++ {
+		field := strct.Field(i)
+		if isBasic(field.Type()) || skipField(field) {
+			continue
+		}
+		fmt.Fprintf(&b, "out.%s = %s\n", field.Name(), c.readValueOfType(field.Type(), "n."+field.Name(), w))
+	}
+	b.WriteString("return &out\n")
+	c.addFunc(t, "n", b.String(), w)
+	return nil
+}
+
+func (c *cloneGen) ptrToBasicMethod(t types.Type, _ *types.Basic, w *typeWalker) error {
+	c.addFunc(t, "n", "if n == nil {\nreturn nil\n}\nout := *n\nreturn &out\n", w)
+	return nil
+}
+
+func (c *cloneGen) sliceMethod(t types.Type, slice *types.Slice, w *typeWalker) error {
+	var b strings.Builder
+	b.WriteString("if n == nil {\nreturn nil\n}\n")
+	fmt.Fprintf(&b, "res := make(%s, len(n))\n", w.ast.typeName(t))
+	if !isNamed(t) && isBasic(slice.Elem()) {
+		b.WriteString("copy(res, n)\n")
+	} else {
+		w.addType(slice.Elem())
+		fmt.Fprintf(&b, "for i, x := range n {\nres[i] = %s\n}\n", c.readValueOfType(slice.Elem(), "x", w))
+	}
+	b.WriteString("return res\n")
+	c.addFunc(t, "n", b.String(), w)
+	return nil
+}
+
+func (c *cloneGen) basicMethod(types.Type, *types.Basic, *typeWalker) error {
+	return nil
+}
+
+// readValueOfType returns the expression cloning expr of type t.
+func (c *cloneGen) readValueOfType(t types.Type, expr string, w *typeWalker) string {
+	if isBasic(t) {
+		return expr
+	}
+	w.addType(t)
+	return fmt.Sprintf("%s%s(%s)", cloneName, printableTypeName(t), expr)
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asthelpergen
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+const cowName = "copyOnRewrite"
+
+// cowGen generates ast_copy_on_rewrite.go, the methods of cow walking the nodes below
+// a node and copying the nodes on the way up from the ones that were replaced.
+type cowGen struct {
+	buf bytes.Buffer
+}
+
+func (c *cowGen) fileName() string {
+	return "ast_copy_on_rewrite.go"
+}
+
+func (c *cowGen) generate(ast *astInfo) ([]byte, error) {
+	var body bytes.Buffer
+	body.Write(c.buf.Bytes())
+	c.funcHeader(&body, visitableName, visitableName, ast)
+	body.WriteString("if c.cursor.stop {\nreturn n, false\n}\n")
+	body.WriteString("out = n\n")
+	body.WriteString("if c.pre == nil || c.pre(n, parent) {\n")
+	fmt.Fprintf(&body, "_inner, changedInner := c.%s%s(n.VisitThis(), n)\n", cowName, printableTypeName(ast.root))
+	body.WriteString("if changedInner {\nres := n.Clone(_inner)\nout = res\nchanged = true\n}\n}\n")
+	body.WriteString(cowPost)
+	body.WriteString("return\n}\n")
+	return renderGo(ast.pkg.Name(), nil, body.String())
+}
+
+func (c *cowGen) funcHeader(buf *bytes.Buffer, name, typeString string, ast *astInfo) {
+	root := ast.root.Obj().Name()
+	fmt.Fprintf(buf, "func (c *cow) %s%s(n %s, parent %s) (out %s, changed bool) {\n", cowName, name, typeString, root, root)
+}
+
+func (c *cowGen) cowFunc(t types.Type, body string, w *typeWalker) {
+	c.funcHeader(&c.buf, printableTypeName(t), w.ast.typeName(t), w.ast)
+	c.buf.WriteString(body)
+	c.buf.WriteString("}\n")
+}
+
+const cowNilCheck = "if n == nil || c.cursor.stop {\nreturn n, false\n}\n"
+
+const cowPost = "if c.post != nil {\nout, changed = c.postVisit(out, parent, changed)\n}\n"
+
+func (c *cowGen) interfaceMethod(t types.Type, iface *types.Interface, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	var b strings.Builder
+	b.WriteString(cowNilCheck)
+	b.WriteString("switch n := n.(type) {\n")
+	w.implementations(iface, func(impl types.Type) {
+		if types.IsInterface(impl) {
+			return
+		}
+		w.addType(impl)
+		fmt.Fprintf(&b, "case %s:\nreturn c.%s%s(n, parent)\n", w.ast.typeName(impl), cowName, printableTypeName(impl))
+	})
+	fmt.Fprintf(&b, "case %s:\nreturn c.%s%s(n, parent)\n", visitableName, cowName, visitableName)
+	b.WriteString("default:\n// this should never happen\nreturn nil, false\n}\n")
+	c.cowFunc(t, b.String(), w)
+	return nil
+}
+
+func (c *cowGen) structMethod(t types.Type, strct *types.Struct, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	c.cowFunc(t, c.copyAllStructFields(t, strct, w, false), w)
+	return nil
+}
+
+func (c *cowGen) ptrToStructMethod(t types.Type, strct *types.Struct, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	c.cowFunc(t, cowNilCheck+c.copyAllStructFields(t, strct, w, true), w)
+	return nil
+}
+
+func (c *cowGen) ptrToBasicMethod(types.Type, *types.Basic, *typeWalker) error {
+	return nil
+}
+
+func (c *cowGen) sliceMethod(t types.Type, slice *types.Slice, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	var b strings.Builder
+	b.WriteString(cowNilCheck)
+	b.WriteString("out = n\n")
+	if !w.ast.isNode(slice.Elem()) {
+		b.WriteString("if c.pre != nil {\nc.pre(n, parent)\n}\n")
+	} else {
+		w.addType(slice.Elem())
+		b.WriteString("if c.pre == nil || c.pre(n, parent) {\n")
+		fmt.Fprintf(&b, "res := make(%s, len(n))\n", w.ast.typeName(t))
+		b.WriteString("for x, el := range n {\n")
+		fmt.Fprintf(&b, "this, change := c.%s%s(el, n)\n", cowName, printableTypeName(slice.Elem()))
+		fmt.Fprintf(&b, "res[x] = this.(%s)\n", w.ast.typeName(slice.Elem()))
+		b.WriteString("if change {\nchanged = true\n}\n}\n")
+		b.WriteString("if changed {\nout = res\n}\n}\n")
+	}
+	b.WriteString(cowPost)
+	b.WriteString("return\n")
+	c.cowFunc(t, b.String(), w)
+	return nil
+}
+
+func (c *cowGen) basicMethod(t types.Type, _ *types.Basic, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	var b strings.Builder
+	b.WriteString("if c.cursor.stop {\nreturn n, false\n}\n")
+	b.WriteString("if c.pre != nil {\nc.pre(n, parent)\n}\n")
+	b.WriteString("if c.post != nil {\nout, changed = c.postVisit(n, parent, changed)\n} else {\nout = n\n}\n")
+	b.WriteString("return\n")
+	c.cowFunc(t, b.String(), w)
+	return nil
+}
+
+// copyAllStructFields walks the fields of a struct that hold nodes, and copies the
+// struct if any of them changed.
+func (c *cowGen) copyAllStructFields(t types.Type, strct *types.Struct, w *typeWalker, ptr bool) string {
+	fields := nodeFields(strct, w)
+	var b strings.Builder
+	b.WriteString("out = n\n")
+	b.WriteString("if c.pre == nil || c.pre(n, parent) {\n")
+	var changed []string
+	for _, field := range fields {
+		changed = append(changed, "changed"+field.name)
+		if field.slice == nil {
+			w.addType(field.typ)
+			fmt.Fprintf(&b, "_%s, changed%s := c.%s%s(n.%s, n)\n", field.name, field.name, cowName, printableTypeName(field.typ), field.name)
+			continue
+		}
+		w.addType(field.slice.Elem())
+		fmt.Fprintf(&b, "var changed%s bool\n", field.name)
+		fmt.Fprintf(&b, "_%s := make(%s, len(n.%s))\n", field.name, w.ast.typeName(field.typ), field.name)
+		fmt.Fprintf(&b, "for x, el := range n.%s {\n", field.name)
+		fmt.Fprintf(&b, "this, changed := c.%s%s(el, n)\n", cowName, printableTypeName(field.slice.Elem()))
+		fmt.Fprintf(&b, "_%s[x] = this.(%s)\n", field.name, w.ast.typeName(field.slice.Elem()))
+		fmt.Fprintf(&b, "if changed {\nchanged%s = true\n}\n}\n", field.name)
+	}
+	if len(fields) > 0 {
+		fmt.Fprintf(&b, "if %s {\n", strings.Join(changed, " || "))
+		if ptr {
+			b.WriteString("res := *n\n")
+		} else {
+			b.WriteString("res := n\n")
+		}
+		for _, field := range fields {
+			if field.slice == nil {
+				fmt.Fprintf(&b, "res.%s, _ = _%s.(%s)\n", field.name, field.name, w.ast.typeName(field.typ))
+			} else {
+				fmt.Fprintf(&b, "res.%s = _%s\n", field.name, field.name)
+			}
+		}
+		b.WriteString("out = &res\n")
+		b.WriteString("if c.cloned != nil {\nc.cloned(n, out)\n}\n")
+		b.WriteString("changed = true\n}\n")
+	}
+	b.WriteString("}\n")
+	b.WriteString(cowPost)
+	b.WriteString("return\n")
+	return b.String()
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asthelpergen
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+const equalsName = "Comparator"
+
+// equalsGen generates ast_equals.go, a method of Comparator for every type doing a deep
+// comparison of two values. Types listed in custom can have their comparison replaced
+// by a function set on the Comparator; they are written as in the AST package, e.g.
+// *ColName.
+type equalsGen struct {
+	custom []string
+	buf    bytes.Buffer
+}
+
+func (e *equalsGen) fileName() string {
+	return "ast_equals.go"
+}
+
+func (e *equalsGen) generate(ast *astInfo) ([]byte, error) {
+	var body bytes.Buffer
+	body.Write(e.buf.Bytes())
+	fmt.Fprintf(&body, "type %s struct {\n", equalsName)
+	for _, typeString := range e.custom {
+		t, err := ast.lookupType(typeString)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&body, "%s_ func(a, b %s) bool\n", printableTypeName(t), typeString)
+	}
+	body.WriteString("}\n")
+	return renderGo(ast.pkg.Name(), nil, body.String())
+}
+
+func (e *equalsGen) addFunc(name, typeString, params, body string) {
+	fmt.Fprintf(&e.buf, "// %s does deep equals between the two objects.\n", name)
+	fmt.Fprintf(&e.buf, "func (cmp *%s) %s(%s %s) bool {\n%s}\n\n", equalsName, name, params, typeString, body)
+}
+
+func (e *equalsGen) isCustom(typeString string) bool {
+	for _, c := range e.custom {
+		if c == typeString {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *equalsGen) interfaceMethod(t types.Type, iface *types.Interface, w *typeWalker) error {
+	var b strings.Builder
+	b.WriteString("if inA == nil && inB == nil {\nreturn true\n}\n")
+	b.WriteString("if inA == nil || inB == nil {\nreturn false\n}\n")
+	b.WriteString("switch a := inA.(type) {\n")
+	w.implementations(iface, func(impl types.Type) {
+		if types.IsInterface(impl) {
+			return
+		}
+		typeString := w.ast.typeName(impl)
+		fmt.Fprintf(&b, "case %s:\nb, ok := inB.(%s)\nif !ok {\nreturn false\n}\n", typeString, typeString)
+		fmt.Fprintf(&b, "return %s\n", e.compareValueOfType(impl, "a", "b", w))
+	})
+	b.WriteString("default:\n// this should never happen\nreturn false\n}\n")
+	e.addFunc(printableTypeName(t), w.ast.typeName(t), "inA, inB", b.String())
+	return nil
+}
+
+func (e *equalsGen) structMethod(t types.Type, strct *types.Struct, w *typeWalker) error {
+	e.addFunc(printableTypeName(t), w.ast.typeName(t), "a, b", e.compareAllStructFields(strct, w))
+	return nil
+}
+
+func (e *equalsGen) ptrToStructMethod(t types.Type, strct *types.Struct, w *typeWalker) error {
+	name := printableTypeName(t)
+	var b strings.Builder
+	b.WriteString("if a == b {\nreturn true\n}\n")
+	b.WriteString("if a == nil || b == nil {\nreturn false\n}\n")
+	if e.isCustom(w.ast.typeName(t)) {
+		fmt.Fprintf(&b, "if cmp.%s_ != nil {\nreturn cmp.%s_(a, b)\n}\n", name, name)
+	}
+	b.WriteString(e.compareAllStructFields(strct, w))
+	e.addFunc(name, w.ast.typeName(t), "a, b", b.String())
+	return nil
+}
+
+func (e *equalsGen) ptrToBasicMethod(t types.Type, _ *types.Basic, w *typeWalker) error {
+	var b strings.Builder
+	b.WriteString("if a == b {\nreturn true\n}\n")
+	b.WriteString("if a == nil || b == nil {\nreturn false\n}\n")
+	b.WriteString("return *a == *b\n")
+	e.addFunc(printableTypeName(t), w.ast.typeName(t), "a, b", b.String())
+	return nil
+}
+
+func (e *equalsGen) sliceMethod(t types.Type, slice *types.Slice, w *typeWalker) error {
+	var b strings.Builder
+	b.WriteString("if len(a) != len(b) {\nreturn false\n}\n")
+	b.WriteString("for i := 0; i < len(a); i++ {\n")
+	if isBasic(slice.Elem()) {
+		b.WriteString("if a[i] != b[i] {\n")
+	} else {
+		fmt.Fprintf(&b, "if !%s {\n", e.compareValueOfType(slice.Elem(), "a[i]", "b[i]", w))
+	}
+	b.WriteString("return false\n}\n}\nreturn true\n")
+	e.addFunc(printableTypeName(t), w.ast.typeName(t), "a, b", b.String())
+	return nil
+}
+
+func (e *equalsGen) basicMethod(types.Type, *types.Basic, *typeWalker) error {
+	return nil
+}
+
+// compareAllStructFields compares the fields of basic types first, as they are the
+// cheapest to compare, and then the others in the order they are declared.
+func (e *equalsGen) compareAllStructFields(strct *types.Struct, w *typeWalker) string {
+	var basics, others []string
+	for i := 0; i < strct.NumFields(); i++ {
+		field := strct.Field(i)
+		if skipField(field) {
+			continue
+		}
+		pred := e.compareValueOfType(field.Type(), "a."+field.Name(), "b."+field.Name(), w)
+		if _, ok := types.Unalias(field.Type()).(*types.Basic); ok {
+			basics = append(basics, pred)
+			continue
+		}
+		others = append(others, pred)
+	}
+	preds := append(basics, others...)
+	if len(preds) == 0 {
+		return "return true\n"
+	}
+	return "return " + strings.Join(preds, " &&\n") + "\n"
+}
+
+func (e *equalsGen) compareValueOfType(t types.Type, a, b string, w *typeWalker) string {
+	if isBasic(t) {
+		return a + " == " + b
+	}
+	w.addType(t)
+	return fmt.Sprintf("cmp.%s(%s, %s)", printableTypeName(t), a, b)
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asthelpergen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const formatFastHeader = "// Code generated by ASTFmtGen. DO NOT EDIT."
+
+// formatFastGen generates ast_format_fast.go from the Format methods in ast_format.go.
+// The calls to astPrintf, which interpret their format string every time, are turned
+// into the writes they end up doing.
+type formatFastGen struct{}
+
+func (g *formatFastGen) fileName() string {
+	return "ast_format_fast.go"
+}
+
+func (g *formatFastGen) generate(info *astInfo) ([]byte, error) {
+	var file *ast.File
+	for _, f := range info.files {
+		if filepath.Base(info.fset.Position(f.Pos()).Filename) == "ast_format.go" {
+			file = f
+		}
+	}
+	if file == nil {
+		return nil, fmt.Errorf("ast_format.go not found")
+	}
+	expr, ok := info.pkg.Scope().Lookup("Expr").Type().Underlying().(*types.Interface)
+	if !ok {
+		return nil, fmt.Errorf("Expr is not an interface")
+	}
+
+	r := &formatRewriter{info: info, expr: expr}
+	ast.Inspect(file, r.rewrite)
+	if r.err != nil {
+		return nil, r.err
+	}
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			comment.Text = strings.ReplaceAll(comment.Text, " Format ", " FormatFast ")
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(formatFastHeader + "\n")
+	if err := format.Node(&buf, info.fset, file); err != nil {
+		return nil, err
+	}
+	src := buf.Bytes()
+	if r.usesFmt {
+		src = bytes.Replace(src, []byte("import (\n"), []byte("import (\n\t\"fmt\"\n\n"), 1)
+	}
+	return format.Source(src)
+}
+
+type formatRewriter struct {
+	info    *astInfo
+	expr    *types.Interface
+	usesFmt bool
+	err     error
+}
+
+func (r *formatRewriter) rewrite(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncDecl:
+		if n.Name.Name == "Format" {
+			n.Name.Name = "FormatFast"
+		}
+	case *ast.CallExpr:
+		if sel, ok := n.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Format" {
+			sel.Sel.Name = "FormatFast"
+		}
+	case *ast.BlockStmt:
+		n.List = r.rewriteStmts(n.List)
+	case *ast.CaseClause:
+		n.Body = r.rewriteStmts(n.Body)
+	case *ast.CommClause:
+		n.Body = r.rewriteStmts(n.Body)
+	}
+	return r.err == nil
+}
+
+func (r *formatRewriter) rewriteStmts(list []ast.Stmt) []ast.Stmt {
+	var out []ast.Stmt
+	for _, stmt := range list {
+		call, sel, ok := bufCall(stmt)
+		if !ok {
+			out = append(out, stmt)
+			continue
+		}
+		switch sel.Sel.Name {
+		case "astPrintf":
+			stmts, err := r.rewriteAstPrintf(call, sel)
+			if err != nil {
+				r.err = err
+				return list
+			}
+			out = append(out, stmts...)
+		case "literal":
+			out = append(out, methodCall(sel.X, "WriteString", call.Args...))
+		default:
+			out = append(out, stmt)
+		}
+	}
+	return out
+}
+
+// bufCall returns the call in stmt if it is a statement calling a method.
+func bufCall(stmt ast.Stmt) (*ast.CallExpr, *ast.SelectorExpr, bool) {
+	exprStmt, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return nil, nil, false
+	}
+	call, ok := exprStmt.X.(*ast.CallExpr)
+	if !ok {
+		return nil, nil, false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	return call, sel, ok
+}
+
+func methodCall(recv ast.Expr, method string, args ...ast.Expr) ast.Stmt {
+	return &ast.ExprStmt{X: &ast.CallExpr{
+		Fun:  &ast.SelectorExpr{X: recv, Sel: ast.NewIdent(method)},
+		Args: args,
+	}}
+}
+
+// rewriteAstPrintf turns a call to astPrintf into a statement for every piece of its
+// format string, as the call would print it.
+func (r *formatRewriter) rewriteAstPrintf(call *ast.CallExpr, sel *ast.SelectorExpr) ([]ast.Stmt, error) {
+	buf := sel.X
+	lit, ok := call.Args[1].(*ast.BasicLit)
+	if !ok {
+		return nil, fmt.Errorf("%s: astPrintf needs a literal format", r.info.fset.Position(call.Pos()))
+	}
+	format, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	var stmts []ast.Stmt
+	end := len(format)
+	fieldnum := 0
+	for i := 0; i < end; {
+		lasti := i
+		for i < end && format[i] != '%' {
+			i++
+		}
+		if i > lasti {
+			if text := format[lasti:i]; len(text) == 1 {
+				stmts = append(stmts, methodCall(buf, "WriteByte", &ast.BasicLit{Kind: token.CHAR, Value: strconv.QuoteRune(rune(text[0]))}))
+			} else {
+				stmts = append(stmts, methodCall(buf, "WriteString", &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(text)}))
+			}
+		}
+		if i >= end {
+			break
+		}
+		i++ // '%'
+		if format[i] == '#' {
+			i++
+		}
+
+		value := call.Args[2+fieldnum]
+		switch format[i] {
+		case 's':
+			stmts = append(stmts, methodCall(buf, "WriteString", value))
+		case 'l', 'r', 'v':
+			node := call.Args[0]
+			if types.Implements(r.info.info.TypeOf(node), r.expr) && types.Implements(r.info.info.TypeOf(value), r.expr) {
+				stmts = append(stmts, methodCall(buf, "printExpr", node, value, ast.NewIdent(strconv.FormatBool(format[i] != 'r'))))
+			} else {
+				stmts = append(stmts, methodCall(value, "FormatFast", buf))
+			}
+		case 'd':
+			r.usesFmt = true
+			sprintf := &ast.CallExpr{
+				Fun:  &ast.SelectorExpr{X: ast.NewIdent("fmt"), Sel: ast.NewIdent("Sprintf")},
+				Args: []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: `"%d"`}, value},
+			}
+			stmts = append(stmts, methodCall(buf, "WriteString", sprintf))
+		case 'a':
+			stmts = append(stmts, methodCall(buf, "WriteArg", &ast.BasicLit{Kind: token.STRING, Value: `""`}, value))
+		case 'n':
+			stmts = append(stmts, methodCall(buf, "formatExprs", value))
+		default:
+			return nil, fmt.Errorf("%s: unsupported format %%%c", r.info.fset.Position(call.Pos()), format[i])
+		}
+		fieldnum++
+		i++
+	}
+	return stmts, nil
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asthelpergen
+
+import (
+	"fmt"
+	"go/types"
+	"sort"
+	"strings"
+)
+
+// jsonGen generates MarshalJSON and UnmarshalJSON for every type reachable from the
+// AST nodes. Structs are encoded as objects with their Go field names as keys and a
+// "type" key holding the name of the struct. Fields holding an interface go through
+// jsonNode and jsonNodes, which encode the node along with its type so that it can
+// be decoded back. The runtime side of it lives in ast_json_funcs.go.
+type jsonGen struct {
+	ast *astInfo
+
+	// types holds the named types of the AST package reachable from the nodes, and
+	// byValue the ones among them that are stored as values somewhere.
+	types   map[*types.Named]bool
+	byValue map[*types.Named]bool
+
+	// imports holds the packages referred to by the generated code.
+	imports map[string]bool
+
+	buf strings.Builder
+}
+
+func (gen *jsonGen) fileName() string {
+	return "ast_json.go"
+}
+
+func (gen *jsonGen) generate(ast *astInfo) ([]byte, error) {
+	gen.ast = ast
+	gen.types = make(map[*types.Named]bool)
+	gen.byValue = make(map[*types.Named]bool)
+	gen.imports = map[string]bool{"encoding/json": true, "fmt": true}
+
+	for _, impl := range ast.implementations {
+		if err := gen.walk(impl); err != nil {
+			return nil, err
+		}
+	}
+	named := make([]*types.Named, 0, len(gen.types))
+	for t := range gen.types {
+		named = append(named, t)
+	}
+	sort.Slice(named, func(i, j int) bool {
+		return named[i].Obj().Name() < named[j].Obj().Name()
+	})
+
+	gen.marshalNode()
+	gen.unmarshalNode()
+	for _, t := range named {
+		if gen.hasCustomJSON(t) {
+			continue
+		}
+		var err error
+		switch u := t.Underlying().(type) {
+		case *types.Struct:
+			err = gen.structMethods(t, u)
+		case *types.Slice:
+			gen.sliceMethods(t, u)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	imports := make([]string, 0, len(gen.imports))
+	for imp := range gen.imports {
+		imports = append(imports, imp)
+	}
+	return renderGo(ast.pkg.Name(), imports, gen.buf.String())
+}
+
+func (gen *jsonGen) printf(format string, args ...any) {
+	fmt.Fprintf(&gen.buf, format, args...)
+}
+
+// walk records t and the types reachable from it.
+func (gen *jsonGen) walk(t types.Type) error {
+	switch t := types.Unalias(t).(type) {
+	case *types.Named:
+		if t.Obj().Pkg() != gen.ast.pkg {
+			return gen.checkForeign(t)
+		}
+		if types.IsInterface(t) {
+			if !gen.ast.isNodeInterface(t) {
+				return fmt.Errorf("cannot encode interface %s", t.Obj().Name())
+			}
+			return nil
+		}
+		gen.byValue[t] = gen.byValue[t] || gen.ast.isNode(t)
+		if gen.types[t] {
+			return nil
+		}
+		gen.types[t] = true
+		if gen.hasCustomJSON(t) {
+			return nil
+		}
+		switch u := t.Underlying().(type) {
+		case *types.Struct:
+			for i := 0; i < u.NumFields(); i++ {
+				field := u.Field(i)
+				if field.Name() == "_" {
+					continue
+				}
+				if !field.Exported() {
+					return fmt.Errorf("%s has unexported field %s and needs a hand written MarshalJSON", t.Obj().Name(), field.Name())
+				}
+				if err := gen.walkValue(field.Type()); err != nil {
+					return fmt.Errorf("%s.%s: %w", t.Obj().Name(), field.Name(), err)
+				}
+			}
+			return nil
+		case *types.Slice:
+			return gen.walkValue(u.Elem())
+		case *types.Basic:
+			return nil
+		default:
+			return fmt.Errorf("cannot encode %s", t.Obj().Name())
+		}
+	case *types.Pointer:
+		return gen.walk(t.Elem())
+	case *types.Slice:
+		return gen.walkValue(t.Elem())
+	case *types.Basic:
+		return nil
+	default:
+		return fmt.Errorf("cannot encode %s", t)
+	}
+}
+
+// walkValue walks a type that is stored as is, rather than through a pointer.
+func (gen *jsonGen) walkValue(t types.Type) error {
+	if named, ok := types.Unalias(t).(*types.Named); ok && named.Obj().Pkg() == gen.ast.pkg && !types.IsInterface(named) {
+		gen.byValue[named] = true
+	}
+	return gen.walk(t)
+}
+
+// checkForeign makes sure that a type from another package can be encoded by
+// encoding/json without losing information.
+func (gen *jsonGen) checkForeign(t *types.Named) error {
+	if gen.hasCustomJSON(t) {
+		return nil
+	}
+	if _, ok := t.Underlying().(*types.Basic); ok {
+		return nil
+	}
+	return fmt.Errorf("cannot encode %s", gen.ast.typeName(t))
+}
+
+// hasCustomJSON returns whether t already knows how to encode and decode itself.
+func (gen *jsonGen) hasCustomJSON(t *types.Named) bool {
+	ptr := types.NewMethodSet(types.NewPointer(t))
+	return ptr.Lookup(t.Obj().Pkg(), "MarshalJSON") != nil && ptr.Lookup(t.Obj().Pkg(), "UnmarshalJSON") != nil
+}
+
+// isInline returns whether the node t is encoded as an object carrying its own type.
+func (gen *jsonGen) isInline(t types.Type) bool {
+	named, ok := namedOf(t)
+	if !ok || gen.hasCustomJSON(named) {
+		return false
+	}
+	_, isStruct := named.Underlying().(*types.Struct)
+	return isStruct
+}
+
+// fieldType returns the type used to encode a field of type t.
+func (gen *jsonGen) fieldType(t types.Type) (string, bool) {
+	if gen.ast.isNodeInterface(t) {
+		return fmt.Sprintf("jsonNode[%s]", gen.ast.typeName(t)), true
+	}
+	if slice, ok := t.(*types.Slice); ok && gen.ast.isNodeInterface(slice.Elem()) {
+		return fmt.Sprintf("jsonNodes[%s]", gen.ast.typeName(slice.Elem())), true
+	}
+	return types.TypeString(t, func(p *types.Package) string {
+		if p == gen.ast.pkg {
+			return ""
+		}
+		gen.imports[p.Path()] = true
+		return p.Name()
+	}), false
+}
+
+func (gen *jsonGen) marshalNode() {
+	name := gen.ast.root.Obj().Name()
+	gen.printf("// marshalJSON%[1]s encodes a node along with the name of its type.\n", name)
+	gen.printf("func marshalJSON%[1]s(in %[1]s) ([]byte, error) {\n", name)
+	gen.printf("if in == nil {\nreturn []byte(\"null\"), nil\n}\n")
+	gen.printf("switch in := in.(type) {\n")
+	for _, impl := range gen.ast.implementations {
+		if gen.isInline(impl) {
+			continue
+		}
+		typeName := gen.ast.typeName(impl)
+		gen.printf("case %s:\n", typeName)
+		gen.printf("return marshalJSONValue(%q, in)\n", strings.TrimPrefix(typeName, "*"))
+	}
+	gen.printf("default:\nreturn json.Marshal(in)\n}\n}\n\n")
+}
+
+func (gen *jsonGen) unmarshalNode() {
+	name := gen.ast.root.Obj().Name()
+	gen.printf("// unmarshalJSON%[1]s decodes a node encoded by marshalJSON%[1]s.\n", name)
+	gen.printf("func unmarshalJSON%[1]s(data []byte) (%[1]s, error) {\n", name)
+	gen.printf("head, err := unmarshalJSONHead(data)\n")
+	gen.printf("if err != nil || head == nil {\nreturn nil, err\n}\n")
+	gen.printf("switch head.Type {\n")
+	for _, impl := range gen.ast.implementations {
+		typeName := gen.ast.typeName(impl)
+		name := strings.TrimPrefix(typeName, "*")
+		src := "head.Value"
+		if gen.isInline(impl) {
+			src = "data"
+		}
+		gen.printf("case %q:\n", name)
+		if _, isPtr := impl.(*types.Pointer); isPtr {
+			gen.printf("node := &%s{}\n", name)
+			gen.printf("if err := json.Unmarshal(%s, node); err != nil {\nreturn nil, err\n}\n", src)
+		} else {
+			gen.printf("var node %s\n", name)
+			gen.printf("if err := json.Unmarshal(%s, &node); err != nil {\nreturn nil, err\n}\n", src)
+		}
+		gen.printf("return node, nil\n")
+	}
+	gen.printf("}\n")
+	gen.printf("return nil, fmt.Errorf(\"unknown %s type %%q\", head.Type)\n}\n\n", name)
+}
+
+// jsonTypeField is the name of the field holding the discriminator in the structs
+// the generated code encodes to and decodes from.
+const jsonTypeField = "JSONType_"
+
+func (gen *jsonGen) structMethods(t *types.Named, st *types.Struct) error {
+	name := t.Obj().Name()
+	type field struct {
+		name, typ string
+		wrapped   bool
+	}
+	var fields []field
+	for i := 0; i < st.NumFields(); i++ {
+		f := st.Field(i)
+		if f.Name() == "_" {
+			continue
+		}
+		if f.Name() == jsonTypeField {
+			return fmt.Errorf("%s.%s clashes with the type discriminator", name, f.Name())
+		}
+		typ, wrapped := gen.fieldType(f.Type())
+		fields = append(fields, field{name: f.Name(), typ: typ, wrapped: wrapped})
+	}
+
+	recv := "node *" + name
+	if gen.byValue[t] {
+		recv = "node " + name
+	}
+	gen.printf("// MarshalJSON encodes %s into JSON.\n", name)
+	gen.printf("func (%s) MarshalJSON() ([]byte, error) {\n", recv)
+	if !gen.byValue[t] {
+		gen.printf("if node == nil {\nreturn []byte(\"null\"), nil\n}\n")
+	}
+	gen.printf("return json.Marshal(struct {\n")
+	gen.printf("%s string `json:\"type\"`\n", jsonTypeField)
+	for _, f := range fields {
+		gen.printf("%s %s `json:%q`\n", f.name, f.typ, f.name)
+	}
+	gen.printf("}{\n%s: %q,\n", jsonTypeField, name)
+	for _, f := range fields {
+		switch {
+		case strings.HasPrefix(f.typ, "jsonNodes["):
+			gen.printf("%s: %s(node.%s),\n", f.name, f.typ, f.name)
+		case f.wrapped:
+			gen.printf("%s: %s{node.%s},\n", f.name, f.typ, f.name)
+		default:
+			gen.printf("%s: node.%s,\n", f.name, f.name)
+		}
+	}
+	gen.printf("})\n}\n\n")
+
+	gen.printf("// UnmarshalJSON decodes %s from JSON.\n", name)
+	gen.printf("func (node *%s) UnmarshalJSON(data []byte) error {\n", name)
+	gen.printf("var v struct {\n")
+	gen.printf("%s string `json:\"type\"`\n", jsonTypeField)
+	for _, f := range fields {
+		gen.printf("%s %s `json:%q`\n", f.name, f.typ, f.name)
+	}
+	gen.printf("}\n")
+	gen.printf("if err := json.Unmarshal(data, &v); err != nil {\nreturn err\n}\n")
+	gen.printf("if v.%[1]s != \"\" && v.%[1]s != %[2]q {\n", jsonTypeField, name)
+	gen.printf("return fmt.Errorf(\"cannot decode %%s into %s\", v.%s)\n}\n", name, jsonTypeField)
+	gen.printf("*node = %s{\n", name)
+	for _, f := range fields {
+		switch {
+		case strings.HasPrefix(f.typ, "jsonNodes["):
+			elem := strings.TrimSuffix(strings.TrimPrefix(f.typ, "jsonNodes["), "]")
+			gen.printf("%s: []%s(v.%s),\n", f.name, elem, f.name)
+		case f.wrapped:
+			gen.printf("%s: v.%s.node,\n", f.name, f.name)
+		default:
+			gen.printf("%s: v.%s,\n", f.name, f.name)
+		}
+	}
+	gen.printf("}\nreturn nil\n}\n\n")
+	return nil
+}
+
+// sliceMethods generates the methods of a named slice whose elements are nodes
+// stored as interfaces. Other slices are left to encoding/json.
+func (gen *jsonGen) sliceMethods(t *types.Named, sl *types.Slice) {
+	if !gen.ast.isNodeInterface(sl.Elem()) {
+		return
+	}
+	name := t.Obj().Name()
+	elem := gen.ast.typeName(sl.Elem())
+	gen.printf("// MarshalJSON encodes %s into JSON.\n", name)
+	gen.printf("func (node %s) MarshalJSON() ([]byte, error) {\n", name)
+	gen.printf("return json.Marshal(jsonNodes[%s](node))\n}\n\n", elem)
+	gen.printf("// UnmarshalJSON decodes %s from JSON.\n", name)
+	gen.printf("func (node *%s) UnmarshalJSON(data []byte) error {\n", name)
+	gen.printf("var v jsonNodes[%s]\n", elem)
+	gen.printf("if err := json.Unmarshal(data, &v); err != nil {\nreturn err\n}\n")
+	gen.printf("*node = %s(v)\nreturn nil\n}\n\n", name)
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"flag"
+	"log"
+	"strings"
+
+	"github.com/vedadiyan/sqlparser/pkg/tools/asthelpergen"
+)
+
+func main() {
+	var options asthelpergen.Options
+	flag.StringVar(&options.Package, "in", ".", "directory of the package holding the AST")
+	flag.StringVar(&options.RootInterface, "iface", "SQLNode", "name of the interface implemented by every AST node")
+	flag.StringVar(&options.ProtoFile, "proto", "../proto/ast.proto", "path of the protobuf schema of the AST, relative to the package")
+	flag.StringVar(&options.ProtoGoPackage, "proto-go-package", "github.com/vedadiyan/sqlparser/pkg/ast", "import path of the Go code compiled from the protobuf schema")
+	equalsCustom := flag.String("equals-custom", "*ColName", "comma separated types whose comparison can be overridden on the Comparator")
+	cloneExclude := flag.String("clone-exclude", "*ColName", "comma separated pointer types that are not deep cloned")
+	verify := flag.Bool("verify", false, "check that the generated files are up to date instead of writing them")
+	flag.Parse()
+
+	if *equalsCustom != "" {
+		options.EqualsCustom = strings.Split(*equalsCustom, ",")
+	}
+	if *cloneExclude != "" {
+		options.CloneExclude = strings.Split(*cloneExclude, ",")
+	}
+	run := asthelpergen.Generate
+	if *verify {
+		run = asthelpergen.Verify
+	}
+	if err := run(options); err != nil {
+		log.Fatal(err)
+	}
+}
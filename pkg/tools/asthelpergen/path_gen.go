@@ -0,0 +1,142 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asthelpergen
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+)
+
+// pathGen generates ast_path.go, the ASTStep constants the rewriter records paths
+// with, and GetNodeFromPath following a path back to the node.
+type pathGen struct {
+	steps []pathStep
+}
+
+// pathStep is a step from a value to one of its children.
+type pathStep struct {
+	name string
+	// debug is how the step is shown by DebugString.
+	debug string
+	// node is the expression reaching the child from node, or empty if the value
+	// is not a node and cannot be found on a path.
+	node string
+	// offset is set for steps to an element of a slice.
+	offset bool
+}
+
+func (p *pathGen) fileName() string {
+	return "ast_path.go"
+}
+
+func (p *pathGen) generate(ast *astInfo) ([]byte, error) {
+	steps := append(p.steps, pathStep{
+		name:  pathStepName(visitableName, "Inner"),
+		debug: pathStepName(visitableName, "Inner"),
+		node:  fmt.Sprintf("node.(%s).VisitThis()", visitableName),
+	})
+
+	var b bytes.Buffer
+	b.WriteString("type ASTStep uint16\n\nconst (\n")
+	for i, step := range steps {
+		if i == 0 {
+			fmt.Fprintf(&b, "%s ASTStep = iota\n", step.name)
+		} else {
+			fmt.Fprintf(&b, "%s\n", step.name)
+		}
+	}
+	b.WriteString(")\n\n")
+
+	b.WriteString("func (s ASTStep) DebugString() string {\nswitch s {\n")
+	for _, step := range steps {
+		fmt.Fprintf(&b, "case %s:\nreturn %q\n", step.name, step.debug)
+	}
+	b.WriteString("}\npanic(\"unknown ASTStep\")\n}\n")
+
+	fmt.Fprintf(&b, "func GetNodeFromPath(node %s, path ASTPath) %s {\n", ast.root.Obj().Name(), ast.root.Obj().Name())
+	b.WriteString("for len(path) >= 2 {\nstep := path.nextPathStep()\npath = path[2:]\nswitch step {\n")
+	for _, step := range steps {
+		if step.node == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "case %s:\n", step.name)
+		if step.offset {
+			b.WriteString("idx, bytesRead := path.nextPathOffset()\npath = path[bytesRead:]\n")
+		}
+		fmt.Fprintf(&b, "node = %s\n", step.node)
+	}
+	b.WriteString("default:\nreturn nil\n}\n}\nreturn node\n}\n")
+	return renderGo(ast.pkg.Name(), nil, b.String())
+}
+
+func (p *pathGen) interfaceMethod(types.Type, *types.Interface, *typeWalker) error {
+	return nil
+}
+
+func (p *pathGen) structMethod(t types.Type, strct *types.Struct, w *typeWalker) error {
+	p.addStructFields(t, strct, w)
+	return nil
+}
+
+func (p *pathGen) ptrToStructMethod(t types.Type, strct *types.Struct, w *typeWalker) error {
+	p.addStructFields(t, strct, w)
+	return nil
+}
+
+func (p *pathGen) ptrToBasicMethod(types.Type, *types.Basic, *typeWalker) error {
+	return nil
+}
+
+func (p *pathGen) sliceMethod(t types.Type, slice *types.Slice, w *typeWalker) error {
+	if !w.ast.isNode(slice.Elem()) {
+		return nil
+	}
+	typeString := w.ast.typeName(t)
+	step := pathStep{
+		name:   pathStepName(printableTypeName(t), "Offset"),
+		debug:  fmt.Sprintf("(%s)[]Offset", typeString),
+		offset: true,
+	}
+	if w.ast.isNode(t) {
+		step.node = fmt.Sprintf("node.(%s)[idx]", typeString)
+	}
+	p.steps = append(p.steps, step)
+	return nil
+}
+
+func (p *pathGen) basicMethod(types.Type, *types.Basic, *typeWalker) error {
+	return nil
+}
+
+func (p *pathGen) addStructFields(t types.Type, strct *types.Struct, w *typeWalker) {
+	typeString := w.ast.typeName(t)
+	for _, field := range nodeFields(strct, w) {
+		step := pathStep{
+			name:   pathStepName(printableTypeName(t), field.step),
+			debug:  fmt.Sprintf("(%s).%s", typeString, field.step),
+			offset: field.slice != nil,
+		}
+		if w.ast.isNode(t) {
+			step.node = fmt.Sprintf("node.(%s).%s", typeString, field.name)
+			if step.offset {
+				step.node += "[idx]"
+			}
+		}
+		p.steps = append(p.steps, step)
+	}
+}
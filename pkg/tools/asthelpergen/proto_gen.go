@@ -0,0 +1,759 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asthelpergen
+
+import (
+	"fmt"
+	"go/types"
+	"path"
+	"sort"
+	"strings"
+)
+
+// The protobuf representation of the AST has a message for every struct and named
+// slice reachable from the nodes, with a field for every field of the struct, in
+// declaration order. Interface fields hold a Node, a message with a oneof over every
+// implementation of the root interface. protoSchemaGen writes the schema, which is
+// compiled with protoc like the rest of the protos, and protoGen the conversions
+// between the AST and the compiled messages.
+
+// protoCustomType describes a type with unexported state, which cannot be converted
+// field by field.
+type protoCustomType struct {
+	// scalar is set for types stored as a string. They are converted with their
+	// String method and a NewX constructor.
+	scalar bool
+	// fields holds the fields of the message of the type otherwise. It is converted
+	// by the hand written toProtoX and fromProtoX.
+	fields []string
+}
+
+var protoCustomTypes = map[string]protoCustomType{
+	"IdentifierCI":   {scalar: true},
+	"IdentifierCS":   {scalar: true},
+	"ParsedComments": {fields: []string{"repeated string comments = 1;"}},
+}
+
+// protoField is a field of a generated message.
+type protoField struct {
+	name   string
+	number int
+	typ    string
+	label  string
+
+	goName string
+	goType types.Type
+}
+
+// protoMessage is a generated message, along with the Go type it represents.
+type protoMessage struct {
+	name   string
+	goType *types.Named
+	fields []protoField
+	custom []string
+}
+
+type protoModel struct {
+	ast      *astInfo
+	messages map[string]*protoMessage
+
+	// node holds the fields of the oneof of Node, one per implementation.
+	node []protoField
+}
+
+func newProtoModel(ast *astInfo) (*protoModel, error) {
+	m := &protoModel{ast: ast, messages: make(map[string]*protoMessage)}
+	for _, impl := range ast.implementations {
+		named, _ := namedOf(impl)
+		name := named.Obj().Name()
+		if err := m.addType(impl); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if m.messages[name] == nil {
+			// scalar nodes need a message to be part of the oneof
+			typ, _, err := m.fieldType(named)
+			if err != nil {
+				return nil, err
+			}
+			m.messages[name] = &protoMessage{
+				name:   name,
+				goType: named,
+				fields: []protoField{{name: "value", number: 1, typ: typ, goName: "Value", goType: named}},
+			}
+		}
+		m.node = append(m.node, protoField{
+			name:   protoFieldName(name),
+			number: len(m.node) + 1,
+			typ:    name,
+			goType: impl,
+		})
+	}
+	return m, nil
+}
+
+func (m *protoModel) sortedMessages() []*protoMessage {
+	messages := make([]*protoMessage, 0, len(m.messages))
+	for _, msg := range m.messages {
+		messages = append(messages, msg)
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].name < messages[j].name
+	})
+	return messages
+}
+
+// addType adds the messages needed to represent t.
+func (m *protoModel) addType(t types.Type) error {
+	switch t := types.Unalias(t).(type) {
+	case *types.Pointer:
+		return m.addType(t.Elem())
+	case *types.Slice:
+		return m.addType(t.Elem())
+	case *types.Named:
+		if t.Obj().Pkg() != m.ast.pkg || types.IsInterface(t) {
+			return nil
+		}
+		name := t.Obj().Name()
+		if m.messages[name] != nil {
+			return nil
+		}
+		if custom, ok := protoCustomTypes[name]; ok {
+			if !custom.scalar {
+				m.messages[name] = &protoMessage{name: name, goType: t, custom: custom.fields}
+			}
+			return nil
+		}
+		switch u := t.Underlying().(type) {
+		case *types.Struct:
+			msg := &protoMessage{name: name, goType: t}
+			m.messages[name] = msg
+			for i := 0; i < u.NumFields(); i++ {
+				field := u.Field(i)
+				if field.Name() == "_" {
+					continue
+				}
+				if !field.Exported() {
+					return fmt.Errorf("%s has unexported field %s and needs a custom conversion", name, field.Name())
+				}
+				typ, label, err := m.fieldType(field.Type())
+				if err != nil {
+					return fmt.Errorf("%s.%s: %w", name, field.Name(), err)
+				}
+				msg.fields = append(msg.fields, protoField{
+					name:   protoFieldName(field.Name()),
+					number: len(msg.fields) + 1,
+					typ:    typ,
+					label:  label,
+					goName: field.Name(),
+					goType: field.Type(),
+				})
+				if err := m.addType(field.Type()); err != nil {
+					return err
+				}
+			}
+		case *types.Slice:
+			typ, label, err := m.fieldType(u)
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			m.messages[name] = &protoMessage{
+				name:   name,
+				goType: t,
+				fields: []protoField{{name: "elements", number: 1, typ: typ, label: label, goName: "Elements", goType: u}},
+			}
+			return m.addType(u.Elem())
+		}
+	}
+	return nil
+}
+
+// fieldType returns the protobuf type of a field holding t, along with its label.
+func (m *protoModel) fieldType(t types.Type) (typ, label string, err error) {
+	t = types.Unalias(t)
+	if m.ast.isNodeInterface(t) {
+		return "Node", "", nil
+	}
+	switch t := t.(type) {
+	case *types.Pointer:
+		elem := types.Unalias(t.Elem())
+		if _, ok := elem.Underlying().(*types.Basic); ok {
+			typ, _, err := m.fieldType(elem)
+			return typ, "optional", err
+		}
+		if named, ok := elem.(*types.Named); ok {
+			if _, ok := named.Underlying().(*types.Struct); ok {
+				return m.fieldType(named)
+			}
+		}
+	case *types.Slice:
+		if basic, ok := t.Elem().(*types.Basic); ok && basic.Kind() == types.Byte {
+			return "bytes", "", nil
+		}
+		typ, label, err := m.fieldType(t.Elem())
+		if err != nil {
+			return "", "", err
+		}
+		if label != "" {
+			return "", "", fmt.Errorf("cannot represent %s", m.ast.typeName(t))
+		}
+		return typ, "repeated", nil
+	case *types.Named:
+		if custom, ok := protoCustomTypes[t.Obj().Name()]; ok && t.Obj().Pkg() == m.ast.pkg {
+			if custom.scalar {
+				return "string", "", nil
+			}
+			return t.Obj().Name(), "", nil
+		}
+		switch u := t.Underlying().(type) {
+		case *types.Basic:
+			return m.fieldType(u)
+		case *types.Struct, *types.Slice:
+			if t.Obj().Pkg() == m.ast.pkg {
+				return t.Obj().Name(), "", nil
+			}
+		}
+	case *types.Basic:
+		if typ, ok := protoScalars[t.Kind()]; ok {
+			return typ, "", nil
+		}
+	}
+	return "", "", fmt.Errorf("cannot represent %s", m.ast.typeName(t))
+}
+
+var protoScalars = map[types.BasicKind]string{
+	types.Bool:    "bool",
+	types.String:  "string",
+	types.Int:     "int64",
+	types.Int8:    "int32",
+	types.Int16:   "int32",
+	types.Int32:   "int32",
+	types.Int64:   "int64",
+	types.Uint:    "uint64",
+	types.Uint8:   "uint32",
+	types.Uint16:  "uint32",
+	types.Uint32:  "uint32",
+	types.Uint64:  "uint64",
+	types.Float32: "float",
+	types.Float64: "double",
+}
+
+// protoGoScalars holds the Go type protoc-gen-go uses for every scalar.
+var protoGoScalars = map[string]string{
+	"bool":   "bool",
+	"string": "string",
+	"int64":  "int64",
+	"int32":  "int32",
+	"uint64": "uint64",
+	"uint32": "uint32",
+	"float":  "float32",
+	"double": "float64",
+	"bytes":  "[]byte",
+}
+
+// protoFieldName returns the snake case name of a Go identifier, e.g. SQLCalcFoundRows
+// becomes sql_calc_found_rows.
+func protoFieldName(name string) string {
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if isUpper(c) {
+			prevLower := i > 0 && !isUpper(name[i-1]) && name[i-1] != '_'
+			nextLower := i > 0 && i+1 < len(name) && isUpper(name[i-1]) && isLower(name[i+1])
+			if prevLower || nextLower {
+				b.WriteByte('_')
+			}
+			c += 'a' - 'A'
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// goCamelCase returns the name protoc-gen-go gives to the Go field of a proto field.
+func goCamelCase(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_' && i == 0:
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(s) && isLower(s[i+1]):
+			// skip the underscore, the next letter is capitalized
+		case isDigit(c):
+			b = append(b, c)
+		default:
+			if isLower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(s) && isLower(s[i+1]); i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+// goFieldName returns the name of the Go field of a message field, which protoc-gen-go
+// suffixes with an underscore when it collides with a method of the message.
+func goFieldName(name string) string {
+	goName := goCamelCase(name)
+	switch goName {
+	case "Reset", "String", "ProtoMessage", "Marshal", "Unmarshal", "ExtensionRangeArray", "ExtensionMap", "Descriptor":
+		return goName + "_"
+	}
+	return goName
+}
+
+func isUpper(c byte) bool { return 'A' <= c && c <= 'Z' }
+func isLower(c byte) bool { return 'a' <= c && c <= 'z' }
+func isDigit(c byte) bool { return '0' <= c && c <= '9' }
+
+// protoSchemaGen writes the protobuf schema of the AST.
+type protoSchemaGen struct {
+	file string
+}
+
+func (gen *protoSchemaGen) fileName() string {
+	return gen.file
+}
+
+func (gen *protoSchemaGen) generate(ast *astInfo) ([]byte, error) {
+	m, err := newProtoModel(ast)
+	if err != nil {
+		return nil, err
+	}
+	pkgName := path.Base(ast.options.ProtoGoPackage)
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "/*\n%s\n*/\n\n%s\n\n", licenseFileHeader, generatedHeader)
+	buf.WriteString("// This package contains the protobuf representation of the sqlparser AST.\n")
+	buf.WriteString("// Fields are numbered in the order they are declared in Go, so messages\n")
+	buf.WriteString("// are only compatible between builds of the same AST.\n\n")
+	fmt.Fprintf(&buf, "syntax = \"proto3\";\noption go_package = %q;\n\npackage %s;\n\n", ast.options.ProtoGoPackage, pkgName)
+
+	buf.WriteString("// Node holds any node of the AST.\nmessage Node {\n  oneof node {\n")
+	for _, f := range m.node {
+		fmt.Fprintf(&buf, "    %s %s = %d;\n", f.typ, f.name, f.number)
+	}
+	buf.WriteString("  }\n}\n")
+
+	for _, msg := range m.sortedMessages() {
+		fmt.Fprintf(&buf, "\nmessage %s {\n", msg.name)
+		for _, f := range msg.custom {
+			fmt.Fprintf(&buf, "  %s\n", f)
+		}
+		for _, f := range msg.fields {
+			label := ""
+			if f.label != "" {
+				label = f.label + " "
+			}
+			fmt.Fprintf(&buf, "  %s%s %s = %d;\n", label, f.typ, f.name, f.number)
+		}
+		buf.WriteString("}\n")
+	}
+	return []byte(buf.String()), nil
+}
+
+// protoGen writes the conversions between the AST and its protobuf messages.
+type protoGen struct {
+	m   *protoModel
+	buf strings.Builder
+
+	// funcs holds the conversion functions generated so far, by the name of the
+	// type they convert, and todo the types that still need one.
+	funcs map[string]bool
+	todo  []types.Type
+
+	imports map[string]bool
+}
+
+func (gen *protoGen) fileName() string {
+	return "ast_proto.go"
+}
+
+func (gen *protoGen) printf(format string, args ...any) {
+	fmt.Fprintf(&gen.buf, format, args...)
+}
+
+func (gen *protoGen) generate(ast *astInfo) ([]byte, error) {
+	m, err := newProtoModel(ast)
+	if err != nil {
+		return nil, err
+	}
+	gen.m = m
+	gen.funcs = make(map[string]bool)
+	gen.imports = map[string]bool{"astpb " + ast.options.ProtoGoPackage: true}
+
+	gen.nodeFuncs()
+	var done []string
+	bodies := make(map[string]string)
+	for len(gen.todo) > 0 {
+		t := gen.todo[0]
+		gen.todo = gen.todo[1:]
+		body := gen.buf.String()
+		gen.buf.Reset()
+		gen.convFuncs(t)
+		name := gen.mangle(t)
+		bodies[name] = gen.buf.String()
+		done = append(done, name)
+		gen.buf.Reset()
+		gen.buf.WriteString(body)
+	}
+	sort.Strings(done)
+	for _, name := range done {
+		gen.buf.WriteString(bodies[name])
+	}
+
+	imports := make([]string, 0, len(gen.imports))
+	for imp := range gen.imports {
+		imports = append(imports, imp)
+	}
+	return renderGo(ast.pkg.Name(), imports, gen.buf.String())
+}
+
+// pb returns the Go name of a protobuf message.
+func (gen *protoGen) pb(name string) string {
+	return "astpb." + name
+}
+
+func (gen *protoGen) typeName(t types.Type) string {
+	return types.TypeString(t, func(p *types.Package) string {
+		if p == gen.m.ast.pkg {
+			return ""
+		}
+		gen.imports[p.Path()] = true
+		return p.Name()
+	})
+}
+
+// mangle returns the name used for the conversion functions of t, following the
+// naming of the other helpers: *Select is RefOfSelect and []Expr SliceOfExpr.
+func (gen *protoGen) mangle(t types.Type) string {
+	switch t := types.Unalias(t).(type) {
+	case *types.Pointer:
+		return "RefOf" + gen.mangle(t.Elem())
+	case *types.Slice:
+		return "SliceOf" + gen.mangle(t.Elem())
+	case *types.Named:
+		return t.Obj().Name()
+	case *types.Basic:
+		return strings.ToUpper(t.Name()[:1]) + t.Name()[1:]
+	}
+	panic(fmt.Sprintf("cannot mangle %s", t))
+}
+
+// need makes sure the conversion functions of t get generated.
+func (gen *protoGen) need(t types.Type) string {
+	name := gen.mangle(t)
+	if !gen.funcs[name] {
+		gen.funcs[name] = true
+		gen.todo = append(gen.todo, t)
+	}
+	return name
+}
+
+// goType returns the Go type of the protobuf field representing t.
+func (gen *protoGen) goType(t types.Type) string {
+	typ, label, err := gen.m.fieldType(t)
+	if err != nil {
+		panic(err)
+	}
+	goTyp, ok := protoGoScalars[typ]
+	if !ok {
+		goTyp = "*" + gen.pb(typ)
+	}
+	switch label {
+	case "repeated":
+		return "[]" + goTyp
+	case "optional":
+		return "*" + goTyp
+	}
+	return goTyp
+}
+
+// kind classifies how a value of type t is converted.
+type convKind int
+
+const (
+	convNode   convKind = iota // an interface holding a node
+	convStruct                 // a struct stored as a value
+	convRef                    // a pointer to a struct
+	convScalar                 // a basic type, named or not
+	convIdent                  // a type stored as a string
+	convFunc                   // anything converted by a generated function
+	convCustom                 // a type converted by hand written functions
+	convUnknown
+)
+
+func (gen *protoGen) kind(t types.Type) convKind {
+	t = types.Unalias(t)
+	if gen.m.ast.isNodeInterface(t) {
+		return convNode
+	}
+	switch t := t.(type) {
+	case *types.Named:
+		if custom, ok := protoCustomTypes[t.Obj().Name()]; ok && t.Obj().Pkg() == gen.m.ast.pkg {
+			if custom.scalar {
+				return convIdent
+			}
+			return convStruct
+		}
+		switch t.Underlying().(type) {
+		case *types.Basic:
+			return convScalar
+		case *types.Struct:
+			return convStruct
+		}
+		return convFunc
+	case *types.Pointer:
+		if named, ok := types.Unalias(t.Elem()).(*types.Named); ok {
+			if _, ok := named.Underlying().(*types.Struct); ok {
+				if custom, ok := protoCustomTypes[named.Obj().Name()]; ok && !custom.scalar {
+					return convCustom
+				}
+				return convRef
+			}
+		}
+		return convFunc
+	case *types.Basic:
+		return convScalar
+	}
+	return convFunc
+}
+
+// toProto returns an expression converting expr, of type t, to its protobuf type.
+// expr must be addressable when t is a struct.
+func (gen *protoGen) toProto(t types.Type, expr string) string {
+	switch gen.kind(t) {
+	case convNode:
+		return fmt.Sprintf("toProtoNode(%s)", expr)
+	case convStruct:
+		named, _ := namedOf(t)
+		return fmt.Sprintf("toProto%s(&%s)", gen.need(types.NewPointer(named)), expr)
+	case convScalar:
+		goTyp := gen.goType(t)
+		if goTyp == gen.typeName(t) {
+			return expr
+		}
+		return fmt.Sprintf("%s(%s)", goTyp, expr)
+	case convIdent:
+		return expr + ".String()"
+	case convCustom:
+		named, _ := namedOf(t)
+		return fmt.Sprintf("toProto%s(%s)", named.Obj().Name(), expr)
+	default:
+		return fmt.Sprintf("toProto%s(%s)", gen.need(t), expr)
+	}
+}
+
+// fromProto writes a statement assigning expr, the protobuf representation of a value
+// of type t, to dst. Errors are stored in err and returned, as nil and err.
+func (gen *protoGen) fromProto(t types.Type, dst, expr string) {
+	assign := func(format string, args ...any) {
+		gen.printf("if %s, err = %s; err != nil {\nreturn nil, err\n}\n", dst, fmt.Sprintf(format, args...))
+	}
+	switch gen.kind(t) {
+	case convNode:
+		if types.Identical(types.Unalias(t), gen.m.ast.root) {
+			assign("fromProtoNode(%s)", expr)
+		} else {
+			assign("fromProtoNodeAs[%s](%s)", gen.typeName(t), expr)
+		}
+	case convStruct:
+		named, _ := namedOf(t)
+		if custom, ok := protoCustomTypes[named.Obj().Name()]; ok && !custom.scalar {
+			assign("derefFromProto(fromProto%s(%s))", named.Obj().Name(), expr)
+		} else {
+			assign("derefFromProto(fromProto%s(%s))", gen.need(types.NewPointer(named)), expr)
+		}
+	case convScalar:
+		typ := gen.typeName(t)
+		if gen.goType(t) == typ {
+			gen.printf("%s = %s\n", dst, expr)
+		} else {
+			gen.printf("%s = %s(%s)\n", dst, typ, expr)
+		}
+	case convIdent:
+		named, _ := namedOf(t)
+		gen.printf("%s = New%s(%s)\n", dst, named.Obj().Name(), expr)
+	case convCustom:
+		named, _ := namedOf(t)
+		assign("fromProto%s(%s)", named.Obj().Name(), expr)
+	default:
+		assign("fromProto%s(%s)", gen.need(t), expr)
+	}
+}
+
+func (gen *protoGen) nodeFuncs() {
+	root := gen.m.ast.root.Obj().Name()
+	gen.printf("// toProtoNode converts a node to its protobuf representation.\n")
+	gen.printf("func toProtoNode(in %s) *%s {\n", root, gen.pb("Node"))
+	gen.printf("if in == nil {\nreturn nil\n}\n")
+	gen.printf("switch in := in.(type) {\n")
+	for _, f := range gen.m.node {
+		field := goCamelCase(f.name)
+		gen.printf("case %s:\n", gen.typeName(f.goType))
+		var value string
+		switch gen.kind(f.goType) {
+		case convScalar, convIdent:
+			value = fmt.Sprintf("&%s{Value: %s}", gen.pb(f.typ), gen.toProto(f.goType, "in"))
+		default:
+			value = gen.toProto(f.goType, "in")
+		}
+		gen.printf("return &%s{Node: &%s{%s: %s}}\n", gen.pb("Node"), gen.pb("Node_"+field), field, value)
+	}
+	gen.printf("}\nreturn nil\n}\n\n")
+
+	gen.printf("// fromProtoNode converts the protobuf representation of a node back to the node.\n")
+	gen.printf("func fromProtoNode(in *%s) (%s, error) {\n", gen.pb("Node"), root)
+	gen.printf("switch in := in.GetNode().(type) {\n")
+	for _, f := range gen.m.node {
+		field := goCamelCase(f.name)
+		gen.printf("case *%s:\n", gen.pb("Node_"+field))
+		switch gen.kind(f.goType) {
+		case convScalar, convIdent:
+			gen.printf("var out %s\n", gen.typeName(f.goType))
+			gen.fromProto(f.goType, "out", fmt.Sprintf("in.%s.GetValue()", field))
+			gen.printf("return out, nil\n")
+		default:
+			gen.printf("out, err := %s\n", gen.fromProtoCall(f.goType, "in."+field))
+			gen.printf("if err != nil {\nreturn nil, err\n}\nreturn out, nil\n")
+		}
+	}
+	gen.printf("}\nreturn nil, nil\n}\n\n")
+}
+
+// fromProtoCall returns a call converting expr back to t, returning the value and an error.
+func (gen *protoGen) fromProtoCall(t types.Type, expr string) string {
+	switch gen.kind(t) {
+	case convStruct:
+		named, _ := namedOf(t)
+		return fmt.Sprintf("derefFromProto(fromProto%s(%s))", gen.need(types.NewPointer(named)), expr)
+	case convCustom:
+		named, _ := namedOf(t)
+		return fmt.Sprintf("fromProto%s(%s)", named.Obj().Name(), expr)
+	default:
+		return fmt.Sprintf("fromProto%s(%s)", gen.need(t), expr)
+	}
+}
+
+// convFuncs writes the conversion functions of t.
+func (gen *protoGen) convFuncs(t types.Type) {
+	name := gen.mangle(t)
+	goName := gen.typeName(t)
+	pbType := gen.goType(t)
+
+	switch t := types.Unalias(t).(type) {
+	case *types.Pointer:
+		elem := types.Unalias(t.Elem())
+		if named, ok := elem.(*types.Named); ok {
+			if _, ok := named.Underlying().(*types.Struct); ok {
+				gen.structFuncs(named)
+				return
+			}
+		}
+		// a pointer to a scalar
+		gen.printf("func toProto%s(in %s) %s {\n", name, goName, pbType)
+		gen.printf("if in == nil {\nreturn nil\n}\n")
+		gen.printf("out := %s\nreturn &out\n}\n\n", gen.toProto(elem, "*in"))
+		gen.printf("func fromProto%s(in %s) (%s, error) {\n", name, pbType, goName)
+		gen.printf("if in == nil {\nreturn nil, nil\n}\n")
+		gen.printf("var out %s\n", gen.typeName(elem))
+		gen.fromProto(elem, "out", "*in")
+		gen.printf("return &out, nil\n}\n\n")
+
+	case *types.Named:
+		// a named slice, wrapped in a message
+		sl := t.Underlying().(*types.Slice)
+		gen.printf("func toProto%s(in %s) %s {\n", name, goName, pbType)
+		gen.printf("if in == nil {\nreturn nil\n}\n")
+		gen.printf("return &%s{Elements: %s}\n}\n\n", gen.pb(t.Obj().Name()), gen.toProto(sl, "in"))
+		gen.printf("func fromProto%s(in %s) (%s, error) {\n", name, pbType, goName)
+		gen.printf("if in == nil {\nreturn nil, nil\n}\n")
+		gen.printf("out := make(%s, 0, len(in.Elements))\n", goName)
+		gen.printf("for _, elem := range in.Elements {\n")
+		gen.elemVar(sl.Elem())
+		gen.fromProto(sl.Elem(), "v", "elem")
+		gen.printf("out = append(out, v)\n}\nreturn out, nil\n}\n\n")
+
+	case *types.Slice:
+		elemPB := gen.goType(t.Elem())
+		gen.printf("func toProto%s(in %s) %s {\n", name, goName, pbType)
+		gen.printf("if in == nil {\nreturn nil\n}\n")
+		gen.printf("out := make([]%s, 0, len(in))\n", elemPB)
+		gen.printf("for i := range in {\nout = append(out, %s)\n}\nreturn out\n}\n\n", gen.toProto(t.Elem(), "in[i]"))
+		gen.printf("func fromProto%s(in %s) (%s, error) {\n", name, pbType, goName)
+		gen.printf("if len(in) == 0 {\nreturn nil, nil\n}\n")
+		gen.printf("out := make(%s, 0, len(in))\n", goName)
+		gen.printf("for _, elem := range in {\n")
+		gen.elemVar(t.Elem())
+		gen.fromProto(t.Elem(), "v", "elem")
+		gen.printf("out = append(out, v)\n}\nreturn out, nil\n}\n\n")
+
+	default:
+		panic(fmt.Sprintf("no conversion for %s", goName))
+	}
+}
+
+func (gen *protoGen) structFuncs(named *types.Named) {
+	name := named.Obj().Name()
+	msg := gen.m.messages[name]
+
+	gen.printf("func toProtoRefOf%s(in *%s) *%s {\n", name, name, gen.pb(name))
+	gen.printf("if in == nil {\nreturn nil\n}\n")
+	gen.printf("return &%s{\n", gen.pb(name))
+	for _, f := range msg.fields {
+		gen.printf("%s: %s,\n", goFieldName(f.name), gen.toProto(f.goType, "in."+f.goName))
+	}
+	gen.printf("}\n}\n\n")
+
+	gen.printf("func fromProtoRefOf%s(in *%s) (*%s, error) {\n", name, gen.pb(name), name)
+	gen.printf("if in == nil {\nreturn nil, nil\n}\n")
+	if len(msg.fields) == 0 {
+		gen.printf("return &%s{}, nil\n}\n\n", name)
+		return
+	}
+	gen.printf("out := &%s{}\n", name)
+	for _, f := range msg.fields {
+		if gen.canFail(f.goType) {
+			gen.printf("var err error\n")
+			break
+		}
+	}
+	for _, f := range msg.fields {
+		gen.fromProto(f.goType, "out."+f.goName, "in."+goFieldName(f.name))
+	}
+	gen.printf("return out, nil\n}\n\n")
+}
+
+// canFail returns whether converting a value of type t back can fail.
+func (gen *protoGen) canFail(t types.Type) bool {
+	switch gen.kind(t) {
+	case convScalar, convIdent:
+		return false
+	}
+	return true
+}
+
+// elemVar declares the variables a slice element is converted into.
+func (gen *protoGen) elemVar(t types.Type) {
+	gen.printf("var v %s\n", gen.typeName(t))
+	if gen.canFail(t) {
+		gen.printf("var err error\n")
+	}
+}
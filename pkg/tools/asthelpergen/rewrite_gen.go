@@ -0,0 +1,255 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asthelpergen
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+const rewriteName = "rewrite"
+
+// rewriteGen generates ast_rewrite.go, the methods of application walking the nodes
+// below a node, calling the pre and post functions and keeping track of the path
+// from the root.
+type rewriteGen struct {
+	buf bytes.Buffer
+}
+
+func (r *rewriteGen) fileName() string {
+	return "ast_rewrite.go"
+}
+
+func (r *rewriteGen) generate(ast *astInfo) ([]byte, error) {
+	var body bytes.Buffer
+	body.Write(bytes.TrimSuffix(r.buf.Bytes(), []byte("\n")))
+	fmt.Fprintf(&body, "func (a *application) %s%s(parent %s, node %s, replacer replacerFunc) bool {\n",
+		rewriteName, visitableName, ast.root.Obj().Name(), visitableName)
+	body.WriteString(r.pre(ast))
+	body.WriteString(r.rewriteChild(ast, pathStepName(visitableName, "Inner"), printableTypeName(ast.root), "node.VisitThis()",
+		fmt.Sprintf("panic(\"[BUG] tried to replace 'VisitThis' on '%s'\")\n", visitableName)))
+	body.WriteString("if a.collectPaths {\na.cur.current.Pop()\n}\n")
+	body.WriteString(r.post(true))
+	body.WriteString("}\n")
+	return renderGo(ast.pkg.Name(), nil, body.String())
+}
+
+func (r *rewriteGen) rewriteFunc(t types.Type, source, body string, w *typeWalker) {
+	fmt.Fprintf(&r.buf, "// Function Generation Source: %s\n", source)
+	fmt.Fprintf(&r.buf, "func (a *application) %s%s(parent %s, node %s, replacer replacerFunc) bool {\n%s}\n\n",
+		rewriteName, printableTypeName(t), w.ast.root.Obj().Name(), w.ast.typeName(t), body)
+}
+
+func (r *rewriteGen) interfaceMethod(t types.Type, iface *types.Interface, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	var b strings.Builder
+	b.WriteString("if node == nil {\nreturn true\n}\n")
+	b.WriteString("switch node := node.(type) {\n")
+	w.implementations(iface, func(impl types.Type) {
+		if types.IsInterface(impl) {
+			return
+		}
+		w.addType(impl)
+		fmt.Fprintf(&b, "case %s:\nreturn a.%s%s(parent, node, replacer)\n", w.ast.typeName(impl), rewriteName, printableTypeName(impl))
+	})
+	fmt.Fprintf(&b, "case %s:\nreturn a.%s%s(parent, node, replacer)\n", visitableName, rewriteName, visitableName)
+	b.WriteString("default:\n// this should never happen\nreturn true\n}\n")
+	r.rewriteFunc(t, "InterfaceMethod", b.String(), w)
+	return nil
+}
+
+func (r *rewriteGen) structMethod(t types.Type, strct *types.Struct, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	r.rewriteFunc(t, "StructMethod", r.rewriteAllStructFields(t, strct, w, false), w)
+	return nil
+}
+
+func (r *rewriteGen) ptrToStructMethod(t types.Type, strct *types.Struct, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	r.rewriteFunc(t, "PtrToStructMethod", r.rewriteAllStructFields(t, strct, w, true), w)
+	return nil
+}
+
+func (r *rewriteGen) ptrToBasicMethod(types.Type, *types.Basic, *typeWalker) error {
+	return nil
+}
+
+func (r *rewriteGen) sliceMethod(t types.Type, slice *types.Slice, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	var b strings.Builder
+	b.WriteString("if node == nil {\nreturn true\n}\n")
+	b.WriteString(r.pre(w.ast))
+	if !w.ast.isNode(slice.Elem()) {
+		b.WriteString(r.post(false))
+		r.rewriteFunc(t, "SliceMethod", b.String(), w)
+		return nil
+	}
+	w.addType(slice.Elem())
+	replacer := fmt.Sprintf("func(idx int) replacerFunc {\nreturn func(newNode, parent %s) {\nparent.(%s)[idx] = newNode.(%s)\n}\n}(x)",
+		w.ast.root.Obj().Name(), w.ast.typeName(t), w.ast.typeName(slice.Elem()))
+	b.WriteString(r.rewriteSliceElements(pathStepName(printableTypeName(t), "Offset"), "node", slice.Elem(), replacer))
+	b.WriteString("if a.collectPaths && len(node) > 0 {\na.cur.current.Pop()\n}\n")
+	b.WriteString(r.post(true))
+	r.rewriteFunc(t, "SliceMethod", b.String(), w)
+	return nil
+}
+
+func (r *rewriteGen) basicMethod(t types.Type, _ *types.Basic, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	r.rewriteFunc(t, "BasicMethod", r.pre(w.ast)+r.post(false), w)
+	return nil
+}
+
+// rewriteAllStructFields walks the fields of a struct that hold nodes. While paths are
+// collected, the step to each field is pushed before walking it and popped after.
+func (r *rewriteGen) rewriteAllStructFields(t types.Type, strct *types.Struct, w *typeWalker, ptr bool) string {
+	fields := nodeFields(strct, w)
+	var b strings.Builder
+	if ptr {
+		b.WriteString("if node == nil {\nreturn true\n}\n")
+	}
+	b.WriteString(r.pre(w.ast))
+
+	typeName := printableTypeName(t)
+	for i, field := range fields {
+		step := pathStepName(typeName, field.step)
+		if field.slice == nil {
+			if i == 0 {
+				fmt.Fprintf(&b, "if a.collectPaths {\na.cur.current.AddStep(uint16(%s))\n}\n", step)
+			}
+			w.addType(field.typ)
+			var replacer string
+			if ptr {
+				replacer = fmt.Sprintf("parent.(%s).%s = newNode.(%s)\n", w.ast.typeName(t), field.name, w.ast.typeName(field.typ))
+			} else {
+				replacer = fmt.Sprintf("panic(\"[BUG] tried to replace '%s' on '%s'\")\n", field.name, w.ast.typeName(t))
+			}
+			b.WriteString(r.rewriteChild(w.ast, "", printableTypeName(field.typ), "node."+field.name, replacer))
+			b.WriteString("if a.collectPaths {\na.cur.current.Pop()\n")
+		} else {
+			w.addType(field.slice.Elem())
+			replacer := fmt.Sprintf("func(idx int) replacerFunc {\nreturn func(newNode, parent %s) {\nparent.(%s).%s[idx] = newNode.(%s)\n}\n}(x)",
+				w.ast.root.Obj().Name(), w.ast.typeName(t), field.name, w.ast.typeName(field.slice.Elem()))
+			b.WriteString(r.rewriteSliceElements(step, "node."+field.name, field.slice.Elem(), replacer))
+			if i+1 < len(fields) {
+				fmt.Fprintf(&b, "if a.collectPaths && len(node.%s) > 0 {\na.cur.current.Pop()\n", field.name)
+			} else {
+				b.WriteString("if a.collectPaths {\na.cur.current.Pop()\n")
+			}
+		}
+		if i+1 < len(fields) && fields[i+1].slice == nil {
+			fmt.Fprintf(&b, "a.cur.current.AddStep(uint16(%s))\n", pathStepName(typeName, fields[i+1].step))
+		}
+		b.WriteString("}\n")
+	}
+	b.WriteString(r.post(len(fields) > 0))
+	return b.String()
+}
+
+// rewriteChild walks the node in expr, pushing step first if it is not empty.
+func (r *rewriteGen) rewriteChild(ast *astInfo, step, typeName, expr, replacer string) string {
+	var b strings.Builder
+	if step != "" {
+		fmt.Fprintf(&b, "if a.collectPaths {\na.cur.current.AddStep(uint16(%s))\n}\n", step)
+	}
+	fmt.Fprintf(&b, "if !a.%s%s(node, %s, func(newNode, parent %s) {\n%s}) {\nreturn false\n}\n",
+		rewriteName, typeName, expr, ast.root.Obj().Name(), replacer)
+	return b.String()
+}
+
+func (r *rewriteGen) rewriteSliceElements(step, expr string, elem types.Type, replacer string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "for x, el := range %s {\n", expr)
+	b.WriteString("if a.collectPaths {\nif x == 0 {\n")
+	fmt.Fprintf(&b, "a.cur.current.AddStepWithOffset(uint16(%s))\n", step)
+	b.WriteString("} else {\na.cur.current.ChangeOffset(x)\n}\n}\n")
+	fmt.Fprintf(&b, "if !a.%s%s(node, el, %s) {\nreturn false\n}\n}\n", rewriteName, printableTypeName(elem), replacer)
+	return b.String()
+}
+
+// pre calls the pre function, which can ask to visit the node again after replacing
+// it, or to skip what is below it.
+func (r *rewriteGen) pre(ast *astInfo) string {
+	return fmt.Sprintf(`if a.pre != nil {
+a.cur.replacer = replacer
+a.cur.parent = parent
+a.cur.node = node
+kontinue := !a.pre(&a.cur)
+if a.cur.revisit {
+a.cur.revisit = false
+return a.%s%s(parent, a.cur.node, replacer)
+}
+if kontinue {
+return true
+}
+}
+`, rewriteName, printableTypeName(ast.root))
+}
+
+// post calls the post function. Walking children changes the cursor, so it is only
+// set up again when there were any, or when pre did not set it up already.
+func (r *rewriteGen) post(hasChildren bool) string {
+	setCursor := "a.cur.replacer = replacer\na.cur.parent = parent\na.cur.node = node\n"
+	if !hasChildren {
+		setCursor = "if a.pre == nil {\n" + setCursor + "}\n"
+	}
+	return "if a.post != nil {\n" + setCursor + "if !a.post(&a.cur) {\nreturn false\n}\n}\nreturn true\n"
+}
+
+// nodeField is a field of a struct holding a node, or a slice of them.
+type nodeField struct {
+	name string
+	typ  types.Type
+	// slice is set for slices of nodes.
+	slice *types.Slice
+	// step is the name of the step to the field in paths.
+	step string
+}
+
+// nodeFields returns the fields of strct that the rewriter walks into.
+func nodeFields(strct *types.Struct, w *typeWalker) []nodeField {
+	var fields []nodeField
+	for i := 0; i < strct.NumFields(); i++ {
+		field := strct.Field(i)
+		if w.ast.isNode(field.Type()) {
+			fields = append(fields, nodeField{name: field.Name(), typ: field.Type(), step: field.Name()})
+			continue
+		}
+		if slice, ok := field.Type().(*types.Slice); ok && w.ast.isNode(slice.Elem()) {
+			fields = append(fields, nodeField{name: field.Name(), typ: field.Type(), slice: slice, step: field.Name() + "Offset"})
+		}
+	}
+	return fields
+}
+
+// pathStepName returns the name of the ASTStep going from a value of the type with the
+// given printable name to one of its children.
+func pathStepName(typeName, step string) string {
+	return typeName + step
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2025 Pouya Vedadiyan.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package asthelpergen
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"strings"
+)
+
+const visitName = "Visit"
+
+// visitGen generates ast_visit.go, a function for every node type calling a Visit
+// function on the node and all the nodes below it.
+type visitGen struct {
+	buf bytes.Buffer
+}
+
+func (v *visitGen) fileName() string {
+	return "ast_visit.go"
+}
+
+func (v *visitGen) generate(ast *astInfo) ([]byte, error) {
+	var body bytes.Buffer
+	body.Write(v.buf.Bytes())
+	fmt.Fprintf(&body, "func %s%s(in %s, f %s) error {\n", visitName, visitableName, visitableName, visitName)
+	body.WriteString(visitIn)
+	fmt.Fprintf(&body, "if err := %s%s(in.VisitThis(), f); err != nil {\nreturn err\n}\n", visitName, printableTypeName(ast.root))
+	body.WriteString("return nil\n}\n")
+	return renderGo(ast.pkg.Name(), nil, body.String())
+}
+
+func (v *visitGen) visitFunc(t types.Type, body string, w *typeWalker) {
+	fmt.Fprintf(&v.buf, "func %s%s(in %s, f %s) error {\n%s}\n", visitName, printableTypeName(t), w.ast.typeName(t), visitName, body)
+}
+
+// visitIn calls f on the node, and stops there if asked to.
+const visitIn = "if cont, err := f(in); err != nil || !cont {\nreturn err\n}\n"
+
+const visitNilIn = "if in == nil {\nreturn nil\n}\n"
+
+func (v *visitGen) interfaceMethod(t types.Type, iface *types.Interface, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	var b strings.Builder
+	b.WriteString(visitNilIn)
+	b.WriteString("switch in := in.(type) {\n")
+	w.implementations(iface, func(impl types.Type) {
+		if types.IsInterface(impl) {
+			return
+		}
+		w.addType(impl)
+		fmt.Fprintf(&b, "case %s:\nreturn %s%s(in, f)\n", w.ast.typeName(impl), visitName, printableTypeName(impl))
+	})
+	fmt.Fprintf(&b, "case %s:\nreturn %s%s(in, f)\n", visitableName, visitName, visitableName)
+	b.WriteString("default:\n// this should never happen\nreturn nil\n}\n")
+	v.visitFunc(t, b.String(), w)
+	return nil
+}
+
+func (v *visitGen) structMethod(t types.Type, strct *types.Struct, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	v.visitFunc(t, v.visitAllStructFields(strct, w), w)
+	return nil
+}
+
+func (v *visitGen) ptrToStructMethod(t types.Type, strct *types.Struct, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	v.visitFunc(t, visitNilIn+v.visitAllStructFields(strct, w), w)
+	return nil
+}
+
+func (v *visitGen) ptrToBasicMethod(types.Type, *types.Basic, *typeWalker) error {
+	return nil
+}
+
+func (v *visitGen) sliceMethod(t types.Type, slice *types.Slice, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	if !w.ast.isNode(slice.Elem()) {
+		v.visitNoChildren(t, w)
+		return nil
+	}
+	w.addType(slice.Elem())
+	var b strings.Builder
+	b.WriteString(visitNilIn)
+	b.WriteString(visitIn)
+	fmt.Fprintf(&b, "for _, el := range in {\n%s}\n", visitChild(slice.Elem(), "el"))
+	b.WriteString("return nil\n")
+	v.visitFunc(t, b.String(), w)
+	return nil
+}
+
+func (v *visitGen) basicMethod(t types.Type, _ *types.Basic, w *typeWalker) error {
+	if !w.ast.isNode(t) {
+		return nil
+	}
+	v.visitNoChildren(t, w)
+	return nil
+}
+
+func (v *visitGen) visitNoChildren(t types.Type, w *typeWalker) {
+	v.visitFunc(t, "_, err := f(in)\nreturn err\n", w)
+}
+
+func (v *visitGen) visitAllStructFields(strct *types.Struct, w *typeWalker) string {
+	var b strings.Builder
+	b.WriteString(visitIn)
+	for i := 0; i < strct.NumFields(); i++ {
+		field := strct.Field(i)
+		if w.ast.isNode(field.Type()) {
+			w.addType(field.Type())
+			b.WriteString(visitChild(field.Type(), "in."+field.Name()))
+			continue
+		}
+		slice, ok := field.Type().(*types.Slice)
+		if ok && w.ast.isNode(slice.Elem()) {
+			w.addType(slice.Elem())
+			fmt.Fprintf(&b, "for _, el := range in.%s {\n%s}\n", field.Name(), visitChild(slice.Elem(), "el"))
+		}
+	}
+	b.WriteString("return nil\n")
+	return b.String()
+}
+
+func visitChild(t types.Type, expr string) string {
+	return fmt.Sprintf("if err := %s%s(%s, f); err != nil {\nreturn err\n}\n", visitName, printableTypeName(t), expr)
+}
@@ -127,6 +127,29 @@ const (
 
 	VectorConversion
 
+	FieldInOrderNotSelect
+	FKCannotDropParent
+	FKColumnCannotDrop
+	FKColumnCannotDropChild
+
+	// collation errors
+	UnknownCollation
+	CollationCharsetMismatch
+	CantAggregateCollations
+
+	// spatial errors
+	GISInvalidData
+	GISDifferentSRIDs
+	SRSNotFound
+	LatitudeOutOfRange
+	LongitudeOutOfRange
+	UnexpectedGeometryType
+	OnlyImplementedForCartesianSRS
+	OnlyImplementedForGeographicSRS
+	InvalidGeoJSON
+	DimensionUnsupported
+	InvalidOption
+
 	// No state should be added below NumOfStates
 	NumOfStates
 )
@@ -0,0 +1,134 @@
+package test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/semantics"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+)
+
+// accessed returns the reads and writes of a statement run against
+// bindingSchema, as "op db.table(columns)".
+func accessed(t *testing.T, query string) (reads, writes []string) {
+	t.Helper()
+	c := loadCatalog(t, bindingSchema)
+	stmt, err := sqlparser.NewTestParser().Parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := semantics.Access(stmt, c.Schema(), "app")
+	if err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+	format := func(accesses []semantics.TableAccess) []string {
+		var res []string
+		for _, access := range accesses {
+			s := access.Operation.String() + " " + access.Database
+			if access.Table != "" {
+				s += "." + access.Table
+			}
+			if len(access.Columns) > 0 {
+				s += "(" + strings.Join(access.Columns, ", ") + ")"
+			}
+			res = append(res, s)
+		}
+		return res
+	}
+	return format(set.Reads), format(set.Writes)
+}
+
+func TestAccess(t *testing.T) {
+	tcases := []struct {
+		query  string
+		reads  []string
+		writes []string
+	}{{
+		query: "select u.name, count(*) from users u join orders o on o.user_id = u.id group by u.name",
+		reads: []string{"select app.orders(user_id)", "select app.users(id, name)"},
+	}, {
+		query: "select count(*) from orders where user_id in (select id from users where email like '%@x')",
+		reads: []string{"select app.orders(user_id)", "select app.users(email, id)"},
+	}, {
+		query:  "select * from users where id = 1 for update",
+		reads:  []string{"select app.users(email, id, name)"},
+		writes: []string{"lock app.users"},
+	}, {
+		query: "select id from orders where user_id = (select id from users limit 1) lock in share mode",
+		reads: []string{"select app.orders(id, user_id)", "select app.users(id)", "lock app.orders"},
+	}, {
+		query:  "insert into orders (id, user_id) select id, 1 from users on duplicate key update total = total + values(total)",
+		reads:  []string{"select app.orders(total)", "select app.users(id)"},
+		writes: []string{"insert app.orders(id, user_id)", "update app.orders(total)"},
+	}, {
+		query:  "replace into users values (1, 'a', 'b')",
+		writes: []string{"insert app.users(email, id, name)", "delete app.users"},
+	}, {
+		query:  "update orders o join users u on u.id = o.user_id set o.total = 0 where u.email is null",
+		reads:  []string{"select app.orders(user_id)", "select app.users(email, id)"},
+		writes: []string{"update app.orders(total)"},
+	}, {
+		query:  "update users set name = 'x'",
+		writes: []string{"update app.users(name)"},
+	}, {
+		query:  "delete o from orders o join items i on i.order_id = o.id where i.name = 'x'",
+		reads:  []string{"select app.items(name, order_id)", "select app.orders(id)"},
+		writes: []string{"delete app.orders"},
+	}, {
+		query:  "delete from items where id > 10",
+		reads:  []string{"select app.items(id)"},
+		writes: []string{"delete app.items"},
+	}, {
+		query:  "load data infile '/tmp/u.csv' replace into table users fields terminated by ','",
+		writes: []string{"insert app.users", "delete app.users"},
+	}, {
+		query:  "load data concurrent infile '/tmp/u.csv' into table users ignore 1 lines (id, @email) set name = upper(@email)",
+		writes: []string{"insert app.users(id, name)"},
+	}, {
+		query: "load data from s3 's3://bucket/users.csv' into table users",
+	}, {
+		query: "call p((select max(id) from users))",
+		reads: []string{"select app.users(id)"},
+	}, {
+		query:  "lock tables users read, orders write",
+		reads:  []string{"lock app.users"},
+		writes: []string{"lock app.orders"},
+	}, {
+		query:  "create view big as select user_id from orders where total > 100",
+		reads:  []string{"select app.orders(total, user_id)"},
+		writes: []string{"ddl app.big"},
+	}, {
+		query:  "create table shipments (id int, order_id int, foreign key (order_id) references orders (id))",
+		reads:  []string{"ddl app.orders"},
+		writes: []string{"ddl app.shipments"},
+	}, {
+		query:  "rename table items to old.items",
+		writes: []string{"ddl app.items", "ddl old.items"},
+	}, {
+		query:  "drop database shop",
+		writes: []string{"ddl shop"},
+	}, {
+		query: "set @a = 1",
+	}}
+	for _, tcase := range tcases {
+		reads, writes := accessed(t, tcase.query)
+		if !reflect.DeepEqual(reads, tcase.reads) || !reflect.DeepEqual(writes, tcase.writes) {
+			t.Errorf("%s:\ngot  reads %v, writes %v\nwant reads %v, writes %v", tcase.query, reads, writes, tcase.reads, tcase.writes)
+		}
+	}
+}
+
+func TestAccessCall(t *testing.T) {
+	stmt, err := sqlparser.NewTestParser().Parse("call shop.restock(1)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := semantics.Access(stmt, loadCatalog(t, bindingSchema).Schema(), "app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []semantics.Routine{{Database: "shop", Name: "restock"}}; !reflect.DeepEqual(set.Calls, want) || set.Type != sqlparser.StmtCallProc {
+		t.Errorf("got %+v", set)
+	}
+}
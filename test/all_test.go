@@ -48,3 +48,70 @@ func TestParse(t *testing.T) {
 		}
 	}
 }
+
+func TestParseLoadData(t *testing.T) {
+	tests := []struct {
+		in, out string
+	}{{
+		in:  "LOAD DATA CONCURRENT INFILE 'f' INTO TABLE t",
+		out: "load data concurrent infile 'f' into table t",
+	}, {
+		in:  "LOAD DATA LOW_PRIORITY LOCAL INFILE '/tmp/t.csv' IGNORE INTO TABLE db.t PARTITION (p0, p1) CHARACTER SET utf8mb4 FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '\"' LINES TERMINATED BY '\\n' IGNORE 1 LINES (a, @b, c) SET d = @b * 2, e = DEFAULT",
+		out: "load data low_priority local infile '/tmp/t.csv' ignore into table db.t partition (p0, p1) character set utf8mb4 fields terminated by ',' optionally enclosed by '\"' lines terminated by '\\n' ignore 1 lines (a, @b, c) set d = @b * 2, e = default",
+	}, {
+		in:  "load data infile 'x' replace into table t ignore 2 rows ()",
+		out: "load data infile 'x' replace into table t ignore 2 lines ()",
+	}, {
+		in:  "LOAD DATA INFILE 'x' INTO TABLE t COLUMNS ESCAPED BY '\\\\'",
+		out: "load data infile 'x' into table t columns escaped by '\\\\'",
+	}, {
+		in:  "SELECT a FROM t INTO OUTFILE 'x' FIELDS TERMINATED BY ','",
+		out: "select a from t into outfile 'x' fields terminated by ','",
+	}, {
+		in:  "LOAD DATA FROM S3 's3://bucket/file' INTO TABLE t FIELDS TERMINATED BY ','",
+		out: "load data from S3 's3://bucket/file' INTO TABLE t FIELDS TERMINATED BY ','",
+	}}
+	parser := sqlparser.NewTestParser()
+	for _, tc := range tests {
+		stmt, err := parser.Parse(tc.in)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.in, err)
+		}
+		if got := sqlparser.String(stmt); got != tc.out {
+			t.Errorf("%s: got %q, want %q", tc.in, got, tc.out)
+		}
+	}
+
+	pieces, err := parser.SplitStatementToPieces("load data from s3 prefix 's3://b/p' into table t; select 1")
+	if err != nil || len(pieces) != 2 {
+		t.Errorf("split: got %v, %v", pieces, err)
+	}
+}
+
+func TestParseQuotedVariableNames(t *testing.T) {
+	parser := sqlparser.NewTestParser()
+	want, err := parser.Parse("select @`my var`")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, query := range []string{"select @'my var'", "select @\"my var\""} {
+		stmt, err := parser.Parse(query)
+		if err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+		if !(&sqlparser.Comparator{}).SQLNode(stmt, want) {
+			t.Errorf("%s: got %s, want %s", query, sqlparser.String(stmt), sqlparser.String(want))
+		}
+		if got := sqlparser.String(stmt); got != "select @`my var` from dual" {
+			t.Errorf("%s: got %q", query, got)
+		}
+	}
+
+	stmt, err := parser.Parse("grant select on t to 'u'@'%', 'v'@'localhost'")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := sqlparser.String(stmt), "grant select on t to 'u'@'%', 'v'@localhost"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,277 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/catalog"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+)
+
+func loadCatalog(t *testing.T, sql string) *catalog.Catalog {
+	t.Helper()
+	c := catalog.New("app")
+	if err := c.Load(sqlparser.NewTestParser(), sql); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func columnNames(table *catalog.Table) []string {
+	var names []string
+	for _, col := range table.Columns {
+		names = append(names, col.Name)
+	}
+	return names
+}
+
+func TestCatalogCreateTable(t *testing.T) {
+	c := loadCatalog(t, `
+		create table users (
+			id bigint unsigned not null auto_increment,
+			email varchar(255) not null,
+			name varchar(100) default 'anonymous' comment 'display name',
+			primary key (id),
+			unique key (email)
+		) engine=InnoDB default charset=utf8mb4;
+		create table orders (
+			id bigint primary key,
+			user_id bigint unsigned,
+			total decimal(10, 2),
+			foreign key (user_id) references users (id) on delete cascade,
+			check (total >= 0)
+		);
+		insert into users (email) values ('a@b.c');
+	`)
+	if c.Version() != 2 {
+		t.Fatalf("version %d, want 2", c.Version())
+	}
+
+	users := c.Schema().Table("app", "users")
+	if users == nil {
+		t.Fatal("users not found")
+	}
+	if got := columnNames(users); !reflect.DeepEqual(got, []string{"id", "email", "name"}) {
+		t.Errorf("columns %v", got)
+	}
+	id := users.Column("ID")
+	if id == nil || id.Nullable || !id.AutoIncrement || id.Type.Type != "bigint" || !id.Type.Unsigned {
+		t.Errorf("id column %+v", id)
+	}
+	name := users.Column("name")
+	if !name.Nullable || sqlparser.String(name.Default) != "'anonymous'" || name.Comment != "display name" {
+		t.Errorf("name column %+v", name)
+	}
+	if pk := users.PrimaryKey(); pk == nil || !reflect.DeepEqual(pk.ColumnNames(), []string{"id"}) {
+		t.Errorf("primary key %+v", pk)
+	}
+	if idx := users.Index("email"); idx == nil || !idx.Unique() {
+		t.Errorf("email index %+v", idx)
+	}
+	if engine, _ := users.Option("engine"); engine != "InnoDB" {
+		t.Errorf("engine %q", engine)
+	}
+	if charset := users.Charset(c.Schema()); charset != "utf8mb4" {
+		t.Errorf("charset %q", charset)
+	}
+
+	orders := c.Table(sqlparser.NewTableName("orders"))
+	if orders.Column("id").Nullable {
+		t.Error("inline primary key column is nullable")
+	}
+	fk := orders.ForeignKey("orders_ibfk_1")
+	if fk == nil || fk.ReferencedDatabase != "app" || fk.ReferencedTable != "users" || fk.OnDelete != sqlparser.Cascade {
+		t.Fatalf("foreign key %+v", fk)
+	}
+	if idx := orders.Index("user_id"); idx == nil {
+		t.Error("no index for the foreign key")
+	}
+	if len(orders.Checks) != 1 || orders.Checks[0].Name != "orders_chk_1" {
+		t.Errorf("checks %+v", orders.Checks)
+	}
+}
+
+func TestCatalogAlterTable(t *testing.T) {
+	c := loadCatalog(t, `
+		create table t (a int, b int, c int, key (b, c));
+		alter table t add column d int after a, drop column b, modify c bigint not null first;
+		alter table t change column d e varchar(10), rename index b to idx_c, add primary key (a);
+		alter table t alter column e set default 'x', engine = MyISAM
+	`)
+	table := c.Schema().Table("app", "t")
+	if got := columnNames(table); !reflect.DeepEqual(got, []string{"c", "a", "e"}) {
+		t.Errorf("columns %v", got)
+	}
+	if col := table.Column("c"); col.Type.Type != "bigint" || col.Nullable {
+		t.Errorf("c column %+v", col)
+	}
+	if table.Column("a").Nullable {
+		t.Error("primary key column is nullable")
+	}
+	if idx := table.Index("idx_c"); idx == nil || !reflect.DeepEqual(idx.ColumnNames(), []string{"c"}) {
+		t.Errorf("index %+v", idx)
+	}
+	if def := sqlparser.String(table.Column("e").Default); def != "'x'" {
+		t.Errorf("default %s", def)
+	}
+	if engine, _ := table.Option("engine"); engine != "MyISAM" {
+		t.Errorf("engine %q", engine)
+	}
+}
+
+func TestCatalogVersions(t *testing.T) {
+	c := loadCatalog(t, `
+		create database other;
+		create table t (id int primary key);
+		create table r (t_id int, foreign key (t_id) references t (id));
+		create view v as select id, id + 1 as succ from t;
+		rename table t to other.t2
+	`)
+	if c.Version() != 5 {
+		t.Fatalf("version %d, want 5", c.Version())
+	}
+	before := c.SchemaAt(4)
+	if before.Table("app", "t") == nil || before.Table("other", "t2") != nil {
+		t.Error("version 4 changed by the rename")
+	}
+	after := c.Schema()
+	if after.Table("app", "t") != nil || after.Table("other", "t2") == nil {
+		t.Error("table not renamed")
+	}
+	if fk := after.Table("app", "r").ForeignKeys[0]; fk.ReferencedDatabase != "other" || fk.ReferencedTable != "t2" {
+		t.Errorf("foreign key references %s.%s", fk.ReferencedDatabase, fk.ReferencedTable)
+	}
+	if v := after.View("app", "v"); v == nil || !reflect.DeepEqual(v.Columns, []string{"id", "succ"}) {
+		t.Errorf("view %+v", v)
+	}
+	if before.Table("app", "r") == after.Table("app", "r") {
+		t.Error("changed table shared between versions")
+	}
+	if before.View("app", "v") != after.View("app", "v") {
+		t.Error("unchanged view copied")
+	}
+}
+
+func TestCatalogErrors(t *testing.T) {
+	tcases := []struct {
+		sql   string
+		state vterrors.State
+	}{
+		{"create table t (a int, a int)", vterrors.DupFieldName},
+		{"alter table missing add column b int", vterrors.NoSuchTable},
+		{"create table t (a int); alter table t alter column b drop default", vterrors.BadFieldError},
+		{"create database app", vterrors.DbCreateExists},
+		{"drop database nope", vterrors.DbDropExists},
+		{"create table nope.t (a int)", vterrors.BadDb},
+		{"drop table missing", vterrors.UnknownTable},
+		{"create table t (a int); alter table t drop index a", vterrors.KeyDoesNotExist},
+		{"create table p (id int primary key); create table c (p_id int, foreign key (p_id) references p (id)); drop table p", vterrors.FKCannotDropParent},
+		{"truncate table missing", vterrors.NoSuchTable},
+	}
+	for _, tcase := range tcases {
+		c := catalog.New("app")
+		err := c.Load(sqlparser.NewTestParser(), tcase.sql)
+		if err == nil {
+			t.Errorf("%s: no error", tcase.sql)
+			continue
+		}
+		if state := vterrors.ErrState(err); state != tcase.state {
+			t.Errorf("%s: state %v, want %v (%v)", tcase.sql, state, tcase.state, err)
+		}
+	}
+
+	// A failing statement leaves the catalog as it was.
+	c := loadCatalog(t, "create table t (a int)")
+	if err := c.Load(sqlparser.NewTestParser(), "alter table t add column b int, add column a int"); err == nil {
+		t.Fatal("no error")
+	}
+	if c.Version() != 1 || len(c.Schema().Table("app", "t").Columns) != 1 {
+		t.Error("failed statement changed the catalog")
+	}
+}
+
+func TestCatalogDropReferencedTable(t *testing.T) {
+	const schema = `
+		create table p (id int primary key);
+		create table c (p_id int, constraint fk_p foreign key (p_id) references p (id));
+		create table s (id int primary key, parent int, foreign key (parent) references s (id));
+	`
+	c := loadCatalog(t, schema)
+	err := c.Load(sqlparser.NewTestParser(), "drop table p")
+	if msg := "Cannot drop table 'p' referenced by a foreign key constraint 'fk_p' on table 'c'."; err == nil || err.Error() != msg {
+		t.Errorf("got %v, want %s", err, msg)
+	}
+	if err := c.Load(sqlparser.NewTestParser(), "drop table s; drop table p, c"); err != nil {
+		t.Fatal(err)
+	}
+	if c.Schema().Table("app", "p") != nil || c.Schema().Table("app", "c") != nil || c.Schema().Table("app", "s") != nil {
+		t.Error("tables not dropped")
+	}
+
+	c = loadCatalog(t, schema+`
+		set foreign_key_checks = 0;
+		drop table p;
+		set foreign_key_checks = on;
+	`)
+	if c.Schema().Table("app", "p") != nil {
+		t.Error("table not dropped with foreign_key_checks off")
+	}
+	if err := c.Load(sqlparser.NewTestParser(), "create table p (id int primary key); drop table p"); err == nil {
+		t.Error("no error with foreign_key_checks on again")
+	}
+}
+
+func TestCatalogDropForeignKeyColumn(t *testing.T) {
+	const schema = `
+		create table p (id int primary key, name varchar(10), note text);
+		create database other;
+		create table other.c (id int, p_id int, constraint fk_p foreign key (p_id) references app.p (id));
+	`
+	tcases := []struct {
+		sql   string
+		state vterrors.State
+		msg   string
+	}{
+		{"alter table other.c drop column p_id", vterrors.FKColumnCannotDrop, "Cannot drop column 'p_id': needed in a foreign key constraint 'fk_p'"},
+		{"alter table p drop column ID", vterrors.FKColumnCannotDropChild, "Cannot drop column 'ID': needed in a foreign key constraint 'fk_p' of table 'c'"},
+	}
+	for _, tcase := range tcases {
+		c := loadCatalog(t, schema)
+		err := c.Load(sqlparser.NewTestParser(), tcase.sql)
+		if err == nil {
+			t.Errorf("%s: no error", tcase.sql)
+			continue
+		}
+		if vterrors.ErrState(err) != tcase.state || err.Error() != tcase.msg {
+			t.Errorf("%s:\ngot  %v (%v)\nwant %s (%v)", tcase.sql, err, vterrors.ErrState(err), tcase.msg, tcase.state)
+		}
+	}
+
+	c := loadCatalog(t, schema+`
+		alter table p drop column name;
+		alter table other.c drop foreign key fk_p, drop column p_id;
+		alter table p drop column id;
+	`)
+	if p := c.Schema().Table("app", "p"); p == nil || len(p.Columns) != 1 {
+		t.Error("columns not dropped")
+	}
+	c = loadCatalog(t, schema+`
+		set foreign_key_checks = 0;
+		alter table p drop column id;
+	`)
+	if p := c.Schema().Table("app", "p"); p == nil || p.Column("id") != nil {
+		t.Error("column not dropped with foreign_key_checks off")
+	}
+}
+
+func TestCatalogTruncate(t *testing.T) {
+	c := loadCatalog(t, "create table t (id int primary key auto_increment) auto_increment = 10")
+	version := c.Version()
+	if err := c.Load(sqlparser.NewTestParser(), "truncate table t"); err != nil {
+		t.Fatal(err)
+	}
+	if c.Version() != version {
+		t.Errorf("version %d, want %d", c.Version(), version)
+	}
+}
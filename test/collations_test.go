@@ -0,0 +1,242 @@
+package test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+func TestCollationCompare(t *testing.T) {
+	tcases := []struct {
+		collation string
+		a, b      string
+		want      int
+	}{
+		{"utf8mb4_0900_ai_ci", "abc", "ABC", 0},
+		{"utf8mb4_0900_ai_ci", "café", "CAFE", 0},
+		{"utf8mb4_0900_ai_ci", "a ", "a", 1},
+		{"utf8mb4_0900_ai_ci", "straße", "STRASSE", 0},
+		{"utf8mb4_0900_ai_ci", "æ", "ae", 0},
+		{"utf8mb4_0900_ai_ci", "1", "a", -1},
+		{"utf8mb4_0900_ai_ci", "-", "1", -1},
+		{"utf8mb4_0900_ai_ci", "ø", "o", 1},
+		{"utf8mb4_0900_ai_ci", "a\u0301", "á", 0},
+		{"utf8mb4_0900_ai_ci", "ạ", "a", 0},
+		{"utf8mb4_0900_ai_ci", "Ǻ", "a", 0},
+		{"utf8mb4_0900_ai_ci", "ё", "е", 0},
+		{"utf8mb4_0900_ai_ci", "ς", "σ", 0},
+		{"utf8mb4_0900_ai_ci", "Σ", "ς", 0},
+		{"utf8mb4_0900_ai_ci", "①", "1", 0},
+		{"utf8mb4_0900_ai_ci", "１", "1", 0},
+		{"utf8mb4_0900_ai_ci", "ﬁ", "FI", 0},
+		{"utf8mb4_0900_ai_ci", "İ", "i", 0},
+		{"utf8mb4_0900_ai_ci", "ı", "i", 1},
+		{"utf8mb4_0900_ai_ci", "е", "a", 1},
+		{"utf8mb4_general_ci", "a ", "a", 0},
+		{"utf8mb4_general_ci", "Ä", "a", 0},
+		{"utf8mb4_general_ci", "a\t", "a", -1},
+		{"utf8mb4_bin", "a", "A", 1},
+		{"utf8mb4_bin", "a  ", "a", 0},
+		{"binary", "a ", "a", 1},
+		{"latin1_swedish_ci", "ä", "z", 1},
+		{"latin1_swedish_ci", "é", "E", 0},
+		{"latin1_swedish_ci", "ab ", "AB", 0},
+	}
+	for _, tcase := range tcases {
+		col, ok := collations.Lookup(tcase.collation)
+		if !ok {
+			t.Fatalf("unknown collation %s", tcase.collation)
+		}
+		if got := col.Compare([]byte(tcase.a), []byte(tcase.b)); got != tcase.want {
+			t.Errorf("%s: %q vs %q: got %d, want %d", tcase.collation, tcase.a, tcase.b, got, tcase.want)
+		}
+		equalHashes := col.Hash([]byte(tcase.a)) == col.Hash([]byte(tcase.b))
+		if equalHashes != (tcase.want == 0) {
+			t.Errorf("%s: %q vs %q: equal hashes is %v", tcase.collation, tcase.a, tcase.b, equalHashes)
+		}
+	}
+}
+
+func TestCollationWeightString(t *testing.T) {
+	tcases := []struct {
+		collation string
+		s         string
+		want      string
+	}{
+		{"utf8mb4_0900_ai_ci", "Ab", "1c471c60"},
+		{"utf8mb4_0900_ai_ci", "á ", "1c470209"},
+		{"utf8mb4_0900_ai_ci", "a\u0301ẠǺ", "1c471c471c47"},
+		{"utf8mb4_0900_ai_ci", "①１", "1c3e1c3e"},
+		{"utf8mb4_general_ci", "ab ", "00410042"},
+		{"utf8mb4_bin", "ab", "000061000062"},
+		{"latin1_swedish_ci", "aÅ", "415b"},
+		{"binary", "ab ", "616220"},
+	}
+	for _, tcase := range tcases {
+		col, _ := collations.Lookup(tcase.collation)
+		if got := hex.EncodeToString(col.WeightString(nil, []byte(tcase.s))); got != tcase.want {
+			t.Errorf("%s: %q: got %s, want %s", tcase.collation, tcase.s, got, tcase.want)
+		}
+	}
+
+	if col, ok := collations.DefaultForCharset("LATIN1"); !ok || col.ID() != collations.Latin1SwedishCI {
+		t.Errorf("DefaultForCharset(LATIN1): got %v", col)
+	}
+	if col := collations.Get(collations.Default); col.Name() != "utf8mb4_0900_ai_ci" || col.PadSpace() {
+		t.Errorf("Default: got %s", col.Name())
+	}
+}
+
+func TestEvaluateCollations(t *testing.T) {
+	tcases := []struct {
+		expr string
+		want string
+	}{
+		{"'abc' = 'ABC'", "INT64(1)"},
+		{"'café' = 'cafe'", "INT64(1)"},
+		{"'a ' = 'a'", "INT64(0)"},
+		{"'ạ' = 'a' and 'Ǻ' = 'a' and 'ё' = 'е' and 'ς' = 'σ' and '①' = '1' and '１' = '1'", "INT64(1)"},
+		{"'ạ' like 'a%'", "INT64(1)"},
+		{"'a ' collate utf8mb4_general_ci = 'a'", "INT64(1)"},
+		{"'abc' collate utf8mb4_bin = 'ABC'", "INT64(0)"},
+		{"'abc' = 'ABC' collate utf8mb4_bin", "INT64(0)"},
+		{"_latin1'ä' collate latin1_swedish_ci > 'z'", "INT64(1)"},
+		{"_latin1'ä' > 'z'", "INT64(0)"},
+		{"'a' collate binary = 'A'", "INT64(0)"},
+		{"strcmp('a', 'B')", "INT64(-1)"},
+		{"strcmp('a' collate utf8mb4_bin, 'B')", "INT64(1)"},
+		{"locate('É', 'café')", "INT64(4)"},
+		{"instr('café' collate utf8mb4_bin, 'É')", "INT64(0)"},
+		{"'Café' like 'cafe'", "INT64(1)"},
+		{"'Café' like 'cafe' collate utf8mb4_bin", "INT64(0)"},
+		{"'Café' like 'Caf_' collate utf8mb4_bin", "INT64(1)"},
+		{"'a_b' like 'a#_b' escape '#'", "INT64(1)"},
+		{"'axb' like 'a#_b' escape '#'", "INT64(0)"},
+		{"json_search('[\"Café\"]', 'one', 'cafe')", "JSON(\"$[0]\")"},
+		{"hex(weight_string('Ab'))", "VARCHAR(1C471C60)"},
+		{"hex(weight_string('ab ' collate utf8mb4_general_ci))", "VARCHAR(00410042)"},
+		{"hex(weight_string(_latin1'a' as char(3)))", "VARCHAR(412020)"},
+		{"hex(weight_string('abc' collate utf8mb4_bin as char(2)))", "VARCHAR(000061000062)"},
+		{"hex(weight_string('ab' as binary(4)))", "VARCHAR(61620000)"},
+		{"hex(weight_string(12))", "VARCHAR(3132)"},
+		{"weight_string('Ab') = weight_string('aB')", "INT64(1)"},
+		{"weight_string(null)", "NULL"},
+		{"collation('a')", "VARCHAR(utf8mb4_0900_ai_ci)"},
+		{"collation('a' collate utf8mb4_bin)", "VARCHAR(utf8mb4_bin)"},
+		{"collation(_latin1'a')", "VARCHAR(latin1_swedish_ci)"},
+		{"collation(x'41')", "VARCHAR(binary)"},
+		{"collation(1)", "VARCHAR(binary)"},
+		{"collation(null)", "VARCHAR(binary)"},
+	}
+	for _, tcase := range tcases {
+		got, err := evalengine.Evaluate(mustParseExpr(t, tcase.expr), nil)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.expr, err)
+			continue
+		}
+		if show := showValue(got); show != tcase.want {
+			t.Errorf("%s: got %s, want %s", tcase.expr, show, tcase.want)
+		}
+	}
+}
+
+func TestEvaluateCollationErrors(t *testing.T) {
+	tcases := []struct {
+		expr string
+		want string
+	}{
+		{"'a' collate no_such_collation", "Unknown collation: 'no_such_collation'"},
+		{"_binary'a' collate utf8mb4_bin", "COLLATION 'utf8mb4_bin' is not valid for CHARACTER SET 'binary'"},
+		{"'a' collate latin1_swedish_ci", "COLLATION 'latin1_swedish_ci' is not valid for CHARACTER SET 'utf8mb4'"},
+		{"'a' collate utf8mb4_bin = 'a' collate utf8mb4_general_ci", "Illegal mix of collations (utf8mb4_bin,EXPLICIT) and (utf8mb4_general_ci,EXPLICIT) for operation 'comparison'"},
+		{"'a' collate utf8mb4_bin like 'a' collate utf8mb4_general_ci", "Illegal mix of collations (utf8mb4_bin,EXPLICIT) and (utf8mb4_general_ci,EXPLICIT) for operation 'like'"},
+	}
+	for _, tcase := range tcases {
+		_, err := evalengine.Evaluate(mustParseExpr(t, tcase.expr), nil)
+		if err == nil {
+			t.Errorf("%s: expected an error", tcase.expr)
+			continue
+		}
+		if err.Error() != tcase.want {
+			t.Errorf("%s: got %q, want %q", tcase.expr, err.Error(), tcase.want)
+		}
+	}
+}
+
+func TestEvaluateColumnCollations(t *testing.T) {
+	fields := []*querypb.Field{
+		{Name: "bin", Type: sqltypes.VarChar, Charset: uint32(collations.Utf8mb4Bin)},
+		{Name: "ci", Type: sqltypes.VarChar, Charset: uint32(collations.Utf8mb4GeneralCI)},
+		{Name: "plain", Type: sqltypes.VarChar},
+	}
+	row := []sqltypes.Value{sqltypes.NewVarChar("Widget"), sqltypes.NewVarChar("Widget "), sqltypes.NewVarChar("Wídget")}
+
+	tcases := []struct {
+		expr string
+		want string
+	}{
+		{"bin = 'widget'", "INT64(0)"},
+		{"bin = 'WIDGET' collate utf8mb4_0900_ai_ci", "INT64(1)"},
+		{"ci = 'widget'", "INT64(1)"},
+		{"plain = 'widget'", "INT64(1)"},
+		{"bin like 'w%'", "INT64(0)"},
+		{"ci like 'w%'", "INT64(1)"},
+		{"collation(ci)", "VARCHAR(utf8mb4_general_ci)"},
+		{"weight_string(ci) = weight_string('WIDGET' collate utf8mb4_general_ci)", "INT64(1)"},
+		{"weight_string(bin) = weight_string('WIDGET' collate utf8mb4_bin)", "INT64(0)"},
+	}
+	for _, tcase := range tcases {
+		expr, err := evalengine.Translate(mustParseExpr(t, tcase.expr), evalengine.FieldsConfig(fields))
+		if err != nil {
+			t.Fatalf("%s: %v", tcase.expr, err)
+		}
+		got, err := evalengine.NewExpressionEnv(nil, row).Evaluate(expr)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.expr, err)
+			continue
+		}
+		if show := showValue(got); show != tcase.want {
+			t.Errorf("%s: got %s, want %s", tcase.expr, show, tcase.want)
+		}
+	}
+
+	expr, err := evalengine.Translate(mustParseExpr(t, "bin = ci"), evalengine.FieldsConfig(fields))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := evalengine.NewExpressionEnv(nil, row).Evaluate(expr); err == nil {
+		t.Errorf("bin = ci: expected an illegal mix of collations")
+	}
+}
+
+func TestLikeToRegexpCollation(t *testing.T) {
+	ci := collations.Get(collations.Default)
+	bin := collations.Get(collations.Utf8mb4Bin)
+	tcases := []struct {
+		pattern string
+		escape  rune
+		col     *collations.Collation
+		s       string
+		want    bool
+	}{
+		{"caf%", '\\', ci, "CAFÉ au lait", true},
+		{"café", '\\', ci, "Cafe", true},
+		{"café", '\\', bin, "Cafe", false},
+		{"a_c", '\\', bin, "a\nc", true},
+		{"a|%", '|', bin, "a%", true},
+		{"a|%", '|', bin, "ab", false},
+		{"a.c", -1, bin, "abc", false},
+		{"100\\%", '\\', ci, "100%", true},
+	}
+	for _, tcase := range tcases {
+		re := sqlparser.LikeToRegexpCollation(tcase.pattern, tcase.escape, tcase.col)
+		if got := re.MatchString(tcase.s); got != tcase.want {
+			t.Errorf("%s like %s (%s): got %v, want %v", tcase.s, tcase.pattern, tcase.col.Name(), got, tcase.want)
+		}
+	}
+}
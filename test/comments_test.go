@@ -0,0 +1,60 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+)
+
+func TestParseWithComments(t *testing.T) {
+	tests := []struct {
+		in, out string
+	}{
+		{
+			in:  "-- leading\nSELECT a, -- the a\n  b FROM t",
+			out: "-- leading\nselect a -- the a\n, b from t",
+		},
+		{
+			in:  "SELECT /* keep */ a FROM t WHERE x IN (1, /* one */ 2)",
+			out: "select /* keep */ a from t where x in (1, /* one */ 2)",
+		},
+		{
+			in:  "select a from t /* tail */",
+			out: "select a from t /* tail */",
+		},
+		{
+			in:  "select a /* c1 */ + b from t",
+			out: "select a /* c1 */ + b from t",
+		},
+		{
+			in:  "select * from t where x = 1 /* a */ and y = 2",
+			out: "select * from t where x = 1 /* a */ and y = 2",
+		},
+		{
+			in:  "update t set a = 1 /* one */ where b = 2",
+			out: "update t set a = 1 /* one */ where b = 2",
+		},
+		{
+			in:  "update t set a = 1\n/* one */ where b = 2",
+			out: "update t set a = 1 /* one */ where b = 2",
+		},
+		{
+			in:  "select a from t where /* w */ x = 1",
+			out: "select a from t where /* w */ x = 1",
+		},
+	}
+	parser := sqlparser.NewTestParser()
+	for _, tc := range tests {
+		stmt, comments, err := parser.ParseWithComments(tc.in)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.in, err)
+		}
+		got := sqlparser.StringWithComments(stmt, comments)
+		if got != tc.out {
+			t.Errorf("%q: got %q, want %q", tc.in, got, tc.out)
+		}
+		if _, err := parser.Parse(got); err != nil {
+			t.Errorf("%q does not parse: %v", got, err)
+		}
+	}
+}
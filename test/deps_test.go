@@ -0,0 +1,229 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/catalog"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+)
+
+func loadGraph(t *testing.T, sql string) *catalog.DependencyGraph {
+	t.Helper()
+	g := catalog.NewDependencyGraph("app")
+	if err := g.Load(sqlparser.NewTestParser(), sql); err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func objectNames(objects []catalog.Object) []string {
+	var names []string
+	for _, obj := range objects {
+		names = append(names, obj.String())
+	}
+	return names
+}
+
+const dependencySchema = `
+	create view active_orders as select o.id, u.email from orders o join users u on u.id = o.user_id where o.state = 'active';
+	create procedure archive(in days int) begin
+		insert into archive.orders select * from orders where created < now() - interval days day;
+		call purge(days);
+	end;
+	create procedure purge(in days int) delete from orders where created < now() - interval days day;
+	create table items (id int primary key, order_id int, foreign key (order_id) references orders (id));
+	create table orders (id int primary key, user_id int, parent_id int, state varchar(10), created datetime,
+		constraint fk_user foreign key (user_id) references users (id),
+		foreign key (parent_id) references orders (id));
+	create table users (id int primary key, email varchar(100));
+	create view recent as with r as (select * from active_orders limit 10) select * from r;
+`
+
+func TestDependencyGraph(t *testing.T) {
+	g := loadGraph(t, dependencySchema)
+
+	order, deferred, err := g.CreateOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deferred) != 0 {
+		t.Errorf("deferred references %v", deferred)
+	}
+	want := []string{
+		"table app.users",
+		"table app.orders",
+		"view app.active_orders",
+		"procedure app.purge",
+		"procedure app.archive",
+		"table app.items",
+		"view app.recent",
+	}
+	if got := objectNames(order); !reflect.DeepEqual(got, want) {
+		t.Errorf("create order:\ngot  %q\nwant %q", got, want)
+	}
+
+	order, _, err = g.DropOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, j := 0, len(want)-1; i < j; i, j = i+1, j-1 {
+		want[i], want[j] = want[j], want[i]
+	}
+	if got := objectNames(order); !reflect.DeepEqual(got, want) {
+		t.Errorf("drop order:\ngot  %q\nwant %q", got, want)
+	}
+
+	users := catalog.Object{Kind: catalog.TableObject, Database: "app", Name: "users"}
+	want = []string{"view app.recent", "table app.items", "procedure app.archive", "procedure app.purge", "view app.active_orders", "table app.orders"}
+	if got := objectNames(g.Dependents(users)); !reflect.DeepEqual(got, want) {
+		t.Errorf("dependents of users:\ngot  %q\nwant %q", got, want)
+	}
+	archive := catalog.Object{Kind: catalog.ProcedureObject, Database: "app", Name: "archive"}
+	want = []string{"procedure app.purge", "table app.orders"}
+	if got := objectNames(g.References(archive)); !reflect.DeepEqual(got, want) {
+		t.Errorf("references of archive:\ngot  %q\nwant %q", got, want)
+	}
+	if cycles := g.Cycles(); len(cycles) != 0 {
+		t.Errorf("unexpected cycles %v", cycles)
+	}
+	want = []string{"procedure app.archive -> table archive.orders"}
+	var external []string
+	for _, ref := range g.ExternalReferences() {
+		external = append(external, ref.String())
+	}
+	if !reflect.DeepEqual(external, want) {
+		t.Errorf("external references:\ngot  %q\nwant %q", external, want)
+	}
+}
+
+func TestDependencyGraphExternalForeignKeys(t *testing.T) {
+	g := loadGraph(t, `
+		create table orders (id int primary key, user_id int, constraint fk_user foreign key (user_id) references accounts.users (id));
+	`)
+	got := g.ExternalReferences()
+	want := []catalog.Reference{{
+		From:       catalog.Object{Kind: catalog.TableObject, Database: "app", Name: "orders"},
+		To:         catalog.Object{Kind: catalog.TableObject, Database: "accounts", Name: "users"},
+		ForeignKey: true,
+		Constraint: "fk_user",
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("external references:\ngot  %v\nwant %v", got, want)
+	}
+}
+
+func TestDependencyGraphChanges(t *testing.T) {
+	g := loadGraph(t, dependencySchema+`
+		alter table orders drop foreign key fk_user;
+		drop view active_orders;
+		rename table items to order_items;
+	`)
+	users := catalog.Object{Kind: catalog.TableObject, Database: "app", Name: "users"}
+	if got := g.Dependents(users); len(got) != 0 {
+		t.Errorf("dependents of users: %q", objectNames(got))
+	}
+	orders := catalog.Object{Kind: catalog.TableObject, Database: "app", Name: "orders"}
+	want := []string{"table app.order_items", "procedure app.archive", "procedure app.purge"}
+	if got := objectNames(g.Dependents(orders)); !reflect.DeepEqual(got, want) {
+		t.Errorf("dependents of orders:\ngot  %q\nwant %q", got, want)
+	}
+}
+
+func TestDependencyGraphRenames(t *testing.T) {
+	g := loadGraph(t, `
+		create table p (id int primary key);
+		create table c (id int, p_id int, foreign key (p_id) references p (id));
+		create view v as select * from p;
+		create table other.p (id int primary key);
+		create table other.c (id int, p_id int, foreign key (p_id) references p (id));
+		rename table p to p2;
+		alter table other.p rename to other.p3;
+	`)
+	tcases := []struct {
+		table catalog.Object
+		want  []string
+	}{
+		{catalog.Object{Kind: catalog.TableObject, Database: "app", Name: "p2"}, []string{"table app.c"}},
+		{catalog.Object{Kind: catalog.TableObject, Database: "other", Name: "p3"}, []string{"table other.c"}},
+	}
+	for _, tcase := range tcases {
+		if got := objectNames(g.Dependents(tcase.table)); !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("dependents of %s:\ngot  %q\nwant %q", tcase.table, got, tcase.want)
+		}
+	}
+	want := []string{"view app.v -> table app.p"}
+	var external []string
+	for _, ref := range g.ExternalReferences() {
+		external = append(external, ref.String())
+	}
+	if !reflect.DeepEqual(external, want) {
+		t.Errorf("external references:\ngot  %q\nwant %q", external, want)
+	}
+}
+
+func TestDependencyGraphCycles(t *testing.T) {
+	g := loadGraph(t, `
+		create table a (id int primary key, b_id int, foreign key (b_id) references b (id));
+		create table b (id int primary key, c_id int, foreign key (c_id) references c (id));
+		create table c (id int primary key, a_id int, foreign key (a_id) references a (id));
+		create table d (id int primary key);
+		create procedure p() call q();
+		create procedure q() call p();
+	`)
+	var got [][]string
+	for _, cycle := range g.Cycles() {
+		got = append(got, objectNames(cycle))
+	}
+	want := [][]string{
+		{"table app.a", "table app.b", "table app.c"},
+		{"procedure app.p", "procedure app.q"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("cycles:\ngot  %q\nwant %q", got, want)
+	}
+	order, deferred, err := g.CreateOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantOrder := []string{"table app.d", "table app.a", "table app.c", "table app.b", "procedure app.p", "procedure app.q"}
+	if got := objectNames(order); !reflect.DeepEqual(got, wantOrder) {
+		t.Errorf("create order:\ngot  %q\nwant %q", got, wantOrder)
+	}
+	var gotDeferred []string
+	for _, ref := range deferred {
+		gotDeferred = append(gotDeferred, ref.String())
+	}
+	wantDeferred := []string{"table app.a -> table app.b", "procedure app.p -> procedure app.q"}
+	if !reflect.DeepEqual(gotDeferred, wantDeferred) {
+		t.Errorf("deferred references:\ngot  %q\nwant %q", gotDeferred, wantDeferred)
+	}
+	if !deferred[0].ForeignKey || deferred[1].ForeignKey {
+		t.Errorf("deferred references: %+v", deferred)
+	}
+
+	order, deferred, err = g.DropOrder()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantOrder = []string{"procedure app.q", "procedure app.p", "table app.b", "table app.c", "table app.a", "table app.d"}
+	if got := objectNames(order); !reflect.DeepEqual(got, wantOrder) || len(deferred) != 2 {
+		t.Errorf("drop order:\ngot  %q\nwant %q", got, wantOrder)
+	}
+}
+
+func TestDependencyGraphViewCycles(t *testing.T) {
+	g := loadGraph(t, `
+		create table t (id int primary key);
+		create view v1 as select * from t;
+		create view v2 as select * from v1;
+		alter view v1 as select * from v2;
+	`)
+	_, _, err := g.CreateOrder()
+	if err == nil {
+		t.Fatal("no error")
+	}
+	if msg := "Dependency cycle between app.v1, app.v2"; err.Error() != msg {
+		t.Errorf("got %v, want %s", err, msg)
+	}
+}
@@ -0,0 +1,80 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+)
+
+func TestEquivalent(t *testing.T) {
+	parser := sqlparser.NewTestParser()
+	equivalent := [][2]string{
+		{"select * from t where a = 1 and b = 2", "select * from t where b = 2 and a = 1"},
+		{"select * from t where a = 1 and (b = 2 and c = 3)", "select * from t where (c = 3 and a = 1) and b = 2"},
+		{"select * from t where a = 1 or b = 2 or a = 1", "select * from t where b = 2 or a = 1"},
+		{"select a + b * c from t", "select c * b + a from t"},
+		{"select * from t where a in (3, 1, 2, 1)", "select * from t where a in (1, 2, 3)"},
+		{"select * from t where 1 = a", "select * from t where a = 1"},
+		{"select * from t where a > 1", "select * from t where 1 < a"},
+		{"select * from t where a between 1 and 10", "select * from t where a >= 1 and a <= 10"},
+		{"select * from t where a not between 1 and 10", "select * from t where a < 1 or a > 10"},
+		{"select * from t where not (a = 1 or b is null)", "select * from t where a != 1 and b is not null"},
+		{"select * from t where not not a like 'x%'", "select * from t where a like 'x%'"},
+		{"select u.id from users as u join orders as o on o.user_id = u.id", "select x.id from users as x join orders as y on x.id = y.user_id"},
+		{"select * from users u where exists (select 1 from orders o where o.user_id = u.id)", "select * from users a where exists (select 1 from orders b where a.id = b.user_id)"},
+		{"select * from (t)", "select * from t"},
+		{"select ID, Upper(Name) from t", "select id, UPPER(name) from t"},
+		{"select * from t where ((a = 1))", "select * from t where a = 1"},
+	}
+	for _, pair := range equivalent {
+		stmtA, errA := parser.Parse(pair[0])
+		stmtB, errB := parser.Parse(pair[1])
+		if errA != nil || errB != nil {
+			t.Fatal(errA, errB)
+		}
+		if !sqlparser.Equivalent(stmtA, stmtB) {
+			t.Errorf("not equivalent:\n%s\n%s", sqlparser.String(sqlparser.Canonicalize(stmtA)), sqlparser.String(sqlparser.Canonicalize(stmtB)))
+		}
+		if sqlparser.CanonicalHash(stmtA) != sqlparser.CanonicalHash(stmtB) {
+			t.Errorf("different hashes: %s, %s", pair[0], pair[1])
+		}
+	}
+
+	different := [][2]string{
+		{"select * from t where a = 1", "select * from t where a = 2"},
+		{"select a - b from t", "select b - a from t"},
+		{"select a + a from t", "select a from t"},
+		{"select * from t where a < 1", "select * from t where a > 1"},
+		{"select * from t where not a <=> 1", "select * from t where a != 1"},
+		{"select u.id from users as u join orders as o on o.user_id = u.id", "select u.id from orders as o join users as u on o.user_id = u.id"},
+		{"select a as x from t", "select a as y from t"},
+	}
+	for _, pair := range different {
+		stmtA, errA := parser.Parse(pair[0])
+		stmtB, errB := parser.Parse(pair[1])
+		if errA != nil || errB != nil {
+			t.Fatal(errA, errB)
+		}
+		if sqlparser.Equivalent(stmtA, stmtB) {
+			t.Errorf("equivalent: %s, %s", pair[0], pair[1])
+		}
+		if sqlparser.CanonicalHash(stmtA) == sqlparser.CanonicalHash(stmtB) {
+			t.Errorf("same hashes: %s, %s", pair[0], pair[1])
+		}
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	stmt, err := sqlparser.NewTestParser().Parse("select U.Name from users as U where U.id between 1 and 5 and not (U.Name = 'x' or U.age > 30)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	original := sqlparser.String(stmt)
+	want := "select t1.`name` from users as t1 where 'x' != t1.`name` and 1 <= t1.id and 30 >= t1.age and 5 >= t1.id"
+	if got := sqlparser.String(sqlparser.Canonicalize(stmt)); got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+	if sqlparser.String(stmt) != original {
+		t.Errorf("statement changed to %s", sqlparser.String(stmt))
+	}
+}
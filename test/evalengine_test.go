@@ -0,0 +1,331 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+func mustParseExpr(t *testing.T, sql string) sqlparser.Expr {
+	t.Helper()
+	expr, err := sqlparser.NewTestParser().ParseExpr(sql)
+	if err != nil {
+		t.Fatalf("%s: %v", sql, err)
+	}
+	return expr
+}
+
+func showValue(v sqltypes.Value) string {
+	if v.IsNull() {
+		return "NULL"
+	}
+	return fmt.Sprintf("%s(%s)", v.Type(), v.RawStr())
+}
+
+func TestEvaluate(t *testing.T) {
+	tcases := []struct {
+		expr string
+		want string
+	}{
+		// arithmetic
+		{"1 + 2", "INT64(3)"},
+		{"1 - 2", "INT64(-1)"},
+		{"18446744073709551615 - 1", "UINT64(18446744073709551614)"},
+		{"1 + 1.50", "DECIMAL(2.50)"},
+		{"1.5 * 1.25", "DECIMAL(1.875)"},
+		{"1 + 1e0", "FLOAT64(2)"},
+		{"1 / 3", "DECIMAL(0.3333)"},
+		{"1.0 / 3", "DECIMAL(0.33333)"},
+		{"1e0 / 4", "FLOAT64(0.25)"},
+		{"1 / 0", "NULL"},
+		{"7 div 2", "INT64(3)"},
+		{"-7 div 2", "INT64(-3)"},
+		{"7 % 3", "INT64(1)"},
+		{"-7 mod 3", "INT64(-1)"},
+		{"7.5 % 2", "DECIMAL(1.5)"},
+		{"mod(7, 0)", "NULL"},
+		{"-(1)", "INT64(-1)"},
+		{"'3' + 4", "FLOAT64(7)"},
+		{"0x41 + 0", "UINT64(65)"},
+		{"null + 1", "NULL"},
+
+		// bitwise
+		{"5 & 3", "UINT64(1)"},
+		{"5 | 3", "UINT64(7)"},
+		{"5 ^ 3", "UINT64(6)"},
+		{"1 << 4", "UINT64(16)"},
+		{"~0", "UINT64(18446744073709551615)"},
+
+		// comparison
+		{"1 = 1.0", "INT64(1)"},
+		{"'abc' = 'ABC'", "INT64(1)"},
+		{"'10' > 9", "INT64(1)"},
+		{"'10' > '9'", "INT64(0)"},
+		{"null = null", "NULL"},
+		{"null <=> null", "INT64(1)"},
+		{"1 <=> null", "INT64(0)"},
+		{"(1, 2) = (1, 2)", "INT64(1)"},
+		{"(1, 2) < (1, 3)", "INT64(1)"},
+		{"2 between 1 and 3", "INT64(1)"},
+		{"2 not between 1 and 3", "INT64(0)"},
+		{"date'2024-01-02' < '2024-01-10'", "INT64(1)"},
+		{"3 in (1, 2, 3)", "INT64(1)"},
+		{"4 in (1, 2, null)", "NULL"},
+		{"4 not in (1, 2, 3)", "INT64(1)"},
+		{"1 in (1, null)", "INT64(1)"},
+
+		// logical
+		{"1 and null", "NULL"},
+		{"0 and null", "INT64(0)"},
+		{"1 or null", "INT64(1)"},
+		{"1 xor 1", "INT64(0)"},
+		{"not 0", "INT64(1)"},
+		{"null is null", "INT64(1)"},
+		{"0 is false", "INT64(1)"},
+		{"null is not true", "INT64(1)"},
+
+		// like and regexp
+		{"'abc' like 'a%'", "INT64(1)"},
+		{"'abc' like 'A_C'", "INT64(1)"},
+		{"'a%c' like 'a\\%c'", "INT64(1)"},
+		{"'a%c' like 'a|%c' escape '|'", "INT64(1)"},
+		{"'abc' like 'a|%c' escape '|'", "INT64(0)"},
+		{"'abc' not like 'b%'", "INT64(1)"},
+		{"null like 'a'", "NULL"},
+		{"'abc' regexp '^a.c$'", "INT64(1)"},
+		{"'ABC' regexp 'abc'", "INT64(1)"},
+
+		// cast
+		{"cast('12abc' as signed)", "INT64(12)"},
+		{"cast(-1 as unsigned)", "UINT64(18446744073709551615)"},
+		{"cast(1.5 as signed)", "INT64(2)"},
+		{"cast('1.2345' as decimal(5, 2))", "DECIMAL(1.23)"},
+		{"cast(12345 as decimal(4, 1))", "DECIMAL(999.9)"},
+		{"cast(1 as char)", "VARCHAR(1)"},
+		{"cast('abcdef' as char(3))", "VARCHAR(abc)"},
+		{"cast('2024-01-02 10:11:12' as date)", "DATE(2024-01-02)"},
+		{"cast('2024-01-02' as datetime)", "DATETIME(2024-01-02 00:00:00)"},
+		{"cast('10:11:12.5' as time(1))", "TIME(10:11:12.5)"},
+		{"cast('2024-02-30' as date)", "NULL"},
+		{"cast(1 as double)", "FLOAT64(1)"},
+
+		// control flow
+		{"case 1 when 1 then 'a' else 'b' end", "VARCHAR(a)"},
+		{"case when 0 then 1 else 2.5 end", "DECIMAL(2.5)"},
+		{"case when null then 1 end", "NULL"},
+		{"if(1, 2, 'x')", "VARCHAR(2)"},
+		{"if(null, 2, 3)", "INT64(3)"},
+		{"ifnull(null, 5)", "INT64(5)"},
+		{"coalesce(null, null, 3)", "INT64(3)"},
+		{"nullif(1, 1)", "NULL"},
+		{"nullif(1, 2)", "INT64(1)"},
+		{"isnull(null)", "INT64(1)"},
+
+		// numeric functions
+		{"abs(-3)", "INT64(3)"},
+		{"ceil(1.2)", "INT64(2)"},
+		{"floor(-1.2)", "INT64(-2)"},
+		{"round(2.5)", "DECIMAL(3)"},
+		{"round(1.2345, 2)", "DECIMAL(1.23)"},
+		{"round(2.5e0)", "FLOAT64(2)"},
+		{"truncate(1.999, 1)", "DECIMAL(1.9)"},
+		{"sign(-5)", "INT64(-1)"},
+		{"pow(2, 10)", "FLOAT64(1024)"},
+		{"sqrt(-1)", "NULL"},
+		{"log(0)", "NULL"},
+		{"greatest(1, 3, 2)", "INT64(3)"},
+		{"least('b', 'a')", "VARCHAR(a)"},
+		{"greatest(1, null)", "NULL"},
+		{"hex(255)", "VARCHAR(FF)"},
+		{"hex('abc')", "VARCHAR(616263)"},
+		{"bin(5)", "VARCHAR(101)"},
+		{"oct(8)", "VARCHAR(10)"},
+
+		// string functions
+		{"concat('a', 1, 'b')", "VARCHAR(a1b)"},
+		{"concat('a', null)", "NULL"},
+		{"concat_ws(',', 'a', null, 'b')", "VARCHAR(a,b)"},
+		{"upper('abc')", "VARCHAR(ABC)"},
+		{"length('héllo')", "INT64(6)"},
+		{"char_length('héllo')", "INT64(5)"},
+		{"repeat('ab', 3)", "VARCHAR(ababab)"},
+		{"left('abcdef', 2)", "VARCHAR(ab)"},
+		{"right('abcdef', 2)", "VARCHAR(ef)"},
+		{"lpad('5', 3, '0')", "VARCHAR(005)"},
+		{"rpad('abc', 2, 'x')", "VARCHAR(ab)"},
+		{"reverse('abc')", "VARCHAR(cba)"},
+		{"replace('aXbX', 'X', '-')", "VARCHAR(a-b-)"},
+		{"substring('abcdef', 2, 3)", "VARCHAR(bcd)"},
+		{"substr('abcdef', -2)", "VARCHAR(ef)"},
+		{"substring_index('a.b.c', '.', 2)", "VARCHAR(a.b)"},
+		{"substring_index('a.b.c', '.', -1)", "VARCHAR(c)"},
+		{"trim('  a  ')", "VARCHAR(a)"},
+		{"trim(leading 'x' from 'xxaxx')", "VARCHAR(axx)"},
+		{"rtrim('a  ')", "VARCHAR(a)"},
+		{"locate('b', 'abcb')", "INT64(2)"},
+		{"locate('b', 'abcb', 3)", "INT64(4)"},
+		{"instr('abc', 'c')", "INT64(3)"},
+		{"strcmp('a', 'b')", "INT64(-1)"},
+		{"ascii('A')", "INT64(65)"},
+		{"field('b', 'a', 'b')", "INT64(2)"},
+		{"elt(2, 'a', 'b')", "VARCHAR(b)"},
+		{"insert('abcdef', 2, 3, 'X')", "VARCHAR(aXef)"},
+		{"unhex('4142')", "VARBINARY(AB)"},
+		{"space(3)", "VARCHAR(   )"},
+	}
+	for _, tcase := range tcases {
+		got, err := evalengine.Evaluate(mustParseExpr(t, tcase.expr), nil)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.expr, err)
+			continue
+		}
+		if show := showValue(got); show != tcase.want {
+			t.Errorf("%s: got %s, want %s", tcase.expr, show, tcase.want)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	tcases := []struct {
+		expr string
+		want string
+	}{
+		{"9223372036854775807 + 1", "BIGINT value is out of range in '(9223372036854775807 + 1)'"},
+		{"-9223372036854775807 - 2", "BIGINT value is out of range in '(-9223372036854775807 - 2)'"},
+		{"18446744073709551615 + 1", "BIGINT UNSIGNED value is out of range in '(18446744073709551615 + 1)'"},
+		{"0 - 18446744073709551615", "BIGINT UNSIGNED value is out of range in '(0 - 18446744073709551615)'"},
+		{"1e308 * 10", "DOUBLE value is out of range in '(1e308 * 10)'"},
+		{"(1, 2) = 1", "Operand should contain 2 column(s)"},
+		{"'a' like 'b' escape 'xy'", "Incorrect arguments to ESCAPE"},
+		{"abs(1, 2)", "Incorrect parameter count in the call to native function 'abs'"},
+		{"x'01' & x'0102'", "Binary operands of bitwise operators must be of equal length"},
+		{"no_such_function(1)", "VT12001: unsupported: evaluating 'no_such_function(1)'"},
+	}
+	for _, tcase := range tcases {
+		_, err := evalengine.Evaluate(mustParseExpr(t, tcase.expr), nil)
+		if err == nil {
+			t.Errorf("%s: expected an error", tcase.expr)
+			continue
+		}
+		if err.Error() != tcase.want {
+			t.Errorf("%s: got %q, want %q", tcase.expr, err.Error(), tcase.want)
+		}
+	}
+}
+
+func TestEvaluateWarnings(t *testing.T) {
+	tcases := []struct {
+		expr string
+		want []evalengine.Warning
+	}{
+		{"1 / 0", []evalengine.Warning{{Code: 1365, Message: "Division by 0"}}},
+		{"'1a' + 1", []evalengine.Warning{{Code: 1292, Message: "Truncated incorrect DOUBLE value: '1a'"}}},
+		{"cast('x' as signed)", []evalengine.Warning{{Code: 1292, Message: "Truncated incorrect INTEGER value: 'x'"}}},
+		{"cast(1000 as decimal(2, 0))", []evalengine.Warning{{Code: 1264, Message: "Out of range value for column 'cast' at row 1"}}},
+		{"1 + 1", nil},
+	}
+	for _, tcase := range tcases {
+		expr, err := evalengine.Translate(mustParseExpr(t, tcase.expr), nil)
+		if err != nil {
+			t.Fatalf("%s: %v", tcase.expr, err)
+		}
+		env := evalengine.NewExpressionEnv(nil, nil)
+		if _, err := env.Evaluate(expr); err != nil {
+			t.Errorf("%s: %v", tcase.expr, err)
+			continue
+		}
+		if got := env.Warnings(); fmt.Sprint(got) != fmt.Sprint(tcase.want) {
+			t.Errorf("%s: got warnings %v, want %v", tcase.expr, got, tcase.want)
+		}
+	}
+}
+
+func TestEvaluateRow(t *testing.T) {
+	columns := map[string]int{"id": 0, "name": 1, "price": 2, "qty": 3, "created": 4}
+	types := []querypb.Type{sqltypes.Int64, sqltypes.VarChar, sqltypes.Decimal, sqltypes.Uint32, sqltypes.Datetime}
+	cfg := &evalengine.Config{
+		ResolveColumn: func(col *sqlparser.ColName) (int, error) {
+			offset, ok := columns[col.Name.Lowered()]
+			if !ok {
+				return 0, fmt.Errorf("unknown column %s", sqlparser.String(col))
+			}
+			return offset, nil
+		},
+		ResolveType: func(offset int) (querypb.Type, bool) {
+			return types[offset], true
+		},
+	}
+	row := []sqltypes.Value{
+		sqltypes.NewInt64(7),
+		sqltypes.NewVarChar("Widget"),
+		sqltypes.MakeTrusted(sqltypes.Decimal, []byte("9.99")),
+		sqltypes.MakeTrusted(sqltypes.Uint32, []byte("3")),
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2024-03-04 05:06:07")),
+	}
+	bindVars := map[string]*querypb.BindVariable{
+		"min": sqltypes.Int64BindVariable(5),
+		"pat": sqltypes.StringBindVariable("wid%"),
+	}
+
+	tcases := []struct {
+		expr string
+		want string
+	}{
+		{"price * qty", "DECIMAL(29.97)"},
+		{"id > :min and name like :pat", "INT64(1)"},
+		{"concat(name, '#', id)", "VARCHAR(Widget#7)"},
+		{"created > '2024-01-01'", "INT64(1)"},
+		{"created + 0", "INT64(20240304050607)"},
+		{"if(id > 10, price, id)", "DECIMAL(7)"},
+	}
+	for _, tcase := range tcases {
+		expr, err := evalengine.Translate(mustParseExpr(t, tcase.expr), cfg)
+		if err != nil {
+			t.Fatalf("%s: %v", tcase.expr, err)
+		}
+		env := evalengine.NewExpressionEnv(bindVars, row)
+		got, err := env.Evaluate(expr)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.expr, err)
+			continue
+		}
+		if show := showValue(got); show != tcase.want {
+			t.Errorf("%s: got %s, want %s", tcase.expr, show, tcase.want)
+		}
+	}
+
+	unsigned, err := evalengine.Translate(mustParseExpr(t, "qty - 4"), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := evalengine.NewExpressionEnv(nil, row).Evaluate(unsigned); err == nil {
+		t.Errorf("qty - 4: expected an out of range error")
+	}
+	if _, err := evalengine.Translate(mustParseExpr(t, "missing + 1"), cfg); err == nil {
+		t.Errorf("expected an error for an unknown column")
+	}
+	if _, err := evalengine.Evaluate(mustParseExpr(t, ":nope + 1"), nil); err == nil {
+		t.Errorf("expected an error for a missing bind variable")
+	}
+}
+
+func TestEvaluateNow(t *testing.T) {
+	expr, err := evalengine.Translate(mustParseExpr(t, "now()"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := evalengine.NewExpressionEnv(nil, nil)
+	env.Now = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	got, err := env.Evaluate(expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if show := showValue(got); show != "DATETIME(2024-05-06 07:08:09)" {
+		t.Errorf("now(): got %s", show)
+	}
+}
@@ -0,0 +1,252 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/executor"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/collations"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+func executorTables() executor.Tables {
+	return executor.Tables{
+		"users": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|name|dept", "int64|varchar|int64"),
+			"1|alice|10",
+			"2|bob|20",
+			"3|carol|10",
+			"4|dave|null",
+		),
+		"depts": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("dept|title", "int64|varchar"),
+			"10|eng",
+			"20|sales",
+			"30|legal",
+		),
+		"orders": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|user_id|amount|doc", "int64|int64|decimal|json"),
+			`1|1|10.50|{"tags": ["a", "b"]}`,
+			`2|1|4.25|{"tags": []}`,
+			`3|2|7.00|{"tags": ["c"]}`,
+			`4|3|1.00|null`,
+		),
+	}
+}
+
+func executeQuery(t *testing.T, query string, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	t.Helper()
+	stmt, ok := mustParse(t, query).(sqlparser.TableStatement)
+	if !ok {
+		t.Fatalf("%s: not a SELECT", query)
+	}
+	return executor.Execute(stmt, executorTables(), bindVars)
+}
+
+func TestExecute(t *testing.T) {
+	bindVars := map[string]*querypb.BindVariable{
+		"n":   sqltypes.Int64BindVariable(2),
+		"ids": sqltypes.TestBindVariable([]any{1, 3}),
+	}
+	tcases := []struct {
+		query string
+		want  string
+	}{
+		// filtering, ordering and limiting
+		{"select id, name from users where dept = 10 order by id desc", "INT64(3) VARCHAR(carol); INT64(1) VARCHAR(alice)"},
+		{"select name from users where id in ::ids", "VARCHAR(alice); VARCHAR(carol)"},
+		{"select name from users order by id limit 1, :n", "VARCHAR(bob); VARCHAR(carol)"},
+		{"select name from users order by dept, name desc", "VARCHAR(dave); VARCHAR(carol); VARCHAR(alice); VARCHAR(bob)"},
+		{"select id * 2 as x from users order by x desc limit 1", "INT64(8)"},
+		{"select distinct dept from users order by 1", "NULL; INT64(10); INT64(20)"},
+		{"select distinct dept from users u order by u.dept desc", "INT64(20); INT64(10); NULL"},
+		{"select distinct dept * 2 from users order by dept * 2 desc", "INT64(40); INT64(20); NULL"},
+		{"select 1 + 1", "INT64(2)"},
+
+		// joins
+		{"select u.name, d.title from users u join depts d on u.dept = d.dept order by u.id", "VARCHAR(alice) VARCHAR(eng); VARCHAR(bob) VARCHAR(sales); VARCHAR(carol) VARCHAR(eng)"},
+		{"select u.name, d.title from users u hash_join depts d on u.dept = d.dept order by u.id", "VARCHAR(alice) VARCHAR(eng); VARCHAR(bob) VARCHAR(sales); VARCHAR(carol) VARCHAR(eng)"},
+		{"select u.name, d.title from users u parallel hash_join depts d on u.dept = d.dept and d.title <> 'eng'", "VARCHAR(bob) VARCHAR(sales)"},
+		{"select u.name, d.title from users u left join depts d on u.dept = d.dept order by u.id desc limit 1", "VARCHAR(dave) NULL"},
+		{"select d.title, count(u.id) from users u right join depts d on u.dept = d.dept group by d.title order by d.title", "VARCHAR(eng) INT64(2); VARCHAR(legal) INT64(0); VARCHAR(sales) INT64(1)"},
+		{"select * from users natural join depts where id = 2", "INT64(20) INT64(2) VARCHAR(bob) VARCHAR(sales)"},
+		{"select dept, title from users join depts using (dept) where name = 'carol'", "INT64(10) VARCHAR(eng)"},
+		{"select count(*) from users, depts", "INT64(12)"},
+
+		// grouping
+		{"select user_id, count(*), sum(amount), avg(amount) from orders group by user_id order by user_id", "INT64(1) INT64(2) DECIMAL(14.75) DECIMAL(7.375000); INT64(2) INT64(1) DECIMAL(7.00) DECIMAL(7.000000); INT64(3) INT64(1) DECIMAL(1.00) DECIMAL(1.000000)"},
+		{"select count(*), sum(id), max(name), min(name) from users where id > 10", "INT64(0) NULL NULL NULL"},
+		{"select dept, group_concat(name order by name desc separator '|') from users group by dept having count(*) > 1", "INT64(10) TEXT(carol|alice)"},
+		{"select count(distinct dept), bit_or(id), bit_and(id) from users", "INT64(2) UINT64(7) UINT64(0)"},
+		{"select dept d, count(*) c from users group by d having c = 1 order by d", "NULL INT64(1); INT64(20) INT64(1)"},
+		{"select stddev_pop(id), var_samp(id) from users", "FLOAT64(1.118033988749895) FLOAT64(1.6666666666666667)"},
+
+		// subqueries
+		{"select name from users where dept = (select dept from depts where title = 'sales')", "VARCHAR(bob)"},
+		{"select name from users where id in (select user_id from orders where amount > 5) order by name", "VARCHAR(alice); VARCHAR(bob)"},
+		{"select name from users u where not exists (select 1 from orders o where o.user_id = u.id) order by name", "VARCHAR(dave)"},
+		{"select name, (select count(*) from orders o where o.user_id = u.id) from users u order by id limit 2", "VARCHAR(alice) INT64(2); VARCHAR(bob) INT64(1)"},
+		{"select t.x from (select id + 1 as x from users) as t where t.x > 4", "INT64(5)"},
+		{"select c.n from (select count(*) from orders) as c(n)", "INT64(4)"},
+		{"with big as (select user_id from orders where amount > 5) select name from users join big on users.id = big.user_id order by name", "VARCHAR(alice); VARCHAR(bob)"},
+		{"select u.name, l.total from users u, lateral (select sum(amount) as total from orders o where o.user_id = u.id) as l where u.id = 1", "VARCHAR(alice) DECIMAL(14.75)"},
+		{"select o.id, j.tag from orders o, json_table(o.doc, '$.tags[*]' columns (tag varchar(10) path '$')) as j order by o.id, j.tag", "INT64(1) VARCHAR(a); INT64(1) VARCHAR(b); INT64(3) VARCHAR(c)"},
+
+		// unions
+		{"select dept from users union select dept from depts order by dept", "NULL; INT64(10); INT64(20); INT64(30)"},
+		{"select id from users where id < 3 union all select id from orders where id < 3", "INT64(1); INT64(2); INT64(1); INT64(2)"},
+		{"select id from users where id = 1 union select amount from orders where id = 2", "DECIMAL(1); DECIMAL(4.25)"},
+		{"select name from users where id = 1 union select title from depts order by 1 desc limit 2", "VARCHAR(sales); VARCHAR(legal)"},
+	}
+	for _, tcase := range tcases {
+		result, err := executeQuery(t, tcase.query, bindVars)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.query, err)
+			continue
+		}
+		var rows []string
+		for _, row := range result.Rows {
+			rows = append(rows, showRows([][]sqltypes.Value{row}))
+		}
+		if got := strings.Join(rows, "; "); got != tcase.want {
+			t.Errorf("%s:\n got %s\nwant %s", tcase.query, got, tcase.want)
+		}
+	}
+}
+
+func TestExecuteCollations(t *testing.T) {
+	words := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|bin|ci", "int64|varchar|varchar"),
+		"1|alice|alice",
+		"2|Alice|Alice",
+		"3|bob|BOB",
+		"4|Bob|bob",
+	)
+	words.Fields[1].Charset = uint32(collations.Utf8mb4Bin)
+	words.Fields[2].Charset = uint32(collations.Utf8mb4GeneralCI)
+	tables := executor.Tables{"words": words}
+
+	tcases := []struct {
+		query string
+		want  string
+	}{
+		{"select bin from words order by bin", "VARCHAR(Alice); VARCHAR(Bob); VARCHAR(alice); VARCHAR(bob)"},
+		{"select ci from words order by ci desc, id", "VARCHAR(BOB); VARCHAR(bob); VARCHAR(alice); VARCHAR(Alice)"},
+		{"select ci from words order by ci collate utf8mb4_bin", "VARCHAR(Alice); VARCHAR(BOB); VARCHAR(alice); VARCHAR(bob)"},
+		{"select distinct ci from words", "VARCHAR(alice); VARCHAR(BOB)"},
+		{"select distinct bin from words", "VARCHAR(alice); VARCHAR(Alice); VARCHAR(bob); VARCHAR(Bob)"},
+		{"select distinct bin collate utf8mb4_general_ci from words", "VARCHAR(alice); VARCHAR(bob)"},
+		{"select count(distinct ci), count(distinct bin) from words", "INT64(2) INT64(4)"},
+		{"select ci, count(*) from words group by ci", "VARCHAR(alice) INT64(2); VARCHAR(BOB) INT64(2)"},
+		{"select bin, count(*) from words group by bin", "VARCHAR(alice) INT64(1); VARCHAR(Alice) INT64(1); VARCHAR(bob) INT64(1); VARCHAR(Bob) INT64(1)"},
+		{"select min(bin), max(ci), min(ci collate utf8mb4_bin) from words", "VARCHAR(Alice) VARCHAR(BOB) VARCHAR(Alice)"},
+		{"select id, row_number() over (partition by ci order by bin) from words order by id", "INT64(1) UINT64(2); INT64(2) UINT64(1); INT64(3) UINT64(2); INT64(4) UINT64(1)"},
+		{"select a.id, b.id from words a join words b on a.ci = b.ci where a.id = 1 order by b.id", "INT64(1) INT64(1); INT64(1) INT64(2)"},
+		{"select a.id, b.id from words a hash_join words b on a.bin collate utf8mb4_general_ci = b.bin where a.id = 1 order by b.id", "INT64(1) INT64(1); INT64(1) INT64(2)"},
+		{"select a.id, b.id from words a hash_join words b on a.bin = b.bin where a.id = 1", "INT64(1) INT64(1)"},
+		{"select ci from words where id < 3 union select 'ALICE'", "VARCHAR(alice)"},
+	}
+	for _, tcase := range tcases {
+		stmt, ok := mustParse(t, tcase.query).(sqlparser.TableStatement)
+		if !ok {
+			t.Fatalf("%s: not a SELECT", tcase.query)
+		}
+		result, err := executor.Execute(stmt, tables, nil)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.query, err)
+			continue
+		}
+		var rows []string
+		for _, row := range result.Rows {
+			rows = append(rows, showRows([][]sqltypes.Value{row}))
+		}
+		if got := strings.Join(rows, "; "); got != tcase.want {
+			t.Errorf("%s:\n got %s\nwant %s", tcase.query, got, tcase.want)
+		}
+	}
+}
+
+func TestExecuteFields(t *testing.T) {
+	result, err := executeQuery(t, "select u.id, u.name as n, count(o.id), sum(o.amount), :v from users u left join orders o on o.user_id = u.id group by u.id, u.name", map[string]*querypb.BindVariable{
+		"v": sqltypes.StringBindVariable("x"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range result.Fields {
+		got = append(got, f.Table+"."+f.Name+":"+f.Type.String())
+	}
+	want := "u.id:INT64 u.n:VARCHAR .count(o.id):INT64 .sum(o.amount):DECIMAL .:v:VARCHAR"
+	if strings.Join(got, " ") != want {
+		t.Errorf("got %s, want %s", strings.Join(got, " "), want)
+	}
+	if result.Fields[0].OrgTable != "users" || result.Fields[1].OrgName != "name" {
+		t.Errorf("got original names %s.%s", result.Fields[0].OrgTable, result.Fields[1].OrgName)
+	}
+	if len(result.Rows) != 4 {
+		t.Errorf("got %d rows, want 4", len(result.Rows))
+	}
+}
+
+func TestExecuteFieldNames(t *testing.T) {
+	tcases := []struct {
+		query string
+		want  string
+	}{
+		{"select MAX( name ), count(*) from users", "MAX( name )|count(*)"},
+		{"select id, ROW_NUMBER() OVER (ORDER BY id DESC) from users", "id|ROW_NUMBER() OVER (ORDER BY id DESC)"},
+		{"select 1+1, 'a' as x, `name` from users", "1+1|x|name"},
+		{"select Upper(name) from (select name from users) d union select 'x'", "Upper(name)"},
+	}
+	for _, tcase := range tcases {
+		stmt, source, err := sqlparser.NewTestParser().ParseLossless(tcase.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		q, err := executor.CompileSource(stmt.(sqlparser.TableStatement), source, executorTables())
+		if err != nil {
+			t.Fatalf("%s: %v", tcase.query, err)
+		}
+		result, err := q.Execute(nil)
+		if err != nil {
+			t.Fatalf("%s: %v", tcase.query, err)
+		}
+		var names []string
+		for _, f := range result.Fields {
+			names = append(names, f.Name)
+		}
+		if got := strings.Join(names, "|"); got != tcase.want {
+			t.Errorf("%s:\n got %s\nwant %s", tcase.query, got, tcase.want)
+		}
+	}
+}
+
+func TestExecuteErrors(t *testing.T) {
+	tcases := []struct {
+		query string
+		err   string
+	}{
+		{"select nope from users", "Unknown column 'nope' in 'field list'"},
+		{"select id from users, orders", "Column 'id' in field list is ambiguous"},
+		{"select id from missing", "Table 'missing' doesn't exist"},
+		{"select id from users u, users u", "not unique table/alias"},
+		{"select * from users where count(*) > 1", "Invalid use of group function"},
+		{"select id from users union select id, name from users", "The used SELECT statements have a different number of columns"},
+		{"select name from users where id = (select id from users)", "Subquery returns more than 1 row"},
+		{"select name from users where id in (select id, name from users)", "Operand should contain 1 column(s)"},
+		{"select name from users order by 2", "Unknown column '2' in 'order clause'"},
+		{"select distinct name from users order by id", "Expression #1 of ORDER BY clause is not in SELECT list, references column 'users.id' which is not in SELECT list; this is incompatible with DISTINCT"},
+		{"select distinct name from users order by name, id + 1", "Expression #2 of ORDER BY clause is not in SELECT list"},
+		{"select distinct name from users group by name order by count(*)", "Expression #1 of ORDER BY clause is not in SELECT list, contains aggregate function"},
+		{"select name from users limit :n", "Incorrect arguments to LIMIT"},
+	}
+	bindVars := map[string]*querypb.BindVariable{"n": sqltypes.StringBindVariable("x")}
+	for _, tcase := range tcases {
+		_, err := executeQuery(t, tcase.query, bindVars)
+		if err == nil || !strings.Contains(err.Error(), tcase.err) {
+			t.Errorf("%s: got %v, want %s", tcase.query, err, tcase.err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+)
+
+func TestRewritePredicateFolding(t *testing.T) {
+	tcases := []struct {
+		query string
+		want  string
+	}{
+		// literals
+		{"select * from t where 1 + 1 = 2", "select * from t where true"},
+		{"select * from t where 'a' = 'b' or x = 1", "select * from t where x = 1"},
+		{"select * from t where x = 1 + 1 and not (1 = 1 and y = 2)", "select * from t where x = 2 and not y = 2"},
+		{"select * from t where a = date_add('2024-01-01', interval 1 day) and b = date_add(date'2024-01-01', interval 1 day)", "select * from t where a = '2024-01-02' and b = date'2024-01-02'"},
+		{"select * from t where a = 1.5 * 2 and b = 1e0 / 4 and c = concat('a', upper('b')) and d = -(3) and e = x'41'", "select * from t where a = 3.0 and b = 2.5e-01 and c = 'aB' and d = -3 and e = X'41'"},
+		{"select * from t where a = cast(1 as unsigned) - 2 and b = 1 / 0 and c = 'abc' + 1 and d = json_array(1)", "select * from t where a = cast(1 as unsigned) - 2 and b = 1 / 0 and c = 'abc' + 1 and d = json_array(1)"},
+		{"select * from t group by a having count(*) > 1 + 1", "select * from t group by a having count(*) > 2"},
+		{"select * from t where x in (select 1 + 1 from u where y = 2 * 2)", "select * from t where x in (select 1 + 1 from u where y = 4)"},
+
+		// only predicates are folded
+		{"select 1 + 1, x + 0 * 2 from t where y = 1 + 1", "select 1 + 1, x + 0 * 2 from t where y = 2"},
+		{"insert into t values (1 + 1)", "insert into t values (1 + 1)"},
+		{"update t set a = 1 + 1 where b = 2 + 2", "update t set a = 1 + 1 where b = 4"},
+		{"select * from t where x > rand() and now() > 1 and uuid() = 'a'", "select * from t where x > rand() and now() > 1 and uuid() = 'a'"},
+		{"select * from t order by 1 + 1", "select * from t order by 1 + 1 asc"},
+
+		// ranges
+		{"select * from t where x > 5 and x < 3", "select * from t where false"},
+		{"select * from t where x in (1, 2) and x between 4 and 6 or y = 2", "select * from t where y = 2"},
+		{"select * from t where x > 5 or x <= 5 or y = 1", "select * from t where x is not null or y = 1"},
+		{"select * from t where x not in (1, 2) or x in (1, 2)", "select * from t where x is not null"},
+		{"select * from t where x between 5 and 3", "select * from t where false"},
+		{"select * from t where x >= 1 and x <= 1 and x != 2", "select * from t where x >= 1 and x <= 1 and x != 2"},
+		{"select * from t join u on t.x = u.x and u.y < 0 and u.y > 0", "select * from t join u on false"},
+		{"select x > 5 and x < 3 from t", "select x > 5 and x < 3 from t"},
+
+		// collations and character sets
+		{"select * from t where a = 'x' collate utf8mb4_bin", "select * from t where a = 'x' collate utf8mb4_bin"},
+		{"select * from t where a like 'A%' collate utf8mb4_bin", "select * from t where a like 'A%' collate utf8mb4_bin"},
+		{"select * from t where a = concat('X', 'y') collate utf8mb4_bin", "select * from t where a = 'Xy' collate utf8mb4_bin"},
+		{"select * from t where a = _latin1 'x'", "select * from t where a = _latin1 'x'"},
+		{"select * from t where a = convert('x' using latin1)", "select * from t where a = convert('x' using latin1)"},
+		{"select * from t where a = cast('x' as char character set latin1)", "select * from t where a = cast('x' as char character set latin1)"},
+		{"select * from t where a = charset('x')", "select * from t where a = charset('x')"},
+		{"select * from t where a = _binary 'x' and b = cast('x' as binary)", "select * from t where a = _binary 'x' and b = _binary 'x'"},
+	}
+	for _, tcase := range tcases {
+		got := sqlparser.String(sqlparser.RewritePredicateWith(mustParse(t, tcase.query), evalengine.FoldConstant))
+		if got != tcase.want {
+			t.Errorf("%s:\n got %s\nwant %s", tcase.query, got, tcase.want)
+		}
+	}
+}
+
+func TestRewritePredicateWithoutFolder(t *testing.T) {
+	query := "select * from t where x = 1 + 1 and 'a' = 'b'"
+	want := "select * from t where x = 1 + 1 and 'a' = 'b'"
+	if got := sqlparser.String(sqlparser.RewritePredicate(mustParse(t, query))); got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestRewritePredicateFolds(t *testing.T) {
+	query := "select * from t where x = 1 + 1 and 'a' = 'b' or y = 2"
+	want := "select * from t where y = 2"
+	if got := sqlparser.String(evalengine.RewritePredicate(mustParse(t, query))); got != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestRewritePredicateExpr(t *testing.T) {
+	tcases := []struct {
+		expr string
+		want string
+	}{
+		{"x > 5 and x < 3", "x > 5 and x < 3"},
+		{"x = 1 and null", "x = 1 and null"},
+		{"x between 5 and 3", "x between 5 and 3"},
+		{"x = 1 + 1 or null", "x = 2 or null"},
+	}
+	for _, tcase := range tcases {
+		got := sqlparser.String(sqlparser.RewritePredicateWith(mustParseExpr(t, tcase.expr), evalengine.FoldConstant))
+		if got != tcase.want {
+			t.Errorf("%s:\n got %s\nwant %s", tcase.expr, got, tcase.want)
+		}
+	}
+}
+
+func TestEquivalentCollations(t *testing.T) {
+	a := mustParse(t, "select * from t where a = 'x' collate utf8mb4_bin")
+	b := mustParse(t, "select * from t where a = 'x'")
+	if sqlparser.EquivalentWith(a, b, evalengine.FoldConstant) {
+		t.Errorf("equivalent: %s, %s", sqlparser.String(a), sqlparser.String(b))
+	}
+	if sqlparser.CanonicalHashWith(a, evalengine.FoldConstant) == sqlparser.CanonicalHashWith(b, evalengine.FoldConstant) {
+		t.Errorf("same hashes: %s, %s", sqlparser.String(a), sqlparser.String(b))
+	}
+	c := mustParse(t, "select * from t where a = 1 + 1")
+	d := mustParse(t, "select * from t where a = 2")
+	if !sqlparser.EquivalentWith(c, d, evalengine.FoldConstant) {
+		t.Errorf("not equivalent: %s, %s", sqlparser.String(c), sqlparser.String(d))
+	}
+	if sqlparser.Equivalent(c, d) {
+		t.Errorf("equivalent without folder: %s, %s", sqlparser.String(c), sqlparser.String(d))
+	}
+}
@@ -0,0 +1,61 @@
+package test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/tools/asthelpergen"
+)
+
+// The options below mirror the go:generate directives in pkg/sqlparser/generate.go.
+
+func TestASTHelpersUpToDate(t *testing.T) {
+	err := asthelpergen.Verify(asthelpergen.Options{
+		Package:        "../pkg/sqlparser",
+		RootInterface:  "SQLNode",
+		ProtoFile:      "../proto/ast.proto",
+		ProtoGoPackage: "github.com/vedadiyan/sqlparser/pkg/ast",
+		EqualsCustom:   []string{"*ColName"},
+		CloneExclude:   []string{"*ColName"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParserUpToDate(t *testing.T) {
+	grammar, err := os.ReadFile("../pkg/sqlparser/sql.y")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// goyacc writes the name of the grammar into the parser, so it is run next to a
+	// copy of it, as go generate runs it.
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "sql.y"), grammar, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	goyacc := filepath.Join(dir, "goyacc")
+	if out, err := exec.Command("go", "build", "-o", goyacc, "../pkg/tools/goyacc").CombinedOutput(); err != nil {
+		t.Fatalf("building goyacc: %v\n%s", err, out)
+	}
+	cmd := exec.Command(goyacc, "-v", "", "-o", "sql.go", "sql.y")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("goyacc: %v\n%s", err, out)
+	}
+
+	want, err := os.ReadFile(filepath.Join(dir, "sql.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile("../pkg/sqlparser/sql.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatal("sql.go is out of date, run go generate in pkg/sqlparser")
+	}
+}
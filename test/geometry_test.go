@@ -0,0 +1,196 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	"github.com/vedadiyan/sqlparser/pkg/mysql/geometry"
+)
+
+func TestGeometryCodecs(t *testing.T) {
+	tcases := []struct {
+		wkt  string
+		want string
+	}{
+		{"POINT(1 2)", "POINT(1 2)"},
+		{"point ( -1.5 2e3 )", "POINT(-1.5 2000)"},
+		{"LINESTRING(0 0, 1 1, 2 0)", "LINESTRING(0 0,1 1,2 0)"},
+		{"POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 1))", "POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 1))"},
+		{"MULTIPOINT(1 2, 3 4)", "MULTIPOINT((1 2),(3 4))"},
+		{"MULTIPOINT((1 2),(3 4))", "MULTIPOINT((1 2),(3 4))"},
+		{"MULTILINESTRING((0 0,1 1),(2 2,3 3))", "MULTILINESTRING((0 0,1 1),(2 2,3 3))"},
+		{"MULTIPOLYGON(((0 0,1 0,1 1,0 0)))", "MULTIPOLYGON(((0 0,1 0,1 1,0 0)))"},
+		{"GEOMCOLLECTION(POINT(1 2),LINESTRING(0 0,1 1))", "GEOMETRYCOLLECTION(POINT(1 2),LINESTRING(0 0,1 1))"},
+		{"GEOMETRYCOLLECTION EMPTY", "GEOMETRYCOLLECTION EMPTY"},
+		{"GEOMETRYCOLLECTION()", "GEOMETRYCOLLECTION EMPTY"},
+	}
+	for _, tcase := range tcases {
+		g, err := geometry.ParseWKT(tcase.wkt)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.wkt, err)
+			continue
+		}
+		if got := g.WKT(); got != tcase.want {
+			t.Errorf("%s: got %s, want %s", tcase.wkt, got, tcase.want)
+		}
+		g.SetSRID(4326)
+		back, err := geometry.Parse(g.Marshal())
+		if err != nil {
+			t.Errorf("%s: %v", tcase.wkt, err)
+			continue
+		}
+		if back.SRID != 4326 || back.WKT() != tcase.want {
+			t.Errorf("%s: internal format round trip gave SRID %d, %s", tcase.wkt, back.SRID, back.WKT())
+		}
+	}
+
+	for _, wkt := range []string{
+		"POINT(1)",
+		"LINESTRING(0 0)",
+		"POLYGON((0 0,1 0,1 1,0 1))",
+		"POLYGON((0 0,1 0,0 0))",
+		"MULTIPOINT()",
+		"POINT(1 2) x",
+		"CIRCLE(1 2)",
+	} {
+		if _, err := geometry.ParseWKT(wkt); err == nil {
+			t.Errorf("%s: expected an error", wkt)
+		}
+	}
+	if _, err := geometry.ParseWKB([]byte{1, 2, 0, 0, 0, 0xff, 0xff, 0xff, 0xff}); err == nil {
+		t.Errorf("expected an error for a linestring with more points than data")
+	}
+}
+
+func TestGeoHash(t *testing.T) {
+	if got := geometry.EncodeGeoHash(180, 0, 10); got != "xbpbpbpbpb" {
+		t.Errorf("got %s, want xbpbpbpbpb", got)
+	}
+	if got := geometry.EncodeGeoHash(-180, -90, 15); got != "000000000000000" {
+		t.Errorf("got %s, want 000000000000000", got)
+	}
+	box, err := geometry.DecodeGeoHash("XBPBPBPBPB")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if box.Longitude() != 180 || box.Latitude() != 0 {
+		t.Errorf("got %v %v, want 180 0", box.Longitude(), box.Latitude())
+	}
+	if _, err := geometry.DecodeGeoHash("abc"); err == nil {
+		t.Errorf("expected an error for a geohash with an 'a'")
+	}
+}
+
+func TestEvaluateGeometry(t *testing.T) {
+	tcases := []struct {
+		expr string
+		want string
+	}{
+		// constructors and axis order
+		{"st_astext(point(1, 2))", "VARCHAR(POINT(1 2))"},
+		{"st_astext(linestring(point(0, 0), point(1, 1)))", "VARCHAR(LINESTRING(0 0,1 1))"},
+		{"st_astext(polygon(linestring(point(0, 0), point(1, 0), point(1, 1), point(0, 0))))", "VARCHAR(POLYGON((0 0,1 0,1 1,0 0)))"},
+		{"st_astext(multipoint(point(0, 0), point(1, 1)))", "VARCHAR(MULTIPOINT((0 0),(1 1)))"},
+		{"st_astext(st_geomfromtext('POINT(10 20)', 4326))", "VARCHAR(POINT(10 20))"},
+		{"st_astext(st_geomfromtext('POINT(10 20)', 4326), 'axis-order=long-lat')", "VARCHAR(POINT(20 10))"},
+		{"st_astext(st_geomfromtext('POINT(10 20)', 4326, 'axis-order=long-lat'))", "VARCHAR(POINT(20 10))"},
+		{"st_x(st_geomfromtext('POINT(10 20)', 4326))", "FLOAT64(10)"},
+		{"st_latitude(st_geomfromtext('POINT(10 20)', 4326))", "FLOAT64(10)"},
+		{"st_longitude(st_geomfromtext('POINT(10 20)', 4326))", "FLOAT64(20)"},
+		{"st_astext(st_x(point(1, 2), 5))", "VARCHAR(POINT(5 2))"},
+		{"st_srid(point(1, 2))", "UINT64(0)"},
+		{"st_srid(st_geomfromtext('POINT(10 20)', 4326))", "UINT64(4326)"},
+		{"st_srid(st_srid(point(10, 20), 4326))", "UINT64(4326)"},
+		{"st_astext(st_srid(point(10, 20), 4326))", "VARCHAR(POINT(20 10))"},
+		{"st_srid(null, 4326)", "NULL"},
+		{"st_astext(st_swapxy(st_geomfromtext('LINESTRING(0 1,2 3)')))", "VARCHAR(LINESTRING(1 0,3 2))"},
+		{"st_srid(st_swapxy(st_geomfromtext('POINT(10 20)', 4326)))", "UINT64(4326)"},
+		{"st_astext(st_geomfromwkb(st_asbinary(st_geomfromtext('LINESTRING(0 0,1 2)'))))", "VARCHAR(LINESTRING(0 0,1 2))"},
+		{"st_pointfromtext('LINESTRING(0 0,1 1)')", "error"},
+		{"st_geomfromtext(null)", "NULL"},
+
+		// properties
+		{"st_geometrytype(st_geomfromtext('MULTIPOINT(0 0,1 1)'))", "VARCHAR(MULTIPOINT)"},
+		{"st_dimension(st_geomfromtext('POLYGON((0 0,1 0,1 1,0 0))'))", "INT64(2)"},
+		{"st_isempty(st_geomfromtext('GEOMETRYCOLLECTION EMPTY'))", "INT64(1)"},
+		{"st_issimple(st_geomfromtext('LINESTRING(0 0,2 2,0 2,2 0)'))", "INT64(0)"},
+		{"st_issimple(st_geomfromtext('LINESTRING(0 0,1 0,1 1,0 0)'))", "INT64(1)"},
+		{"st_astext(st_envelope(st_geomfromtext('LINESTRING(0 0,2 3)')))", "VARCHAR(POLYGON((0 0,2 0,2 3,0 3,0 0)))"},
+		{"st_length(st_geomfromtext('LINESTRING(0 0,3 4)'))", "FLOAT64(5)"},
+		{"st_numpoints(st_geomfromtext('LINESTRING(0 0,3 4,5 5)'))", "INT64(3)"},
+		{"st_astext(st_pointn(st_geomfromtext('LINESTRING(0 0,3 4,5 5)'), 2))", "VARCHAR(POINT(3 4))"},
+		{"st_pointn(st_geomfromtext('LINESTRING(0 0,3 4)'), 3)", "NULL"},
+		{"st_isclosed(st_geomfromtext('LINESTRING(0 0,1 0,0 0)'))", "INT64(1)"},
+		{"st_area(st_geomfromtext('POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 2,1 1))'))", "FLOAT64(15)"},
+		{"st_astext(st_centroid(st_geomfromtext('POLYGON((0 0,4 0,4 4,0 4,0 0))')))", "VARCHAR(POINT(2 2))"},
+		{"st_numinteriorrings(st_geomfromtext('POLYGON((0 0,4 0,4 4,0 4,0 0),(1 1,2 1,2 2,1 1))'))", "INT64(1)"},
+		{"st_astext(st_exteriorring(st_geomfromtext('POLYGON((0 0,1 0,1 1,0 0))')))", "VARCHAR(LINESTRING(0 0,1 0,1 1,0 0))"},
+		{"st_numgeometries(st_geomfromtext('MULTIPOINT(0 0,1 1)'))", "INT64(2)"},
+		{"st_astext(st_geometryn(st_geomfromtext('MULTIPOINT(0 0,1 1)'), 2))", "VARCHAR(POINT(1 1))"},
+
+		// geohashes
+		{"st_geohash(180, 0, 10)", "VARCHAR(xbpbpbpbpb)"},
+		{"st_geohash(point(-20, 45), 10) = st_geohash(-20, 45, 10)", "INT64(1)"},
+		{"st_latfromgeohash(st_geohash(45, -20, 10))", "FLOAT64(-20)"},
+		{"st_longfromgeohash(st_geohash(45, -20, 10))", "FLOAT64(45)"},
+		{"st_astext(st_pointfromgeohash(st_geohash(45, -20, 10), 0))", "VARCHAR(POINT(45 -20))"},
+
+		// GeoJSON
+		{"st_asgeojson(point(11.11111, 12.22222), 2)", `JSON({"type": "Point", "coordinates": [11.11, 12.22]})`},
+		{"st_asgeojson(st_geomfromtext('POINT(12 11)', 4326), 2, 2)", `JSON({"crs": {"type": "name", "properties": {"name": "EPSG:4326"}}, "type": "Point", "coordinates": [11.0, 12.0]})`},
+		{"st_astext(st_geomfromgeojson('{\"type\": \"Point\", \"coordinates\": [102.0, 0.5]}'))", "VARCHAR(POINT(0.5 102))"},
+		{"st_astext(st_geomfromgeojson('{\"type\": \"Point\", \"coordinates\": [1, 2, 3]}', 2, 0))", "VARCHAR(POINT(1 2))"},
+		{"st_geomfromgeojson('{\"type\": \"Feature\", \"geometry\": null, \"properties\": {}}')", "NULL"},
+	}
+	for _, tcase := range tcases {
+		got, err := evalengine.Evaluate(mustParseExpr(t, tcase.expr), nil)
+		if tcase.want == "error" {
+			if err == nil {
+				t.Errorf("%s: expected an error", tcase.expr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tcase.expr, err)
+			continue
+		}
+		if show := showValue(got); show != tcase.want {
+			t.Errorf("%s: got %s, want %s", tcase.expr, show, tcase.want)
+		}
+	}
+}
+
+func TestEvaluateGeometryErrors(t *testing.T) {
+	tcases := []struct {
+		expr string
+		want string
+	}{
+		{"st_geomfromtext('POINT(1)')", "Invalid GIS data provided to function st_geometryfromtext."},
+		{"st_geomfromtext('POINT(1 2)', 1234)", "There's no spatial reference system with SRID 1234."},
+		{"st_geomfromtext('POINT(100 0)', 4326)", "Latitude 100.000000 is out of range in function st_geometryfromtext. It must be within [-90.000000, 90.000000]."},
+		{"st_geomfromtext('POINT(0 200)', 4326)", "Longitude 200.000000 is out of range in function st_geometryfromtext. It must be within (-180.000000, 180.000000]."},
+		{"st_geomfromtext('POINT(1 2)', 4326, 'axis-order=up-down')", "Invalid value 'up-down' for option 'axis-order' in function 'st_geometryfromtext'."},
+		{"st_geomfromtext('POINT(1 2)', 4326, 'order=lat-long')", "Invalid option key 'order' in function st_geometryfromtext."},
+		{"st_x(st_geomfromtext('LINESTRING(0 0,1 1)'))", "POINT value is a geometry of unexpected type LINESTRING in st_x."},
+		{"st_srid(point(1, 2), 1234)", "There's no spatial reference system with SRID 1234."},
+		{"st_srid(point(0, 100), 4326)", "Latitude 100.000000 is out of range in function st_srid. It must be within [-90.000000, 90.000000]."},
+		{"st_latitude(point(1, 2))", "Function st_latitude is only defined for geographic spatial reference systems, but its argument is in SRID 0, which is not geographic."},
+		{"st_length(st_geomfromtext('LINESTRING(0 0,1 1)', 4326))", "st_length(LINESTRING) has not been implemented for geographic spatial reference systems."},
+		{"linestring(point(0, 0), st_geomfromtext('POINT(1 1)', 4326))", "Binary geometry function linestring given two geometries of different srids: 0 and 4326, which should have been identical."},
+		{"st_latfromgeohash('abc')", "Incorrect geohash value: 'abc' for function st_latfromgeohash"},
+		{"st_geohash(0, 0, 0)", "Incorrect max_length value: '0' for function st_geohash"},
+		{"st_geomfromgeojson('{\"type\": \"Point\"}')", "Invalid GeoJSON data provided to function st_geomfromgeojson: Missing required member 'coordinates'"},
+		{"st_geomfromgeojson('{\"type\": \"Point\", \"coordinates\": 1}')", "Invalid GeoJSON data provided to function st_geomfromgeojson: Member 'coordinates' must be of type 'array'"},
+		{"st_geomfromgeojson('{\"type\": \"Point\", \"coordinates\": [1, 2, 3]}')", "Unsupported number of coordinate dimensions in function st_geomfromgeojson: Found 3, expected 2"},
+	}
+	for _, tcase := range tcases {
+		_, err := evalengine.Evaluate(mustParseExpr(t, tcase.expr), nil)
+		if err == nil {
+			t.Errorf("%s: expected an error", tcase.expr)
+			continue
+		}
+		if err.Error() != tcase.want {
+			t.Errorf("%s: got %q, want %q", tcase.expr, err.Error(), tcase.want)
+		}
+	}
+}
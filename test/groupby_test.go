@@ -0,0 +1,110 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/semantics"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+)
+
+const groupingSchema = `
+	create table users (id int primary key, name varchar(100), email varchar(100) not null, nick varchar(20), unique key (email), unique key (nick));
+	create table orders (id int primary key, user_id int, total decimal(10, 2));
+`
+
+func validateGroupBy(t *testing.T, query string) error {
+	t.Helper()
+	c := loadCatalog(t, groupingSchema)
+	stmt, source, err := sqlparser.NewTestParser().ParseLossless(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return semantics.ValidateGroupBy(stmt, c.Schema(), "app", source)
+}
+
+func TestValidateGroupBy(t *testing.T) {
+	valid := []string{
+		"select name, count(*) from users group by name",
+		"select u.name, count(*) from users u group by name",
+		"select id, name, email from users group by id",
+		"select email, name from users group by email",
+		"select users.id, name, sum(total) from users join orders on orders.user_id = users.id group by users.id",
+		"select user_id, total from orders where total = user_id group by user_id",
+		"select name, any_value(email) from users group by name",
+		"select name as n, count(*) from users group by n having n like 'a%' order by n",
+		"select name, count(*) from users group by 1 order by 1",
+		"select upper(name), count(*) from users group by upper(name) order by upper(name)",
+		"select name, sum(count(*)) over (order by name) from users group by name",
+		"select name, (select count(*) from orders) as n from users group by n, name",
+		"select name, id from users",
+		"select id, (select count(*) from orders where orders.user_id = users.id) from users group by id",
+		"select d.n, count(*) from (select name as n, email from users) as d group by d.n",
+		"select name from users where id in (select user_id from orders group by user_id having sum(total) > 10)",
+	}
+	for _, query := range valid {
+		if err := validateGroupBy(t, query); err != nil {
+			t.Errorf("%s: %v", query, err)
+		}
+	}
+
+	tcases := []struct {
+		query string
+		state vterrors.State
+		msg   string
+	}{{
+		query: "select name, email, count(*) from users group by name",
+		state: vterrors.WrongFieldWithGroup,
+		msg:   "Expression #2 of SELECT list is not in GROUP BY clause and contains nonaggregated column 'app.users.email' which is not functionally dependent on columns in GROUP BY clause; this is incompatible with sql_mode=only_full_group_by at position 14",
+	}, {
+		query: "select nick, name from users group by nick",
+		state: vterrors.WrongFieldWithGroup,
+		msg:   "Expression #2 of SELECT list is not in GROUP BY clause and contains nonaggregated column 'app.users.name' which is not functionally dependent on columns in GROUP BY clause; this is incompatible with sql_mode=only_full_group_by at position 14",
+	}, {
+		query: "select name from users group by name having count(*) > 1 and id > 3",
+		state: vterrors.WrongFieldWithGroup,
+		msg:   "Expression #1 of HAVING clause is not in GROUP BY clause and contains nonaggregated column 'app.users.id' which is not functionally dependent on columns in GROUP BY clause; this is incompatible with sql_mode=only_full_group_by at position 62",
+	}, {
+		query: "select user_id from orders group by user_id order by total",
+		state: vterrors.WrongFieldWithGroup,
+		msg:   "Expression #1 of ORDER BY clause is not in GROUP BY clause and contains nonaggregated column 'app.orders.total' which is not functionally dependent on columns in GROUP BY clause; this is incompatible with sql_mode=only_full_group_by at position 54",
+	}, {
+		query: "select * from orders group by user_id",
+		state: vterrors.WrongFieldWithGroup,
+		msg:   "Expression #1 of SELECT list is not in GROUP BY clause and contains nonaggregated column 'app.orders.id' which is not functionally dependent on columns in GROUP BY clause; this is incompatible with sql_mode=only_full_group_by at position 8",
+	}, {
+		query: "select o.user_id, u.name from orders o left join users u on u.id = o.user_id group by o.user_id",
+		state: vterrors.WrongFieldWithGroup,
+		msg:   "Expression #2 of SELECT list is not in GROUP BY clause and contains nonaggregated column 'app.users.name' which is not functionally dependent on columns in GROUP BY clause; this is incompatible with sql_mode=only_full_group_by at position 19",
+	}, {
+		query: "select name, (select total from orders where user_id = users.id limit 1) from users group by name",
+		state: vterrors.WrongFieldWithGroup,
+		msg:   "Expression #2 of SELECT list is not in GROUP BY clause and contains nonaggregated column 'app.users.id' which is not functionally dependent on columns in GROUP BY clause; this is incompatible with sql_mode=only_full_group_by at position 56",
+	}, {
+		query: "select count(*) c from users group by c",
+		state: vterrors.WrongGroupField,
+		msg:   "Can't group on 'c'",
+	}, {
+		query: "select name, max(id) + 1 from users group by 1, 2",
+		state: vterrors.WrongGroupField,
+		msg:   "Can't group on 'max(id) + 1'",
+	}, {
+		query: "select name, count(*) from users",
+		state: vterrors.MixOfGroupFuncAndFields,
+		msg:   "In aggregated query without GROUP BY, expression #1 of SELECT list contains nonaggregated column 'app.users.name'; this is incompatible with sql_mode=only_full_group_by at position 8",
+	}, {
+		query: "select count(*) from (select user_id, max(total) from orders) as d",
+		state: vterrors.MixOfGroupFuncAndFields,
+		msg:   "In aggregated query without GROUP BY, expression #1 of SELECT list contains nonaggregated column 'app.orders.user_id'; this is incompatible with sql_mode=only_full_group_by at position 30",
+	}}
+	for _, tcase := range tcases {
+		err := validateGroupBy(t, tcase.query)
+		if err == nil {
+			t.Errorf("%s: no error", tcase.query)
+			continue
+		}
+		if vterrors.ErrState(err) != tcase.state || err.Error() != tcase.msg {
+			t.Errorf("%s:\ngot  %v (%v)\nwant %s (%v)", tcase.query, err, vterrors.ErrState(err), tcase.msg, tcase.state)
+		}
+	}
+}
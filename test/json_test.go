@@ -0,0 +1,59 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+)
+
+var roundTripQueries = []string{
+	"select /* hint */ distinct a, b as c, count(*), t.* from t as x join u on x.id = u.id where a in (1, 2) and b is not null group by a having count(*) > 1 order by a desc limit 10, 5",
+	"select * from t where (a, b) in ((1, 'x'), (2, 'y')) and c = :bv and d in ::list and e = true",
+	"with recursive cte(n) as (select 1 union all select n + 1 from cte where n < 10) select n from cte",
+	"select row_number() over (partition by a order by b) from t window w as (order by c)",
+	"select case when a = 1 then 'one' else 'other' end, cast(a as char(10)), json_extract(doc, '$.a') from t",
+	"insert into t(a, b) values (1, 2), (3, default) on duplicate key update b = values(b)",
+	"update t set a = a + 1 where b = 2 order by c limit 1",
+	"delete from t where a = 1",
+	"load data local infile '/tmp/t.csv' replace into table db.t fields terminated by ','",
+	"load data concurrent infile '/tmp/t.csv' into table t partition (p0) character set utf8mb4 lines starting by 'x' ignore 1 lines (a, @b) set c = @b + 1",
+	"load data from s3 's3://bucket/t.csv' into table t",
+	"grant select (a, b), insert on db.t to 'u'@'%' with grant option",
+	"create table t (id bigint unsigned not null auto_increment primary key, name varchar(64) default 'x' comment 'the name', key idx_name (name(10))) engine=InnoDB",
+	"alter table t add column c int after b, drop index idx_name",
+	"create view v as select a from t",
+	"set @a = 1, session sql_mode = 'ANSI'",
+	"show tables from db like 'x%'",
+	"explain select * from t",
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	parser := sqlparser.NewTestParser()
+	for _, query := range roundTripQueries {
+		stmt, err := parser.Parse(query)
+		if err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+		data, err := sqlparser.MarshalJSONNode(stmt)
+		if err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+		got, err := sqlparser.UnmarshalJSONStatement(data)
+		if err != nil {
+			t.Fatalf("%s: %v\n%s", query, err, data)
+		}
+		if !(&sqlparser.Comparator{}).SQLNode(stmt, got) {
+			t.Errorf("%s: round trip gave %s", query, sqlparser.String(got))
+		}
+
+		// nodes are also encoded with their type by encoding/json
+		viaJSON, err := json.Marshal(stmt)
+		if err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+		if string(viaJSON) != string(data) {
+			t.Errorf("%s: json.Marshal gave %s, want %s", query, viaJSON, data)
+		}
+	}
+}
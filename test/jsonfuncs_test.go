@@ -0,0 +1,360 @@
+package test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+func TestJSONFunctions(t *testing.T) {
+	tcases := []struct {
+		expr     string
+		want     string
+		warnings []int
+	}{
+		// JSON_EXTRACT, -> and ->>
+		{expr: `json_extract('{"a": 1, "b": [2, 3]}', '$.b[1]')`, want: "JSON(3)"},
+		{expr: `json_extract('{"a": 1, "b": [2, 3]}', '$.a', '$.b')`, want: "JSON([1, [2, 3]])"},
+		{expr: `json_extract('{"a": 1, "b": [2, 3]}', '$.c')`, want: "NULL"},
+		{expr: `json_extract('{"a": {"x": 1}, "b": {"x": 2}}', '$.*.x')`, want: "JSON([1, 2])"},
+		{expr: `json_extract('{"a": {"x": 1}, "b": [{"x": 2}]}', '$**.x')`, want: "JSON([1, 2])"},
+		{expr: `json_extract('[1, 2, 3, 4, 5]', '$[1 to 3]')`, want: "JSON([2, 3, 4])"},
+		{expr: `json_extract('[1, 2, 3, 4, 5]', '$[last-1]')`, want: "JSON(4)"},
+		{expr: `json_extract('[1, 2, 3]', '$[*]')`, want: "JSON([1, 2, 3])"},
+		{expr: `json_extract('"x"', '$[0]')`, want: `JSON("x")`},
+		{expr: `json_extract('{"a b": 1}', '$."a b"')`, want: "JSON(1)"},
+		{expr: `json_extract('{"a": 1.50, "b": 1e2, "c": 18446744073709551615}', '$.a', '$.b', '$.c')`, want: "JSON([1.5, 100.0, 18446744073709551615])"},
+		{expr: `json_extract(null, '$')`, want: "NULL"},
+
+		// JSON_UNQUOTE and JSON_QUOTE
+		{expr: `json_unquote('"a\\u00e9b"')`, want: "VARCHAR(aéb)"},
+		{expr: `json_unquote('abc')`, want: "VARCHAR(abc)"},
+		{expr: `json_quote('a"b')`, want: `VARCHAR("a\"b")`},
+
+		// JSON_ARRAY and JSON_OBJECT
+		{expr: `json_array(1, 'a', null, true, 1 = 1, 2.5, 1e1)`, want: `JSON([1, "a", null, true, true, 2.5, 10.0])`},
+		{expr: `json_array()`, want: "JSON([])"},
+		{expr: `json_object('b', 1, 'a', json_array(2), 'b', 3)`, want: `JSON({"a": [2], "b": 3})`},
+		{expr: `json_object('key', date'2024-01-02')`, want: `JSON({"key": "2024-01-02"})`},
+		{expr: `json_object('bb', 1, 'a', 2, 'c', 3)`, want: `JSON({"a": 2, "c": 3, "bb": 1})`},
+
+		// JSON_CONTAINS, JSON_CONTAINS_PATH, JSON_OVERLAPS and MEMBER OF
+		{expr: `json_contains('{"a": 1, "b": [2, 3]}', '[3]', '$.b')`, want: "INT64(1)"},
+		{expr: `json_contains('{"a": 1, "b": [2, 3]}', '{"a": 1}')`, want: "INT64(1)"},
+		{expr: `json_contains('[1, [2, 3]]', '[[2]]')`, want: "INT64(1)"},
+		{expr: `json_contains('[1, [2, 3]]', '[2]')`, want: "INT64(0)"},
+		{expr: `json_contains('[1, [2, 3]]', '2')`, want: "INT64(0)"},
+		{expr: `json_contains('[1, 2]', '1.0')`, want: "INT64(1)"},
+		{expr: `json_contains('{"a": 1}', '1', '$.b')`, want: "NULL"},
+		{expr: `json_contains_path('{"a": 1, "b": 2}', 'one', '$.a', '$.c')`, want: "INT64(1)"},
+		{expr: `json_contains_path('{"a": 1, "b": 2}', 'all', '$.a', '$.c')`, want: "INT64(0)"},
+		{expr: `json_overlaps('[1, 3, 5]', '[2, 5]')`, want: "INT64(1)"},
+		{expr: `json_overlaps('{"a": 1}', '{"a": 2}')`, want: "INT64(0)"},
+		{expr: `json_overlaps('5', '[2, 5]')`, want: "INT64(1)"},
+		{expr: `17 member of ('[23, "abc", 17, "ab", 10]')`, want: "INT64(1)"},
+		{expr: `'17' member of ('[23, "abc", 17, "ab", 10]')`, want: "INT64(0)"},
+		{expr: `json_array(1) member of ('[[1], 2]')`, want: "INT64(1)"},
+
+		// JSON_KEYS and JSON_SEARCH
+		{expr: `json_keys('{"b": 1, "a": {"c": 2}}')`, want: `JSON(["a", "b"])`},
+		{expr: `json_keys('{"b": 1, "a": {"c": 2}}', '$.a')`, want: `JSON(["c"])`},
+		{expr: `json_keys('[1]')`, want: "NULL"},
+		{expr: `json_search('["abc", [{"k": "10"}, "def"], {"x": "abc"}]', 'one', 'abc')`, want: `JSON("$[0]")`},
+		{expr: `json_search('["abc", [{"k": "10"}, "def"], {"x": "abc"}]', 'all', 'abc')`, want: `JSON(["$[0]", "$[2].x"])`},
+		{expr: `json_search('["abc", [{"k": "10"}, "def"], {"x": "abc"}]', 'all', '1%')`, want: `JSON("$[1][0].k")`},
+		{expr: `json_search('["abc", [{"k": "10"}, "def"], {"x": "abc"}]', 'all', 'ABC', null, '$[2]')`, want: `JSON("$[2].x")`},
+		{expr: `json_search('["a_c", "abc"]', 'all', 'a|_c', '|')`, want: `JSON("$[0]")`},
+		{expr: `json_search('["abc"]', 'all', 'zzz')`, want: "NULL"},
+
+		// JSON_DEPTH, JSON_LENGTH, JSON_TYPE and JSON_VALID
+		{expr: `json_depth('[]')`, want: "INT64(1)"},
+		{expr: `json_depth('[10, {"a": 20}]')`, want: "INT64(3)"},
+		{expr: `json_length('{"a": 1, "b": {"c": 30}}')`, want: "INT64(2)"},
+		{expr: `json_length('{"a": 1, "b": {"c": 30}}', '$.b')`, want: "INT64(1)"},
+		{expr: `json_length('"x"')`, want: "INT64(1)"},
+		{expr: `json_type('{"a": [10, true]}')`, want: "VARCHAR(OBJECT)"},
+		{expr: `json_type(json_extract('{"a": [10, true]}', '$.a[1]'))`, want: "VARCHAR(BOOLEAN)"},
+		{expr: `json_type(cast(1.5 as json))`, want: "VARCHAR(DECIMAL)"},
+		{expr: `json_type(cast(time'10:00:00' as json))`, want: "VARCHAR(TIME)"},
+		{expr: `json_type(cast(-1 as json))`, want: "VARCHAR(INTEGER)"},
+		{expr: `json_valid('{"a": 1}')`, want: "INT64(1)"},
+		{expr: `json_valid('hello')`, want: "INT64(0)"},
+		{expr: `json_valid(null)`, want: "NULL"},
+
+		// JSON_SET and the other modifications
+		{expr: `json_set('{"a": 1, "b": [2, 3]}', '$.a', 10, '$.c', '[true, false]')`, want: `JSON({"a": 10, "b": [2, 3], "c": "[true, false]"})`},
+		{expr: `json_insert('{"a": 1, "b": [2, 3]}', '$.a', 10, '$.c', '[true, false]')`, want: `JSON({"a": 1, "b": [2, 3], "c": "[true, false]"})`},
+		{expr: `json_replace('{"a": 1, "b": [2, 3]}', '$.a', 10, '$.c', '[true, false]')`, want: `JSON({"a": 10, "b": [2, 3]})`},
+		{expr: `json_set('{"a": 1}', '$.b', cast('[1]' as json))`, want: `JSON({"a": 1, "b": [1]})`},
+		{expr: `json_set('[1, 2]', '$[5]', 3)`, want: "JSON([1, 2, 3])"},
+		{expr: `json_set('1', '$[1]', 2)`, want: "JSON([1, 2])"},
+		{expr: `json_set('1', '$[0]', 2)`, want: "JSON(2)"},
+		{expr: `json_set('{"a": 1}', '$', '[]')`, want: `JSON("[]")`},
+		{expr: `json_set('{"a": 1}', '$.a', null)`, want: `JSON({"a": null})`},
+		{expr: `json_set('{"a": 1}', null, 2)`, want: "NULL"},
+		{expr: `json_array_append('["a", ["b", "c"], "d"]', '$[1]', 1, '$[0]', 2)`, want: `JSON([["a", 2], ["b", "c", 1], "d"])`},
+		{expr: `json_array_append('{"a": 1}', '$', 'z')`, want: `JSON([{"a": 1}, "z"])`},
+		{expr: `json_array_insert('["a", {"b": [1, 2]}, [3, 4]]', '$[1]', 'x', '$[100]', 'y')`, want: `JSON(["a", "x", {"b": [1, 2]}, [3, 4], "y"])`},
+		{expr: `json_array_insert('["a", {"b": [1, 2]}, [3, 4]]', '$[1].b[0]', 'x')`, want: `JSON(["a", {"b": ["x", 1, 2]}, [3, 4]])`},
+		{expr: `json_remove('["a", ["b", "c"], "d"]', '$[1]')`, want: `JSON(["a", "d"])`},
+		{expr: `json_remove('{"a": 1, "b": 2}', '$.b', '$.z')`, want: `JSON({"a": 1})`},
+
+		// JSON_MERGE_PRESERVE, JSON_MERGE_PATCH and JSON_PRETTY
+		{expr: `json_merge_preserve('[1, 2]', '{"id": 47}')`, want: `JSON([1, 2, {"id": 47}])`},
+		{expr: `json_merge_preserve('{"a": 1, "b": 2}', '{"a": 3, "c": 4}')`, want: `JSON({"a": [1, 3], "b": 2, "c": 4})`},
+		{expr: `json_merge('1', '2', '[true]')`, want: "JSON([1, 2, true])"},
+		{expr: `json_merge_patch('{"a": 1, "b": 2}', '{"a": 3, "c": 4}')`, want: `JSON({"a": 3, "b": 2, "c": 4})`},
+		{expr: `json_merge_patch('{"a": 1, "b": 2}', '{"b": null}')`, want: `JSON({"a": 1})`},
+		{expr: `json_merge_patch('{"a": {"x": 1}}', '{"a": {"y": 2}}', '[1]')`, want: "JSON([1])"},
+		{expr: `json_pretty('[1, {"a": []}]')`, want: "VARCHAR([\n  1,\n  {\n    \"a\": []\n  }\n])"},
+
+		// CAST, comparisons and conversions
+		{expr: `cast('{"b": 1, "a": 2}' as json)`, want: `JSON({"a": 2, "b": 1})`},
+		{expr: `cast(1 = 1 as json)`, want: "JSON(true)"},
+		{expr: `cast(json_extract('{"a": "2024-01-02"}', '$.a') as date)`, want: "DATE(2024-01-02)"},
+		{expr: `json_extract('{"a": 2}', '$.a') = 2`, want: "INT64(1)"},
+		{expr: `json_extract('{"a": 2}', '$.a') = 2.0`, want: "INT64(1)"},
+		{expr: `json_extract('{"a": "2"}', '$.a') = 2`, want: "INT64(0)"},
+		{expr: `json_extract('{"a": "x"}', '$.a') = 'x'`, want: "INT64(1)"},
+		{expr: `cast('[1, 2]' as json) < cast('[1, 3]' as json)`, want: "INT64(1)"},
+		{expr: `cast('true' as json) > cast('{"a": 1}' as json)`, want: "INT64(1)"},
+		{expr: `json_extract('{"a": 2}', '$.a') + 1`, want: "INT64(3)"},
+		{expr: `json_extract('{"a": "2.5"}', '$.a') + 1`, want: "FLOAT64(3.5)"},
+		{expr: `concat(json_extract('{"a": "x"}', '$.a'), '')`, want: `VARCHAR("x")`},
+
+		// JSON_VALUE
+		{expr: `json_value('{"a": "12"}', '$.a')`, want: "VARCHAR(12)"},
+		{expr: `json_value('{"a": 12.5}', '$.a' returning decimal(4, 2))`, want: "DECIMAL(12.50)"},
+		{expr: `json_value('{"a": "x"}', '$.a' returning signed)`, want: "NULL"},
+		{expr: `json_value('{"a": "x"}', '$.a' returning signed default '7' on error)`, want: "INT64(7)"},
+		{expr: `json_value('{"a": "x"}', '$.b' default 'none' on empty)`, want: "VARCHAR(none)"},
+		{expr: `json_value('{"a": [1]}', '$.a' returning json)`, want: "JSON([1])"},
+		{expr: `json_value('{"a": null}', '$.a')`, want: "NULL"},
+	}
+	for _, tcase := range tcases {
+		expr, err := evalengine.Translate(mustParseExpr(t, tcase.expr), nil)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.expr, err)
+			continue
+		}
+		env := evalengine.NewExpressionEnv(nil, nil)
+		got, err := env.Evaluate(expr)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.expr, err)
+			continue
+		}
+		if show := showValue(got); show != tcase.want {
+			t.Errorf("%s: got %s, want %s", tcase.expr, show, tcase.want)
+		}
+		var codes []int
+		for _, w := range env.Warnings() {
+			codes = append(codes, w.Code)
+		}
+		if fmt.Sprint(codes) != fmt.Sprint(tcase.warnings) {
+			t.Errorf("%s: got warnings %v, want %v", tcase.expr, env.Warnings(), tcase.warnings)
+		}
+	}
+}
+
+func TestJSONFunctionErrors(t *testing.T) {
+	tcases := []struct {
+		expr string
+		err  string
+	}{
+		{`json_extract('{"a": 1', '$.a')`, `Invalid JSON text in argument 1 to function json_extract: "Missing a comma or '}' after an object member." at position 7.`},
+		{`json_extract('[1, 2] x', '$')`, `Invalid JSON text in argument 1 to function json_extract: "The document root must not be followed by other values." at position 7.`},
+		{`json_extract('{}', '$.')`, "Invalid JSON path expression. The error is around character position 2."},
+		{`json_extract('{}', 'a')`, "Invalid JSON path expression. The error is around character position 0."},
+		{`json_extract('{}', '$**')`, "Invalid JSON path expression. The error is around character position 3."},
+		{`json_extract(1, '$')`, "Invalid data type for JSON data in argument 1 to function json_extract; a JSON string or JSON type is required."},
+		{`json_set('{}', '$[*]', 1)`, "In this situation, path expressions may not contain the * and ** tokens or an array range."},
+		{`json_remove('{}', '$')`, "The path expression '$' is not allowed in this context."},
+		{`json_array_insert('[]', '$.a', 1)`, "A path expression is not a path to a cell in an array."},
+		{`json_contains_path('{}', 'some', '$')`, "The oneOrAll argument to json_contains_path may take these values: 'one' or 'all'."},
+		{`json_object('a', 1, null, 2)`, "JSON documents may not contain NULL member names."},
+		{`json_quote(1)`, "Incorrect type for argument 1 in function json_quote."},
+		{`cast('nope' as json)`, `Invalid JSON text in argument 1 to function cast_as_json: "Invalid value." at position 1.`},
+		{`cast(x'00' as json)`, "Cannot create a JSON value from a string with CHARACTER SET 'binary'."},
+		{`json_value('{"a": "x"}', '$.b' error on empty)`, "No value was found by 'json_value' on the specified path."},
+		{`json_value('[1, 2]', '$[*]' error on error)`, "More than one value was found by 'json_value' on the specified path."},
+		{`json_value('{"a": "x"}', '$.a' returning signed error on error)`, "Invalid JSON value for CAST to SIGNED from column json_value at row 1"},
+	}
+	for _, tcase := range tcases {
+		expr, err := evalengine.Translate(mustParseExpr(t, tcase.expr), nil)
+		if err == nil {
+			_, err = evalengine.NewExpressionEnv(nil, nil).Evaluate(expr)
+		}
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("%s: got %v, want %s", tcase.expr, err, tcase.err)
+		}
+	}
+}
+
+func TestJSONColumn(t *testing.T) {
+	cfg := &evalengine.Config{
+		ResolveColumn: func(*sqlparser.ColName) (int, error) { return 0, nil },
+	}
+	row := []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte(`{"name": "ann\tlee", "age": 30}`))}
+	tcases := []struct {
+		expr string
+		want string
+	}{
+		{"doc->'$.name'", `JSON("ann\tlee")`},
+		{"doc->>'$.name'", "VARCHAR(ann\tlee)"},
+		{"doc->'$.age' > 18", "INT64(1)"},
+		{"doc", `JSON({"age": 30, "name": "ann\tlee"})`},
+	}
+	for _, tcase := range tcases {
+		expr, err := evalengine.Translate(mustParseExpr(t, tcase.expr), cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := evalengine.NewExpressionEnv(nil, row).Evaluate(expr)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.expr, err)
+			continue
+		}
+		if show := showValue(got); show != tcase.want {
+			t.Errorf("%s: got %s, want %s", tcase.expr, show, tcase.want)
+		}
+	}
+}
+
+func mustTranslateJSONTable(t *testing.T, query string) *evalengine.JSONTable {
+	t.Helper()
+	sel, ok := mustParse(t, query).(*sqlparser.Select)
+	if !ok {
+		t.Fatalf("%s is not a SELECT", query)
+	}
+	jt, ok := sel.From[0].(*sqlparser.JSONTableExpr)
+	if !ok {
+		t.Fatalf("%s does not select from JSON_TABLE", query)
+	}
+	table, err := evalengine.TranslateJSONTable(jt, nil)
+	if err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+	return table
+}
+
+func showRows(rows [][]sqltypes.Value) string {
+	var lines []string
+	for _, row := range rows {
+		var values []string
+		for _, v := range row {
+			values = append(values, showValue(v))
+		}
+		lines = append(lines, strings.Join(values, " "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestJSONTable(t *testing.T) {
+	tcases := []struct {
+		query  string
+		fields string
+		rows   string
+	}{
+		{
+			query: `select * from json_table('[{"a": 1, "b": "x"}, {"a": "2"}, {"b": [1]}]', '$[*]' columns(
+				id for ordinality,
+				a int path '$.a',
+				b varchar(10) path '$.b' default '"none"' on empty,
+				has_b int exists path '$.b'
+			)) as t`,
+			fields: "id:UINT32 a:INT32 b:VARCHAR has_b:INT32",
+			rows: "UINT32(1) INT32(1) VARCHAR(x) INT32(1)\n" +
+				"UINT32(2) INT32(2) VARCHAR(none) INT32(0)\n" +
+				"UINT32(3) NULL NULL INT32(1)",
+		},
+		{
+			query: `select * from json_table('{"name": "ann", "pets": [{"n": "rex"}, {"n": "tom"}], "toys": ["ball"]}', '$' columns(
+				name varchar(10) path '$.name',
+				nested path '$.pets[*]' columns(pet_id for ordinality, pet varchar(10) path '$.n'),
+				nested path '$.toys[*]' columns(toy varchar(10) path '$')
+			)) as t`,
+			fields: "name:VARCHAR pet_id:UINT32 pet:VARCHAR toy:VARCHAR",
+			rows: "VARCHAR(ann) UINT32(1) VARCHAR(rex) NULL\n" +
+				"VARCHAR(ann) UINT32(2) VARCHAR(tom) NULL\n" +
+				"VARCHAR(ann) NULL NULL VARCHAR(ball)",
+		},
+		{
+			query: `select * from json_table('[{"a": 1}]', '$[*]' columns(
+				a int path '$.a',
+				nested path '$.none[*]' columns(b int path '$')
+			)) as t`,
+			fields: "a:INT32 b:INT32",
+			rows:   "INT32(1) NULL",
+		},
+		{
+			query: `select * from json_table('[300, -1, "x", 5, {"a": 1}]', '$[*]' columns(
+				v tinyint unsigned path '$' default '0' on error,
+				j json path '$'
+			)) as t`,
+			fields: "v:UINT8 j:JSON",
+			rows: "UINT8(0) JSON(300)\n" +
+				"UINT8(0) JSON(-1)\n" +
+				`UINT8(0) JSON("x")` + "\n" +
+				"UINT8(5) JSON(5)\n" +
+				`UINT8(0) JSON({"a": 1})`,
+		},
+		{
+			query: `select * from json_table('[{"d": "2024-01-02", "x": 1.255}]', '$[*]' columns(
+				d date path '$.d',
+				x decimal(4, 2) path '$.x'
+			)) as t`,
+			fields: "d:DATE x:DECIMAL",
+			rows:   "DATE(2024-01-02) DECIMAL(1.26)",
+		},
+		{
+			query:  `select * from json_table(null, '$[*]' columns(a int path '$')) as t`,
+			fields: "a:INT32",
+		},
+	}
+	for _, tcase := range tcases {
+		table := mustTranslateJSONTable(t, tcase.query)
+		var fields []string
+		for _, f := range table.Fields() {
+			fields = append(fields, f.Name+":"+f.Type.String())
+		}
+		if got := strings.Join(fields, " "); got != tcase.fields {
+			t.Errorf("%s: got fields %s, want %s", tcase.query, got, tcase.fields)
+		}
+		rows, err := table.Rows(evalengine.NewExpressionEnv(nil, nil))
+		if err != nil {
+			t.Errorf("%s: %v", tcase.query, err)
+			continue
+		}
+		if got := showRows(rows); got != tcase.rows {
+			t.Errorf("%s: got rows\n%s\nwant\n%s", tcase.query, got, tcase.rows)
+		}
+	}
+}
+
+func TestJSONTableErrors(t *testing.T) {
+	tcases := []struct {
+		query string
+		err   string
+	}{
+		{`select * from json_table('[1]', '$[*]' columns(a int path '$', A int path '$')) as t`, "Duplicate column name 'A'"},
+		{`select * from json_table('[1]', '$[*]' columns(a int path '$.x' error on empty)) as t`, "Missing value for JSON_TABLE column 'a'"},
+		{`select * from json_table('[[1]]', '$[*]' columns(a int path '$' error on error)) as t`, "Can't store an array or an object in the scalar column 'a' of JSON_TABLE 't'."},
+		{`select * from json_table('[300]', '$[*]' columns(a tinyint path '$' error on error)) as t`, "Value is out of range for JSON_TABLE's column 'a'"},
+		{`select * from json_table('[1]', '$[*]' columns(a int path '$' default 'x' on empty)) as t`, "Invalid default value for 'a'"},
+		{`select * from json_table('[1', '$[*]' columns(a int path '$')) as t`, `Invalid JSON text in argument 1 to function json_table: "Missing a comma or ']' after an array element." at position 2.`},
+	}
+	for _, tcase := range tcases {
+		sel := mustParse(t, tcase.query).(*sqlparser.Select)
+		table, err := evalengine.TranslateJSONTable(sel.From[0].(*sqlparser.JSONTableExpr), nil)
+		if err == nil {
+			_, err = table.Rows(evalengine.NewExpressionEnv(nil, nil))
+		}
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("%s: got %v, want %s", tcase.query, err, tcase.err)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/semantics"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+)
+
+// lineage returns the lineage of the columns of a statement run against
+// bindingSchema, as the sources of every column with their kind.
+func lineage(t *testing.T, query string) map[string]map[string]string {
+	t.Helper()
+	c := loadCatalog(t, bindingSchema)
+	stmt, err := sqlparser.NewTestParser().Parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	columns, err := semantics.Lineage(stmt, c.Schema(), "app")
+	if err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+	res := map[string]map[string]string{}
+	for _, col := range columns {
+		sources := map[string]string{}
+		for _, src := range col.SortedSources() {
+			sources[src.String()] = col.Sources[src].String()
+		}
+		res[col.Column.String()] = sources
+	}
+	return res
+}
+
+func TestLineage(t *testing.T) {
+	tcases := []struct {
+		query string
+		want  map[string]map[string]string
+	}{{
+		query: "select u.name, o.total * 2 as doubled from users u join orders o on o.user_id = u.id where u.email like '%@x'",
+		want: map[string]map[string]string{
+			"name": {
+				"app.users.name":     "direct",
+				"app.orders.user_id": "filter",
+				"app.users.id":       "filter",
+				"app.users.email":    "filter",
+			},
+			"doubled": {
+				"app.orders.total":   "transformation",
+				"app.orders.user_id": "filter",
+				"app.users.id":       "filter",
+				"app.users.email":    "filter",
+			},
+		},
+	}, {
+		query: "with big as (select user_id, total from orders where total > 100) select d.user_id, d.s from (select user_id, sum(total) as s from big group by user_id) as d",
+		want: map[string]map[string]string{
+			"user_id": {
+				"app.orders.user_id": "direct",
+				"app.orders.total":   "filter",
+			},
+			"s": {
+				"app.orders.total":   "transformation",
+				"app.orders.user_id": "filter",
+			},
+		},
+	}, {
+		query: "select name from users union all select name from items",
+		want: map[string]map[string]string{
+			"name": {
+				"app.users.name": "direct",
+				"app.items.name": "direct",
+			},
+		},
+	}, {
+		query: "select id, rank() over (partition by user_id order by total) as r from orders",
+		want: map[string]map[string]string{
+			"id": {"app.orders.id": "direct"},
+			"r": {
+				"app.orders.user_id": "transformation",
+				"app.orders.total":   "transformation",
+			},
+		},
+	}, {
+		query: "select id, (select count(*) from orders where orders.user_id = users.id) as n from users",
+		want: map[string]map[string]string{
+			"id": {"app.users.id": "direct"},
+			"n": {
+				"app.orders.user_id": "filter",
+				"app.users.id":       "filter",
+			},
+		},
+	}, {
+		query: "insert into items (order_id, name) select o.id, u.name from orders o join users u on u.id = o.user_id",
+		want: map[string]map[string]string{
+			"app.items.order_id": {
+				"app.orders.id":      "direct",
+				"app.users.id":       "filter",
+				"app.orders.user_id": "filter",
+			},
+			"app.items.name": {
+				"app.users.name":     "direct",
+				"app.users.id":       "filter",
+				"app.orders.user_id": "filter",
+			},
+		},
+	}, {
+		query: "update orders join users on users.id = orders.user_id set total = total + 1 where users.email is null",
+		want: map[string]map[string]string{
+			"app.orders.total": {
+				"app.orders.total":   "transformation",
+				"app.users.id":       "filter",
+				"app.orders.user_id": "filter",
+				"app.users.email":    "filter",
+			},
+		},
+	}, {
+		query: "create view v (who, amount) as select u.name, o.total from users u join orders o using (id)",
+		want: map[string]map[string]string{
+			"app.v.who": {
+				"app.users.name": "direct",
+				"app.users.id":   "filter",
+				"app.orders.id":  "filter",
+			},
+			"app.v.amount": {
+				"app.orders.total": "direct",
+				"app.users.id":     "filter",
+				"app.orders.id":    "filter",
+			},
+		},
+	}}
+	for _, tcase := range tcases {
+		if got := lineage(t, tcase.query); !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("%s:\ngot  %v\nwant %v", tcase.query, got, tcase.want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+)
+
+func TestParseLossless(t *testing.T) {
+	query := "SELECT  Id,\n  `Name` -- who\nFROM Users u\nWHERE u.Age > 18 AND u.Status = 'x'"
+
+	parser := sqlparser.NewTestParser()
+	stmt, tree, err := parser.ParseLossless(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tree.String(stmt); got != query {
+		t.Fatalf("untouched statement: got %q, want %q", got, query)
+	}
+
+	sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Literal:
+			if node.Val == "18" {
+				cursor.Replace(sqlparser.NewIntLiteral("21"))
+			}
+		case *sqlparser.ComparisonExpr:
+			if node.Operator == sqlparser.EqualOp {
+				cursor.Replace(&sqlparser.OrExpr{Left: node, Right: &sqlparser.IsExpr{Left: node.Left, Right: sqlparser.IsNullOp}})
+			}
+		}
+		return true
+	}, nil)
+
+	want := "SELECT  Id,\n  `Name` -- who\nFROM Users u\nWHERE u.Age > 21 AND (u.Status = 'x' or u.Status is null)"
+	if got := tree.String(stmt); got != want {
+		t.Fatalf("rewritten statement: got %q, want %q", got, want)
+	}
+
+	where := stmt.(*sqlparser.Select).Where
+	tokens := tree.Tokens(where)
+	if len(tokens) == 0 || tokens[0].Text != "WHERE" || tokens[0].Trivia != "\n" {
+		t.Fatalf("unexpected tokens for WHERE clause: %v", tokens)
+	}
+}
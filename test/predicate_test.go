@@ -0,0 +1,157 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+func TestPredicate(t *testing.T) {
+	fields := []*querypb.Field{
+		{Name: "id", Type: sqltypes.Int64, Table: "t"},
+		{Name: "name", Type: sqltypes.VarChar, Table: "t"},
+		{Name: "score", Type: sqltypes.Decimal, Table: "t"},
+		{Name: "id", Type: sqltypes.Int64, Table: "u"},
+	}
+	row := []sqltypes.Value{
+		sqltypes.NewInt64(7),
+		sqltypes.NewVarChar("50%_off"),
+		sqltypes.NULL,
+		sqltypes.NewInt64(8),
+	}
+	bindVars := map[string]*querypb.BindVariable{
+		"min":  sqltypes.Int64BindVariable(5),
+		"ids":  sqltypes.TestBindVariable([]any{1, 7, 9}),
+		"name": sqltypes.StringBindVariable("50%_OFF"),
+	}
+	tcases := []struct {
+		where string
+		want  evalengine.Truth
+	}{
+		{"t.id > :min", evalengine.True},
+		{"t.id = u.id", evalengine.False},
+		{"t.id in ::ids", evalengine.True},
+		{"u.id in ::ids", evalengine.False},
+		{"u.id not in (1, null)", evalengine.Unknown},
+		{"t.id between 7 and 8", evalengine.True},
+		{"t.id not between 1 and 10", evalengine.False},
+		{"score > 1", evalengine.Unknown},
+		{"score > 1 or t.id = 7", evalengine.True},
+		{"score > 1 and t.id = 7", evalengine.Unknown},
+		{"score > 1 and t.id = 8", evalengine.False},
+		{"not (score > 1)", evalengine.Unknown},
+		{"score is null", evalengine.True},
+		{"(score > 1) is unknown", evalengine.True},
+		{"(t.id = 7) is not true", evalengine.False},
+		{"name like '50|%|_off' escape '|'", evalengine.True},
+		{"name like '50\\%\\_%'", evalengine.True},
+		{"name like '5__off'", evalengine.False},
+		{"name = :name", evalengine.True},
+		{"name like binary :name", evalengine.False},
+	}
+	env := evalengine.NewExpressionEnv(bindVars, nil)
+	for _, tcase := range tcases {
+		p, err := evalengine.CompilePredicate(mustParseExpr(t, tcase.where), fields)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.where, err)
+			continue
+		}
+		got, err := p.Eval(env, row)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.where, err)
+			continue
+		}
+		if got != tcase.want {
+			t.Errorf("%s: got %s, want %s", tcase.where, got, tcase.want)
+		}
+		match, _ := p.Match(env, row)
+		if match != (tcase.want == evalengine.True) {
+			t.Errorf("%s: got match %v", tcase.where, match)
+		}
+	}
+}
+
+func TestPredicateErrors(t *testing.T) {
+	fields := []*querypb.Field{
+		{Name: "id", Type: sqltypes.Int64, Table: "t"},
+		{Name: "id", Type: sqltypes.Int64, Table: "u"},
+	}
+	tcases := []struct {
+		where string
+		err   string
+	}{
+		{"id = 1", "Column 'id' in where clause is ambiguous"},
+		{"t.nope = 1", "Unknown column 't.nope' in 'where clause'"},
+		{"v.id = 1", "Unknown column 'v.id' in 'where clause'"},
+	}
+	for _, tcase := range tcases {
+		_, err := evalengine.CompilePredicate(mustParseExpr(t, tcase.where), fields)
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("%s: got %v, want %s", tcase.where, err, tcase.err)
+		}
+	}
+
+	p, err := evalengine.CompilePredicate(mustParseExpr(t, "t.id = :missing"), fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Match(evalengine.NewExpressionEnv(nil, nil), []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}); err == nil {
+		t.Errorf("expected an error for a missing bind variable")
+	}
+}
+
+func TestPredicateFilter(t *testing.T) {
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"),
+		"1|ann",
+		"2|bob",
+		"3|null",
+		"4|bea",
+	)
+	p, err := evalengine.CompilePredicate(mustParseExpr(t, "name like :prefix or id = :id"), result.Fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bindVars := map[string]*querypb.BindVariable{
+		"prefix": sqltypes.StringBindVariable("b%"),
+		"id":     sqltypes.Int64BindVariable(3),
+	}
+	filtered, err := p.Filter(result, bindVars)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := sqltypes.MakeTestResult(result.Fields, "2|bob", "3|null", "4|bea")
+	if !filtered.Equal(want) {
+		t.Errorf("got %v, want %v", filtered.Rows, want.Rows)
+	}
+
+	env := evalengine.NewExpressionEnv(bindVars, nil)
+	named := sqltypes.RowNamedValues{"ID": sqltypes.NewInt64(9), "name": sqltypes.NewVarChar("bo")}
+	if ok, err := p.MatchNamed(env, named); err != nil || !ok {
+		t.Errorf("MatchNamed(%v): got %v, %v", named, ok, err)
+	}
+	if ok, err := p.MatchNamed(env, sqltypes.RowNamedValues{"id": sqltypes.NewInt64(9)}); err != nil || ok {
+		t.Errorf("MatchNamed without name: got %v, %v", ok, err)
+	}
+}
+
+func TestPredicateNow(t *testing.T) {
+	fields := []*querypb.Field{{Name: "at", Type: sqltypes.Datetime}}
+	p, err := evalengine.CompilePredicate(mustParseExpr(t, "at = now()"), fields)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := &evalengine.ExpressionEnv{Now: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+	for _, at := range []string{"2024-01-02 03:04:05", "2024-01-02 03:04:06"} {
+		row := []sqltypes.Value{sqltypes.MakeTrusted(sqltypes.Datetime, []byte(at))}
+		ok, err := p.Match(env, row)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != (at == "2024-01-02 03:04:05") {
+			t.Errorf("%s = now(): got %v", at, ok)
+		}
+	}
+}
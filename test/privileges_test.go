@@ -0,0 +1,198 @@
+package test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/semantics"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+)
+
+// required returns the privileges a statement run against bindingSchema
+// needs, as "privilege db.table(columns)".
+func required(t *testing.T, query string) []string {
+	t.Helper()
+	reqs := requirements(t, query)
+	var res []string
+	for _, req := range reqs {
+		s := string(req.Privilege)
+		for _, or := range req.Or {
+			s += "|" + string(or)
+		}
+		switch {
+		case req.Database == "":
+			s += " *.*"
+		case req.Table == "":
+			s += " " + req.Database + ".*"
+		default:
+			s += " " + req.Database + "." + req.Table
+		}
+		if req.Routine {
+			s += "()"
+		}
+		if len(req.Columns) > 0 {
+			s += "(" + strings.Join(req.Columns, ", ") + ")"
+		}
+		res = append(res, s)
+	}
+	return res
+}
+
+func requirements(t *testing.T, query string) []semantics.Requirement {
+	t.Helper()
+	stmt, err := sqlparser.NewTestParser().Parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reqs, err := semantics.Privileges(stmt, loadCatalog(t, bindingSchema).Schema(), "app")
+	if err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+	return reqs
+}
+
+func TestPrivileges(t *testing.T) {
+	tcases := []struct {
+		query string
+		want  []string
+	}{{
+		query: "select u.name from users u join orders o on o.user_id = u.id",
+		want:  []string{"select app.orders(user_id)", "select app.users(id, name)"},
+	}, {
+		query: "select * from users where id = 1 for update",
+		want:  []string{"select app.users(email, id, name)", "update|delete|lock tables app.users"},
+	}, {
+		query: "update users set name = 'x' where id = 1",
+		want:  []string{"select app.users(id)", "update app.users(name)"},
+	}, {
+		query: "insert into orders (id, user_id) values (1, 2) on duplicate key update total = values(total)",
+		want:  []string{"insert app.orders(id, user_id)", "update app.orders(total)"},
+	}, {
+		query: "delete from items where order_id = 3",
+		want:  []string{"select app.items(order_id)", "delete app.items"},
+	}, {
+		query: "select id from users into outfile '/tmp/users'",
+		want:  []string{"file *.*", "select app.users(id)"},
+	}, {
+		query: "load data infile '/tmp/t.csv' into table items",
+		want:  []string{"file *.*", "insert app.items"},
+	}, {
+		query: "load data local infile '/tmp/t.csv' into table items",
+		want:  []string{"insert app.items"},
+	}, {
+		query: "lock tables users read, orders write",
+		want:  []string{"select app.users", "lock tables app.users", "select app.orders", "lock tables app.orders"},
+	}, {
+		query: "call shop.restock(1)",
+		want:  []string{"execute shop.restock()"},
+	}, {
+		query: "create table t (id int, user_id int, foreign key (user_id) references users (id))",
+		want:  []string{"create app.t", "references app.users"},
+	}, {
+		query: "create temporary table t like users",
+		want:  []string{"create temporary tables app.*", "select app.users"},
+	}, {
+		query: "alter table users add index (name)",
+		want:  []string{"index app.users"},
+	}, {
+		query: "alter table users add column age int",
+		want:  []string{"alter app.users", "create app.users", "insert app.users"},
+	}, {
+		query: "rename table users to people",
+		want:  []string{"alter app.users", "drop app.users", "create app.people", "insert app.people"},
+	}, {
+		query: "drop table users, orders",
+		want:  []string{"drop app.users", "drop app.orders"},
+	}, {
+		query: "create or replace view v as select name from users",
+		want:  []string{"create view app.v", "drop app.v", "select app.users(name)"},
+	}, {
+		query: "drop procedure p",
+		want:  []string{"alter routine app.p()"},
+	}, {
+		query: "create database shop",
+		want:  []string{"create shop.*"},
+	}, {
+		query: "grant select (name), insert on users to 'u'@'%'",
+		want:  []string{"grant option app.users", "select app.users(name)", "insert app.users"},
+	}}
+	for _, tcase := range tcases {
+		if got := required(t, tcase.query); !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("%s:\ngot  %q\nwant %q", tcase.query, got, tcase.want)
+		}
+	}
+}
+
+func TestCheckGrants(t *testing.T) {
+	grants := semantics.NewGrants("u", "%")
+	for _, query := range []string{
+		"grant select on app.users to 'u'@'%'",
+		"grant select (id, user_id), update (total) on app.orders to 'u'@'%'",
+		"grant all privileges on shop.* to 'u'@'%'",
+		"grant execute on procedure app.refresh to 'u'@'%'",
+		"grant delete on app.items to 'other'@'%'",
+	} {
+		stmt, err := sqlparser.NewTestParser().Parse(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := grants.Apply(stmt.(*sqlparser.Grant), "app"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	grants.Grant(semantics.PrivInsert, "app", "items")
+
+	allowed := []string{
+		"select * from users",
+		"select o.id from users u join orders o on o.user_id = u.id",
+		"update orders set total = 0 where id = 1",
+		"insert into items (id, name) values (1, 'x')",
+		"select count(*) from orders",
+		"create table shop.t (id int)",
+		"drop table shop.t",
+		"call refresh()",
+	}
+	for _, query := range allowed {
+		if err := grants.Check(requirements(t, query)); err != nil {
+			t.Errorf("%s: %v", query, err)
+		}
+	}
+
+	tcases := []struct {
+		query string
+		msg   string
+	}{{
+		query: "select total from orders",
+		msg:   "SELECT command denied to user 'u'@'%' for column 'total' in table 'orders'",
+	}, {
+		query: "update orders set user_id = 1",
+		msg:   "UPDATE command denied to user 'u'@'%' for column 'user_id' in table 'orders'",
+	}, {
+		query: "delete from items",
+		msg:   "DELETE command denied to user 'u'@'%' for table 'items'",
+	}, {
+		query: "call restock()",
+		msg:   "execute command denied to user 'u'@'%' for routine 'app.restock'",
+	}, {
+		query: "create database other",
+		msg:   "Access denied for user 'u'@'%' to database 'other'",
+	}, {
+		query: "select id from users into outfile '/tmp/users'",
+		msg:   "Access denied; you need (at least one of) the FILE privilege(s) for this operation",
+	}, {
+		query: "grant select on shop.t to 'v'@'%'",
+		msg:   "GRANT command denied to user 'u'@'%' for table 't'",
+	}}
+	for _, tcase := range tcases {
+		err := grants.Check(requirements(t, tcase.query))
+		if err == nil {
+			t.Errorf("%s: no error", tcase.query)
+			continue
+		}
+		if vterrors.ErrState(err) != vterrors.AccessDeniedError || err.Error() != tcase.msg {
+			t.Errorf("%s:\ngot  %v\nwant %s", tcase.query, err, tcase.msg)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+)
+
+func TestProtoRoundTrip(t *testing.T) {
+	parser := sqlparser.NewTestParser()
+	for _, query := range roundTripQueries {
+		stmt, err := parser.Parse(query)
+		if err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+		data, err := sqlparser.MarshalProto(stmt)
+		if err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+		got, err := sqlparser.UnmarshalProtoStatement(data)
+		if err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+		if !(&sqlparser.Comparator{}).SQLNode(stmt, got) {
+			t.Errorf("%s: round trip gave %s", query, sqlparser.String(got))
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+)
+
+func TestEvaluateRegexp(t *testing.T) {
+	tcases := []struct {
+		expr string
+		want string
+	}{
+		// match types
+		{"regexp_like('ABC', 'abc')", "INT64(1)"},
+		{"regexp_like('ABC', 'abc', 'c')", "INT64(0)"},
+		{"regexp_like('ABC', 'abc', 'ci')", "INT64(1)"},
+		{"regexp_like('ABC' collate utf8mb4_bin, 'abc')", "INT64(0)"},
+		{"regexp_like('ABC' collate utf8mb4_bin, 'abc', 'i')", "INT64(1)"},
+		{"regexp_like('a\\nb', '^b')", "INT64(0)"},
+		{"regexp_like('a\\nb', '^b', 'm')", "INT64(1)"},
+		{"regexp_like('a\\nb', 'a.b')", "INT64(0)"},
+		{"regexp_like('a\\nb', 'a.b', 'n')", "INT64(1)"},
+		{"regexp_like('a\\rb', 'a.b')", "INT64(0)"},
+		{"regexp_like('a\\rb', 'a.b', 'u')", "INT64(1)"},
+		{"'abc' not regexp 'x'", "INT64(1)"},
+		{"regexp_like(null, 'a')", "NULL"},
+		{"regexp_like(_binary'abc', _binary'b')", "INT64(1)"},
+
+		// ICU syntax
+		{"regexp_like('a+b', '\\\\Qa+b\\\\E')", "INT64(1)"},
+		{"regexp_like('é', '^\\\\u00e9$')", "INT64(1)"},
+		{"regexp_like('a\\tb', 'a\\\\hb')", "INT64(1)"},
+		{"regexp_like('ab', 'a(?# a comment)b')", "INT64(1)"},
+		{"regexp_like('a1', '[[:alpha:]][[:digit:]]')", "INT64(1)"},
+
+		// positions and occurrences
+		{"regexp_instr('dog cat dog', 'dog')", "INT64(1)"},
+		{"regexp_instr('dog cat dog', 'dog', 2)", "INT64(9)"},
+		{"regexp_instr('dog cat dog', 'dog', 1, 2)", "INT64(9)"},
+		{"regexp_instr('dog cat dog', 'dog', 1, 3)", "INT64(0)"},
+		{"regexp_instr('dog cat dog', 'dog', 1, 1, 1)", "INT64(4)"},
+		{"regexp_instr('áé dog', 'dog')", "INT64(4)"},
+		{"regexp_substr('abc def ghi', '[a-z]+', 1, 3)", "VARCHAR(ghi)"},
+		{"regexp_substr('abc def ghi', '[a-z]+', 6)", "VARCHAR(ef)"},
+		{"regexp_substr('abc', 'x')", "NULL"},
+		{"regexp_replace('a b c', 'b', 'X')", "VARCHAR(a X c)"},
+		{"regexp_replace('abc abc abc', 'b', 'X', 1, 2)", "VARCHAR(abc aXc abc)"},
+		{"regexp_replace('abc abc abc', 'b', 'X', 5)", "VARCHAR(abc aXc aXc)"},
+		{"regexp_replace('John Smith', '(\\\\w+) (\\\\w+)', '$2, $1')", "VARCHAR(Smith, John)"},
+		{"regexp_replace('John Smith', '(?<first>\\\\w+) (?<last>\\\\w+)', '${last}')", "VARCHAR(Smith)"},
+		{"regexp_replace('a.b', '\\\\.', '\\\\$')", "VARCHAR(a$b)"},
+		{"regexp_replace('abc', 'x*', '-')", "VARCHAR(-a-b-c-)"},
+		{"regexp_replace(_binary'abc', _binary'b', _binary'X')", "VARBINARY(aXc)"},
+	}
+	for _, tcase := range tcases {
+		got, err := evalengine.Evaluate(mustParseExpr(t, tcase.expr), nil)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.expr, err)
+			continue
+		}
+		if show := showValue(got); show != tcase.want {
+			t.Errorf("%s: got %s, want %s", tcase.expr, show, tcase.want)
+		}
+	}
+}
+
+func TestEvaluateRegexpErrors(t *testing.T) {
+	tcases := []struct {
+		expr string
+		want string
+	}{
+		{"regexp_like('', '')", "Illegal argument to a regular expression."},
+		{"regexp_replace('a', '', 'b')", "Illegal argument to a regular expression."},
+		{"regexp_like('a', 'a', 'x')", "Invalid match mode flag in regular expression."},
+		{"regexp_like('aa', '(a)\\\\1')", "The regular expression contains a feature that is not implemented in this library: back-references."},
+		{"regexp_like('ab', 'a(?=b)')", "The regular expression contains a feature that is not implemented in this library: look-ahead assertions."},
+		{"regexp_like('ab', '(?<!a)b')", "The regular expression contains a feature that is not implemented in this library: look-behind assertions."},
+		{"regexp_like('ab', '(?>a)b')", "The regular expression contains a feature that is not implemented in this library: atomic groups."},
+		{"regexp_like('aa', 'a++')", "The regular expression contains a feature that is not implemented in this library: possessive quantifiers."},
+		{"regexp_like('a', '[a-z&&[^b]]')", "The regular expression contains a feature that is not implemented in this library: set operations in character classes."},
+		{"regexp_like('a', '(a')", "Mismatched parenthesis in regular expression."},
+		{"regexp_like('a', '[a')", "The regular expression contains an unclosed bracket expression."},
+		{"regexp_like('a', '[z-a]')", "The regular expression contains an [x-y] character range where x comes after y."},
+		{"regexp_like('a', 'a{3,1}')", "The maximum is less than the minumum in a {x,y} specifier."},
+		{"regexp_like('a', 'a**')", "Syntax error in regular expression on line 1, character 2."},
+		{"regexp_like(_binary'a', 'a')", "Character set 'binary' cannot be used in conjunction with 'utf8mb4_0900_ai_ci' in call to regexp_like."},
+		{"regexp_instr('abc', 'b', 5)", "Index out of bounds in regular expression search."},
+		{"regexp_instr('abc', 'b', 1, 1, 2)", "Incorrect arguments to regexp_instr: return_option must be 1 or 0."},
+		{"regexp_replace('abc', 'b', '$2')", "Index out of bounds in regular expression search."},
+		{"regexp_replace('abc', 'b', '${x}')", "A capture group has an invalid name."},
+	}
+	for _, tcase := range tcases {
+		_, err := evalengine.Evaluate(mustParseExpr(t, tcase.expr), nil)
+		if err == nil {
+			t.Errorf("%s: expected an error", tcase.expr)
+			continue
+		}
+		if err.Error() != tcase.want {
+			t.Errorf("%s: got %q, want %q", tcase.expr, err.Error(), tcase.want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/semantics"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+)
+
+const bindingSchema = `
+	create table users (id int primary key, name varchar(100), email varchar(100));
+	create table orders (id int primary key, user_id int, total decimal(10, 2));
+	create table items (id int primary key, order_id int, name varchar(100));
+`
+
+// bound parses a query, binds it against bindingSchema and returns the bindings
+// by the text of the column names, which must each be bound the same way.
+func bound(t *testing.T, query string) map[string]string {
+	t.Helper()
+	c := loadCatalog(t, bindingSchema)
+	stmt, err := sqlparser.NewTestParser().Parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bindings, err := semantics.Bind(stmt, c.Schema(), "app")
+	if err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+	res := map[string]string{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		col, ok := node.(*sqlparser.ColName)
+		if !ok {
+			return true, nil
+		}
+		binding := bindings[col]
+		if binding == nil {
+			t.Fatalf("%s: %s not bound", query, sqlparser.String(col))
+		}
+		var got string
+		if binding.Alias != nil {
+			got = "alias " + sqlparser.String(binding.Alias.Expr)
+		} else {
+			got = binding.Source.Name + "." + binding.Column
+			if binding.Source.Kind == semantics.BaseTable {
+				got = binding.Source.Database + "." + binding.Source.Table + "." + binding.Column
+			}
+		}
+		if binding.Outer > 0 {
+			got += " (outer)"
+		}
+		key := sqlparser.String(col)
+		if prev, ok := res[key]; ok && prev != got {
+			t.Fatalf("%s: %s bound to both %s and %s", query, key, prev, got)
+		}
+		res[key] = got
+		return true, nil
+	}, stmt)
+	return res
+}
+
+func TestBind(t *testing.T) {
+	tcases := []struct {
+		query string
+		want  map[string]string
+	}{{
+		query: "select u.name, total from users as u join orders on u.id = user_id where total > 10",
+		want: map[string]string{
+			"u.`name`": "app.users.name",
+			"total":    "app.orders.total",
+			"u.id":     "app.users.id",
+			"user_id":  "app.orders.user_id",
+		},
+	}, {
+		query: "with big as (select user_id, total as amount from orders where total > 100) select name, amount from users join big on users.id = big.user_id",
+		want: map[string]string{
+			"user_id":     "app.orders.user_id",
+			"total":       "app.orders.total",
+			"`name`":      "app.users.name",
+			"amount":      "big.amount",
+			"users.id":    "app.users.id",
+			"big.user_id": "big.user_id",
+		},
+	}, {
+		query: "select d.n from (select name as n from users) as d",
+		want: map[string]string{
+			"d.n":    "d.n",
+			"`name`": "app.users.name",
+		},
+	}, {
+		query: "select id, total from orders join items using (id)",
+		want: map[string]string{
+			"id":    "app.orders.id",
+			"total": "app.orders.total",
+		},
+	}, {
+		query: "select name from users natural join items",
+		want: map[string]string{
+			"`name`": "app.users.name",
+		},
+	}, {
+		query: "select name from users where exists (select 1 from orders where user_id = users.id and total > 0)",
+		want: map[string]string{
+			"`name`":   "app.users.name",
+			"user_id":  "app.orders.user_id",
+			"users.id": "app.users.id (outer)",
+			"total":    "app.orders.total",
+		},
+	}, {
+		query: "select user_id, sum(total) as s from orders group by user_id having s > 10 order by s desc, user_id",
+		want: map[string]string{
+			"user_id": "app.orders.user_id",
+			"total":   "app.orders.total",
+			"s":       "alias sum(total)",
+		},
+	}, {
+		query: "select id as k from users union select user_id from orders order by k",
+		want: map[string]string{
+			"id":      "app.users.id",
+			"user_id": "app.orders.user_id",
+			"k":       "alias id",
+		},
+	}, {
+		query: "with recursive r (n) as (select 1 union all select n + 1 from r where n < 5) select n from r",
+		want: map[string]string{
+			"n": "r.n",
+		},
+	}, {
+		query: "select id, row_number() over (partition by user_id order by total) from orders",
+		want: map[string]string{
+			"id":      "app.orders.id",
+			"user_id": "app.orders.user_id",
+			"total":   "app.orders.total",
+		},
+	}, {
+		query: "update users join orders on users.id = orders.user_id set email = 'x' where total > 5",
+		want: map[string]string{
+			"users.id":       "app.users.id",
+			"orders.user_id": "app.orders.user_id",
+			"email":          "app.users.email",
+			"total":          "app.orders.total",
+		},
+	}, {
+		query: "delete o from orders o join users u on o.user_id = u.id where u.email is null",
+		want: map[string]string{
+			"o.user_id": "app.orders.user_id",
+			"u.id":      "app.users.id",
+			"u.email":   "app.users.email",
+		},
+	}}
+	for _, tcase := range tcases {
+		got := bound(t, tcase.query)
+		for col, want := range tcase.want {
+			if got[col] != want {
+				t.Errorf("%s: %s bound to %q, want %q", tcase.query, col, got[col], want)
+			}
+		}
+		if len(got) != len(tcase.want) {
+			t.Errorf("%s: bound %v", tcase.query, got)
+		}
+	}
+}
+
+func TestBindErrors(t *testing.T) {
+	c := loadCatalog(t, bindingSchema)
+	tcases := []struct {
+		query string
+		state vterrors.State
+		err   string
+	}{
+		{"select nope from users", vterrors.BadFieldError, "Unknown column 'nope' in 'field list'"},
+		{"select id from users join orders on users.id = orders.user_id", vterrors.NonUniqError, "Column 'id' in field list is ambiguous"},
+		{"select * from users where u.id = 1", vterrors.BadFieldError, "Unknown column 'u.id' in 'where clause'"},
+		{"select name as n from users where n = 'x'", vterrors.BadFieldError, "Unknown column 'n' in 'where clause'"},
+		{"select * from missing", vterrors.NoSuchTable, "Table 'app.missing' doesn't exist"},
+		{"select users.id from users join orders on id = user_id", vterrors.NonUniqError, "Column 'id' in on clause is ambiguous"},
+	}
+	for _, tcase := range tcases {
+		stmt, err := sqlparser.NewTestParser().Parse(tcase.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = semantics.Bind(stmt, c.Schema(), "app")
+		if err == nil {
+			t.Errorf("%s: no error", tcase.query)
+			continue
+		}
+		if vterrors.ErrState(err) != tcase.state || err.Error() != tcase.err {
+			t.Errorf("%s: got %v, want %s", tcase.query, err, tcase.err)
+		}
+	}
+}
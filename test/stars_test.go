@@ -0,0 +1,81 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/semantics"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/vterrors"
+)
+
+func TestExpandStars(t *testing.T) {
+	tcases := []struct {
+		query string
+		want  string
+	}{{
+		query: "select * from users",
+		want:  "select users.id, users.`name`, users.email from users",
+	}, {
+		query: "select o.*, u.name from users as u join orders as o on o.user_id = u.id",
+		want:  "select o.id, o.user_id, o.total, u.`name` from users as u join orders as o on o.user_id = u.id",
+	}, {
+		query: "select * from app.users",
+		want:  "select app.users.id, app.users.`name`, app.users.email from app.users",
+	}, {
+		query: "select * from orders join items using (id)",
+		want:  "select orders.id, orders.user_id, orders.total, items.order_id, items.`name` from orders join items using (id)",
+	}, {
+		query: "select * from users natural join items",
+		want:  "select users.id, users.`name`, users.email, items.order_id from users natural join items",
+	}, {
+		query: "select * from users natural right join items",
+		want:  "select items.id, items.`name`, users.email, items.order_id from users natural right join items",
+	}, {
+		query: "select users.*, items.* from users natural join items",
+		want:  "select users.id, users.`name`, users.email, items.id, items.order_id, items.`name` from users natural join items",
+	}, {
+		query: "select * from (select name, total * 2 as double_total from users join orders on orders.user_id = users.id) as d",
+		want:  "select d.`name`, d.double_total from (select `name`, total * 2 as double_total from users join orders on orders.user_id = users.id) as d",
+	}, {
+		query: "with big(uid, amount) as (select user_id, total from orders where total > 100) select * from big",
+		want:  "with big(uid, amount) as (select user_id, total from orders where total > 100) select big.uid, big.amount from big",
+	}, {
+		query: "select * from (select * from items) as i where exists (select * from orders where orders.id = i.order_id)",
+		want:  "select i.id, i.order_id, i.`name` from (select items.id, items.order_id, items.`name` from items) as i where exists (select orders.id, orders.user_id, orders.total from orders where orders.id = i.order_id)",
+	}, {
+		query: "select count(*) from users",
+		want:  "select count(*) from users",
+	}}
+	for _, tcase := range tcases {
+		stmt, err := sqlparser.NewTestParser().Parse(tcase.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expanded, err := semantics.ExpandStars(stmt, loadCatalog(t, bindingSchema).Schema(), "app")
+		if err != nil {
+			t.Errorf("%s: %v", tcase.query, err)
+			continue
+		}
+		if got := sqlparser.String(expanded); got != tcase.want {
+			t.Errorf("%s:\ngot  %s\nwant %s", tcase.query, got, tcase.want)
+		}
+		if got := sqlparser.String(stmt); got != sqlparser.String(mustParse(t, tcase.query)) {
+			t.Errorf("%s: statement changed to %s", tcase.query, got)
+		}
+	}
+
+	stmt := mustParse(t, "select x.* from users")
+	_, err := semantics.ExpandStars(stmt, loadCatalog(t, bindingSchema).Schema(), "app")
+	if vterrors.ErrState(err) != vterrors.BadTableError {
+		t.Errorf("got %v", err)
+	}
+}
+
+func mustParse(t *testing.T, query string) sqlparser.Statement {
+	t.Helper()
+	stmt, err := sqlparser.NewTestParser().Parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stmt
+}
@@ -0,0 +1,200 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/vedadiyan/sqlparser/pkg/evalengine"
+)
+
+func TestTemporalFunctions(t *testing.T) {
+	tcases := []struct {
+		expr     string
+		want     string
+		warnings []int
+	}{
+		// DATE_ADD, DATE_SUB and the other interval additions
+		{expr: "date_add(date'2024-01-31', interval 1 month)", want: "DATE(2024-02-29)"},
+		{expr: "date_add('2024-01-31', interval 1 month)", want: "VARCHAR(2024-02-29)"},
+		{expr: "date_add('2024-01-31', interval 1 hour)", want: "VARCHAR(2024-01-31 01:00:00)"},
+		{expr: "date_add(timestamp'2024-12-31 23:59:59', interval 1 second)", want: "DATETIME(2025-01-01 00:00:00)"},
+		{expr: "date_sub(date'2024-03-01', interval 1 day)", want: "DATE(2024-02-29)"},
+		{expr: "date_add(date'2024-02-29', interval 1 year)", want: "DATE(2025-02-28)"},
+		{expr: "date_add(date'2024-01-01', interval '1 2' day_hour)", want: "DATETIME(2024-01-02 02:00:00)"},
+		{expr: "date_add('2024-01-01 10:00:00', interval '1.5' second)", want: "VARCHAR(2024-01-01 10:00:01.500000)"},
+		{expr: "date_add(20240101, interval 1 week)", want: "VARCHAR(2024-01-08)"},
+		{expr: "date_add(time'10:00:00', interval 30 minute)", want: "TIME(10:30:00)"},
+		{expr: "time'01:00:00' - interval 2 hour", want: "TIME(-01:00:00)"},
+		{expr: "time'830:00:00' + interval 10 hour", want: "NULL", warnings: []int{1441}},
+		{expr: "date'2024-01-01' + interval 2 quarter", want: "DATE(2024-07-01)"},
+		{expr: "date'2024-01-01' - interval 1 day", want: "DATE(2023-12-31)"},
+		{expr: "adddate(date'2024-01-01', 10)", want: "DATE(2024-01-11)"},
+		{expr: "subdate(date'2024-01-01', interval 1 month)", want: "DATE(2023-12-01)"},
+		{expr: "timestampadd(minute, 90, timestamp'2024-01-01 00:00:00')", want: "DATETIME(2024-01-01 01:30:00)"},
+		{expr: "date_add(date'9999-12-31', interval 1 day)", want: "NULL", warnings: []int{1441}},
+		{expr: "date_add('not a date', interval 1 day)", want: "NULL", warnings: []int{1292}},
+		{expr: "date_add(null, interval 1 day)", want: "NULL"},
+		{expr: "date_add(date'2024-01-01', interval null day)", want: "NULL"},
+
+		// DATE_FORMAT and STR_TO_DATE
+		{expr: "date_format(timestamp'2024-03-05 14:07:09', '%Y-%m-%d %H:%i:%s')", want: "VARCHAR(2024-03-05 14:07:09)"},
+		{expr: "date_format(date'2024-03-05', '%W %M %D %y')", want: "VARCHAR(Tuesday March 5th 24)"},
+		{expr: "date_format('2024-03-05 14:07:09.25', '%r %f %j')", want: "VARCHAR(02:07:09 PM 250000 065)"},
+		{expr: "date_format('2024-13-01', '%Y')", want: "NULL", warnings: []int{1292}},
+		{expr: "str_to_date('05/03/2024', '%d/%m/%Y')", want: "DATE(2024-03-05)"},
+		{expr: "str_to_date('March 5, 2024', '%M %e, %Y')", want: "DATE(2024-03-05)"},
+		{expr: "str_to_date('Mar 05 2024 2:07:09 PM', '%b %d %Y %r')", want: "DATETIME(2024-03-05 14:07:09)"},
+		{expr: "str_to_date('14:07:09.5', '%H:%i:%s.%f')", want: "TIME(14:07:09.500000)"},
+		{expr: "str_to_date('2024 065', '%Y %j')", want: "DATE(2024-03-05)"},
+		{expr: "str_to_date('01,5,2013', '%d,%m,%Y')", want: "DATE(2013-05-01)"},
+		{expr: "str_to_date('2013-5-1', '%Y-%m-%d')", want: "DATE(2013-05-01)"},
+		{expr: "str_to_date('2013-05-01 9:5:7', '%Y-%m-%d %H:%i:%s')", want: "DATETIME(2013-05-01 09:05:07)"},
+		{expr: "str_to_date('2024-02-30', '%Y-%m-%d')", want: "NULL", warnings: []int{1411}},
+		{expr: "str_to_date('hello', '%Y')", want: "NULL", warnings: []int{1411}},
+
+		// STR_TO_DATE examples of the MySQL reference manual
+		{expr: "str_to_date('May 1, 2013', '%M %d,%Y')", want: "DATE(2013-05-01)"},
+		{expr: "str_to_date('a09:30:17', 'a%h:%i:%s')", want: "TIME(09:30:17)"},
+		{expr: "str_to_date('a09:30:17', '%h:%i:%s')", want: "NULL", warnings: []int{1411}},
+		{expr: "str_to_date('09:30:17a', '%h:%i:%s')", want: "TIME(09:30:17)", warnings: []int{1292}},
+		{expr: "str_to_date('abc', 'abc')", want: "DATE(0000-00-00)"},
+		{expr: "str_to_date('9', '%m')", want: "DATE(0000-09-00)"},
+		{expr: "str_to_date('9', '%s')", want: "TIME(00:00:09)"},
+		{expr: "str_to_date('200442 Monday', '%X%V %W')", want: "DATE(2004-10-18)"},
+		{expr: "str_to_date('2013-05-01 extra', '%Y-%m-%d')", want: "DATE(2013-05-01)", warnings: []int{1292}},
+		{expr: "str_to_date('2013-05-01   ', '%Y-%m-%d')", want: "DATE(2013-05-01)"},
+		{expr: "str_to_date('2013-05', '%Y-%m-%d')", want: "DATE(2013-05-00)"},
+		{expr: "str_to_date('12:15:00', '%h:%i:%s')", want: "TIME(00:15:00)"},
+		{expr: "str_to_date('12:15:00 PM', '%h:%i:%s %p')", want: "TIME(12:15:00)"},
+		{expr: "str_to_date('0:15:00', '%h:%i:%s')", want: "NULL", warnings: []int{1411}},
+		{expr: "str_to_date('2004 42 1', '%x %v %w')", want: "DATE(2004-10-11)"},
+		{expr: "str_to_date('2004 42 Monday', '%Y %U %W')", want: "DATE(2004-10-18)"},
+		{expr: "str_to_date('2004 42 Mon', '%Y %u %a')", want: "DATE(2004-10-11)"},
+		{expr: "str_to_date('2004 42 Monday', '%Y %V %W')", want: "NULL", warnings: []int{1411}},
+		{expr: "str_to_date('2004 42 Monday', '%x %V %W')", want: "NULL", warnings: []int{1411}},
+
+		// TIMESTAMPDIFF
+		{expr: "timestampdiff(day, '2024-01-01', '2024-03-01')", want: "INT64(60)"},
+		{expr: "timestampdiff(month, '2024-01-31', '2024-02-29')", want: "INT64(0)"},
+		{expr: "timestampdiff(month, '2024-01-15', '2024-03-15')", want: "INT64(2)"},
+		{expr: "timestampdiff(month, '2024-03-15', '2024-01-15 00:00:01')", want: "INT64(-1)"},
+		{expr: "timestampdiff(year, '2000-02-29', '2024-02-28')", want: "INT64(23)"},
+		{expr: "timestampdiff(quarter, '2024-01-01', '2024-12-31')", want: "INT64(3)"},
+		{expr: "timestampdiff(hour, '2024-01-01 10:00:00', '2024-01-01 08:30:00')", want: "INT64(-1)"},
+		{expr: "timestampdiff(second, '2024-01-01', '2024-01-02')", want: "INT64(86400)"},
+		{expr: "timestampdiff(week, '2024-01-01', '2024-01-15')", want: "INT64(2)"},
+		{expr: "timestampdiff(microsecond, '2024-01-01 00:00:00', '2024-01-01 00:00:00.000123')", want: "INT64(123)"},
+		{expr: "timestampdiff(day, 'bogus', '2024-01-01')", want: "NULL", warnings: []int{1292}},
+
+		// EXTRACT
+		{expr: "extract(year from '2024-03-05 14:07:09')", want: "INT64(2024)"},
+		{expr: "extract(quarter from date'2024-08-01')", want: "INT64(3)"},
+		{expr: "extract(week from date'2024-03-05')", want: "INT64(9)"},
+		{expr: "extract(year_month from date'2024-03-05')", want: "INT64(202403)"},
+		{expr: "extract(day_minute from '2024-03-05 14:07:09')", want: "INT64(51407)"},
+		{expr: "extract(hour from time'-838:00:00')", want: "INT64(-838)"},
+		{expr: "extract(minute_second from '14:07:09')", want: "INT64(709)"},
+		{expr: "extract(second_microsecond from '14:07:09.5')", want: "INT64(9500000)"},
+		{expr: "extract(day from 'nope')", want: "NULL", warnings: []int{1292}},
+
+		// CONVERT_TZ
+		{expr: "convert_tz('2024-01-01 12:00:00', '+00:00', '+10:30')", want: "DATETIME(2024-01-01 22:30:00)"},
+		{expr: "convert_tz('2024-07-01 12:00:00', 'UTC', 'Europe/Berlin')", want: "DATETIME(2024-07-01 14:00:00)"},
+		{expr: "convert_tz('1960-01-01 12:00:00', '+00:00', '+01:00')", want: "DATETIME(1960-01-01 12:00:00)"},
+		{expr: "convert_tz('2024-01-01 12:00:00', '+00:00', 'Nowhere/Special')", want: "NULL"},
+
+		// UNIX_TIMESTAMP and FROM_UNIXTIME
+		{expr: "unix_timestamp('2024-01-01 00:00:00')", want: "INT64(1704067200)"},
+		{expr: "unix_timestamp('2024-01-01 00:00:00.25')", want: "DECIMAL(1704067200.25)"},
+		{expr: "unix_timestamp(date'1969-12-31')", want: "INT64(0)"},
+		{expr: "unix_timestamp('garbage')", want: "NULL", warnings: []int{1292}},
+		{expr: "from_unixtime(1704067200)", want: "DATETIME(2024-01-01 00:00:00)"},
+		{expr: "from_unixtime(1704067200.5)", want: "DATETIME(2024-01-01 00:00:00.5)"},
+		{expr: "from_unixtime(1704067200, '%Y %M')", want: "VARCHAR(2024 January)"},
+		{expr: "from_unixtime(-1)", want: "NULL"},
+
+		// WEEK and YEARWEEK
+		{expr: "week(date'2024-01-01')", want: "INT64(0)"},
+		{expr: "week(date'2024-01-01', 1)", want: "INT64(1)"},
+		{expr: "week(date'2024-12-31', 3)", want: "INT64(1)"},
+		{expr: "yearweek(date'2024-01-01')", want: "INT64(202353)"},
+		{expr: "yearweek(date'2024-01-01', 1)", want: "INT64(202401)"},
+		{expr: "week('0000-00-00')", want: "NULL"},
+		{expr: "week('xyz')", want: "NULL", warnings: []int{1292}},
+
+		// LAST_DAY, MAKEDATE and PERIOD_ADD
+		{expr: "last_day('2024-02-10')", want: "DATE(2024-02-29)"},
+		{expr: "last_day(timestamp'2023-12-05 10:00:00')", want: "DATE(2023-12-31)"},
+		{expr: "last_day('2024-13-01')", want: "NULL", warnings: []int{1292}},
+		{expr: "makedate(2024, 60)", want: "DATE(2024-02-29)"},
+		{expr: "makedate(24, 1)", want: "DATE(2024-01-01)"},
+		{expr: "makedate(2023, 366)", want: "DATE(2024-01-01)"},
+		{expr: "makedate(2024, 0)", want: "NULL"},
+		{expr: "makedate(9999, 366)", want: "NULL"},
+		{expr: "period_add(202401, 11)", want: "INT64(202412)"},
+		{expr: "period_add(2401, -1)", want: "INT64(202312)"},
+	}
+	for _, tcase := range tcases {
+		expr, err := evalengine.Translate(mustParseExpr(t, tcase.expr), nil)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.expr, err)
+			continue
+		}
+		env := evalengine.NewExpressionEnv(nil, nil)
+		got, err := env.Evaluate(expr)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.expr, err)
+			continue
+		}
+		if show := showValue(got); show != tcase.want {
+			t.Errorf("%s: got %s, want %s", tcase.expr, show, tcase.want)
+		}
+		var codes []int
+		for _, w := range env.Warnings() {
+			codes = append(codes, w.Code)
+		}
+		if fmt.Sprint(codes) != fmt.Sprint(tcase.warnings) {
+			t.Errorf("%s: got warnings %v, want %v", tcase.expr, env.Warnings(), tcase.warnings)
+		}
+	}
+}
+
+func TestTemporalFunctionsTimeZone(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	tcases := []struct {
+		expr string
+		want string
+	}{
+		{"unix_timestamp('2024-01-01 00:00:00')", "INT64(1704085200)"},
+		{"from_unixtime(1704085200)", "DATETIME(2024-01-01 00:00:00)"},
+		{"unix_timestamp()", "INT64(1704085200)"},
+	}
+	for _, tcase := range tcases {
+		expr, err := evalengine.Translate(mustParseExpr(t, tcase.expr), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		env := evalengine.NewExpressionEnv(nil, nil)
+		env.TimeZone = loc
+		env.Now = time.Unix(1704085200, 0)
+		got, err := env.Evaluate(expr)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.expr, err)
+			continue
+		}
+		if show := showValue(got); show != tcase.want {
+			t.Errorf("%s: got %s, want %s", tcase.expr, show, tcase.want)
+		}
+	}
+}
+
+func TestPeriodAddErrors(t *testing.T) {
+	_, err := evalengine.Evaluate(mustParseExpr(t, "period_add(202413, 1)"), nil)
+	if err == nil || err.Error() != "Incorrect arguments to period_add" {
+		t.Errorf("period_add(202413, 1): got %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package test
+
+import (
+	"testing"
+
+	querypb "github.com/vedadiyan/sqlparser/pkg/query"
+	"github.com/vedadiyan/sqlparser/pkg/semantics"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+const typingSchema = `
+	create table t (
+		id int unsigned not null primary key,
+		n bigint,
+		price decimal(10, 2) not null,
+		rate decimal(6, 4),
+		f double,
+		name varchar(20) collate utf8mb4_bin,
+		note text,
+		d date,
+		ts datetime(3),
+		doc json
+	) default charset = latin1;
+	create table u (id int primary key, t_id int unsigned)
+`
+
+func fields(t *testing.T, query string) []semantics.Field {
+	t.Helper()
+	c := loadCatalog(t, typingSchema)
+	stmt, err := sqlparser.NewTestParser().Parse(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := semantics.InferTypes(stmt, c.Schema(), "app")
+	if err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+	return info.Fields
+}
+
+func TestInferTypes(t *testing.T) {
+	tcases := []struct {
+		expr string
+		want semantics.Type
+	}{
+		{"id", semantics.Type{Type: sqltypes.Uint32, Size: 10}},
+		{"name", semantics.Type{Type: sqltypes.VarChar, Nullable: true, Collation: "utf8mb4_bin", Size: 20}},
+		{"note", semantics.Type{Type: sqltypes.Text, Nullable: true, Collation: "latin1_swedish_ci", Size: 65535}},
+		{"ts", semantics.Type{Type: sqltypes.Datetime, Nullable: true, Size: 23, Scale: 3}},
+		{"id + 1", semantics.Type{Type: sqltypes.Uint64, Size: 20}},
+		{"n - 1", semantics.Type{Type: sqltypes.Int64, Nullable: true, Size: 20}},
+		{"price + rate", semantics.Type{Type: sqltypes.Decimal, Nullable: true, Size: 13, Scale: 4}},
+		{"price * rate", semantics.Type{Type: sqltypes.Decimal, Nullable: true, Size: 16, Scale: 6}},
+		{"price / 3", semantics.Type{Type: sqltypes.Decimal, Nullable: true, Size: 14, Scale: 6}},
+		{"price + 1.5", semantics.Type{Type: sqltypes.Decimal, Size: 11, Scale: 2}},
+		{"price + f", semantics.Type{Type: sqltypes.Float64, Nullable: true, Size: 22}},
+		{"name + 1", semantics.Type{Type: sqltypes.Float64, Nullable: true, Size: 22}},
+		{"id div 2", semantics.Type{Type: sqltypes.Uint64, Nullable: true, Size: 20}},
+		{"id = 1", semantics.Type{Type: sqltypes.Int64, Size: 1}},
+		{"n <=> null", semantics.Type{Type: sqltypes.Int64, Size: 1}},
+		{"case when id > 1 then price else n end", semantics.Type{Type: sqltypes.Decimal, Nullable: true, Size: 21, Scale: 2}},
+		{"case when id > 1 then id end", semantics.Type{Type: sqltypes.Uint32, Nullable: true, Size: 10}},
+		{"coalesce(n, id)", semantics.Type{Type: sqltypes.Int64, Size: 20}},
+		{"coalesce(id, price)", semantics.Type{Type: sqltypes.Decimal, Size: 12, Scale: 2}},
+		{"ifnull(name, 'x')", semantics.Type{Type: sqltypes.VarChar, Collation: "utf8mb4_bin", Size: 20}},
+		{"if(id > 1, d, ts)", semantics.Type{Type: sqltypes.Datetime, Nullable: true, Size: 23, Scale: 3}},
+		{"concat(name, id)", semantics.Type{Type: sqltypes.VarChar, Nullable: true, Collation: "utf8mb4_bin", Size: 30}},
+		{"date_add(d, interval 1 day)", semantics.Type{Type: sqltypes.Date, Nullable: true, Size: 10}},
+		{"d + interval 1 hour", semantics.Type{Type: sqltypes.Datetime, Nullable: true, Size: 19}},
+		{"now(6)", semantics.Type{Type: sqltypes.Datetime, Size: 26, Scale: 6}},
+		{"year(d)", semantics.Type{Type: sqltypes.Int64, Nullable: true, Size: 20}},
+		{"doc->'$.a'", semantics.Type{Type: sqltypes.TypeJSON, Nullable: true, Collation: "utf8mb4_bin", Size: 4294967295}},
+		{"doc->>'$.a'", semantics.Type{Type: sqltypes.Text, Nullable: true, Collation: "utf8mb4_bin", Size: 4294967295}},
+		{"json_length(doc)", semantics.Type{Type: sqltypes.Int64, Nullable: true, Size: 20}},
+		{"cast(n as char(5))", semantics.Type{Type: sqltypes.VarChar, Nullable: true, Collation: "utf8mb4_0900_ai_ci", Size: 5}},
+		{"cast(name as decimal(8, 3))", semantics.Type{Type: sqltypes.Decimal, Nullable: true, Size: 8, Scale: 3}},
+		{"(select max(u.id) from u)", semantics.Type{Type: sqltypes.Int32, Nullable: true, Size: 11}},
+	}
+	for _, tcase := range tcases {
+		got := fields(t, "select "+tcase.expr+" from t")
+		if len(got) != 1 || got[0].Type != tcase.want {
+			t.Errorf("%s: got %+v, want %+v", tcase.expr, got, tcase.want)
+		}
+	}
+}
+
+func TestInferTypesAggregates(t *testing.T) {
+	got := fields(t, "select count(*), sum(price), avg(id), min(name), group_concat(name) from t")
+	want := []querypb.Type{sqltypes.Int64, sqltypes.Decimal, sqltypes.Decimal, sqltypes.VarChar, sqltypes.Text}
+	for i, f := range got {
+		if f.Type.Type != want[i] {
+			t.Errorf("%s: got %v, want %v", f.Name, f.Type.Type, want[i])
+		}
+	}
+	if got[0].Type.Nullable || !got[1].Type.Nullable {
+		t.Errorf("nullability %+v", got)
+	}
+	if sum := got[1].Type; sum.Size != 32 || sum.Scale != 2 {
+		t.Errorf("sum %+v", sum)
+	}
+	if avg := got[2].Type; avg.Size != 14 || avg.Scale != 4 {
+		t.Errorf("avg %+v", avg)
+	}
+}
+
+func TestInferTypesSources(t *testing.T) {
+	// The columns of the inner side of an outer join can be NULL.
+	got := fields(t, "select t.id, u.id from t left join u on u.t_id = t.id")
+	if got[0].Type.Nullable || !got[1].Type.Nullable {
+		t.Errorf("left join %+v", got)
+	}
+
+	got = fields(t, "with c as (select id, price * 2 as p from t) select d.* from (select p, id from c) as d")
+	if len(got) != 2 || got[0].Name != "p" || got[0].Type.Type != sqltypes.Decimal || got[1].Type.Type != sqltypes.Uint32 {
+		t.Errorf("derived tables %+v", got)
+	}
+
+	got = fields(t, "select id from t union select n from t")
+	if got[0].Type.Type != sqltypes.Int64 || !got[0].Type.Nullable {
+		t.Errorf("union %+v", got)
+	}
+
+	got = fields(t, "with recursive r (k) as (select 1 union all select k + 1 from r where k < 5) select k from r")
+	if got[0].Type.Type != sqltypes.Int64 {
+		t.Errorf("recursive %+v", got)
+	}
+
+	got = fields(t, "select * from t")
+	if len(got) != 10 || got[5].Name != "name" || got[5].Type.Collation != "utf8mb4_bin" {
+		t.Errorf("star %+v", got)
+	}
+}
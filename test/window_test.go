@@ -0,0 +1,183 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vedadiyan/sqlparser/pkg/executor"
+	"github.com/vedadiyan/sqlparser/pkg/sqlparser"
+	"github.com/vedadiyan/sqlparser/pkg/sqltypes"
+)
+
+func executeWindowQuery(t *testing.T, query string) (*sqltypes.Result, error) {
+	t.Helper()
+	tables := executor.Tables{
+		"sales": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("id|region|amount|day", "int64|varchar|int64|date"),
+			"1|east|10|2024-01-01",
+			"2|east|20|2024-01-02",
+			"3|east|20|2024-01-04",
+			"4|west|5|2024-01-01",
+			"5|west|null|2024-01-03",
+			"6|west|15|2024-01-05",
+		),
+	}
+	return executor.Execute(mustParse(t, query).(sqlparser.TableStatement), tables, nil)
+}
+
+func TestWindowFunctions(t *testing.T) {
+	tcases := []struct {
+		query string
+		want  string
+	}{
+		// ranking
+		{
+			"select id, row_number() over w, rank() over w, dense_rank() over w from sales window w as (partition by region order by amount) order by id",
+			"INT64(1) UINT64(1) UINT64(1) UINT64(1); INT64(2) UINT64(2) UINT64(2) UINT64(2); INT64(3) UINT64(3) UINT64(2) UINT64(2); INT64(4) UINT64(2) UINT64(2) UINT64(2); INT64(5) UINT64(1) UINT64(1) UINT64(1); INT64(6) UINT64(3) UINT64(3) UINT64(3)",
+		},
+		{
+			"select id, percent_rank() over (order by amount), cume_dist() over (order by amount) from sales order by id",
+			"INT64(1) FLOAT64(0.4) FLOAT64(0.5); INT64(2) FLOAT64(0.8) FLOAT64(1); INT64(3) FLOAT64(0.8) FLOAT64(1); INT64(4) FLOAT64(0.2) FLOAT64(0.3333333333333333); INT64(5) FLOAT64(0) FLOAT64(0.16666666666666666); INT64(6) FLOAT64(0.6) FLOAT64(0.6666666666666666)",
+		},
+		{
+			"select id, ntile(4) over (order by id) from sales",
+			"INT64(1) UINT64(1); INT64(2) UINT64(1); INT64(3) UINT64(2); INT64(4) UINT64(2); INT64(5) UINT64(3); INT64(6) UINT64(4)",
+		},
+
+		// frames
+		{
+			"select id, sum(amount) over (partition by region order by day) from sales order by id",
+			"INT64(1) DECIMAL(10); INT64(2) DECIMAL(30); INT64(3) DECIMAL(50); INT64(4) DECIMAL(5); INT64(5) DECIMAL(5); INT64(6) DECIMAL(20)",
+		},
+		{
+			"select id, sum(amount) over (order by amount) from sales order by id",
+			"INT64(1) DECIMAL(15); INT64(2) DECIMAL(70); INT64(3) DECIMAL(70); INT64(4) DECIMAL(5); INT64(5) NULL; INT64(6) DECIMAL(30)",
+		},
+		{
+			"select id, sum(amount) over (order by id rows between 1 preceding and 1 following) from sales",
+			"INT64(1) DECIMAL(30); INT64(2) DECIMAL(50); INT64(3) DECIMAL(45); INT64(4) DECIMAL(25); INT64(5) DECIMAL(20); INT64(6) DECIMAL(15)",
+		},
+		{
+			"select id, count(*) over (order by id rows between 3 following and unbounded following) from sales",
+			"INT64(1) INT64(3); INT64(2) INT64(2); INT64(3) INT64(1); INT64(4) INT64(0); INT64(5) INT64(0); INT64(6) INT64(0)",
+		},
+		{
+			"select id, count(*) over (order by amount groups between 1 preceding and current row) from sales order by id",
+			"INT64(1) INT64(2); INT64(2) INT64(3); INT64(3) INT64(3); INT64(4) INT64(2); INT64(5) INT64(1); INT64(6) INT64(2)",
+		},
+		{
+			"select id, sum(amount) over (order by amount range between 5 preceding and 5 following) from sales order by id",
+			"INT64(1) DECIMAL(30); INT64(2) DECIMAL(55); INT64(3) DECIMAL(55); INT64(4) DECIMAL(15); INT64(5) NULL; INT64(6) DECIMAL(65)",
+		},
+		{
+			"select id, sum(amount) over (order by amount desc range between 5 preceding and current row) from sales order by id",
+			"INT64(1) DECIMAL(25); INT64(2) DECIMAL(40); INT64(3) DECIMAL(40); INT64(4) DECIMAL(15); INT64(5) NULL; INT64(6) DECIMAL(55)",
+		},
+		{
+			"select id, count(*) over (order by day range between interval 1 day preceding and current row) from sales order by id",
+			"INT64(1) INT64(2); INT64(2) INT64(3); INT64(3) INT64(2); INT64(4) INT64(2); INT64(5) INT64(2); INT64(6) INT64(2)",
+		},
+
+		// values of other rows
+		{
+			"select id, lag(amount) over w, lead(amount, 2, -1) over w from sales window w as (order by id)",
+			"INT64(1) NULL INT64(20); INT64(2) INT64(10) INT64(5); INT64(3) INT64(20) NULL; INT64(4) INT64(20) INT64(15); INT64(5) INT64(5) INT64(-1); INT64(6) NULL INT64(-1)",
+		},
+		{
+			"select id, first_value(amount) over w, last_value(amount) over w, nth_value(amount, 2) over w from sales window w as (partition by region order by id) order by id",
+			"INT64(1) INT64(10) INT64(10) NULL; INT64(2) INT64(10) INT64(20) INT64(20); INT64(3) INT64(10) INT64(20) INT64(20); INT64(4) INT64(5) INT64(5) NULL; INT64(5) INT64(5) NULL NULL; INT64(6) INT64(5) INT64(15) NULL",
+		},
+
+		// named windows
+		{
+			"select id, sum(amount) over (w order by id rows unbounded preceding) from sales window w as (partition by region) order by id",
+			"INT64(1) DECIMAL(10); INT64(2) DECIMAL(30); INT64(3) DECIMAL(50); INT64(4) DECIMAL(5); INT64(5) DECIMAL(5); INT64(6) DECIMAL(20)",
+		},
+		{
+			"select id, count(*) over w2 from sales window w1 as (partition by region), window w2 as (w1 order by id) order by id",
+			"INT64(1) INT64(1); INT64(2) INT64(2); INT64(3) INT64(3); INT64(4) INT64(1); INT64(5) INT64(2); INT64(6) INT64(3)",
+		},
+
+		// with groups and ordering
+		{
+			"select region, sum(amount), sum(sum(amount)) over (), rank() over (order by sum(amount) desc) from sales group by region order by region",
+			"VARCHAR(east) DECIMAL(50) DECIMAL(70) UINT64(1); VARCHAR(west) DECIMAL(20) DECIMAL(70) UINT64(2)",
+		},
+		{
+			"select id, row_number() over (order by amount desc) rn from sales order by rn limit 2",
+			"INT64(2) UINT64(1); INT64(3) UINT64(2)",
+		},
+		{
+			"select id from sales order by row_number() over (order by id desc) limit 1",
+			"INT64(6)",
+		},
+	}
+	for _, tcase := range tcases {
+		result, err := executeWindowQuery(t, tcase.query)
+		if err != nil {
+			t.Errorf("%s: %v", tcase.query, err)
+			continue
+		}
+		var rows []string
+		for _, row := range result.Rows {
+			rows = append(rows, showRows([][]sqltypes.Value{row}))
+		}
+		if got := strings.Join(rows, "; "); got != tcase.want {
+			t.Errorf("%s:\n got %s\nwant %s", tcase.query, got, tcase.want)
+		}
+	}
+}
+
+func TestWindowFields(t *testing.T) {
+	result, err := executeWindowQuery(t, "select row_number() over w as rn, avg(amount) over w, lag(day) over w from sales window w as (order by id)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range result.Fields {
+		got = append(got, f.Name+":"+f.Type.String())
+	}
+	want := "rn:UINT64 avg(amount) over w:DECIMAL lag(`day`) over w:DATE"
+	if strings.Join(got, " ") != want {
+		t.Errorf("got %s, want %s", strings.Join(got, " "), want)
+	}
+}
+
+func TestWindowErrors(t *testing.T) {
+	tcases := []struct {
+		query string
+		err   string
+	}{
+		{"select id from sales where row_number() over () > 1", "You cannot use the window function 'row_number' in this context."},
+		{"select region from sales group by region having rank() over () = 1", "You cannot use the window function 'rank' in this context."},
+		{"select sum(row_number() over ()) from sales", "You cannot use the window function 'row_number' in this context."},
+		{"select rank() over w from sales", "Window name 'w' is not defined."},
+		{"select id from sales window w as (), window w as ()", "Window 'w' is defined twice."},
+		{"select count(*) over w from sales window w as (w2), window w2 as (w)", "There is a circularity in the window dependency graph."},
+		{"select sum(amount) over (w partition by id) from sales window w as (order by id)", "A window which depends on another cannot define partitioning."},
+		{"select sum(amount) over (w order by id) from sales window w as (order by day)", "Window '<unnamed window>' cannot inherit 'w' since both contain an ORDER BY clause."},
+		{"select sum(amount) over w2 from sales window w as (order by id rows 1 preceding), window w2 as (w)", "Window 'w' has a frame definition, so cannot be referenced by another window."},
+		{"select sum(amount) over (order by region range 1 preceding) from sales", "requires exactly one ORDER BY expression, of numeric or temporal type"},
+		{"select sum(amount) over (order by day range 1 preceding) from sales", "Only INTERVAL bound value allowed."},
+		{"select sum(amount) over (order by id range interval 1 day preceding) from sales", "INTERVAL bound value not allowed."},
+		{"select sum(amount) over (order by id rows interval 1 day preceding) from sales", "INTERVAL can only be used with RANGE frames."},
+		{"select sum(amount) over (order by id rows between unbounded following and current row) from sales", "frame start cannot be UNBOUNDED FOLLOWING."},
+		{"select ntile(0) over () from sales", "Incorrect arguments to ntile"},
+		{"select count(distinct amount) over () from sales", "count(DISTINCT ...) as a window function"},
+		{"select first_value(amount) ignore nulls over () from sales", "IGNORE NULLS"},
+	}
+	for _, tcase := range tcases {
+		_, err := executeWindowQuery(t, tcase.query)
+		if err == nil || !strings.Contains(err.Error(), tcase.err) {
+			t.Errorf("%s: got %v, want %s", tcase.query, err, tcase.err)
+		}
+	}
+}
+
+func TestParseGroupsFrame(t *testing.T) {
+	stmt := mustParse(t, "select sum(a) over (order by b groups between 1 preceding and current row) from t")
+	want := "select sum(a) over ( order by b asc groups between 1 preceding and current row) from t"
+	if got := sqlparser.String(stmt); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}